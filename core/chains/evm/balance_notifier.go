@@ -0,0 +1,38 @@
+package evm
+
+import (
+	"encoding/json"
+	"math/big"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// newBalanceNotifier returns a monitor.BalanceListener notifying the balance
+// changes of a chain's keys on pg.ChannelEthKeyBalanceChanged. It is called by
+// the balance monitor's worker, so notifying does not block heads.
+func newBalanceNotifier(chainID *big.Int, eventBroadcaster pg.EventBroadcaster, lggr logger.Logger) monitor.BalanceListener {
+	lggr = lggr.Named("BalanceNotifier")
+	return func(address gethCommon.Address, previous *assets.Eth, balance assets.Eth) {
+		change := pg.EthKeyBalanceChanged{
+			EVMChainID: chainID.String(),
+			Address:    address.Hex(),
+			Balance:    balance.ToInt().String(),
+		}
+		if previous != nil {
+			change.PreviousBalance = previous.ToInt().String()
+		}
+		payload, err := json.Marshal(change)
+		if err != nil {
+			lggr.Errorw("Failed to serialize key balance change", "err", err)
+			return
+		}
+		if err = eventBroadcaster.Notify(pg.ChannelEthKeyBalanceChanged, string(payload)); err != nil {
+			lggr.Warnw("Failed to notify key balance change", "err", err, "address", address)
+		}
+	}
+}
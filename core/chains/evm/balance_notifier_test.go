@@ -0,0 +1,27 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+)
+
+func TestBalanceNotifier(t *testing.T) {
+	t.Parallel()
+
+	address := gethCommon.HexToAddress("0x2aB9a2Dc53736b361b72d900CdF9F78F9406fbbb")
+	eb := pgmocks.NewEventBroadcaster(t)
+	eb.On("Notify", pg.ChannelEthKeyBalanceChanged, `{"evmChainID":"42","address":"0x2aB9a2Dc53736b361b72d900CdF9F78F9406fbbb","balance":"10"}`).Return(nil).Once()
+	eb.On("Notify", pg.ChannelEthKeyBalanceChanged, `{"evmChainID":"42","address":"0x2aB9a2Dc53736b361b72d900CdF9F78F9406fbbb","previousBalance":"10","balance":"7"}`).Return(nil).Once()
+
+	notify := newBalanceNotifier(big.NewInt(42), eb, logger.TestLogger(t))
+	bal10 := assets.NewEthValue(10)
+	notify(address, nil, bal10)
+	notify(address, &bal10, assets.NewEthValue(7))
+}
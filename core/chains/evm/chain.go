@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"reflect"
+	"strings"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -17,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	cfgv2 "github.com/smartcontractkit/chainlink/core/config/v2"
@@ -40,6 +44,9 @@ type Chain interface {
 	Logger() logger.Logger
 	BalanceMonitor() monitor.BalanceMonitor
 	LogPoller() logpoller.LogPoller
+	ReorgBroadcaster() reorg.Broadcaster
+	// HealthReport returns the health of the chain, of its components and of its RPC nodes. See services.HealthReporter.
+	HealthReport() map[string]error
 }
 
 var _ Chain = &chain{}
@@ -57,7 +64,9 @@ type chain struct {
 	headTracker     httypes.HeadTracker
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
+	reorgs          reorg.Broadcaster
 	balanceMonitor  monitor.BalanceMonitor
+	nodeStates      *nodeStateNotifier
 	keyStore        keystore.Eth
 }
 
@@ -106,12 +115,21 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 	chainID := cfg.ChainID()
 	l := opts.Logger.With("evmChainID", chainID.String())
 	var client evmclient.Client
+	var nodeStates *nodeStateNotifier
 	if !cfg.EVMRPCEnabled() {
 		client = evmclient.NewNullClient(chainID, l)
 	} else if opts.GenEthClient == nil {
+		var stateListeners []evmclient.NodeStateListener
+		if opts.EventBroadcaster != nil {
+			nodeStates = newNodeStateNotifier(chainID, opts.EventBroadcaster, l)
+			stateListeners = append(stateListeners, nodeStates.OnStateChange)
+		}
 		var err2 error
-		client, err2 = newEthClientFromChain(cfg, l, cfg.ChainID(), nodes)
+		client, err2 = newEthClientFromChain(cfg, l, cfg.ChainID(), nodes, stateListeners...)
 		if err2 != nil {
+			if nodeStates != nil {
+				err2 = multierr.Combine(err2, nodeStates.Close())
+			}
 			return nil, errors.Wrapf(err2, "failed to instantiate eth client for chain with ID %s", cfg.ChainID().String())
 		}
 	} else {
@@ -120,6 +138,10 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 
 	db := opts.DB
 	headBroadcaster := headtracker.NewHeadBroadcaster(l)
+	reorgs := reorg.NullBroadcaster
+	if cfg.EVMRPCEnabled() {
+		reorgs = reorg.NewBroadcaster(reorg.NewORM(db, l, cfg), l)
+	}
 	headSaver := headtracker.NullSaver
 	var headTracker httypes.HeadTracker
 	if !cfg.EVMRPCEnabled() {
@@ -127,17 +149,17 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 	} else if opts.GenHeadTracker == nil {
 		orm := headtracker.NewORM(db, l, cfg, *chainID)
 		headSaver = headtracker.NewHeadSaver(l, orm, cfg)
-		headTracker = headtracker.NewHeadTracker(l, client, cfg, headBroadcaster, headSaver, opts.MailMon)
+		headTracker = headtracker.NewHeadTracker(l, client, cfg, headBroadcaster, headSaver, reorgs, opts.MailMon)
 	} else {
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
 
 	logPoller := logpoller.LogPollerDisabled
-	if cfg.FeatureLogPoller() {
+	if cfg.FeatureLogPoller() || cfg.EvmLogBroadcasterUseLogPoller() {
 		if opts.GenLogPoller != nil {
 			logPoller = opts.GenLogPoller(chainID)
 		} else {
-			logPoller = logpoller.NewLogPoller(logpoller.NewORM(chainID, db, l, cfg), client, l, cfg.EvmLogPollInterval(), int64(cfg.EvmFinalityDepth()), int64(cfg.EvmLogBackfillBatchSize()), int64(cfg.EvmRPCDefaultBatchSize()), int64(cfg.EvmLogKeepBlocksDepth()))
+			logPoller = logpoller.NewLogPoller(logpoller.NewORM(chainID, db, l, cfg), client, reorgs, l, cfg.EvmLogPollInterval(), cfg.EvmFinalityTagEnabled(), int64(cfg.EvmFinalityDepth()), int64(cfg.EvmLogBackfillBatchSize()), int64(cfg.EvmRPCDefaultBatchSize()), int64(cfg.EvmLogKeepBlocksDepth()))
 		}
 	}
 
@@ -146,7 +168,11 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		txm = &txmgr.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if opts.GenTxManager == nil {
 		checker := &txmgr.CheckerFactory{Client: client}
-		txm = txmgr.NewTxm(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l, checker, logPoller)
+		t := txmgr.NewTxm(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l, checker, logPoller)
+		if opts.IsLeader != nil {
+			t.SetLeaderCheck(opts.IsLeader)
+		}
+		txm = t
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
@@ -156,12 +182,19 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 	// Highest seen head height is used as part of the start of LogBroadcaster backfill range
 	highestSeenHead, err := headSaver.LatestHeadFromDB(ctx)
 	if err != nil {
+		if nodeStates != nil {
+			err = multierr.Combine(err, nodeStates.Close())
+		}
 		return nil, err
 	}
 
 	var balanceMonitor monitor.BalanceMonitor
 	if cfg.EVMRPCEnabled() && cfg.BalanceMonitorEnabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		var balanceListeners []monitor.BalanceListener
+		if opts.EventBroadcaster != nil {
+			balanceListeners = append(balanceListeners, newBalanceNotifier(chainID, opts.EventBroadcaster, l))
+		}
+		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l, balanceListeners...)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
@@ -170,7 +203,11 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if opts.GenLogBroadcaster == nil {
 		logORM := log.NewORM(db, l, cfg, *chainID)
-		logBroadcaster = log.NewBroadcaster(logORM, client, cfg, l, highestSeenHead, opts.MailMon)
+		if cfg.EvmLogBroadcasterUseLogPoller() {
+			logBroadcaster = log.NewLogPollerBroadcaster(logORM, logPoller, cfg, l, *chainID, highestSeenHead, opts.MailMon)
+		} else {
+			logBroadcaster = log.NewBroadcaster(logORM, client, cfg, l, highestSeenHead, opts.MailMon)
+		}
 	} else {
 		logBroadcaster = opts.GenLogBroadcaster(chainID)
 	}
@@ -192,7 +229,9 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		headTracker:     headTracker,
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
+		reorgs:          reorgs,
 		balanceMonitor:  balanceMonitor,
+		nodeStates:      nodeStates,
 		keyStore:        opts.KeyStore,
 	}, nil
 }
@@ -208,7 +247,7 @@ func (c *chain) Start(ctx context.Context) error {
 		// We do not start the log poller here, it gets
 		// started after the jobs so they have a chance to apply their filters.
 		var ms services.MultiStart
-		if err := ms.Start(ctx, c.txm, c.headBroadcaster, c.headTracker, c.logBroadcaster); err != nil {
+		if err := ms.Start(ctx, c.reorgs, c.txm, c.headBroadcaster, c.headTracker, c.logBroadcaster); err != nil {
 			return err
 		}
 		if c.balanceMonitor != nil {
@@ -237,8 +276,14 @@ func (c *chain) Close() error {
 		merr = multierr.Combine(merr, c.headBroadcaster.Close())
 		c.logger.Debug("Chain: stopping txm")
 		merr = multierr.Combine(merr, c.txm.Close())
+		c.logger.Debug("Chain: stopping reorgBroadcaster")
+		merr = multierr.Combine(merr, c.reorgs.Close())
 		c.logger.Debug("Chain: stopping client")
 		c.client.Close()
+		if c.nodeStates != nil {
+			c.logger.Debug("Chain: stopping node state notifier")
+			merr = multierr.Combine(merr, c.nodeStates.Close())
+		}
 		c.logger.Debug("Chain: stopped")
 		return merr
 	})
@@ -247,6 +292,7 @@ func (c *chain) Close() error {
 func (c *chain) Ready() (merr error) {
 	merr = multierr.Combine(
 		c.StartStopOnce.Ready(),
+		c.reorgs.Ready(),
 		c.txm.Ready(),
 		c.headBroadcaster.Ready(),
 		c.headTracker.Ready(),
@@ -261,6 +307,7 @@ func (c *chain) Ready() (merr error) {
 func (c *chain) Healthy() (merr error) {
 	merr = multierr.Combine(
 		c.StartStopOnce.Healthy(),
+		c.reorgs.Healthy(),
 		c.txm.Healthy(),
 		c.headBroadcaster.Healthy(),
 		c.headTracker.Healthy(),
@@ -272,6 +319,42 @@ func (c *chain) Healthy() (merr error) {
 	return
 }
 
+func (c *chain) HealthReport() map[string]error {
+	report := map[string]error{
+		"":                 c.StartStopOnce.Healthy(),
+		"ReorgBroadcaster": c.reorgs.Healthy(),
+		"TxManager":        c.txm.Healthy(),
+		"HeadBroadcaster":  c.headBroadcaster.Healthy(),
+		"HeadTracker":      c.headTracker.Healthy(),
+		"LogBroadcaster":   c.logBroadcaster.Healthy(),
+	}
+	if c.logPoller != logpoller.LogPollerDisabled {
+		report["LogPoller"] = c.logPoller.Healthy()
+	}
+	if c.balanceMonitor != nil {
+		report["BalanceMonitor"] = c.balanceMonitor.Healthy()
+	}
+	if states := c.client.NodeStates(); len(states) > 0 {
+		// The RPC nodes are failing unless alive, and the chain has an outage when none are.
+		var alive bool
+		for name, state := range states {
+			var err error
+			if state == evmclient.NodeStateAlive.String() {
+				alive = true
+			} else {
+				err = errors.Errorf("node is %s", state)
+			}
+			report["Nodes."+strings.ReplaceAll(name, ".", "_")] = err
+		}
+		var err error
+		if !alive {
+			err = errors.New("no live RPC nodes")
+		}
+		report["Nodes"] = err
+	}
+	return report
+}
+
 func (c *chain) ID() *big.Int                        { return c.id }
 func (c *chain) Client() evmclient.Client            { return c.client }
 func (c *chain) Config() evmconfig.ChainScopedConfig { return c.cfg }
@@ -282,36 +365,81 @@ func (c *chain) UpdateConfig(cfg *types.ChainCfg) {
 	}
 	c.cfg.Configure(*cfg)
 }
+
 func (c *chain) LogBroadcaster() log.Broadcaster          { return c.logBroadcaster }
 func (c *chain) LogPoller() logpoller.LogPoller           { return c.logPoller }
+func (c *chain) ReorgBroadcaster() reorg.Broadcaster      { return c.reorgs }
 func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster { return c.headBroadcaster }
 func (c *chain) TxManager() txmgr.TxManager               { return c.txm }
 func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker }
 func (c *chain) Logger() logger.Logger                    { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
 
-func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chainID *big.Int, nodes []*v2.Node) (evmclient.Client, error) {
-	var primaries []evmclient.Node
-	var sendonlys []evmclient.SendOnlyNode
-	for i, node := range nodes {
+// reloadConfig replaces the TOML config of the chain, and the nodes of its
+// client if they changed.
+func (c *chain) reloadConfig(ctx context.Context, cfg *v2.EVMConfig) error {
+	scoped, ok := c.cfg.(*v2.ChainScoped)
+	if !ok {
+		return cfgv2.ErrUnsupported
+	}
+	old := scoped.EVMConfig()
+	if old == cfg {
+		return nil
+	}
+	scoped.SetEVMConfig(cfg)
+	if reflect.DeepEqual(old.Nodes, cfg.Nodes) || !c.cfg.EVMRPCEnabled() {
+		return nil
+	}
+	client, ok := c.client.(interface {
+		SetNodes(context.Context, []evmclient.Node, []evmclient.SendOnlyNode) error
+	})
+	if !ok {
+		return errors.New("client does not support changing nodes")
+	}
+	var stateListeners []evmclient.NodeStateListener
+	if c.nodeStates != nil {
+		stateListeners = append(stateListeners, c.nodeStates.OnStateChange)
+	}
+	primaries, sendonlys, err := newNodes(c.cfg, c.logger, c.id, cfg.Nodes, stateListeners...)
+	if err != nil {
+		return err
+	}
+	return client.SetNodes(ctx, primaries, sendonlys)
+}
+
+func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chainID *big.Int, nodes []*v2.Node, stateListeners ...evmclient.NodeStateListener) (evmclient.Client, error) {
+	primaries, sendonlys, err := newNodes(cfg, lggr, chainID, nodes, stateListeners...)
+	if err != nil {
+		return nil, err
+	}
+	return evmclient.NewClientWithNodes(lggr, cfg, primaries, sendonlys, chainID)
+}
+
+// lastNodeID is the ID of the last node created. Node IDs are allocated from it,
+// rather than from the index of nodes in the config, so that the nodes added by
+// reloadConfig never reuse the IDs of the nodes they are running with.
+var lastNodeID atomic.Int32
+
+func newNodes(cfg evmclient.NodeConfig, lggr logger.Logger, chainID *big.Int, nodes []*v2.Node, stateListeners ...evmclient.NodeStateListener) (primaries []evmclient.Node, sendonlys []evmclient.SendOnlyNode, err error) {
+	for _, node := range nodes {
 		if node.SendOnly != nil && *node.SendOnly {
 			sendonly := evmclient.NewSendOnlyNode(lggr, (url.URL)(*node.HTTPURL), *node.Name, chainID)
 			sendonlys = append(sendonlys, sendonly)
 		} else {
-			primary, err := newPrimary(cfg, lggr, node, int32(i), chainID)
-			if err != nil {
-				return nil, err
+			primary, err2 := newPrimary(cfg, lggr, node, lastNodeID.Add(1), chainID, stateListeners...)
+			if err2 != nil {
+				return nil, nil, err2
 			}
 			primaries = append(primaries, primary)
 		}
 	}
-	return evmclient.NewClientWithNodes(lggr, cfg, primaries, sendonlys, chainID)
+	return
 }
 
-func newPrimary(cfg evmclient.NodeConfig, lggr logger.Logger, n *v2.Node, id int32, chainID *big.Int) (evmclient.Node, error) {
+func newPrimary(cfg evmclient.NodeConfig, lggr logger.Logger, n *v2.Node, id int32, chainID *big.Int, stateListeners ...evmclient.NodeStateListener) (evmclient.Node, error) {
 	if n.SendOnly != nil && *n.SendOnly {
 		return nil, errors.New("cannot cast send-only node to primary")
 	}
 
-	return evmclient.NewNode(cfg, lggr, (url.URL)(*n.WSURL), (*url.URL)(n.HTTPURL), *n.Name, id, chainID), nil
+	return evmclient.NewNode(cfg, lggr, (url.URL)(*n.WSURL), (*url.URL)(n.HTTPURL), *n.Name, id, chainID, stateListeners...), nil
 }
@@ -63,6 +63,11 @@ type ChainSet interface {
 
 	CreateNode(ctx context.Context, data types.Node) (types.Node, error)
 	DeleteNode(ctx context.Context, id int32) error
+
+	// ReloadConfigs applies reloaded TOML configs to the running chains, which
+	// are matched by ID. Only the gas estimator settings and the nodes are
+	// expected to change.
+	ReloadConfigs(ctx context.Context, cfgs []*v2.EVMConfig) error
 }
 
 type chainSet struct {
@@ -126,6 +131,27 @@ func (cll *chainSet) Healthy() (err error) {
 	}
 	return
 }
+
+// Name implements services.HealthReporter.
+func (cll *chainSet) Name() string { return "EVM" }
+
+// HealthReport implements services.HealthReporter, with the components of each chain under its ID.
+func (cll *chainSet) HealthReport() map[string]error {
+	report := map[string]error{}
+	for _, c := range cll.Chains() {
+		id := c.ID().String()
+		for path, err := range c.HealthReport() {
+			if path != "" {
+				path = id + "." + path
+			} else {
+				path = id
+			}
+			report[path] = err
+		}
+	}
+	return report
+}
+
 func (cll *chainSet) Ready() (err error) {
 	for _, c := range cll.Chains() {
 		err = multierr.Combine(err, c.Ready())
@@ -307,6 +333,24 @@ func (cll *chainSet) UpdateConfig(id *big.Int, updaters ...ChainConfigUpdater) e
 	return err
 }
 
+func (cll *chainSet) ReloadConfigs(ctx context.Context, cfgs []*v2.EVMConfig) (err error) {
+	if !cll.immutable {
+		return cfgv2.ErrUnsupported
+	}
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	for _, cfg := range cfgs {
+		chain, exists := cll.chains[cfg.ChainID.String()]
+		if !exists {
+			continue
+		}
+		if err2 := chain.reloadConfig(ctx, cfg); err2 != nil {
+			err = multierr.Append(err, errors.Wrapf(err2, "failed to reload config of chain %s", cfg.ChainID.String()))
+		}
+	}
+	return
+}
+
 func (cll *chainSet) Chains() (c []Chain) {
 	cll.chainsMu.RLock()
 	defer cll.chainsMu.RUnlock()
@@ -407,6 +451,9 @@ type ChainSetOpts struct {
 	EventBroadcaster pg.EventBroadcaster
 	ORM              types.ORM
 	MailMon          *utils.MailboxMonitor
+	// IsLeader is set when the database is shared with other nodes, and only
+	// the leader may send transactions.
+	IsLeader func() bool
 
 	// Gen-functions are useful for dependency injection by tests
 	GenEthClient      func(*big.Int) client.Client
@@ -531,7 +578,7 @@ func (opts *ChainSetOpts) check() error {
 	}
 
 	if tomlConfig, ok := opts.Config.(v2.HasEVMConfigs); ok {
-		opts.ORM = chains.NewORMImmut[utils.Big, *types.ChainCfg, types.Node](tomlConfig.EVMConfigs())
+		opts.ORM = chains.NewORMImmut[utils.Big, *types.ChainCfg, types.Node](tomlChainConfigs{tomlConfig})
 	} else if opts.ORM == nil {
 		// legacy config only
 		opts.ORM = NewORM(opts.DB, opts.Logger, opts.Config)
@@ -553,3 +600,25 @@ func UpdateKeySpecificMaxGasPrice(addr common.Address, maxGasPriceWei *assets.We
 		return nil
 	}
 }
+
+// tomlChainConfigs reads the current EVMConfigs on every call, so that reloaded
+// configs are reflected.
+type tomlChainConfigs struct {
+	v2.HasEVMConfigs
+}
+
+func (t tomlChainConfigs) Chains(ids ...utils.Big) []types.DBChain {
+	return t.EVMConfigs().Chains(ids...)
+}
+
+func (t tomlChainConfigs) Node(name string) (types.Node, error) {
+	return t.EVMConfigs().Node(name)
+}
+
+func (t tomlChainConfigs) Nodes() []types.Node {
+	return t.EVMConfigs().Nodes()
+}
+
+func (t tomlChainConfigs) NodesByID(chainIDs ...utils.Big) []types.Node {
+	return t.EVMConfigs().NodesByID(chainIDs...)
+}
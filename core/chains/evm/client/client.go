@@ -119,9 +119,15 @@ func (client *client) Close() {
 	client.pool.Close()
 }
 
+// SetNodes replaces the nodes of the pool. See Pool.SetNodes.
+func (client *client) SetNodes(ctx context.Context, primaryNodes []Node, sendOnlyNodes []SendOnlyNode) error {
+	return client.pool.SetNodes(ctx, primaryNodes, sendOnlyNodes)
+}
+
 func (client *client) NodeStates() (states map[string]string) {
 	states = make(map[string]string)
-	for _, n := range client.pool.nodes {
+	nodes, _ := client.pool.getNodes()
+	for _, n := range nodes {
 		states[n.Name()] = n.State().String()
 	}
 	return
@@ -268,10 +274,21 @@ func (client *client) HeadByHash(ctx context.Context, hash common.Hash) (head *e
 	return
 }
 
+// ToBlockNumArg converts a block number to the string representation expected
+// by the RPC. nil means "latest"; the negative rpc.BlockNumber constants
+// (rpc.FinalizedBlockNumber, rpc.SafeBlockNumber, rpc.PendingBlockNumber) are
+// translated into their block tags.
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
 	}
+	if number.Sign() < 0 && number.IsInt64() {
+		switch bn := rpc.BlockNumber(number.Int64()); bn {
+		case rpc.FinalizedBlockNumber, rpc.SafeBlockNumber, rpc.PendingBlockNumber, rpc.LatestBlockNumber:
+			tag, _ := bn.MarshalText()
+			return string(tag)
+		}
+	}
 	return hexutil.EncodeBig(number)
 }
 
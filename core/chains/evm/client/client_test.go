@@ -343,6 +343,29 @@ func TestEthClient_HeaderByNumber(t *testing.T) {
 	}
 }
 
+func TestToBlockNumArg(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name string
+		n    *big.Int
+		exp  string
+	}{
+		{"nil", nil, "latest"},
+		{"zero", big.NewInt(0), "0x0"},
+		{"number", big.NewInt(42), "0x2a"},
+		{"latest", big.NewInt(rpc.LatestBlockNumber.Int64()), "latest"},
+		{"pending", big.NewInt(rpc.PendingBlockNumber.Int64()), "pending"},
+		{"finalized", big.NewInt(rpc.FinalizedBlockNumber.Int64()), "finalized"},
+		{"safe", big.NewInt(rpc.SafeBlockNumber.Int64()), "safe"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, evmclient.ToBlockNumArg(tt.n))
+		})
+	}
+}
+
 func TestEthClient_SendTransaction_NoSecondaryURL(t *testing.T) {
 	t.Parallel()
 
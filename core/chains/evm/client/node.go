@@ -163,6 +163,9 @@ type node struct {
 	//  moved to out-of-sync state. It is better to have one out-of-sync node than no nodes at all.
 	//  2. compare against the highest head (by number or difficulty) to ensure we don't fall behind too far.
 	nLiveNodes func() (count int, blockNumber int64, totalDifficulty *utils.Big)
+
+	// stateListeners are called on every state change
+	stateListeners []NodeStateListener
 }
 
 // NodeConfig allows configuration of the node
@@ -175,9 +178,10 @@ type NodeConfig interface {
 }
 
 // NewNode returns a new *node as Node
-func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int) Node {
+func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int, stateListeners ...NodeStateListener) Node {
 	n := new(node)
 	n.name = name
+	n.stateListeners = stateListeners
 	n.id = id
 	n.chainID = chainID
 	n.cfg = nodeCfg
@@ -353,7 +357,7 @@ func (n *node) Close() error {
 
 		n.cancelNodeCtx()
 		n.cancelInflightRequests()
-		n.state = NodeStateClosed
+		n.changeState(NodeStateClosed)
 		return nil
 	})
 }
@@ -1045,6 +1049,16 @@ func (n *node) String() string {
 	return s
 }
 
+// configKey identifies the configuration of the node. Unlike String, it
+// includes the credentials of the URLs, so it must not be logged.
+func (n *node) configKey() string {
+	s := fmt.Sprintf("%s:%s", n.name, n.ws.uri.String())
+	if n.http != nil {
+		s = s + fmt.Sprintf(":%s", n.http.uri.String())
+	}
+	return s
+}
+
 func (n *node) Name() string {
 	return n.name
 }
@@ -116,7 +116,23 @@ func (n *node) StateAndLatest() (NodeState, int64, *utils.Big) {
 func (n *node) setState(s NodeState) {
 	n.stateMu.Lock()
 	defer n.stateMu.Unlock()
+	n.changeState(s)
+}
+
+// NodeStateListener is called when a node changes state. It is called with
+// the node's state locked, so it must not block nor call the node.
+type NodeStateListener func(nodeName string, from, to NodeState)
+
+// changeState sets the state and tells the listeners. n.stateMu must be locked.
+func (n *node) changeState(s NodeState) {
+	from := n.state
 	n.state = s
+	if from == s {
+		return
+	}
+	for _, l := range n.stateListeners {
+		l(n.name, from, s)
+	}
 }
 
 // declareXXX methods change the state and pass conrol off the new state
@@ -139,7 +155,7 @@ func (n *node) transitionToAlive(fn func()) {
 	}
 	switch n.state {
 	case NodeStateDialed, NodeStateInvalidChainID:
-		n.state = NodeStateAlive
+		n.changeState(NodeStateAlive)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateAlive))
 	}
@@ -166,7 +182,7 @@ func (n *node) transitionToInSync(fn func()) {
 	}
 	switch n.state {
 	case NodeStateOutOfSync:
-		n.state = NodeStateAlive
+		n.changeState(NodeStateAlive)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateAlive))
 	}
@@ -193,7 +209,7 @@ func (n *node) transitionToOutOfSync(fn func()) {
 	switch n.state {
 	case NodeStateAlive:
 		n.disconnectAll()
-		n.state = NodeStateOutOfSync
+		n.changeState(NodeStateOutOfSync)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateOutOfSync))
 	}
@@ -218,7 +234,7 @@ func (n *node) transitionToUnreachable(fn func()) {
 	switch n.state {
 	case NodeStateUndialed, NodeStateDialed, NodeStateAlive, NodeStateOutOfSync, NodeStateInvalidChainID:
 		n.disconnectAll()
-		n.state = NodeStateUnreachable
+		n.changeState(NodeStateUnreachable)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateUnreachable))
 	}
@@ -243,7 +259,7 @@ func (n *node) transitionToInvalidChainID(fn func()) {
 	switch n.state {
 	case NodeStateDialed, NodeStateOutOfSync:
 		n.disconnectAll()
-		n.state = NodeStateInvalidChainID
+		n.changeState(NodeStateInvalidChainID)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateInvalidChainID))
 	}
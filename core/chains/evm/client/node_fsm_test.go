@@ -170,3 +170,31 @@ func TestUnit_Node_StateTransitions(t *testing.T) {
 		assert.Error(t, n.Close())
 	})
 }
+
+func TestUnit_Node_StateListeners(t *testing.T) {
+	t.Parallel()
+
+	type change struct {
+		name     string
+		from, to NodeState
+	}
+	var changes []change
+	s := testutils.NewWSServer(t, testutils.FixtureChainID, nil)
+	iN := NewNode(TestNodeConfig{}, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID, func(name string, from, to NodeState) {
+		changes = append(changes, change{name, from, to})
+	})
+	n := iN.(*node)
+
+	require.NoError(t, n.dial(testutils.Context(t)))
+	n.setState(NodeStateDialed)
+	n.transitionToAlive(func() {})
+	// setting the same state is not a change
+	n.setState(NodeStateAlive)
+	n.transitionToUnreachable(func() {})
+
+	assert.Equal(t, []change{
+		{"test node", NodeStateUndialed, NodeStateDialed},
+		{"test node", NodeStateDialed, NodeStateAlive},
+		{"test node", NodeStateAlive, NodeStateUnreachable},
+	}, changes)
+}
@@ -54,11 +54,13 @@ type PoolConfig interface {
 // It is responsible for liveness checking and balancing queries across live nodes
 type Pool struct {
 	utils.StartStopOnce
+	chainID *big.Int
+	logger  logger.Logger
+	config  PoolConfig
+
+	nodesMu      sync.RWMutex
 	nodes        []Node
 	sendonlys    []SendOnlyNode
-	chainID      *big.Int
-	logger       logger.Logger
-	config       PoolConfig
 	nodeSelector NodeSelector
 
 	activeMu   sync.RWMutex
@@ -73,18 +75,7 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 		panic("chainID is required")
 	}
 
-	nodeSelector := func() NodeSelector {
-		switch cfg.NodeSelectionMode() {
-		case NodeSelectionMode_HighestHead:
-			return NewHighestHeadNodeSelector(nodes)
-		case NodeSelectionMode_RoundRobin:
-			return NewRoundRobinSelector(nodes)
-		case NodeSelectionMode_TotalDifficulty:
-			return NewTotalDifficultyNodeSelector(nodes)
-		default:
-			panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", cfg.NodeSelectionMode()))
-		}
-	}()
+	nodeSelector := newNodeSelector(cfg.NodeSelectionMode(), nodes)
 
 	lggr := logger.Named("Pool").With("evmChainID", chainID.String())
 
@@ -103,37 +94,36 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 	return p
 }
 
+func newNodeSelector(mode string, nodes []Node) NodeSelector {
+	switch mode {
+	case NodeSelectionMode_HighestHead:
+		return NewHighestHeadNodeSelector(nodes)
+	case NodeSelectionMode_RoundRobin:
+		return NewRoundRobinSelector(nodes)
+	case NodeSelectionMode_TotalDifficulty:
+		return NewTotalDifficultyNodeSelector(nodes)
+	default:
+		panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", mode))
+	}
+}
+
+// getNodes returns the current primary and sendonly nodes. The slices are
+// replaced, never modified, by SetNodes, so they are safe to iterate.
+func (p *Pool) getNodes() ([]Node, []SendOnlyNode) {
+	p.nodesMu.RLock()
+	defer p.nodesMu.RUnlock()
+	return p.nodes, p.sendonlys
+}
+
 // Dial starts every node in the pool
 func (p *Pool) Dial(ctx context.Context) error {
 	return p.StartOnce("Pool", func() (merr error) {
-		if len(p.nodes) == 0 {
+		nodes, sendonlys := p.getNodes()
+		if len(nodes) == 0 {
 			return errors.Errorf("no available nodes for chain %s", p.chainID.String())
 		}
-		var ms services.MultiStart
-		for _, n := range p.nodes {
-			if n.ChainID().Cmp(p.chainID) != 0 {
-				return ms.CloseBecause(errors.Errorf("node %s has chain ID %s which does not match pool chain ID of %s", n.String(), n.ChainID().String(), p.chainID.String()))
-			}
-			rawNode, ok := n.(*node)
-			if ok {
-				// This is a bit hacky but it allows the node to be aware of
-				// pool state and prevent certain state transitions that might
-				// otherwise leave no nodes available. It is better to have one
-				// node in a degraded state than no nodes at all.
-				rawNode.nLiveNodes = p.nLiveNodes
-			}
-			// node will handle its own redialing and automatic recovery
-			if err := ms.Start(ctx, n); err != nil {
-				return err
-			}
-		}
-		for _, s := range p.sendonlys {
-			if s.ChainID().Cmp(p.chainID) != 0 {
-				return ms.CloseBecause(errors.Errorf("sendonly node %s has chain ID %s which does not match pool chain ID of %s", s.String(), s.ChainID().String(), p.chainID.String()))
-			}
-			if err := ms.Start(ctx, s); err != nil {
-				return err
-			}
+		if err := p.startNodes(ctx, nodes, sendonlys); err != nil {
+			return err
 		}
 		p.wg.Add(1)
 		go p.runLoop()
@@ -142,11 +132,130 @@ func (p *Pool) Dial(ctx context.Context) error {
 	})
 }
 
+// startNodes starts the given nodes, which must all be on the chain of the
+// pool. If any fails to start, those already started are closed.
+func (p *Pool) startNodes(ctx context.Context, nodes []Node, sendonlys []SendOnlyNode) error {
+	var ms services.MultiStart
+	for _, n := range nodes {
+		if n.ChainID().Cmp(p.chainID) != 0 {
+			return ms.CloseBecause(errors.Errorf("node %s has chain ID %s which does not match pool chain ID of %s", n.String(), n.ChainID().String(), p.chainID.String()))
+		}
+		rawNode, ok := n.(*node)
+		if ok {
+			// This is a bit hacky but it allows the node to be aware of
+			// pool state and prevent certain state transitions that might
+			// otherwise leave no nodes available. It is better to have one
+			// node in a degraded state than no nodes at all.
+			rawNode.nLiveNodes = p.nLiveNodes
+		}
+		// node will handle its own redialing and automatic recovery
+		if err := ms.Start(ctx, n); err != nil {
+			return err
+		}
+	}
+	for _, s := range sendonlys {
+		if s.ChainID().Cmp(p.chainID) != 0 {
+			return ms.CloseBecause(errors.Errorf("sendonly node %s has chain ID %s which does not match pool chain ID of %s", s.String(), s.ChainID().String(), p.chainID.String()))
+		}
+		if err := ms.Start(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configKey returns the key identifying the configuration of a node. Nodes of
+// other implementations are identified by their String().
+func configKey(n fmt.Stringer) string {
+	if k, ok := n.(interface{ configKey() string }); ok {
+		return k.configKey()
+	}
+	return n.String()
+}
+
+// SetNodes replaces the nodes of the pool, so that the RPC nodes of a chain can
+// be changed without restarting it. Nodes which are already in the pool, as
+// identified by their name and full URLs, credentials included, are kept along
+// with their state. If the pool
+// is running, the new nodes are started before the swap, and the removed ones
+// are closed after it.
+func (p *Pool) SetNodes(ctx context.Context, nodes []Node, sendonlys []SendOnlyNode) error {
+	if len(nodes) == 0 {
+		return errors.Errorf("no available nodes for chain %s", p.chainID.String())
+	}
+	nodes = append([]Node(nil), nodes...)
+	sendonlys = append([]SendOnlyNode(nil), sendonlys...)
+	oldNodes, oldSendonlys := p.getNodes()
+
+	currentNodes := make(map[string]Node, len(oldNodes))
+	for _, n := range oldNodes {
+		currentNodes[configKey(n)] = n
+	}
+	currentSendonlys := make(map[string]SendOnlyNode, len(oldSendonlys))
+	for _, s := range oldSendonlys {
+		currentSendonlys[configKey(s)] = s
+	}
+	var added []Node
+	var addedSendonlys []SendOnlyNode
+	kept := make(map[interface{}]struct{})
+	for i, n := range nodes {
+		if old, ok := currentNodes[configKey(n)]; ok {
+			nodes[i] = old
+			kept[old] = struct{}{}
+			continue
+		}
+		added = append(added, n)
+	}
+	for i, s := range sendonlys {
+		if old, ok := currentSendonlys[configKey(s)]; ok {
+			sendonlys[i] = old
+			kept[old] = struct{}{}
+			continue
+		}
+		addedSendonlys = append(addedSendonlys, s)
+	}
+
+	if p.State() == utils.StartStopOnce_Started {
+		if err := p.startNodes(ctx, added, addedSendonlys); err != nil {
+			return errors.Wrap(err, "failed to start new nodes")
+		}
+	}
+
+	p.nodesMu.Lock()
+	p.nodes = nodes
+	p.sendonlys = sendonlys
+	p.nodeSelector = newNodeSelector(p.config.NodeSelectionMode(), nodes)
+	p.nodesMu.Unlock()
+
+	p.activeMu.Lock()
+	if p.activeNode != nil {
+		if _, ok := kept[p.activeNode]; !ok {
+			p.activeNode = nil
+		}
+	}
+	p.activeMu.Unlock()
+
+	var mc services.MultiClose
+	for _, n := range oldNodes {
+		if _, ok := kept[n]; !ok {
+			mc = append(mc, n)
+		}
+	}
+	for _, s := range oldSendonlys {
+		if _, ok := kept[s]; !ok {
+			mc = append(mc, s)
+		}
+	}
+	p.logger.Infow("Replaced nodes", "added", len(added)+len(addedSendonlys), "removed", len(mc))
+	return mc.Close()
+}
+
 // nLiveNodes returns the number of currently alive nodes, as well as the highest block number and greatest total difficulty.
 // totalDifficulty will be 0 if all nodes return nil.
 func (p *Pool) nLiveNodes() (nLiveNodes int, blockNumber int64, totalDifficulty *utils.Big) {
 	totalDifficulty = utils.NewBigI(0)
-	for _, n := range p.nodes {
+	nodes, _ := p.getNodes()
+	for _, n := range nodes {
 		if s, num, td := n.StateAndLatest(); s == NodeStateAlive {
 			nLiveNodes++
 			if num > blockNumber {
@@ -189,8 +298,9 @@ func (p *Pool) report() {
 
 	var total, dead int
 	counts := make(map[NodeState]int)
-	nodeStates := make([]nodeWithState, len(p.nodes))
-	for i, n := range p.nodes {
+	nodes, _ := p.getNodes()
+	nodeStates := make([]nodeWithState, len(nodes))
+	for i, n := range nodes {
 		state := n.State()
 		nodeStates[i] = nodeWithState{n.String(), state.String()}
 		total++
@@ -219,11 +329,12 @@ func (p *Pool) Close() error {
 		close(p.chStop)
 		p.wg.Wait()
 
+		nodes, sendonlys := p.getNodes()
 		var mc services.MultiClose
-		for _, n := range p.nodes {
+		for _, n := range nodes {
 			mc = append(mc, n)
 		}
-		for _, s := range p.sendonlys {
+		for _, s := range sendonlys {
 			mc = append(mc, s)
 		}
 		return mc.Close()
@@ -251,10 +362,13 @@ func (p *Pool) selectNode() (node Node) {
 		return // another goroutine beat us here
 	}
 
-	p.activeNode = p.nodeSelector.Select()
+	p.nodesMu.RLock()
+	nodeSelector := p.nodeSelector
+	p.nodesMu.RUnlock()
+	p.activeNode = nodeSelector.Select()
 
 	if p.activeNode == nil {
-		p.logger.Criticalw("No live RPC nodes available", "NodeSelectionMode", p.nodeSelector.Name())
+		p.logger.Criticalw("No live RPC nodes available", "NodeSelectionMode", nodeSelector.Name())
 		return &erroringNode{errMsg: fmt.Sprintf("no live nodes available for chain %s", p.chainID.String())}
 	}
 
@@ -278,11 +392,12 @@ func (p *Pool) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error
 	defer wg.Wait()
 
 	main := p.selectNode()
+	nodes, sendonlys := p.getNodes()
 	var all []SendOnlyNode
-	for _, n := range p.nodes {
+	for _, n := range nodes {
 		all = append(all, n)
 	}
-	all = append(all, p.sendonlys...)
+	all = append(all, sendonlys...)
 	for _, n := range all {
 		if n == main {
 			// main node is used at the end for the return value
@@ -307,11 +422,12 @@ func (p *Pool) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error
 // Wrapped Geth client methods
 func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	main := p.selectNode()
+	nodes, sendonlys := p.getNodes()
 	var all []SendOnlyNode
-	for _, n := range p.nodes {
+	for _, n := range nodes {
 		all = append(all, n)
 	}
-	all = append(all, p.sendonlys...)
+	all = append(all, sendonlys...)
 	for _, n := range all {
 		if n == main {
 			// main node is used at the end for the return value
@@ -330,3 +330,60 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 
 	require.NoError(t, p.BatchCallContextAll(ctx, b))
 }
+
+func TestUnit_Pool_SetNodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	newNode := func(name string, state evmclient.NodeState) *evmmocks.Node {
+		n := evmmocks.NewNode(t)
+		n.On("String").Maybe().Return(name)
+		n.On("ChainID").Maybe().Return(testutils.FixtureChainID)
+		n.On("State").Maybe().Return(state)
+		return n
+	}
+	newSendOnly := func(name string) *evmmocks.SendOnlyNode {
+		s := evmmocks.NewSendOnlyNode(t)
+		s.On("String").Maybe().Return(name)
+		s.On("ChainID").Maybe().Return(testutils.FixtureChainID)
+		return s
+	}
+
+	n1 := newNode("n1", evmclient.NodeStateAlive)
+	n2 := newNode("n2", evmclient.NodeStateAlive)
+	s1 := newSendOnly("s1")
+	for _, n := range []*evmmocks.Node{n1, n2} {
+		n.On("Start", mock.Anything).Return(nil).Once()
+	}
+	s1.On("Start", mock.Anything).Return(nil).Once()
+
+	p := evmclient.NewPool(logger.TestLogger(t), defaultConfig, []evmclient.Node{n1, n2}, []evmclient.SendOnlyNode{s1}, &cltest.FixtureChainID)
+	require.NoError(t, p.Dial(ctx))
+
+	t.Run("no nodes", func(t *testing.T) {
+		require.ErrorContains(t, p.SetNodes(ctx, nil, nil), "no available nodes")
+	})
+
+	// n1 is kept, n2 and s1 are closed, n3 and s2 are started
+	n1Again := newNode("n1", evmclient.NodeStateAlive)
+	n3 := newNode("n3", evmclient.NodeStateAlive)
+	s2 := newSendOnly("s2")
+	n3.On("Start", mock.Anything).Return(nil).Once()
+	s2.On("Start", mock.Anything).Return(nil).Once()
+	n2.On("Close").Return(nil).Once()
+	s1.On("Close").Return(nil).Once()
+
+	require.NoError(t, p.SetNodes(ctx, []evmclient.Node{n1Again, n3}, []evmclient.SendOnlyNode{s2}))
+
+	b := []rpc.BatchElem{{Method: "method"}}
+	n1.On("BatchCallContext", ctx, b).Return(nil).Once()
+	n3.On("BatchCallContext", ctx, b).Return(nil).Once()
+	s2.On("BatchCallContext", ctx, b).Return(nil).Once()
+	require.NoError(t, p.BatchCallContextAll(ctx, b))
+
+	for _, n := range []*evmmocks.Node{n1, n3} {
+		n.On("Close").Return(nil).Once()
+	}
+	s2.On("Close").Return(nil).Once()
+	require.NoError(t, p.Close())
+}
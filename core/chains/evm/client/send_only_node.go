@@ -181,6 +181,12 @@ func (s *sendOnlyNode) String() string {
 	return fmt.Sprintf("(secondary)%s:%s", s.name, s.uri.Redacted())
 }
 
+// configKey identifies the configuration of the node. Unlike String, it
+// includes the credentials of the URL, so it must not be logged.
+func (s *sendOnlyNode) configKey() string {
+	return fmt.Sprintf("%s:%s", s.name, s.uri.String())
+}
+
 // getChainID returns the chainID and converts zero/empty values to errors.
 func (s *sendOnlyNode) getChainID(parentCtx context.Context) (*big.Int, error) {
 	ctx, cancel := s.makeQueryCtx(parentCtx)
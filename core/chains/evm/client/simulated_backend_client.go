@@ -224,7 +224,9 @@ func (c *SimulatedBackendClient) blockNumber(number interface{}) (blockNumber *b
 
 // HeadByNumber returns our own header type.
 func (c *SimulatedBackendClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
-	if n == nil {
+	if n == nil || n.Sign() < 0 {
+		// The simulated backend has instant finality, so block tags such as
+		// "finalized" and "safe" resolve to the latest block.
 		n = c.currentBlockNumber()
 	}
 	header, err := c.b.HeaderByNumber(ctx, n)
@@ -39,6 +39,7 @@ type (
 		ethTxReaperThreshold                          time.Duration
 		ethTxResendAfterThreshold                     time.Duration
 		finalityDepth                                 uint32
+		finalityTagEnabled                            bool
 		flagsContractAddress                          string
 		gasBumpPercent                                uint16
 		gasBumpThreshold                              uint64
@@ -65,6 +66,7 @@ type (
 		operatorFactoryAddress                        string
 		logBackfillBatchSize                          uint32
 		logKeepBlocksDepth                            uint32
+		logBroadcasterUseLogPoller                    bool
 		logPollInterval                               time.Duration
 		maxGasPriceWei                                assets.Wei
 		maxInFlightTransactions                       uint32
@@ -133,6 +135,7 @@ func setChainSpecificConfigDefaultSets() {
 		ethTxReaperThreshold:                  168 * time.Hour,
 		ethTxResendAfterThreshold:             1 * time.Minute,
 		finalityDepth:                         50,
+		finalityTagEnabled:                    false,
 		gasBumpPercent:                        20,
 		gasBumpThreshold:                      3,
 		gasBumpTxDepth:                        10,
@@ -152,6 +155,7 @@ func setChainSpecificConfigDefaultSets() {
 		linkContractAddress:                   "",
 		logBackfillBatchSize:                  100,
 		logKeepBlocksDepth:                    100_000,
+		logBroadcasterUseLogPoller:            false,
 		logPollInterval:                       15 * time.Second,
 		maxGasPriceWei:                        *MaxLegalGasPrice,
 		maxInFlightTransactions:               16,
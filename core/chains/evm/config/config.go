@@ -43,6 +43,7 @@ type ChainScopedOnlyConfig interface {
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmFinalityDepth() uint32
+	EvmFinalityTagEnabled() bool
 	EvmGasBumpPercent() uint16
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
@@ -65,6 +66,7 @@ type ChainScopedOnlyConfig interface {
 	EvmHeadTrackerSamplingInterval() time.Duration
 	EvmLogBackfillBatchSize() uint32
 	EvmLogKeepBlocksDepth() uint32
+	EvmLogBroadcasterUseLogPoller() bool
 	EvmLogPollInterval() time.Duration
 	EvmMaxGasPriceWei() *assets.Wei
 	EvmMaxInFlightTransactions() uint32
@@ -585,6 +587,18 @@ func (c *chainScopedConfig) EvmFinalityDepth() uint32 {
 	return c.defaultSet.finalityDepth
 }
 
+// EvmFinalityTagEnabled means that the chain supports the `finalized` block tag
+// (post-merge chains). When enabled, the latest finalized block is fetched from
+// the RPC node and used instead of EvmFinalityDepth to determine finality.
+func (c *chainScopedConfig) EvmFinalityTagEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmFinalityTagEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmFinalityTagEnabled", val)
+		return val
+	}
+	return c.defaultSet.finalityTagEnabled
+}
+
 // EvmHeadTrackerHistoryDepth tracks the top N block numbers to keep in the `heads` database table.
 // Note that this can easily result in MORE than N records since in the case of re-orgs we keep multiple heads for a particular block height.
 // This number should be at least as large as `EvmFinalityDepth`.
@@ -1115,6 +1129,17 @@ func (c *chainScopedConfig) EvmLogKeepBlocksDepth() uint32 {
 	return c.defaultSet.logKeepBlocksDepth
 }
 
+// EvmLogBroadcasterUseLogPoller makes the log broadcaster read logs from the
+// log poller instead of subscribing to them.
+func (c *chainScopedConfig) EvmLogBroadcasterUseLogPoller() bool {
+	val, ok := c.GeneralConfig.GlobalEvmLogBroadcasterUseLogPoller()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogBroadcasterUseLogPoller", val)
+		return val
+	}
+	return c.defaultSet.logBroadcasterUseLogPoller
+}
+
 // EvmLogBackfillBatchSize sets the batch size for calling FilterLogs when we backfill missing logs
 func (c *chainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	val, ok := c.GeneralConfig.GlobalEvmLogBackfillBatchSize()
@@ -3,7 +3,10 @@
 package mocks
 
 import (
+	context "context"
+
 	assets "github.com/smartcontractkit/chainlink/core/assets"
+
 	audit "github.com/smartcontractkit/chainlink/core/logger/audit"
 
 	big "math/big"
@@ -388,6 +391,94 @@ func (_m *ChainScopedConfig) AutoPprofProfileRoot() string {
 	return r0
 }
 
+// AutoPprofUploadAuthToken provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofUploadAuthToken() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AutoPprofUploadCPUDuration provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofUploadCPUDuration() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// AutoPprofUploadInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofUploadInterval() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// AutoPprofUploadProfileTypes provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofUploadProfileTypes() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// AutoPprofUploadTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofUploadTimeout() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// AutoPprofUploadURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofUploadURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // BalanceMonitorEnabled provides a mock function with given fields:
 func (_m *ChainScopedConfig) BalanceMonitorEnabled() bool {
 	ret := _m.Called()
@@ -542,6 +633,20 @@ func (_m *ChainScopedConfig) BlockHistoryEstimatorTransactionPercentile() uint16
 	return r0
 }
 
+// BridgeBearerToken provides a mock function with given fields: name
+func (_m *ChainScopedConfig) BridgeBearerToken(name string) string {
+	ret := _m.Called(name)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // BridgeCacheTTL provides a mock function with given fields:
 func (_m *ChainScopedConfig) BridgeCacheTTL() time.Duration {
 	ret := _m.Called()
@@ -635,6 +740,36 @@ func (_m *ChainScopedConfig) DatabaseBackupDir() string {
 	return r0
 }
 
+// DatabaseBackupEncryptionKey provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupEncryptionKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// DatabaseBackupExcludeTableData provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupExcludeTableData() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseBackupFrequency provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupFrequency() time.Duration {
 	ret := _m.Called()
@@ -649,6 +784,34 @@ func (_m *ChainScopedConfig) DatabaseBackupFrequency() time.Duration {
 	return r0
 }
 
+// DatabaseBackupMaxAge provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupMaxAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseBackupMaxBackups provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupMaxBackups() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // DatabaseBackupMode provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupMode() coreconfig.DatabaseBackupMode {
 	ret := _m.Called()
@@ -677,6 +840,22 @@ func (_m *ChainScopedConfig) DatabaseBackupOnVersionUpgrade() bool {
 	return r0
 }
 
+// DatabaseBackupTables provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupTables() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseBackupURL provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupURL() *url.URL {
 	ret := _m.Called()
@@ -693,6 +872,20 @@ func (_m *ChainScopedConfig) DatabaseBackupURL() *url.URL {
 	return r0
 }
 
+// DatabaseBackupUpload provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupUpload() coreconfig.DatabaseBackupUpload {
+	ret := _m.Called()
+
+	var r0 coreconfig.DatabaseBackupUpload
+	if rf, ok := ret.Get(0).(func() coreconfig.DatabaseBackupUpload); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(coreconfig.DatabaseBackupUpload)
+	}
+
+	return r0
+}
+
 // DatabaseDefaultIdleInTxSessionTimeout provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseDefaultIdleInTxSessionTimeout() time.Duration {
 	ret := _m.Called()
@@ -777,6 +970,50 @@ func (_m *ChainScopedConfig) DatabaseLockingMode() string {
 	return r0
 }
 
+// DatabaseReadReplicaCheckInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseReadReplicaCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseReadReplicaMaxLag provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseReadReplicaMaxLag() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseReadReplicaURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseReadReplicaURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseURL provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseURL() url.URL {
 	ret := _m.Called()
@@ -1021,6 +1258,20 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFinalityTagEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -1335,6 +1586,20 @@ func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return r0
 }
 
+// EvmLogBroadcasterUseLogPoller provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogBroadcasterUseLogPoller() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmLogKeepBlocksDepth provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmLogKeepBlocksDepth() uint32 {
 	ret := _m.Called()
@@ -1691,6 +1956,22 @@ func (_m *ChainScopedConfig) HTTPServerWriteTimeout() time.Duration {
 	return r0
 }
 
+// HealthReadinessGates provides a mock function with given fields:
+func (_m *ChainScopedConfig) HealthReadinessGates() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // InsecureFastScrypt provides a mock function with given fields:
 func (_m *ChainScopedConfig) InsecureFastScrypt() bool {
 	ret := _m.Called()
@@ -1775,39 +2056,111 @@ func (_m *ChainScopedConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return r0
 }
 
-// KeeperBaseFeeBufferPercent provides a mock function with given fields:
-func (_m *ChainScopedConfig) KeeperBaseFeeBufferPercent() uint16 {
+// JobShardingEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobShardingEnabled() bool {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// KeeperCheckUpkeepGasPriceFeatureEnabled provides a mock function with given fields:
-func (_m *ChainScopedConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
+// JobShardingHeartbeatInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobShardingHeartbeatInterval() time.Duration {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// KeeperDefaultTransactionQueueDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) KeeperDefaultTransactionQueueDepth() uint32 {
+// JobShardingJobTypes provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobShardingJobTypes() []string {
 	ret := _m.Called()
 
-	var r0 uint32
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// JobShardingLeaseDuration provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobShardingLeaseDuration() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobShardingMemberName provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobShardingMemberName() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// KeeperBaseFeeBufferPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperBaseFeeBufferPercent() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// KeeperCheckUpkeepGasPriceFeatureEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// KeeperDefaultTransactionQueueDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeeperDefaultTransactionQueueDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
 	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
@@ -1987,6 +2340,254 @@ func (_m *ChainScopedConfig) KeystorePassword() string {
 	return r0
 }
 
+// LDAPActiveAttribute provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPActiveAttribute() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPActiveAttributeAllowedValue provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPActiveAttributeAllowedValue() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPAdminGroups provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPAdminGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// LDAPBaseGroupDN provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPBaseGroupDN() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPBaseUserDN provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPBaseUserDN() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPBindCacheDuration provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPBindCacheDuration() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LDAPEditGroups provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPEditGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// LDAPEmailAttribute provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPEmailAttribute() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// LDAPGroupMemberAttribute provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPGroupMemberAttribute() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPQueryTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPQueryTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LDAPReadOnlyUserDN provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPReadOnlyUserDN() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPReadOnlyUserPassword provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPReadOnlyUserPassword() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPRunGroups provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPRunGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// LDAPServerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPServerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// LDAPSyncInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPSyncInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LDAPViewGroups provides a mock function with given fields:
+func (_m *ChainScopedConfig) LDAPViewGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // LeaseLockDuration provides a mock function with given fields:
 func (_m *ChainScopedConfig) LeaseLockDuration() time.Duration {
 	ret := _m.Called()
@@ -2192,34 +2793,134 @@ func (_m *ChainScopedConfig) MinIncomingConfirmations() uint32 {
 func (_m *ChainScopedConfig) MinimumContractPayment() *assets.Link {
 	ret := _m.Called()
 
-	var r0 *assets.Link
-	if rf, ok := ret.Get(0).(func() *assets.Link); ok {
+	var r0 *assets.Link
+	if rf, ok := ret.Get(0).(func() *assets.Link); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Link)
+		}
+	}
+
+	return r0
+}
+
+// NodeNoNewHeadsThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeNoNewHeadsThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// NodePollFailureThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodePollFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NodePollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodePollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// NodeSelectionMode provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeSelectionMode() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// NodeSyncThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeSyncThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NotificationsEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) NotificationsEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NotificationsJobErrorThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) NotificationsJobErrorThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*assets.Link)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	return r0
 }
 
-// NodeNoNewHeadsThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) NodeNoNewHeadsThreshold() time.Duration {
+// NotificationsKeyBalanceThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) NotificationsKeyBalanceThreshold() *assets.Wei {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
 	}
 
 	return r0
 }
 
-// NodePollFailureThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) NodePollFailureThreshold() uint32 {
+// NotificationsMaxAttempts provides a mock function with given fields:
+func (_m *ChainScopedConfig) NotificationsMaxAttempts() uint32 {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -2232,8 +2933,8 @@ func (_m *ChainScopedConfig) NodePollFailureThreshold() uint32 {
 	return r0
 }
 
-// NodePollInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) NodePollInterval() time.Duration {
+// NotificationsRequestTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) NotificationsRequestTimeout() time.Duration {
 	ret := _m.Called()
 
 	var r0 time.Duration
@@ -2246,29 +2947,31 @@ func (_m *ChainScopedConfig) NodePollInterval() time.Duration {
 	return r0
 }
 
-// NodeSelectionMode provides a mock function with given fields:
-func (_m *ChainScopedConfig) NodeSelectionMode() string {
+// NotificationsRetryBackoff provides a mock function with given fields:
+func (_m *ChainScopedConfig) NotificationsRetryBackoff() time.Duration {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// NodeSyncThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) NodeSyncThreshold() uint32 {
+// NotificationsWebhooks provides a mock function with given fields:
+func (_m *ChainScopedConfig) NotificationsWebhooks() []coreconfig.NotificationWebhook {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 []coreconfig.NotificationWebhook
+	if rf, ok := ret.Get(0).(func() []coreconfig.NotificationWebhook); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]coreconfig.NotificationWebhook)
+		}
 	}
 
 	return r0
@@ -2603,6 +3306,174 @@ func (_m *ChainScopedConfig) OCRTransmitterAddress() (ethkey.EIP55Address, error
 	return r0, r1
 }
 
+// OIDCAdminGroups provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCAdminGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCClientID provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCClientID() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCClientSecret provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCClientSecret() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCEditGroups provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCEditGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// OIDCGroupsClaim provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCGroupsClaim() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCIssuerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCIssuerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRedirectURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCRedirectURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRunGroups provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCRunGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCScopes provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCScopes() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCViewGroups provides a mock function with given fields:
+func (_m *ChainScopedConfig) OIDCViewGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // ORMMaxIdleConns provides a mock function with given fields:
 func (_m *ChainScopedConfig) ORMMaxIdleConns() int {
 	ret := _m.Called()
@@ -3114,6 +3985,50 @@ func (_m *ChainScopedConfig) ReaperExpiration() models.Duration {
 	return r0
 }
 
+// RefreshSecrets provides a mock function with given fields: ctx
+func (_m *ChainScopedConfig) RefreshSecrets(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoteSignerBearerToken provides a mock function with given fields:
+func (_m *ChainScopedConfig) RemoteSignerBearerToken() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// RemoteSignerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) RemoteSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *ChainScopedConfig) RootDir() string {
 	ret := _m.Called()
@@ -3128,6 +4043,34 @@ func (_m *ChainScopedConfig) RootDir() string {
 	return r0
 }
 
+// SecretsProviderBackend provides a mock function with given fields:
+func (_m *ChainScopedConfig) SecretsProviderBackend() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SecretsProviderRefreshInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) SecretsProviderRefreshInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // SecureCookies provides a mock function with given fields:
 func (_m *ChainScopedConfig) SecureCookies() bool {
 	ret := _m.Called()
@@ -3588,6 +4531,22 @@ func (_m *ChainScopedConfig) TriggerFallbackDBPollInterval() time.Duration {
 	return r0
 }
 
+// TrustedProxies provides a mock function with given fields:
+func (_m *ChainScopedConfig) TrustedProxies() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // UnAuthenticatedRateLimit provides a mock function with given fields:
 func (_m *ChainScopedConfig) UnAuthenticatedRateLimit() int64 {
 	ret := _m.Called()
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -16,7 +17,9 @@ import (
 )
 
 func NewTOMLChainScopedConfig(genCfg gencfg.BasicConfig, chain *EVMConfig, lggr logger.Logger) *ChainScoped {
-	return &ChainScoped{BasicConfig: genCfg, cfg: chain, lggr: lggr}
+	c := &ChainScoped{BasicConfig: genCfg, lggr: lggr}
+	c.cfg.Store(chain)
+	return c
 }
 
 // ChainScoped implements config.ChainScopedConfig with a gencfg.BasicConfig and EVMConfig.
@@ -24,18 +27,30 @@ type ChainScoped struct {
 	gencfg.BasicConfig
 	lggr logger.Logger
 
-	cfg *EVMConfig
+	cfg atomic.Pointer[EVMConfig]
+}
+
+// EVMConfig returns the current chain configuration.
+func (c *ChainScoped) EVMConfig() *EVMConfig {
+	return c.cfg.Load()
+}
+
+// SetEVMConfig replaces the chain configuration, so that the accessors return
+// the values of cfg from now on. It is used to apply a reloaded configuration
+// without restarting the chain.
+func (c *ChainScoped) SetEVMConfig(cfg *EVMConfig) {
+	c.cfg.Store(cfg)
 }
 
 func (c *ChainScoped) ChainID() *big.Int {
-	return c.cfg.ChainID.ToInt()
+	return c.cfg.Load().ChainID.ToInt()
 }
 
 func (c *ChainScoped) ChainType() gencfg.ChainType {
-	if c.cfg.ChainType == nil {
+	if c.cfg.Load().ChainType == nil {
 		return ""
 	}
-	return gencfg.ChainType(*c.cfg.ChainType)
+	return gencfg.ChainType(*c.cfg.Load().ChainType)
 }
 
 func (c *ChainScoped) Validate() (err error) {
@@ -57,15 +72,15 @@ func (c *ChainScoped) Validate() (err error) {
 }
 
 func (c *ChainScoped) BlockBackfillDepth() uint64 {
-	return uint64(*c.cfg.BlockBackfillDepth)
+	return uint64(*c.cfg.Load().BlockBackfillDepth)
 }
 
 func (c *ChainScoped) BlockBackfillSkip() bool {
-	return *c.cfg.BlockBackfillSkip
+	return *c.cfg.Load().BlockBackfillSkip
 }
 
 func (c *ChainScoped) BalanceMonitorEnabled() bool {
-	return *c.cfg.BalanceMonitor.Enabled
+	return *c.cfg.Load().BalanceMonitor.Enabled
 }
 
 func (c *ChainScoped) BlockEmissionIdleWarningThreshold() time.Duration {
@@ -73,191 +88,199 @@ func (c *ChainScoped) BlockEmissionIdleWarningThreshold() time.Duration {
 }
 
 func (c *ChainScoped) BlockHistoryEstimatorBatchSize() (size uint32) {
-	return *c.cfg.GasEstimator.BlockHistory.BatchSize
+	return *c.cfg.Load().GasEstimator.BlockHistory.BatchSize
 }
 
 func (c *ChainScoped) BlockHistoryEstimatorBlockDelay() uint16 {
-	return *c.cfg.RPCBlockQueryDelay
+	return *c.cfg.Load().RPCBlockQueryDelay
 }
 
 func (c *ChainScoped) BlockHistoryEstimatorBlockHistorySize() uint16 {
-	return *c.cfg.GasEstimator.BlockHistory.BlockHistorySize
+	return *c.cfg.Load().GasEstimator.BlockHistory.BlockHistorySize
 }
 
 func (c *ChainScoped) BlockHistoryEstimatorCheckInclusionBlocks() uint16 {
-	return *c.cfg.GasEstimator.BlockHistory.CheckInclusionBlocks
+	return *c.cfg.Load().GasEstimator.BlockHistory.CheckInclusionBlocks
 }
 
 func (c *ChainScoped) BlockHistoryEstimatorCheckInclusionPercentile() uint16 {
-	return *c.cfg.GasEstimator.BlockHistory.CheckInclusionPercentile
+	return *c.cfg.Load().GasEstimator.BlockHistory.CheckInclusionPercentile
 }
 
 func (c *ChainScoped) BlockHistoryEstimatorEIP1559FeeCapBufferBlocks() uint16 {
-	if c.cfg.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks == nil {
+	if c.cfg.Load().GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks == nil {
 		return uint16(c.EvmGasBumpThreshold()) + 1
 	}
-	return *c.cfg.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks
+	return *c.cfg.Load().GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks
 }
 
 func (c *ChainScoped) BlockHistoryEstimatorTransactionPercentile() uint16 {
-	return *c.cfg.GasEstimator.BlockHistory.TransactionPercentile
+	return *c.cfg.Load().GasEstimator.BlockHistory.TransactionPercentile
 }
 
 func (c *ChainScoped) EvmEIP1559DynamicFees() bool {
-	return *c.cfg.GasEstimator.EIP1559DynamicFees
+	return *c.cfg.Load().GasEstimator.EIP1559DynamicFees
 }
 
 func (c *ChainScoped) EthTxReaperInterval() time.Duration {
-	return c.cfg.Transactions.ReaperInterval.Duration()
+	return c.cfg.Load().Transactions.ReaperInterval.Duration()
 }
 
 func (c *ChainScoped) EthTxReaperThreshold() time.Duration {
-	return c.cfg.Transactions.ReaperThreshold.Duration()
+	return c.cfg.Load().Transactions.ReaperThreshold.Duration()
 }
 
 func (c *ChainScoped) EthTxResendAfterThreshold() time.Duration {
-	return c.cfg.Transactions.ResendAfterThreshold.Duration()
+	return c.cfg.Load().Transactions.ResendAfterThreshold.Duration()
 }
 
 func (c *ChainScoped) EvmFinalityDepth() uint32 {
-	return *c.cfg.FinalityDepth
+	return *c.cfg.Load().FinalityDepth
+}
+
+func (c *ChainScoped) EvmFinalityTagEnabled() bool {
+	return *c.cfg.Load().FinalityTagEnabled
 }
 
 func (c *ChainScoped) EvmGasBumpPercent() uint16 {
-	return *c.cfg.GasEstimator.BumpPercent
+	return *c.cfg.Load().GasEstimator.BumpPercent
 }
 
 func (c *ChainScoped) EvmGasBumpThreshold() uint64 {
-	return uint64(*c.cfg.GasEstimator.BumpThreshold)
+	return uint64(*c.cfg.Load().GasEstimator.BumpThreshold)
 }
 
 func (c *ChainScoped) EvmGasBumpTxDepth() uint16 {
-	return *c.cfg.GasEstimator.BumpTxDepth
+	return *c.cfg.Load().GasEstimator.BumpTxDepth
 }
 
 func (c *ChainScoped) EvmGasBumpWei() *assets.Wei {
-	return c.cfg.GasEstimator.BumpMin
+	return c.cfg.Load().GasEstimator.BumpMin
 }
 
 func (c *ChainScoped) EvmGasFeeCapDefault() *assets.Wei {
-	return c.cfg.GasEstimator.FeeCapDefault
+	return c.cfg.Load().GasEstimator.FeeCapDefault
 }
 
 func (c *ChainScoped) EvmGasLimitDefault() uint32 {
-	return *c.cfg.GasEstimator.LimitDefault
+	return *c.cfg.Load().GasEstimator.LimitDefault
 }
 
 func (c *ChainScoped) EvmGasLimitMax() uint32 {
-	return *c.cfg.GasEstimator.LimitMax
+	return *c.cfg.Load().GasEstimator.LimitMax
 }
 
 func (c *ChainScoped) EvmGasLimitMultiplier() float32 {
-	f, _ := c.cfg.GasEstimator.LimitMultiplier.BigFloat().Float32()
+	f, _ := c.cfg.Load().GasEstimator.LimitMultiplier.BigFloat().Float32()
 	return f
 }
 
 func (c *ChainScoped) EvmGasLimitTransfer() uint32 {
-	return *c.cfg.GasEstimator.LimitTransfer
+	return *c.cfg.Load().GasEstimator.LimitTransfer
 }
 
 func (c *ChainScoped) EvmGasLimitOCRJobType() *uint32 {
-	return c.cfg.GasEstimator.LimitJobType.OCR
+	return c.cfg.Load().GasEstimator.LimitJobType.OCR
 }
 
 func (c *ChainScoped) EvmGasLimitDRJobType() *uint32 {
-	return c.cfg.GasEstimator.LimitJobType.DR
+	return c.cfg.Load().GasEstimator.LimitJobType.DR
 }
 
 func (c *ChainScoped) EvmGasLimitVRFJobType() *uint32 {
-	return c.cfg.GasEstimator.LimitJobType.VRF
+	return c.cfg.Load().GasEstimator.LimitJobType.VRF
 }
 
 func (c *ChainScoped) EvmGasLimitFMJobType() *uint32 {
-	return c.cfg.GasEstimator.LimitJobType.FM
+	return c.cfg.Load().GasEstimator.LimitJobType.FM
 }
 
 func (c *ChainScoped) EvmGasLimitKeeperJobType() *uint32 {
-	return c.cfg.GasEstimator.LimitJobType.Keeper
+	return c.cfg.Load().GasEstimator.LimitJobType.Keeper
 }
 
 func (c *ChainScoped) EvmGasPriceDefault() *assets.Wei {
-	return c.cfg.GasEstimator.PriceDefault
+	return c.cfg.Load().GasEstimator.PriceDefault
 }
 
 func (c *ChainScoped) EvmMinGasPriceWei() *assets.Wei {
-	return c.cfg.GasEstimator.PriceMin
+	return c.cfg.Load().GasEstimator.PriceMin
 }
 
 func (c *ChainScoped) EvmMaxGasPriceWei() *assets.Wei {
-	return c.cfg.GasEstimator.PriceMax
+	return c.cfg.Load().GasEstimator.PriceMax
 }
 
 func (c *ChainScoped) EvmGasTipCapDefault() *assets.Wei {
-	return c.cfg.GasEstimator.TipCapDefault
+	return c.cfg.Load().GasEstimator.TipCapDefault
 }
 
 func (c *ChainScoped) EvmGasTipCapMinimum() *assets.Wei {
-	return c.cfg.GasEstimator.TipCapMin
+	return c.cfg.Load().GasEstimator.TipCapMin
 }
 
 func (c *ChainScoped) EvmHeadTrackerHistoryDepth() uint32 {
-	return *c.cfg.HeadTracker.HistoryDepth
+	return *c.cfg.Load().HeadTracker.HistoryDepth
 }
 
 func (c *ChainScoped) EvmHeadTrackerMaxBufferSize() uint32 {
-	return *c.cfg.HeadTracker.MaxBufferSize
+	return *c.cfg.Load().HeadTracker.MaxBufferSize
 }
 
 func (c *ChainScoped) EvmHeadTrackerSamplingInterval() time.Duration {
-	return c.cfg.HeadTracker.SamplingInterval.Duration()
+	return c.cfg.Load().HeadTracker.SamplingInterval.Duration()
 }
 
 func (c *ChainScoped) EvmLogBackfillBatchSize() uint32 {
-	return *c.cfg.LogBackfillBatchSize
+	return *c.cfg.Load().LogBackfillBatchSize
 }
 
 func (c *ChainScoped) EvmLogPollInterval() time.Duration {
-	return c.cfg.LogPollInterval.Duration()
+	return c.cfg.Load().LogPollInterval.Duration()
 }
 
 func (c *ChainScoped) EvmLogKeepBlocksDepth() uint32 {
-	return *c.cfg.LogKeepBlocksDepth
+	return *c.cfg.Load().LogKeepBlocksDepth
+}
+
+func (c *ChainScoped) EvmLogBroadcasterUseLogPoller() bool {
+	return *c.cfg.Load().LogBroadcasterUseLogPoller
 }
 
 func (c *ChainScoped) EvmMaxInFlightTransactions() uint32 {
-	return *c.cfg.Transactions.MaxInFlight
+	return *c.cfg.Load().Transactions.MaxInFlight
 }
 
 func (c *ChainScoped) EvmMaxQueuedTransactions() uint64 {
-	return uint64(*c.cfg.Transactions.MaxQueued)
+	return uint64(*c.cfg.Load().Transactions.MaxQueued)
 }
 
 func (c *ChainScoped) EvmNonceAutoSync() bool {
-	return *c.cfg.NonceAutoSync
+	return *c.cfg.Load().NonceAutoSync
 }
 
 func (c *ChainScoped) EvmUseForwarders() bool {
-	return *c.cfg.Transactions.ForwardersEnabled
+	return *c.cfg.Load().Transactions.ForwardersEnabled
 }
 
 func (c *ChainScoped) EvmRPCDefaultBatchSize() uint32 {
-	return *c.cfg.RPCDefaultBatchSize
+	return *c.cfg.Load().RPCDefaultBatchSize
 }
 
 func (c *ChainScoped) FlagsContractAddress() string {
-	if c.cfg.FlagsContractAddress == nil {
+	if c.cfg.Load().FlagsContractAddress == nil {
 		return ""
 	}
-	return c.cfg.FlagsContractAddress.String()
+	return c.cfg.Load().FlagsContractAddress.String()
 }
 
 func (c *ChainScoped) GasEstimatorMode() string {
-	return *c.cfg.GasEstimator.Mode
+	return *c.cfg.Load().GasEstimator.Mode
 }
 func (c *ChainScoped) KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei {
 	var keySpecific *assets.Wei
-	for i := range c.cfg.KeySpecific {
-		ks := c.cfg.KeySpecific[i]
+	for i := range c.cfg.Load().KeySpecific {
+		ks := c.cfg.Load().KeySpecific[i]
 		if ks.Key.Address() == addr {
 			keySpecific = ks.GasEstimator.PriceMax
 			break
@@ -273,63 +296,63 @@ func (c *ChainScoped) KeySpecificMaxGasPriceWei(addr common.Address) *assets.Wei
 }
 
 func (c *ChainScoped) LinkContractAddress() string {
-	if c.cfg.LinkContractAddress == nil {
+	if c.cfg.Load().LinkContractAddress == nil {
 		return ""
 	}
-	return c.cfg.LinkContractAddress.String()
+	return c.cfg.Load().LinkContractAddress.String()
 }
 
 func (c *ChainScoped) OperatorFactoryAddress() string {
-	if c.cfg.OperatorFactoryAddress == nil {
+	if c.cfg.Load().OperatorFactoryAddress == nil {
 		return ""
 	}
-	return c.cfg.OperatorFactoryAddress.String()
+	return c.cfg.Load().OperatorFactoryAddress.String()
 }
 
 func (c *ChainScoped) MinIncomingConfirmations() uint32 {
-	return *c.cfg.MinIncomingConfirmations
+	return *c.cfg.Load().MinIncomingConfirmations
 }
 
 func (c *ChainScoped) MinimumContractPayment() *assets.Link {
-	return c.cfg.MinContractPayment
+	return c.cfg.Load().MinContractPayment
 }
 
 func (c *ChainScoped) NodeNoNewHeadsThreshold() time.Duration {
-	return c.cfg.NoNewHeadsThreshold.Duration()
+	return c.cfg.Load().NoNewHeadsThreshold.Duration()
 }
 
 func (c *ChainScoped) NodePollFailureThreshold() uint32 {
-	return *c.cfg.NodePool.PollFailureThreshold
+	return *c.cfg.Load().NodePool.PollFailureThreshold
 }
 
 func (c *ChainScoped) NodePollInterval() time.Duration {
-	return c.cfg.NodePool.PollInterval.Duration()
+	return c.cfg.Load().NodePool.PollInterval.Duration()
 }
 
 func (c *ChainScoped) NodeSelectionMode() string {
-	return *c.cfg.NodePool.SelectionMode
+	return *c.cfg.Load().NodePool.SelectionMode
 }
 
 func (c *ChainScoped) NodeSyncThreshold() uint32 {
-	return *c.cfg.NodePool.SyncThreshold
+	return *c.cfg.Load().NodePool.SyncThreshold
 }
 
 func (c *ChainScoped) OCRContractConfirmations() uint16 {
-	return *c.cfg.OCR.ContractConfirmations
+	return *c.cfg.Load().OCR.ContractConfirmations
 }
 
 func (c *ChainScoped) OCRContractTransmitterTransmitTimeout() time.Duration {
-	return c.cfg.OCR.ContractTransmitterTransmitTimeout.Duration()
+	return c.cfg.Load().OCR.ContractTransmitterTransmitTimeout.Duration()
 }
 
 func (c *ChainScoped) OCRObservationGracePeriod() time.Duration {
-	return c.cfg.OCR.ObservationGracePeriod.Duration()
+	return c.cfg.Load().OCR.ObservationGracePeriod.Duration()
 }
 
 func (c *ChainScoped) OCRDatabaseTimeout() time.Duration {
-	return c.cfg.OCR.DatabaseTimeout.Duration()
+	return c.cfg.Load().OCR.DatabaseTimeout.Duration()
 }
 
 func (c *ChainScoped) OCR2AutomationGasLimit() uint32 {
-	return *c.cfg.OCR2.Automation.GasLimit
+	return *c.cfg.Load().OCR2.Automation.GasLimit
 }
@@ -276,22 +276,24 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 }
 
 type Chain struct {
-	BlockBackfillDepth       *uint32
-	BlockBackfillSkip        *bool
-	ChainType                *string
-	FinalityDepth            *uint32
-	FlagsContractAddress     *ethkey.EIP55Address
-	LinkContractAddress      *ethkey.EIP55Address
-	LogBackfillBatchSize     *uint32
-	LogPollInterval          *models.Duration
-	LogKeepBlocksDepth       *uint32
-	MinIncomingConfirmations *uint32
-	MinContractPayment       *assets.Link
-	NonceAutoSync            *bool
-	NoNewHeadsThreshold      *models.Duration
-	OperatorFactoryAddress   *ethkey.EIP55Address
-	RPCDefaultBatchSize      *uint32
-	RPCBlockQueryDelay       *uint16
+	BlockBackfillDepth         *uint32
+	BlockBackfillSkip          *bool
+	ChainType                  *string
+	FinalityDepth              *uint32
+	FinalityTagEnabled         *bool
+	FlagsContractAddress       *ethkey.EIP55Address
+	LinkContractAddress        *ethkey.EIP55Address
+	LogBackfillBatchSize       *uint32
+	LogPollInterval            *models.Duration
+	LogKeepBlocksDepth         *uint32
+	LogBroadcasterUseLogPoller *bool
+	MinIncomingConfirmations   *uint32
+	MinContractPayment         *assets.Link
+	NonceAutoSync              *bool
+	NoNewHeadsThreshold        *models.Duration
+	OperatorFactoryAddress     *ethkey.EIP55Address
+	RPCDefaultBatchSize        *uint32
+	RPCBlockQueryDelay         *uint16
 
 	Transactions   Transactions      `toml:",omitempty"`
 	BalanceMonitor BalanceMonitor    `toml:",omitempty"`
@@ -116,6 +116,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.FinalityDepth; v != nil {
 		c.FinalityDepth = v
 	}
+	if v := f.FinalityTagEnabled; v != nil {
+		c.FinalityTagEnabled = v
+	}
 	if v := f.FlagsContractAddress; v != nil {
 		c.FlagsContractAddress = v
 	}
@@ -131,6 +134,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.LogKeepBlocksDepth; v != nil {
 		c.LogKeepBlocksDepth = v
 	}
+	if v := f.LogBroadcasterUseLogPoller; v != nil {
+		c.LogBroadcasterUseLogPoller = v
+	}
 	if v := f.MinIncomingConfirmations; v != nil {
 		c.MinIncomingConfirmations = v
 	}
@@ -27,20 +27,22 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 		BlockBackfillDepth: ptr[uint32](10),
 		BlockBackfillSkip:  ptr(false),
 
-		ChainType:                ptr(string(set.chainType)),
-		FinalityDepth:            ptr(set.finalityDepth),
-		FlagsContractAddress:     asEIP155Address(set.flagsContractAddress),
-		LinkContractAddress:      asEIP155Address(set.linkContractAddress),
-		LogBackfillBatchSize:     ptr(set.logBackfillBatchSize),
-		LogPollInterval:          models.MustNewDuration(set.logPollInterval),
-		LogKeepBlocksDepth:       ptr(set.logKeepBlocksDepth),
-		MinIncomingConfirmations: ptr(set.minIncomingConfirmations),
-		MinContractPayment:       set.minimumContractPayment,
-		NonceAutoSync:            ptr(set.nonceAutoSync),
-		NoNewHeadsThreshold:      models.MustNewDuration(set.nodeDeadAfterNoNewHeadersThreshold),
-		OperatorFactoryAddress:   asEIP155Address(set.operatorFactoryAddress),
-		RPCDefaultBatchSize:      ptr(set.rpcDefaultBatchSize),
-		RPCBlockQueryDelay:       ptr(set.blockHistoryEstimatorBlockDelay),
+		ChainType:                  ptr(string(set.chainType)),
+		FinalityDepth:              ptr(set.finalityDepth),
+		FinalityTagEnabled:         ptr(set.finalityTagEnabled),
+		FlagsContractAddress:       asEIP155Address(set.flagsContractAddress),
+		LinkContractAddress:        asEIP155Address(set.linkContractAddress),
+		LogBackfillBatchSize:       ptr(set.logBackfillBatchSize),
+		LogPollInterval:            models.MustNewDuration(set.logPollInterval),
+		LogKeepBlocksDepth:         ptr(set.logKeepBlocksDepth),
+		LogBroadcasterUseLogPoller: ptr(set.logBroadcasterUseLogPoller),
+		MinIncomingConfirmations:   ptr(set.minIncomingConfirmations),
+		MinContractPayment:         set.minimumContractPayment,
+		NonceAutoSync:              ptr(set.nonceAutoSync),
+		NoNewHeadsThreshold:        models.MustNewDuration(set.nodeDeadAfterNoNewHeadersThreshold),
+		OperatorFactoryAddress:     asEIP155Address(set.operatorFactoryAddress),
+		RPCDefaultBatchSize:        ptr(set.rpcDefaultBatchSize),
+		RPCBlockQueryDelay:         ptr(set.blockHistoryEstimatorBlockDelay),
 		Transactions: v2.Transactions{
 			ForwardersEnabled:    ptr(set.useForwarders),
 			MaxInFlight:          ptr(set.maxInFlightTransactions),
@@ -195,9 +195,15 @@ func (f *FwdMgr) subscribeForwardersLogs(fwdrs []Forwarder) error {
 	return nil
 }
 
+// FilterName is the name of the log poller filter of the forwarder at addr.
+func FilterName(addr common.Address) string {
+	return evmlogpoller.FilterName("ForwarderManager AuthorizedSendersChanged", addr.String())
+}
+
 func (f *FwdMgr) subscribeSendersChangedLogs(addr common.Address) error {
-	_, err := f.logpoller.RegisterFilter(
+	err := f.logpoller.RegisterFilter(
 		evmlogpoller.Filter{
+			Name:      FilterName(addr),
 			EventSigs: []common.Hash{authChangedTopic},
 			Addresses: []common.Address{addr},
 		})
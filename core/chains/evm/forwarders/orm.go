@@ -1,8 +1,6 @@
 package forwarders
 
 import (
-	"database/sql"
-
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
@@ -16,7 +14,7 @@ type ORM interface {
 	CreateForwarder(addr common.Address, evmChainId utils.Big) (fwd Forwarder, err error)
 	FindForwarders(offset, limit int) ([]Forwarder, int, error)
 	FindForwardersByChain(evmChainId utils.Big) ([]Forwarder, error)
-	DeleteForwarder(id int32) error
+	DeleteForwarder(id int32, cleanup func(tx pg.Queryer, evmChainID utils.Big, addr common.Address) error) error
 	FindForwardersInListByChain(evmChainId utils.Big, addrs []common.Address) ([]Forwarder, error)
 }
 
@@ -38,20 +36,22 @@ func (o *orm) CreateForwarder(addr common.Address, evmChainId utils.Big) (fwd Fo
 }
 
 // DeleteForwarder removes a forwarder address.
-func (o *orm) DeleteForwarder(id int32) error {
-	q := `DELETE FROM evm_forwarders WHERE id = $1`
-	result, err := o.q.Exec(q, id)
-	if err != nil {
+// If cleanup is non-nil, it is called in the same transaction, before the forwarder is deleted, to remove the state
+// kept for the forwarder, like its log poller filters. The forwarder is not deleted if cleanup returns an error.
+func (o *orm) DeleteForwarder(id int32, cleanup func(tx pg.Queryer, evmChainID utils.Big, addr common.Address) error) error {
+	return o.q.Transaction(func(tx pg.Queryer) error {
+		var fwd Forwarder
+		if err := tx.Get(&fwd, `SELECT * FROM evm_forwarders WHERE id = $1`, id); err != nil {
+			return err
+		}
+		if cleanup != nil {
+			if err := cleanup(tx, fwd.EVMChainID, fwd.Address); err != nil {
+				return err
+			}
+		}
+		_, err := tx.Exec(`DELETE FROM evm_forwarders WHERE id = $1`, id)
 		return err
-	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rowsAffected == 0 {
-		return sql.ErrNoRows
-	}
-	return nil
+	})
 }
 
 // FindForwarders returns all forwarder addresses from offset up until limit.
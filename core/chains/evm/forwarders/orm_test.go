@@ -0,0 +1,59 @@
+package forwarders_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/forwarders"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestORM_DeleteForwarder(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := pgtest.NewQConfig(true)
+	orm := forwarders.NewORM(db, lggr, cfg)
+	chainID := utils.Big(*testutils.FixtureChainID)
+	addr := testutils.NewAddress()
+	fwd, err := orm.CreateForwarder(addr, chainID)
+	require.NoError(t, err)
+
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, cfg), nil, reorg.NullBroadcaster, lggr, time.Hour, false, 2, 3, 2, 1000)
+	require.NoError(t, lp.RegisterFilter(logpoller.Filter{Name: forwarders.FilterName(addr), EventSigs: []common.Hash{utils.NewHash()}, Addresses: []common.Address{addr}}))
+	cleanup := func(tx pg.Queryer, evmChainID utils.Big, a common.Address) error {
+		assert.Equal(t, chainID, evmChainID)
+		assert.Equal(t, addr, a)
+		return lp.UnregisterFilter(forwarders.FilterName(a), pg.WithQueryer(tx))
+	}
+
+	// A failed cleanup aborts the deletion.
+	errCleanup := errors.New("cleanup failed")
+	require.ErrorIs(t, orm.DeleteForwarder(int32(fwd.ID), func(pg.Queryer, utils.Big, common.Address) error { return errCleanup }), errCleanup)
+	fwds, err := orm.FindForwardersByChain(chainID)
+	require.NoError(t, err)
+	require.Len(t, fwds, 1)
+
+	require.NoError(t, orm.DeleteForwarder(int32(fwd.ID), cleanup))
+	fwds, err = orm.FindForwardersByChain(chainID)
+	require.NoError(t, err)
+	assert.Empty(t, fwds)
+	filters, err := logpoller.NewORM(testutils.FixtureChainID, db, lggr, cfg).LoadFilters()
+	require.NoError(t, err)
+	assert.NotContains(t, filters, forwarders.FilterName(addr))
+
+	require.ErrorIs(t, orm.DeleteForwarder(int32(fwd.ID), cleanup), sql.ErrNoRows)
+}
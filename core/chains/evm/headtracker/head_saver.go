@@ -38,6 +38,14 @@ func (hs *headSaver) Save(ctx context.Context, head *evmtypes.Head) error {
 }
 
 func (hs *headSaver) LoadFromDB(ctx context.Context) (chain *evmtypes.Head, err error) {
+	if hs.config.EvmFinalityTagEnabled() {
+		finalized, err := hs.orm.LatestFinalizedHead(ctx)
+		if err != nil {
+			return nil, err
+		}
+		hs.heads.MarkFinalized(finalized)
+	}
+
 	historyDepth := uint(hs.config.EvmHeadTrackerHistoryDepth())
 	heads, err := hs.orm.LatestHeads(ctx, historyDepth)
 	if err != nil {
@@ -67,6 +75,18 @@ func (hs *headSaver) Chain(hash common.Hash) *evmtypes.Head {
 	return hs.heads.HeadByHash(hash)
 }
 
+func (hs *headSaver) MarkFinalized(ctx context.Context, finalized *evmtypes.Head) error {
+	if err := hs.orm.UpsertFinalizedHead(ctx, finalized); err != nil {
+		return err
+	}
+	hs.heads.MarkFinalized(finalized)
+	return nil
+}
+
+func (hs *headSaver) LatestFinalizedHead() *evmtypes.Head {
+	return hs.heads.LatestFinalizedHead()
+}
+
 var NullSaver httypes.HeadSaver = &nullSaver{}
 
 type nullSaver struct{}
@@ -76,3 +96,5 @@ func (*nullSaver) LoadFromDB(ctx context.Context) (*evmtypes.Head, error)
 func (*nullSaver) LatestHeadFromDB(ctx context.Context) (*evmtypes.Head, error) { return nil, nil }
 func (*nullSaver) LatestChain() *evmtypes.Head                                  { return nil }
 func (*nullSaver) Chain(hash common.Hash) *evmtypes.Head                        { return nil }
+func (*nullSaver) MarkFinalized(ctx context.Context, head *evmtypes.Head) error { return nil }
+func (*nullSaver) LatestFinalizedHead() *evmtypes.Head                          { return nil }
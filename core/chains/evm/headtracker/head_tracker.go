@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -14,9 +15,11 @@ import (
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -26,6 +29,11 @@ var (
 		Help: "The highest seen head number",
 	}, []string{"evmChainID"})
 
+	promFinalizedHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_finalized_head",
+		Help: "The latest finalized head number, as reported by the finalized block tag",
+	}, []string{"evmChainID"})
+
 	promOldHead = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is ETH_FINALITY_DEPTH or greater below the highest seen head)",
@@ -39,6 +47,7 @@ type headTracker struct {
 	log             logger.Logger
 	headBroadcaster httypes.HeadBroadcaster
 	headSaver       httypes.HeadSaver
+	reorgs          reorg.Broadcaster
 	mailMon         *utils.MailboxMonitor
 	ethClient       evmclient.Client
 	chainID         big.Int
@@ -59,6 +68,7 @@ func NewHeadTracker(
 	config Config,
 	headBroadcaster httypes.HeadBroadcaster,
 	headSaver httypes.HeadSaver,
+	reorgs reorg.Broadcaster,
 	mailMon *utils.MailboxMonitor,
 ) httypes.HeadTracker {
 	chStop := make(chan struct{})
@@ -75,6 +85,7 @@ func NewHeadTracker(
 		chStop:          chStop,
 		headListener:    NewHeadListener(lggr, ethClient, config, chStop),
 		headSaver:       headSaver,
+		reorgs:          reorgs,
 		mailMon:         mailMon,
 	}
 }
@@ -163,6 +174,10 @@ func (ht *headTracker) LatestChain() *evmtypes.Head {
 	return ht.headSaver.LatestChain()
 }
 
+func (ht *headTracker) LatestFinalizedHead() *evmtypes.Head {
+	return ht.headSaver.LatestFinalizedHead()
+}
+
 func (ht *headTracker) getInitialHead(ctx context.Context) (*evmtypes.Head, error) {
 	head, err := ht.ethClient.HeadByNumber(ctx, nil)
 	if err != nil {
@@ -185,6 +200,16 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 		"parentHeadHash", head.ParentHash,
 	)
 
+	if ht.config.EvmFinalityTagEnabled() && (prevHead == nil || head.Number > prevHead.Number) {
+		// Finality is refreshed before saving, so that the new chain is
+		// marked accordingly before it gets broadcast.
+		if err := ht.updateFinalizedHead(ctx); ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			ht.log.Warnw("Failed to update latest finalized head", "err", err)
+		}
+	}
+
 	err := ht.headSaver.Save(ctx, head)
 	if ctx.Err() != nil {
 		return nil
@@ -199,6 +224,9 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 		if headWithChain == nil {
 			return errors.Errorf("HeadTracker#handleNewHighestHead headWithChain was unexpectedly nil")
 		}
+		if prevHead != nil {
+			ht.detectReorg(ctx, prevHead, headWithChain)
+		}
 		ht.backfillMB.Deliver(headWithChain)
 		ht.broadcastMB.Deliver(headWithChain)
 	} else if head.Number == prevHead.Number {
@@ -217,6 +245,65 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	return nil
 }
 
+// detectReorg records and broadcasts a reorg if newHead is not a descendant of prevHead.
+func (ht *headTracker) detectReorg(ctx context.Context, prevHead, newHead *evmtypes.Head) {
+	r, newHashes, ok := reorg.FromHeads(utils.Big(ht.chainID), prevHead, newHead)
+	if !ok {
+		return
+	}
+	ht.log.Warnw("Reorg detected", "fromBlock", r.FromBlock, "toBlock", r.ToBlock, "depth", r.Depth, "oldHash", r.OldHash, "newHash", r.NewHash)
+	if err := ht.reorgs.Record(&r, newHashes, pg.WithParentCtx(ctx)); err != nil {
+		ht.log.Errorw("Failed to record reorg", "err", err)
+		return
+	}
+	ht.reorgs.Broadcast(r)
+}
+
+// updateFinalizedHead fetches the latest finalized block using the `finalized`
+// block tag and persists it, if it is newer than the one we already have.
+func (ht *headTracker) updateFinalizedHead(ctx context.Context) error {
+	finalized, err := ht.ethClient.HeadByNumber(ctx, big.NewInt(rpc.FinalizedBlockNumber.Int64()))
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch finalized head")
+	} else if finalized == nil {
+		return errors.New("got nil finalized head")
+	}
+	if latest := ht.headSaver.LatestFinalizedHead(); latest != nil && finalized.Number <= latest.Number {
+		if finalized.Number < latest.Number {
+			ht.log.Warnw("Got finalized head older than the latest known finalized head", "blockNum", finalized.Number, "latestFinalized", latest.Number)
+		}
+		return nil
+	}
+	if err := ht.headSaver.MarkFinalized(ctx, finalized); err != nil {
+		return errors.Wrapf(err, "failed to save finalized head: %#v", finalized)
+	}
+	promFinalizedHead.WithLabelValues(ht.chainID.String()).Set(float64(finalized.Number))
+	ht.log.Debugw("New finalized head", "blockNum", finalized.Number, "blockHash", finalized.Hash)
+	return nil
+}
+
+// backfillDepth returns how deep the chain ending at head should be backfilled.
+// With finality tags enabled, the chain is backfilled at least down to the
+// latest finalized head, so that it can be marked as such.
+func (ht *headTracker) backfillDepth(head *evmtypes.Head) uint {
+	depth := uint(ht.config.EvmFinalityDepth())
+	if !ht.config.EvmFinalityTagEnabled() {
+		return depth
+	}
+	finalized := ht.headSaver.LatestFinalizedHead()
+	if finalized == nil || finalized.Number > head.Number {
+		return depth
+	}
+	finalityDepth := uint(head.Number-finalized.Number) + 1
+	if historyDepth := uint(ht.config.EvmHeadTrackerHistoryDepth()); finalityDepth > historyDepth {
+		finalityDepth = historyDepth
+	}
+	if finalityDepth > depth {
+		return finalityDepth
+	}
+	return depth
+}
+
 func (ht *headTracker) broadcastLoop() {
 	defer ht.wgDone.Done()
 
@@ -273,7 +360,7 @@ func (ht *headTracker) backfillLoop() {
 					break
 				}
 				{
-					err := ht.Backfill(ctx, head, uint(ht.config.EvmFinalityDepth()))
+					err := ht.Backfill(ctx, head, ht.backfillDepth(head))
 					if err != nil {
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
@@ -354,4 +441,5 @@ func (*nullTracker) SetLogLevel(zapcore.Level)   {}
 func (*nullTracker) Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error) {
 	return nil
 }
-func (*nullTracker) LatestChain() *evmtypes.Head { return nil }
+func (*nullTracker) LatestChain() *evmtypes.Head         { return nil }
+func (*nullTracker) LatestFinalizedHead() *evmtypes.Head { return nil }
@@ -20,11 +20,17 @@ type Heads interface {
 	AddHeads(historyDepth uint, newHeads ...*evmtypes.Head)
 	// Count returns number of heads in the collection.
 	Count() int
+	// MarkFinalized records the latest finalized head. Heads in the collection which are
+	// ancestors of (or equal to) it are marked IsFinalized the next time AddHeads is called.
+	MarkFinalized(finalized *evmtypes.Head)
+	// LatestFinalizedHead returns the latest head recorded with MarkFinalized, or nil.
+	LatestFinalizedHead() *evmtypes.Head
 }
 
 type heads struct {
-	heads []*evmtypes.Head
-	mu    sync.RWMutex
+	heads     []*evmtypes.Head
+	finalized *evmtypes.Head
+	mu        sync.RWMutex
 }
 
 func NewHeads() Heads {
@@ -60,6 +66,27 @@ func (h *heads) Count() int {
 	return len(h.heads)
 }
 
+func (h *heads) MarkFinalized(finalized *evmtypes.Head) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if finalized == nil || (h.finalized != nil && finalized.Number < h.finalized.Number) {
+		// finality never moves backwards
+		return
+	}
+	finalizedCopy := *finalized
+	finalizedCopy.Parent = nil
+	finalizedCopy.IsFinalized = true
+	h.finalized = &finalizedCopy
+}
+
+func (h *heads) LatestFinalizedHead() *evmtypes.Head {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.finalized
+}
+
 func (h *heads) AddHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -108,6 +135,15 @@ func (h *heads) AddHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 		}
 	}
 
+	// mark finalized
+	if h.finalized != nil {
+		if head, exists := headsMap[h.finalized.Hash]; exists {
+			for ; head != nil; head = head.Parent {
+				head.IsFinalized = true
+			}
+		}
+	}
+
 	// set
 	h.heads = heads
 }
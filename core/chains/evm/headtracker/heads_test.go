@@ -107,3 +107,42 @@ func TestHeads_AddHeads(t *testing.T) {
 	require.NotNil(t, head)
 	require.Equal(t, 2, int(head.ChainLength()))
 }
+
+func TestHeads_MarkFinalized(t *testing.T) {
+	t.Parallel()
+
+	heads := headtracker.NewHeads()
+
+	var testHeads []*evmtypes.Head
+	var parentHash common.Hash
+	for i := 0; i < 5; i++ {
+		hash := utils.NewHash()
+		h := evmtypes.NewHead(big.NewInt(int64(i)), hash, parentHash, uint64(time.Now().Unix()), utils.NewBigI(0))
+		testHeads = append(testHeads, &h)
+		parentHash = hash
+	}
+	uncle := evmtypes.NewHead(big.NewInt(2), utils.NewHash(), testHeads[1].Hash, uint64(time.Now().Unix()), utils.NewBigI(0))
+
+	heads.AddHeads(10, append(testHeads, &uncle)...)
+	require.Nil(t, heads.LatestFinalizedHead())
+	require.Nil(t, heads.LatestHead().LatestFinalizedHead())
+
+	heads.MarkFinalized(testHeads[2])
+	require.Equal(t, testHeads[2].Hash, heads.LatestFinalizedHead().Hash)
+
+	// marking is applied on the next AddHeads
+	heads.AddHeads(10)
+	latest := heads.LatestHead()
+	require.NotNil(t, latest)
+	finalized := latest.LatestFinalizedHead()
+	require.NotNil(t, finalized)
+	require.Equal(t, testHeads[2].Hash, finalized.Hash)
+	for i, h := range testHeads {
+		require.Equal(t, i <= 2, heads.HeadByHash(h.Hash).IsFinalized, "head %d", i)
+	}
+	require.False(t, heads.HeadByHash(uncle.Hash).IsFinalized)
+
+	// finality never moves backwards
+	heads.MarkFinalized(testHeads[1])
+	require.Equal(t, testHeads[2].Hash, heads.LatestFinalizedHead().Hash)
+}
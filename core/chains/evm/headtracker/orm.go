@@ -28,6 +28,10 @@ type ORM interface {
 	LatestHeads(ctx context.Context, limit uint) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// UpsertFinalizedHead persists the given head as the latest finalized head for this chain.
+	UpsertFinalizedHead(ctx context.Context, head *evmtypes.Head) error
+	// LatestFinalizedHead returns the latest persisted finalized head, or nil if none exists
+	LatestFinalizedHead(ctx context.Context) (head *evmtypes.Head, err error)
 }
 
 type orm struct {
@@ -92,3 +96,30 @@ func (orm *orm) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtype
 	}
 	return head, err
 }
+
+func (orm *orm) UpsertFinalizedHead(ctx context.Context, head *evmtypes.Head) error {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	query := `
+	INSERT INTO evm_finalized_heads (evm_chain_id, hash, number, parent_hash, timestamp, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+	ON CONFLICT (evm_chain_id) DO UPDATE SET
+		hash = EXCLUDED.hash,
+		number = EXCLUDED.number,
+		parent_hash = EXCLUDED.parent_hash,
+		timestamp = EXCLUDED.timestamp,
+		updated_at = NOW()
+	WHERE evm_finalized_heads.number <= EXCLUDED.number`
+	err := q.ExecQ(query, orm.chainID, head.Hash, head.Number, head.ParentHash, head.Timestamp)
+	return errors.Wrap(err, "UpsertFinalizedHead failed")
+}
+
+func (orm *orm) LatestFinalizedHead(ctx context.Context) (head *evmtypes.Head, err error) {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	head = new(evmtypes.Head)
+	err = q.Get(head, `SELECT evm_chain_id, hash, number, parent_hash, timestamp, created_at FROM evm_finalized_heads WHERE evm_chain_id = $1`, orm.chainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	err = errors.Wrap(err, "LatestFinalizedHead failed")
+	return
+}
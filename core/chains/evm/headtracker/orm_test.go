@@ -119,3 +119,38 @@ func TestORM_LatestHeads_NoRows(t *testing.T) {
 	require.Zero(t, len(heads))
 	require.NoError(t, err)
 }
+
+func TestORM_FinalizedHead(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	orm := headtracker.NewORM(db, logger, cfg, cltest.FixtureChainID)
+
+	head, err := orm.LatestFinalizedHead(testutils.Context(t))
+	require.NoError(t, err)
+	require.Nil(t, head)
+
+	h5 := cltest.Head(5)
+	require.NoError(t, orm.UpsertFinalizedHead(testutils.Context(t), h5))
+
+	head, err = orm.LatestFinalizedHead(testutils.Context(t))
+	require.NoError(t, err)
+	require.NotNil(t, head)
+	assert.Equal(t, h5.Hash, head.Hash)
+	assert.Equal(t, int64(5), head.Number)
+
+	// finality never moves backwards
+	require.NoError(t, orm.UpsertFinalizedHead(testutils.Context(t), cltest.Head(3)))
+	head, err = orm.LatestFinalizedHead(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, h5.Hash, head.Hash)
+
+	h8 := cltest.Head(8)
+	require.NoError(t, orm.UpsertFinalizedHead(testutils.Context(t), h8))
+	head, err = orm.LatestFinalizedHead(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, h8.Hash, head.Hash)
+	assert.Equal(t, int64(8), head.Number)
+}
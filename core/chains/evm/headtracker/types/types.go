@@ -22,6 +22,11 @@ type HeadSaver interface {
 	LatestChain() *evmtypes.Head
 	// Chain returns a head for the specified hash, or nil.
 	Chain(hash common.Hash) *evmtypes.Head
+	// MarkFinalized persists the latest finalized head. Heads in the chain up to and including
+	// it are marked as finalized on the next Save.
+	MarkFinalized(ctx context.Context, finalized *evmtypes.Head) error
+	// LatestFinalizedHead returns the latest finalized head, or nil.
+	LatestFinalizedHead() *evmtypes.Head
 }
 
 // HeadTracker holds and stores the latest block number experienced by this particular node in a thread safe manner.
@@ -34,6 +39,9 @@ type HeadTracker interface {
 	// (used for testing)
 	Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error)
 	LatestChain() *evmtypes.Head
+	// LatestFinalizedHead returns the latest finalized head, as reported by the
+	// `finalized` block tag. Always nil unless EVM.FinalityTagEnabled is set.
+	LatestFinalizedHead() *evmtypes.Head
 }
 
 // HeadTrackable represents any object that wishes to respond to ethereum events,
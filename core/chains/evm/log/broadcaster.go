@@ -682,6 +682,10 @@ func (b *broadcaster) MarkConsumed(lb Broadcast, qopts ...pg.QOpt) error {
 
 // MarkManyConsumed marks the logs as having been successfully consumed by the subscriber
 func (b *broadcaster) MarkManyConsumed(lbs []Broadcast, qopts ...pg.QOpt) (err error) {
+	return markManyConsumed(b.orm, lbs, qopts...)
+}
+
+func markManyConsumed(orm ORM, lbs []Broadcast, qopts ...pg.QOpt) error {
 	var (
 		blockHashes  = make([]common.Hash, len(lbs))
 		blockNumbers = make([]uint64, len(lbs))
@@ -694,7 +698,7 @@ func (b *broadcaster) MarkManyConsumed(lbs []Broadcast, qopts ...pg.QOpt) (err e
 		logIndexes[i] = lbs[i].RawLog().Index
 		jobIDs[i] = lbs[i].JobID()
 	}
-	return b.orm.MarkBroadcastsConsumed(blockHashes, blockNumbers, logIndexes, jobIDs, qopts...)
+	return orm.MarkBroadcastsConsumed(blockHashes, blockNumbers, logIndexes, jobIDs, qopts...)
 }
 
 // test only
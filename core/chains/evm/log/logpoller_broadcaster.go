@@ -0,0 +1,450 @@
+package log
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"go.uber.org/atomic"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// logPollerBroadcaster is a Broadcaster which reads logs from the chain's log poller instead of subscribing to them,
+// so that a chain does not ingest logs twice.
+//
+// Delivery and consumption semantics are the same as the broadcaster's:
+//   - The registered listeners are merged into a single log poller filter.
+//   - On every head, the logs of the registered contracts are read from the log poller and sent to the listeners
+//     whose MinIncomingConfirmations are met, unless already consumed (see registrations.sendLogs).
+//   - Logs are sent until they are older than max(EvmFinalityDepth, highest MinIncomingConfirmations), or, if the log
+//     poller is lagging, until it has caught up with them. The lowest block still pending is persisted, so that the
+//     logs are sent again after a restart.
+//
+// Removed logs don't need to be handled, as the log poller deletes the logs of reorged blocks.
+type logPollerBroadcaster struct {
+	orm        ORM
+	lp         logpoller.LogPoller
+	config     Config
+	evmChainID big.Int
+	logger     logger.Logger
+
+	registrations *registrations
+	// a block number from which logs are pending, if it's lower than the kept depth
+	pendingMinBlock  null.Int64
+	highestSavedHead *evmtypes.Head
+
+	mailMon                *utils.MailboxMonitor
+	changeSubscriberStatus *utils.Mailbox[changeSubscriberStatus]
+	newHeads               *utils.Mailbox[*evmtypes.Head]
+	replayChannel          chan replayRequest
+
+	utils.StartStopOnce
+	utils.DependentAwaiter
+
+	connected atomic.Bool
+	chStop    chan struct{}
+	wgDone    sync.WaitGroup
+}
+
+var _ Broadcaster = (*logPollerBroadcaster)(nil)
+
+// NewLogPollerBroadcaster creates a Broadcaster which reads logs from lp.
+func NewLogPollerBroadcaster(orm ORM, lp logpoller.LogPoller, config Config, lggr logger.Logger, evmChainID big.Int, highestSavedHead *evmtypes.Head, mailMon *utils.MailboxMonitor) *logPollerBroadcaster {
+	lggr = lggr.Named("LogBroadcaster")
+	return &logPollerBroadcaster{
+		orm:                    orm,
+		lp:                     lp,
+		config:                 config,
+		evmChainID:             evmChainID,
+		logger:                 lggr,
+		registrations:          newRegistrations(lggr, evmChainID),
+		highestSavedHead:       highestSavedHead,
+		mailMon:                mailMon,
+		changeSubscriberStatus: utils.NewHighCapacityMailbox[changeSubscriberStatus](),
+		newHeads:               utils.NewSingleMailbox[*evmtypes.Head](),
+		replayChannel:          make(chan replayRequest, 1),
+		DependentAwaiter:       utils.NewDependentAwaiter(),
+		chStop:                 make(chan struct{}),
+	}
+}
+
+// logPollerFilterName is the name of the log poller filter of the registered listeners.
+func logPollerFilterName() string {
+	return logpoller.FilterName("LogBroadcaster")
+}
+
+func (b *logPollerBroadcaster) Start(context.Context) error {
+	return b.StartOnce("LogBroadcaster", func() error {
+		b.wgDone.Add(1)
+		go b.run()
+		b.mailMon.Monitor(b.changeSubscriberStatus, "LogBroadcaster", "ChangeSubscriber", b.evmChainID.String())
+		return nil
+	})
+}
+
+func (b *logPollerBroadcaster) Close() error {
+	return b.StopOnce("LogBroadcaster", func() error {
+		close(b.chStop)
+		b.wgDone.Wait()
+		return b.changeSubscriberStatus.Close()
+	})
+}
+
+// ReplayFromBlock implements the Broadcaster interface. Logs are replayed from the log poller, which must be
+// replayed separately if it is missing logs.
+func (b *logPollerBroadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {
+	b.logger.Infow("Replay requested", "block number", number, "force", forceBroadcast)
+	select {
+	case b.replayChannel <- replayRequest{
+		fromBlock:      number,
+		forceBroadcast: forceBroadcast,
+	}:
+	default:
+	}
+}
+
+func (b *logPollerBroadcaster) IsConnected() bool {
+	return b.connected.Load()
+}
+
+func (b *logPollerBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubscribe func()) {
+	// See broadcaster.Register; it is ok to register listeners before starting.
+	ok := b.IfNotStopped(func() {
+		if len(opts.LogsWithTopics) == 0 {
+			b.logger.Panic("Must supply at least 1 LogsWithTopics element to Register")
+		}
+		if opts.MinIncomingConfirmations <= 0 {
+			b.logger.Warnw(fmt.Sprintf("LogBroadcaster requires that MinIncomingConfirmations must be at least 1 (got %v). MinIncomingConfirmations will be set to 1.", opts.MinIncomingConfirmations), "addr", opts.Contract.Hex(), "jobID", listener.JobID())
+			opts.MinIncomingConfirmations = 1
+		}
+
+		sub := &subscriber{listener, opts}
+		b.logger.Debugf("Registering subscriber %p with job ID %v", sub, sub.listener.JobID())
+		if b.changeSubscriberStatus.Deliver(changeSubscriberStatus{subscriberStatusSubscribe, sub}) {
+			b.logger.Panicf("LogBroadcaster subscribe: cannot subscribe %p with job ID %v; changeSubscriberStatus channel was full", sub, sub.listener.JobID())
+		}
+
+		unsubscribe = func() {
+			b.logger.Debugf("Unregistering subscriber %p with job ID %v", sub, sub.listener.JobID())
+			if b.changeSubscriberStatus.Deliver(changeSubscriberStatus{subscriberStatusUnsubscribe, sub}) {
+				b.logger.Panicf("LogBroadcaster unsubscribe: cannot unsubscribe %p with job ID %v; changeSubscriberStatus channel was full", sub, sub.listener.JobID())
+			}
+		}
+	})
+	if !ok {
+		b.logger.Panic("Register cannot be called on a stopped log broadcaster (this is an invariant violation because all dependent services should have unregistered themselves before logbroadcaster.Close was called)")
+	}
+	return
+}
+
+func (b *logPollerBroadcaster) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	if b.newHeads.Deliver(head) {
+		b.logger.Debugw("Dropped the older head in the mailbox, while inserting latest (which is fine)", "latestBlockNumber", head.Number)
+	}
+}
+
+func (b *logPollerBroadcaster) run() {
+	defer b.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+
+	b.logger.Debug("Starting to await initial subscribers until all dependents are ready...")
+	select {
+	case <-b.DependentAwaiter.AwaitDependents():
+	case <-b.chStop:
+		return
+	}
+	// ensure that any queued dependent subscriptions are registered first
+	b.onChangeSubscriberStatus()
+
+	if !b.reinitialize(ctx) {
+		return
+	}
+
+	var needsFilterUpdate bool
+	if err := b.updateFilter(ctx); err != nil {
+		b.logger.Errorw("Failed to register log poller filter", "err", err)
+		needsFilterUpdate = true
+	}
+	b.connected.Store(true)
+	defer b.connected.Store(false)
+
+	// Like the broadcaster's resubscriptions, filter updates are debounced.
+	debounceFilterUpdate := time.NewTicker(1 * time.Second)
+	defer debounceFilterUpdate.Stop()
+
+	b.logger.Debug("Starting the event loop")
+	for {
+		select {
+		case <-b.newHeads.Notify():
+			b.onNewHeads(ctx)
+
+		case <-b.changeSubscriberStatus.Notify():
+			needsFilterUpdate = b.onChangeSubscriberStatus() || needsFilterUpdate
+
+		case req := <-b.replayChannel:
+			b.onReplayRequest(ctx, req)
+
+		case <-debounceFilterUpdate.C:
+			if needsFilterUpdate {
+				if err := b.updateFilter(ctx); err != nil {
+					b.logger.Errorw("Failed to update log poller filter - will retry", "err", err)
+				} else {
+					needsFilterUpdate = false
+				}
+			}
+
+		case <-b.chStop:
+			return
+		}
+	}
+}
+
+// reinitialize removes leftover unconsumed broadcasts and determines from which block logs are pending,
+// see broadcaster.startResubscribeLoop.
+func (b *logPollerBroadcaster) reinitialize(ctx context.Context) bool {
+	var pendingMin *int64
+	utils.RetryWithBackoff(ctx, func() bool {
+		var err error
+		pendingMin, err = b.orm.Reinitialize(pg.WithParentCtx(ctx))
+		if err != nil {
+			b.logger.Errorw("Failed to reinitialize database", "err", err)
+			return true
+		}
+		return false
+	})
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if b.config.BlockBackfillSkip() && b.highestSavedHead != nil {
+		b.logger.Warn("BlockBackfillSkip is set to true, preventing a deep backfill - some earlier chain events might be missed.")
+	} else if b.highestSavedHead != nil {
+		from := b.highestSavedHead.Number -
+			int64(b.registrations.highestNumConfirmations) -
+			int64(b.config.BlockBackfillDepth())
+		if from < 0 {
+			from = 0
+		}
+		b.pendingMinBlock = null.NewInt64(from, true)
+	}
+	if pendingMin != nil && (!b.pendingMinBlock.Valid || *pendingMin < b.pendingMinBlock.Int64) {
+		b.pendingMinBlock.SetValid(*pendingMin)
+	}
+	return true
+}
+
+// updateFilter registers the addresses and topics of all listeners with the log poller. A changed filter
+// is backfilled as deep as the broadcaster backfills on resubscription.
+func (b *logPollerBroadcaster) updateFilter(ctx context.Context) error {
+	addresses, topics := b.registrations.addressesAndTopics()
+	if len(addresses) == 0 {
+		err := b.lp.UnregisterFilter(logPollerFilterName(), pg.WithParentCtx(ctx))
+		if errors.Is(err, logpoller.ErrFilterNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	var startBlock int64
+	if latest, err := b.lp.LatestBlock(pg.WithParentCtx(ctx)); err == nil {
+		startBlock = latest - int64(b.registrations.highestNumConfirmations) - int64(b.config.BlockBackfillDepth())
+		if startBlock < 1 {
+			startBlock = 1
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	b.logger.Debugw("Updating log poller filter", "addresses", len(addresses), "topics", len(topics), "startBlock", startBlock)
+	return b.lp.RegisterFilter(logpoller.Filter{
+		Name:       logPollerFilterName(),
+		EventSigs:  uniqueHashes(topics),
+		Addresses:  uniqueAddresses(addresses),
+		StartBlock: startBlock,
+	}, pg.WithParentCtx(ctx))
+}
+
+func (b *logPollerBroadcaster) onChangeSubscriberStatus() (needsFilterUpdate bool) {
+	for {
+		change, exists := b.changeSubscriberStatus.Retrieve()
+		if !exists {
+			return
+		}
+		sub := change.sub
+		if change.newStatus == subscriberStatusSubscribe {
+			b.logger.Debugw("Subscribing listener", "requiredBlockConfirmations", sub.opts.MinIncomingConfirmations, "address", sub.opts.Contract, "jobID", sub.listener.JobID())
+			needsFilterUpdate = b.registrations.addSubscriber(sub) || needsFilterUpdate
+		} else {
+			b.logger.Debugw("Unsubscribing listener", "requiredBlockConfirmations", sub.opts.MinIncomingConfirmations, "address", sub.opts.Contract, "jobID", sub.listener.JobID())
+			needsFilterUpdate = b.registrations.removeSubscriber(sub) || needsFilterUpdate
+		}
+	}
+}
+
+// onReplayRequest notifies the listeners and sends the logs again from the requested block.
+func (b *logPollerBroadcaster) onReplayRequest(ctx context.Context, req replayRequest) {
+	for subscriber := range b.registrations.registeredSubs {
+		if subscriber.opts.ReplayStartedCallback != nil {
+			subscriber.opts.ReplayStartedCallback()
+		}
+	}
+	b.pendingMinBlock.SetValid(req.fromBlock)
+	if req.forceBroadcast {
+		if err := b.orm.MarkBroadcastsUnconsumed(req.fromBlock, pg.WithParentCtx(ctx)); err != nil {
+			b.logger.Errorw("Error marking broadcasts as unconsumed", "error", err, "fromBlock", req.fromBlock)
+		}
+	}
+	b.logger.Debugw("Replaying logs from specific block number", "fromBlock", req.fromBlock, "forceBroadcast", req.forceBroadcast)
+}
+
+func (b *logPollerBroadcaster) onNewHeads(ctx context.Context) {
+	var latestHead *evmtypes.Head
+	for {
+		// We only care about the most recent head
+		head := b.newHeads.RetrieveLatestAndClear()
+		if head == nil {
+			break
+		}
+		latestHead = head
+	}
+	if latestHead == nil || len(b.registrations.registeredSubs) == 0 {
+		return
+	}
+	b.logger.Debugw("Received head", "blockNumber", latestHead.Number, "blockHash", latestHead.Hash)
+
+	keptLogsDepth := b.config.EvmFinalityDepth()
+	if b.registrations.highestNumConfirmations > keptLogsDepth {
+		keptLogsDepth = b.registrations.highestNumConfirmations
+	}
+	keptDepth := latestHead.Number - int64(keptLogsDepth)
+	if keptDepth < 0 {
+		keptDepth = 0
+	}
+	from := keptDepth
+	if b.pendingMinBlock.Valid && b.pendingMinBlock.Int64 < from {
+		from = b.pendingMinBlock.Int64
+	}
+
+	// Logs are only known up to the last block processed by the log poller.
+	to, err := b.lp.LatestBlock(pg.WithParentCtx(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return
+	} else if err != nil {
+		b.logger.Errorw("Failed to get latest log poller block", "err", err)
+		return
+	}
+	if to > latestHead.Number {
+		to = latestHead.Number
+	}
+
+	if from <= to {
+		logs, err := b.logs(ctx, from, to)
+		if err != nil {
+			b.logger.Errorw("Failed to get logs from log poller", "fromBlock", from, "toBlock", to, "err", err)
+			return
+		}
+		if len(logs) > 0 {
+			broadcasts, err := b.orm.FindBroadcasts(from, latestHead.Number)
+			if err != nil {
+				b.logger.Errorf("Failed to query for log broadcasts, %v", err)
+				return
+			}
+			b.registrations.sendLogs(logs, *latestHead, broadcasts, b.orm)
+		}
+	}
+
+	// Logs after the log poller's latest block are pending until it catches up.
+	var pendingMin *int64
+	if next := to + 1; next < keptDepth {
+		pendingMin = &next
+		b.pendingMinBlock.SetValid(next)
+	} else {
+		b.pendingMinBlock = null.Int64{}
+	}
+	if err := b.orm.SetPendingMinBlock(pendingMin, pg.WithParentCtx(ctx)); err != nil {
+		b.logger.Errorw("Failed to set pending broadcasts number", "err", err)
+	}
+}
+
+// logs returns the logs of all registered contracts and topics in the block range, grouped by block.
+func (b *logPollerBroadcaster) logs(ctx context.Context, from, to int64) ([]logsOnBlock, error) {
+	addresses, topics := b.registrations.addressesAndTopics()
+	lgs, err := b.lp.LogsWithSigsAddrs(from, to, uniqueHashes(topics), uniqueAddresses(addresses), pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+	logs := make([]types.Log, len(lgs))
+	for i, l := range lgs {
+		logs[i] = l.ToGethLog()
+	}
+	return groupLogsByBlock(logs), nil
+}
+
+// groupLogsByBlock groups logs by block number, in block number and log index order.
+func groupLogsByBlock(logs []types.Log) []logsOnBlock {
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+	var grouped []logsOnBlock
+	for _, l := range logs {
+		if n := len(grouped); n == 0 || grouped[n-1].BlockNumber != l.BlockNumber {
+			grouped = append(grouped, logsOnBlock{BlockNumber: l.BlockNumber})
+		}
+		grouped[len(grouped)-1].Logs = append(grouped[len(grouped)-1].Logs, l)
+	}
+	return grouped
+}
+
+func uniqueAddresses(addresses []common.Address) (unique []common.Address) {
+	seen := make(map[common.Address]struct{}, len(addresses))
+	for _, a := range addresses {
+		if _, ok := seen[a]; !ok {
+			seen[a] = struct{}{}
+			unique = append(unique, a)
+		}
+	}
+	return
+}
+
+func uniqueHashes(hashes []common.Hash) (unique []common.Hash) {
+	seen := make(map[common.Hash]struct{}, len(hashes))
+	for _, h := range hashes {
+		if _, ok := seen[h]; !ok {
+			seen[h] = struct{}{}
+			unique = append(unique, h)
+		}
+	}
+	return
+}
+
+// WasAlreadyConsumed reports whether the given consumer had already consumed the given log
+func (b *logPollerBroadcaster) WasAlreadyConsumed(lb Broadcast, qopts ...pg.QOpt) (bool, error) {
+	return b.orm.WasBroadcastConsumed(lb.RawLog().BlockHash, lb.RawLog().Index, lb.JobID(), qopts...)
+}
+
+// MarkConsumed marks the log as having been successfully consumed by the subscriber
+func (b *logPollerBroadcaster) MarkConsumed(lb Broadcast, qopts ...pg.QOpt) error {
+	return b.orm.MarkBroadcastConsumed(lb.RawLog().BlockHash, lb.RawLog().BlockNumber, lb.RawLog().Index, lb.JobID(), qopts...)
+}
+
+// MarkManyConsumed marks the logs as having been successfully consumed by the subscriber
+func (b *logPollerBroadcaster) MarkManyConsumed(lbs []Broadcast, qopts ...pg.QOpt) error {
+	return markManyConsumed(b.orm, lbs, qopts...)
+}
@@ -0,0 +1,255 @@
+package log
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/core/chains/evm/logpoller/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type testConfig struct {
+	finalityDepth uint32
+}
+
+func (c testConfig) BlockBackfillDepth() uint64      { return 10 }
+func (c testConfig) BlockBackfillSkip() bool         { return false }
+func (c testConfig) EvmFinalityDepth() uint32        { return c.finalityDepth }
+func (c testConfig) EvmLogBackfillBatchSize() uint32 { return 100 }
+
+// memORM is an in-memory ORM, only keeping track of broadcasts and the pending min block.
+type memORM struct {
+	mu         sync.Mutex
+	broadcasts map[LogBroadcastAsKey]*LogBroadcast
+	blocks     map[LogBroadcastAsKey]uint64
+	pendingMin *int64
+}
+
+var _ ORM = (*memORM)(nil)
+
+func newMemORM() *memORM {
+	return &memORM{broadcasts: make(map[LogBroadcastAsKey]*LogBroadcast), blocks: make(map[LogBroadcastAsKey]uint64)}
+}
+
+func (o *memORM) FindBroadcasts(fromBlockNum int64, toBlockNum int64) (bs []LogBroadcast, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for k, b := range o.broadcasts {
+		if n := int64(o.blocks[k]); n >= fromBlockNum && n <= toBlockNum {
+			bs = append(bs, *b)
+		}
+	}
+	return
+}
+
+func (o *memORM) CreateBroadcast(blockHash common.Hash, blockNumber uint64, logIndex uint, jobID int32, qopts ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	k := LogBroadcastAsKey{blockHash, logIndex, jobID}
+	o.broadcasts[k] = &LogBroadcast{BlockHash: blockHash, LogIndex: logIndex, JobID: jobID}
+	o.blocks[k] = blockNumber
+	return nil
+}
+
+func (o *memORM) WasBroadcastConsumed(blockHash common.Hash, logIndex uint, jobID int32, qopts ...pg.QOpt) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	b, ok := o.broadcasts[LogBroadcastAsKey{blockHash, logIndex, jobID}]
+	return ok && b.Consumed, nil
+}
+
+func (o *memORM) MarkBroadcastConsumed(blockHash common.Hash, blockNumber uint64, logIndex uint, jobID int32, qopts ...pg.QOpt) error {
+	return o.MarkBroadcastsConsumed([]common.Hash{blockHash}, []uint64{blockNumber}, []uint{logIndex}, []int32{jobID})
+}
+
+func (o *memORM) MarkBroadcastsConsumed(blockHashes []common.Hash, blockNumbers []uint64, logIndexes []uint, jobIDs []int32, qopts ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i := range blockHashes {
+		k := LogBroadcastAsKey{blockHashes[i], logIndexes[i], jobIDs[i]}
+		o.broadcasts[k] = &LogBroadcast{BlockHash: blockHashes[i], LogIndex: logIndexes[i], JobID: jobIDs[i], Consumed: true}
+		o.blocks[k] = blockNumbers[i]
+	}
+	return nil
+}
+
+func (o *memORM) MarkBroadcastsUnconsumed(fromBlock int64, qopts ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for k, b := range o.broadcasts {
+		if int64(o.blocks[k]) >= fromBlock {
+			b.Consumed = false
+		}
+	}
+	return nil
+}
+
+func (o *memORM) SetPendingMinBlock(blockNum *int64, qopts ...pg.QOpt) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.pendingMin = blockNum
+	return nil
+}
+
+func (o *memORM) GetPendingMinBlock(qopts ...pg.QOpt) (*int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.pendingMin, nil
+}
+
+func (o *memORM) Reinitialize(qopts ...pg.QOpt) (*int64, error) {
+	return o.GetPendingMinBlock()
+}
+
+func newTestLogPollerBroadcaster(t *testing.T, orm ORM, lp logpoller.LogPoller, finalityDepth uint32) *logPollerBroadcaster {
+	b := NewLogPollerBroadcaster(orm, lp, testConfig{finalityDepth}, logger.TestLogger(t), *testutils.FixtureChainID, nil, utils.NewMailboxMonitor(t.Name()))
+	b.AddDependents(1)
+	require.NoError(t, b.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, b.Close()) })
+	return b
+}
+
+func newLogPollerLog(address common.Address, topic common.Hash, blockNumber int64, logIndex int64) logpoller.Log {
+	return logpoller.Log{
+		EvmChainId:  utils.NewBig(testutils.FixtureChainID),
+		LogIndex:    logIndex,
+		BlockHash:   utils.NewHash(),
+		BlockNumber: blockNumber,
+		Topics:      [][]byte{topic.Bytes()},
+		EventSig:    topic,
+		Address:     address,
+		TxHash:      utils.NewHash(),
+	}
+}
+
+func TestLogPollerBroadcaster_SendsConfirmedLogs(t *testing.T) {
+	contractAddr := testutils.NewAddress()
+	topic := utils.NewHash()
+	orm := newMemORM()
+	lp := lpmocks.NewLogPoller(t)
+
+	log9 := newLogPollerLog(contractAddr, topic, 9, 0)
+	log10 := newLogPollerLog(contractAddr, topic, 10, 1)
+
+	lp.On("LatestBlock", mock.Anything).Return(int64(10), nil).Once()
+	lp.On("RegisterFilter", mock.MatchedBy(func(f logpoller.Filter) bool {
+		return f.Name == logPollerFilterName() &&
+			assert.ObjectsAreEqual([]common.Address{contractAddr}, f.Addresses) &&
+			assert.ObjectsAreEqual([]common.Hash{topic}, f.EventSigs) &&
+			f.StartBlock == 1 // 10 - 2 confirmations - 10 BlockBackfillDepth, at least 1
+	}), mock.Anything).Return(nil).Once()
+
+	b := newTestLogPollerBroadcaster(t, orm, lp, 5)
+	l := &recordingListener{jobID: 1}
+	unsubscribe := b.Register(l, ListenerOpts{
+		Contract:                 contractAddr,
+		LogsWithTopics:           map[common.Hash][][]Topic{topic: {}},
+		ParseLog:                 func(log types.Log) (generated.AbigenLog, error) { return nil, nil },
+		MinIncomingConfirmations: 2,
+	})
+	b.DependentReady()
+	require.Eventually(t, b.IsConnected, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	// Only the log of block 9 has 2 confirmations at head 10.
+	lp.On("LatestBlock", mock.Anything).Return(int64(10), nil).Once()
+	lp.On("LogsWithSigsAddrs", int64(5), int64(10), []common.Hash{topic}, []common.Address{contractAddr}, mock.Anything).Return([]logpoller.Log{log9, log10}, nil).Once()
+	b.OnNewLongestChain(testutils.Context(t), &evmtypes.Head{Number: 10, Hash: utils.NewHash()})
+	require.Eventually(t, func() bool { return l.received() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.Equal(t, log9.ToGethLog(), l.log(0))
+
+	consumed, err := b.WasAlreadyConsumed(NewLogBroadcast(l.log(0), *testutils.FixtureChainID, nil))
+	require.NoError(t, err)
+	assert.False(t, consumed)
+	require.NoError(t, b.MarkConsumed(&broadcast{rawLog: l.log(0), jobID: 1}))
+
+	// The consumed log is not sent again.
+	lp.On("LatestBlock", mock.Anything).Return(int64(11), nil).Once()
+	lp.On("LogsWithSigsAddrs", int64(6), int64(11), []common.Hash{topic}, []common.Address{contractAddr}, mock.Anything).Return([]logpoller.Log{log9, log10}, nil).Once()
+	b.OnNewLongestChain(testutils.Context(t), &evmtypes.Head{Number: 11, Hash: utils.NewHash()})
+	require.Eventually(t, func() bool { return l.received() == 2 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.Equal(t, log10.ToGethLog(), l.log(1))
+
+	// The filter is unregistered with the last listener.
+	done := make(chan struct{})
+	lp.On("UnregisterFilter", logPollerFilterName(), mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { close(done) })
+	unsubscribe()
+	select {
+	case <-done:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("filter not unregistered")
+	}
+}
+
+func TestLogPollerBroadcaster_LaggingLogPoller(t *testing.T) {
+	contractAddr := testutils.NewAddress()
+	topic := utils.NewHash()
+	orm := newMemORM()
+	lp := lpmocks.NewLogPoller(t)
+
+	lp.On("LatestBlock", mock.Anything).Return(int64(50), nil).Once()
+	lp.On("RegisterFilter", mock.Anything, mock.Anything).Return(nil).Once()
+
+	b := newTestLogPollerBroadcaster(t, orm, lp, 5)
+	l := &recordingListener{jobID: 1}
+	b.Register(l, ListenerOpts{
+		Contract:                 contractAddr,
+		LogsWithTopics:           map[common.Hash][][]Topic{topic: {}},
+		ParseLog:                 func(log types.Log) (generated.AbigenLog, error) { return nil, nil },
+		MinIncomingConfirmations: 1,
+	})
+	b.DependentReady()
+	require.Eventually(t, b.IsConnected, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	// The log poller is behind the kept depth: logs after its latest block are pending.
+	lp.On("LatestBlock", mock.Anything).Return(int64(50), nil).Once()
+	b.OnNewLongestChain(testutils.Context(t), &evmtypes.Head{Number: 100, Hash: utils.NewHash()})
+	require.Eventually(t, func() bool {
+		pendingMin, err := orm.GetPendingMinBlock()
+		require.NoError(t, err)
+		return pendingMin != nil && *pendingMin == 51
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	// Once it has caught up, the logs from the pending block are sent.
+	log60 := newLogPollerLog(contractAddr, topic, 60, 0)
+	lp.On("LatestBlock", mock.Anything).Return(int64(101), nil).Once()
+	lp.On("LogsWithSigsAddrs", int64(51), int64(101), []common.Hash{topic}, []common.Address{contractAddr}, mock.Anything).Return([]logpoller.Log{log60}, nil).Once()
+	b.OnNewLongestChain(testutils.Context(t), &evmtypes.Head{Number: 101, Hash: utils.NewHash()})
+	require.Eventually(t, func() bool { return l.received() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		pendingMin, err := orm.GetPendingMinBlock()
+		require.NoError(t, err)
+		return pendingMin == nil
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+}
+
+func (rl *recordingListener) log(i int) types.Log {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return rl.logs[i]
+}
+
+func TestUnit_groupLogsByBlock(t *testing.T) {
+	logs := []types.Log{
+		{BlockNumber: 2, Index: 1},
+		{BlockNumber: 1, Index: 3},
+		{BlockNumber: 2, Index: 0},
+	}
+	assert.Equal(t, []logsOnBlock{
+		{BlockNumber: 1, Logs: []types.Log{{BlockNumber: 1, Index: 3}}},
+		{BlockNumber: 2, Logs: []types.Log{{BlockNumber: 2, Index: 0}, {BlockNumber: 2, Index: 1}}},
+	}, groupLogsByBlock(logs))
+	assert.Empty(t, groupLogsByBlock(nil))
+}
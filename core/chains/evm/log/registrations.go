@@ -223,17 +223,28 @@ func (r *registrations) sendLogs(logsToSend []logsOnBlock, latestHead evmtypes.H
 
 	latestBlockNumber := uint64(latestHead.Number)
 
+	// If the head tracker knows the chain's latest finalized block, logs in finalized
+	// blocks can be sent regardless of the number of confirmations requested.
+	var latestFinalizedBlockNumber uint64
+	var haveFinalized bool
+	if finalized := latestHead.LatestFinalizedHead(); finalized != nil {
+		latestFinalizedBlockNumber = uint64(finalized.Number)
+		haveFinalized = true
+	}
+
 	for _, logsPerBlock := range logsToSend {
 		for numConfirmations, handlers := range r.handlersByConfs {
 
-			if numConfirmations != 0 && latestBlockNumber < uint64(numConfirmations) {
+			isFinalized := haveFinalized && logsPerBlock.BlockNumber <= latestFinalizedBlockNumber
+
+			if !isFinalized && numConfirmations != 0 && latestBlockNumber < uint64(numConfirmations) {
 				// Skipping send because the block is definitely too young
 				continue
 			}
 
 			// We attempt the send multiple times per log
 			// so here we need to see if this particular listener actually should receive it at this depth
-			isOldEnough := numConfirmations == 0 || (logsPerBlock.BlockNumber+uint64(numConfirmations)-1) <= latestBlockNumber
+			isOldEnough := numConfirmations == 0 || isFinalized || (logsPerBlock.BlockNumber+uint64(numConfirmations)-1) <= latestBlockNumber
 			if !isOldEnough {
 				continue
 			}
@@ -1,14 +1,19 @@
 package log
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -249,3 +254,64 @@ func TestUnit_Registrations_addSubscriber_removeSubscriber(t *testing.T) {
 		assert.Len(t, r.registeredSubs, 0)
 	})
 }
+
+type recordingListener struct {
+	jobID int32
+	mu    sync.Mutex
+	logs  []types.Log
+}
+
+func (rl *recordingListener) JobID() int32 { return rl.jobID }
+func (rl *recordingListener) HandleLog(b Broadcast) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.logs = append(rl.logs, b.RawLog())
+}
+
+func (rl *recordingListener) received() int {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return len(rl.logs)
+}
+
+type nullBroadcastCreator struct{}
+
+func (nullBroadcastCreator) CreateBroadcast(common.Hash, uint64, uint, int32, ...pg.QOpt) error {
+	return nil
+}
+
+func TestUnit_Registrations_sendLogs_Finalized(t *testing.T) {
+	contractAddr := testutils.NewAddress()
+	topic := utils.NewHash()
+	r := newTestRegistrations(t)
+
+	l := &recordingListener{jobID: 1}
+	r.addSubscriber(&subscriber{l, ListenerOpts{
+		Contract:                 contractAddr,
+		LogsWithTopics:           map[common.Hash][][]Topic{topic: {}},
+		ParseLog:                 func(log types.Log) (generated.AbigenLog, error) { return nil, nil },
+		MinIncomingConfirmations: 10,
+	}})
+
+	logsToSend := []logsOnBlock{{
+		BlockNumber: 5,
+		Logs:        []types.Log{{Address: contractAddr, Topics: []common.Hash{topic}, BlockNumber: 5, BlockHash: utils.NewHash()}},
+	}}
+
+	// Not enough confirmations and no finalized head: nothing is sent.
+	latestHead := evmtypes.Head{Number: 8, Hash: utils.NewHash()}
+	r.sendLogs(logsToSend, latestHead, nil, nullBroadcastCreator{})
+	assert.Equal(t, 0, l.received())
+
+	// Finalized head below the log's block: nothing is sent.
+	latestHead.Parent = &evmtypes.Head{Number: 7, Hash: utils.NewHash()}
+	latestHead.Parent.Parent = &evmtypes.Head{Number: 6, Hash: utils.NewHash()}
+	latestHead.Parent.Parent.Parent = &evmtypes.Head{Number: 4, Hash: utils.NewHash(), IsFinalized: true}
+	r.sendLogs(logsToSend, latestHead, nil, nullBroadcastCreator{})
+	assert.Equal(t, 0, l.received())
+
+	// Once the log's block is finalized it is sent, regardless of MinIncomingConfirmations.
+	latestHead.Parent.Parent.IsFinalized = true
+	r.sendLogs(logsToSend, latestHead, nil, nullBroadcastCreator{})
+	assert.Equal(t, 1, l.received())
+}
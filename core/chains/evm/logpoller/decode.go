@@ -0,0 +1,208 @@
+package logpoller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// EventQuery selects the logs of an ABI event emitted by Address in the block range [FromBlock, ToBlock],
+// which have at least Confs confirmations.
+type EventQuery struct {
+	Event     abi.Event
+	Address   common.Address
+	FromBlock int64
+	ToBlock   int64 // 0 selects up to the latest block saved
+	// Indexed optionally filters logs by the values of indexed arguments, keyed by argument name.
+	// A log matches if, for every argument, its value is one of the given values.
+	Indexed map[string][]any
+	Confs   int
+}
+
+// topicFilters converts the Indexed argument values into topic values, keyed by topic index.
+func (q EventQuery) topicFilters() (map[int][]common.Hash, error) {
+	filters := make(map[int][]common.Hash, len(q.Indexed))
+	for name, values := range q.Indexed {
+		index, i := -1, 0
+		for _, arg := range q.Event.Inputs {
+			if !arg.Indexed {
+				continue
+			}
+			// Topic 0 is the event signature.
+			i++
+			if arg.Name == name {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, errors.Errorf("event %s has no indexed argument %q", q.Event.Name, name)
+		}
+		if len(values) == 0 {
+			return nil, errors.Errorf("no values given for indexed argument %q", name)
+		}
+		topics, err := abi.MakeTopics(values)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid values for indexed argument %q", name)
+		}
+		filters[index] = topics[0]
+	}
+	return filters, nil
+}
+
+// EventLogs returns the logs matching q, ordered by block number and log index.
+func (lp *logPoller) EventLogs(q EventQuery, qopts ...pg.QOpt) ([]Log, error) {
+	topics, err := q.topicFilters()
+	if err != nil {
+		return nil, err
+	}
+	if q.ToBlock == 0 {
+		return lp.orm.SelectLogsByTopics(q.Address, q.Event.ID, q.FromBlock, q.ToBlock, topics, q.Confs, readOnly(qopts)...)
+	}
+	var logs []Log
+	err = lp.readOnlyUpTo(q.ToBlock, qopts, func(qopts ...pg.QOpt) (err error) {
+		logs, err = lp.orm.SelectLogsByTopics(q.Address, q.Event.ID, q.FromBlock, q.ToBlock, topics, q.Confs, qopts...)
+		return
+	})
+	return logs, err
+}
+
+// EventABI returns the ABI of the event with the given signature, if it was registered with a filter.
+func (lp *logPoller) EventABI(eventSig common.Hash) (abi.Event, bool) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	for _, filter := range lp.filters {
+		for _, event := range filter.Events {
+			if event.ID == eventSig {
+				return event, true
+			}
+		}
+	}
+	return abi.Event{}, false
+}
+
+// QueryEvents returns the logs matching q decoded into values of type T, see DecodeLog.
+func QueryEvents[T any](lp LogPoller, q EventQuery, qopts ...pg.QOpt) ([]T, error) {
+	logs, err := lp.EventLogs(q, qopts...)
+	if err != nil {
+		return nil, err
+	}
+	decoded := make([]T, len(logs))
+	for i := range logs {
+		if decoded[i], err = DecodeLog[T](q.Event, logs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return decoded, nil
+}
+
+// DecodeLog decodes l, a log of event, into a value of type T. T must be a struct with an exported field
+// for each of the event's arguments, named as abigen does, like the event types of the generated contract wrappers.
+// If T has a Raw types.Log field, it is set to l.
+func DecodeLog[T any](event abi.Event, l Log) (decoded T, err error) {
+	gethLog, err := eventLog(event, l)
+	if err != nil {
+		return decoded, err
+	}
+	if err = boundEvent(event).UnpackLog(&decoded, event.Name, gethLog); err != nil {
+		return decoded, errors.Wrapf(err, "failed to decode %s log", event.Name)
+	}
+	if v := reflect.ValueOf(&decoded).Elem(); v.Kind() == reflect.Struct {
+		if raw := v.FieldByName("Raw"); raw.IsValid() && raw.CanSet() && raw.Type() == reflect.TypeOf(gethLog) {
+			raw.Set(reflect.ValueOf(gethLog))
+		}
+	}
+	return decoded, nil
+}
+
+// DecodeLogArgs decodes the arguments of l, a log of event, keyed by argument name.
+func DecodeLogArgs(event abi.Event, l Log) (map[string]any, error) {
+	gethLog, err := eventLog(event, l)
+	if err != nil {
+		return nil, err
+	}
+	args := make(map[string]any)
+	if err = boundEvent(event).UnpackLogIntoMap(args, event.Name, gethLog); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s log", event.Name)
+	}
+	return args, nil
+}
+
+func eventLog(event abi.Event, l Log) (types.Log, error) {
+	if len(l.Topics) == 0 || common.BytesToHash(l.Topics[0]) != event.ID {
+		return types.Log{}, errors.Errorf("log is not a %s event", event.Name)
+	}
+	return l.ToGethLog(), nil
+}
+
+func boundEvent(event abi.Event) *bind.BoundContract {
+	return bind.NewBoundContract(common.Address{}, abi.ABI{Events: map[string]abi.Event{event.Name: event}}, nil, nil, nil)
+}
+
+// eventABIArgument is an argument of an event in the JSON format of contract ABIs.
+type eventABIArgument struct {
+	Name       string             `json:"name"`
+	Type       string             `json:"type"`
+	Indexed    bool               `json:"indexed,omitempty"`
+	Components []eventABIArgument `json:"components,omitempty"`
+}
+
+// marshalEventABI encodes event in the JSON format of contract ABIs, as a single element array.
+func marshalEventABI(event abi.Event) ([]byte, error) {
+	inputs := make([]eventABIArgument, len(event.Inputs))
+	for i, input := range event.Inputs {
+		inputs[i] = newEventABIArgument(input.Name, input.Type)
+		inputs[i].Indexed = input.Indexed
+	}
+	return json.Marshal([]any{struct {
+		Type      string             `json:"type"`
+		Name      string             `json:"name"`
+		Anonymous bool               `json:"anonymous"`
+		Inputs    []eventABIArgument `json:"inputs"`
+	}{"event", event.RawName, event.Anonymous, inputs}})
+}
+
+func newEventABIArgument(name string, t abi.Type) eventABIArgument {
+	switch t.T {
+	case abi.TupleTy:
+		arg := eventABIArgument{Name: name, Type: "tuple"}
+		for i, elem := range t.TupleElems {
+			arg.Components = append(arg.Components, newEventABIArgument(t.TupleRawNames[i], *elem))
+		}
+		return arg
+	case abi.SliceTy:
+		arg := newEventABIArgument(name, *t.Elem)
+		arg.Type += "[]"
+		return arg
+	case abi.ArrayTy:
+		arg := newEventABIArgument(name, *t.Elem)
+		arg.Type += fmt.Sprintf("[%d]", t.Size)
+		return arg
+	default:
+		return eventABIArgument{Name: name, Type: t.String()}
+	}
+}
+
+// unmarshalEventABI decodes an event encoded by marshalEventABI.
+func unmarshalEventABI(b []byte) (abi.Event, error) {
+	parsed, err := abi.JSON(bytes.NewReader(b))
+	if err != nil {
+		return abi.Event{}, err
+	}
+	if len(parsed.Events) != 1 {
+		return abi.Event{}, errors.Errorf("expected a single event, got %d", len(parsed.Events))
+	}
+	var event abi.Event
+	for _, event = range parsed.Events {
+	}
+	return event, nil
+}
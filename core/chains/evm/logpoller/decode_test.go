@@ -0,0 +1,156 @@
+package logpoller
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/log_emitter"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestEventQuery_topicFilters(t *testing.T) {
+	q := EventQuery{Event: EmitterABI.Events["Log2"], Indexed: map[string][]any{"arg0": {big.NewInt(1), big.NewInt(2)}}}
+	topics, err := q.topicFilters()
+	require.NoError(t, err)
+	assert.Equal(t, map[int][]common.Hash{1: {common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))}}, topics)
+
+	// Unknown argument
+	q.Indexed = map[string][]any{"foo": {big.NewInt(1)}}
+	_, err = q.topicFilters()
+	require.Error(t, err)
+
+	// Argument is not indexed
+	q = EventQuery{Event: EmitterABI.Events["Log1"], Indexed: map[string][]any{"arg0": {big.NewInt(1)}}}
+	_, err = q.topicFilters()
+	require.Error(t, err)
+
+	// No values
+	q = EventQuery{Event: EmitterABI.Events["Log2"], Indexed: map[string][]any{"arg0": {}}}
+	_, err = q.topicFilters()
+	require.Error(t, err)
+}
+
+func TestDecodeLog(t *testing.T) {
+	chainID := testutils.NewRandomEVMChainID()
+	address := testutils.NewAddress()
+
+	data, err := EmitterABI.Events["Log1"].Inputs.Pack(big.NewInt(7))
+	require.NoError(t, err)
+	log1 := GenLog(chainID, 1, 10, utils.NewHash().String(), EmitterABI.Events["Log1"].ID.Bytes(), address)
+	log1.Data = data
+
+	decoded1, err := DecodeLog[log_emitter.LogEmitterLog1](EmitterABI.Events["Log1"], log1)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(7), decoded1.Arg0)
+	assert.Equal(t, log1.ToGethLog(), decoded1.Raw)
+
+	args, err := DecodeLogArgs(EmitterABI.Events["Log1"], log1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"arg0": big.NewInt(7)}, args)
+
+	log2 := GenLog(chainID, 2, 10, utils.NewHash().String(), EmitterABI.Events["Log2"].ID.Bytes(), address)
+	log2.Topics = append(log2.Topics, common.BigToHash(big.NewInt(3)).Bytes())
+
+	decoded2, err := DecodeLog[log_emitter.LogEmitterLog2](EmitterABI.Events["Log2"], log2)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(3), decoded2.Arg0)
+
+	// Wrong event
+	_, err = DecodeLog[log_emitter.LogEmitterLog1](EmitterABI.Events["Log1"], log2)
+	require.Error(t, err)
+	_, err = DecodeLogArgs(EmitterABI.Events["Log1"], log2)
+	require.Error(t, err)
+}
+
+func TestLogPoller_EventLogs(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	event := EmitterABI.Events["Log2"]
+	require.NoError(t, th.LogPoller.RegisterFilter(Filter{
+		Name:      "Emitter 1 Log2",
+		Events:    []abi.Event{event},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}))
+	registered, ok := th.LogPoller.EventABI(event.ID)
+	require.True(t, ok)
+	assert.Equal(t, event.Name, registered.Name)
+	_, ok = th.LogPoller.EventABI(EmitterABI.Events["Log1"].ID)
+	assert.False(t, ok)
+
+	// The ABI is persisted with the filter, so it is known after a restart.
+	lp2 := NewLogPoller(th.ORM, nil, reorg.NullBroadcaster, th.Lggr, 15*time.Second, false, 1, 1, 2, 1000)
+	lp2.filterMu.Lock()
+	require.NoError(t, lp2.loadFilters())
+	lp2.filterMu.Unlock()
+	registered, ok = lp2.EventABI(event.ID)
+	require.True(t, ok)
+	assert.Equal(t, event.Sig, registered.Sig)
+	assert.Equal(t, event.Inputs, registered.Inputs)
+
+	// Emit Log2 with values 1->4 in blocks 2->5.
+	for i := int64(1); i <= 4; i++ {
+		_, err := th.Emitter1.EmitLog2(th.Owner, []*big.Int{big.NewInt(i)})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+	require.Equal(t, int64(6), th.LogPoller.PollAndSaveLogs(testutils.Context(t), 1))
+
+	q := EventQuery{
+		Event:   event,
+		Address: th.EmitterAddress1,
+		Indexed: map[string][]any{"arg0": {big.NewInt(2), big.NewInt(4)}},
+	}
+	lgs, err := th.LogPoller.EventLogs(q)
+	require.NoError(t, err)
+	require.Len(t, lgs, 2)
+	assert.Equal(t, int64(3), lgs[0].BlockNumber)
+	assert.Equal(t, int64(5), lgs[1].BlockNumber)
+
+	// Block range and confirmations are applied.
+	q.ToBlock = 4
+	lgs, err = th.LogPoller.EventLogs(q)
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+	q.ToBlock = 0
+	q.Confs = 1
+	lgs, err = th.LogPoller.EventLogs(q)
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+
+	decoded, err := QueryEvents[log_emitter.LogEmitterLog2](th.LogPoller, EventQuery{Event: event, Address: th.EmitterAddress1, FromBlock: 3})
+	require.NoError(t, err)
+	require.Len(t, decoded, 3)
+	for i, d := range decoded {
+		assert.Equal(t, big.NewInt(int64(i+2)), d.Arg0)
+		assert.Equal(t, th.EmitterAddress1, d.Raw.Address)
+	}
+}
+
+func TestEventABI_marshal(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(`[{"type":"event","name":"Transmitted","anonymous":false,"inputs":[
+		{"name":"digest","type":"bytes32","indexed":true},
+		{"name":"reports","type":"tuple[2][]","components":[{"name":"epoch","type":"uint32"},{"name":"signers","type":"address[]"}]},
+		{"name":"data","type":"bytes"}]}]`))
+	require.NoError(t, err)
+
+	for _, event := range []abi.Event{EmitterABI.Events["Log1"], EmitterABI.Events["Log2"], parsed.Events["Transmitted"]} {
+		b, err := marshalEventABI(event)
+		require.NoError(t, err)
+		got, err := unmarshalEventABI(b)
+		require.NoError(t, err)
+		assert.Equal(t, event.ID, got.ID)
+		assert.Equal(t, event.Sig, got.Sig)
+		assert.Equal(t, event.Inputs, got.Inputs)
+	}
+
+	_, err = unmarshalEventABI([]byte(`[]`))
+	require.Error(t, err)
+}
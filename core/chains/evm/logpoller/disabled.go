@@ -3,6 +3,7 @@ package logpoller
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
@@ -26,9 +27,9 @@ func (disabled) Healthy() error { return ErrDisabled }
 
 func (disabled) Replay(ctx context.Context, fromBlock int64) error { return ErrDisabled }
 
-func (disabled) RegisterFilter(filter Filter) (int, error) { return -1, ErrDisabled }
+func (disabled) RegisterFilter(filter Filter, qopts ...pg.QOpt) error { return ErrDisabled }
 
-func (disabled) UnregisterFilter(filterID int) error { return ErrDisabled }
+func (disabled) UnregisterFilter(name string, qopts ...pg.QOpt) error { return ErrDisabled }
 
 func (disabled) LatestBlock(qopts ...pg.QOpt) (int64, error) { return -1, ErrDisabled }
 
@@ -40,6 +41,14 @@ func (disabled) Logs(start, end int64, eventSig common.Hash, address common.Addr
 	return nil, ErrDisabled
 }
 
+func (disabled) LogsWithSigsAddrs(start, end int64, eventSigs []common.Hash, addresses []common.Address, qopts ...pg.QOpt) ([]Log, error) {
+	return nil, ErrDisabled
+}
+
+func (disabled) LogsPage(start, end int64, eventSig common.Hash, address common.Address, offset, limit int, qopts ...pg.QOpt) ([]Log, int, error) {
+	return nil, 0, ErrDisabled
+}
+
 func (disabled) LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error) {
 	return nil, ErrDisabled
 }
@@ -71,3 +80,9 @@ func (disabled) LogsDataWordRange(eventSig common.Hash, address common.Address,
 func (disabled) LogsDataWordGreaterThan(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
 	return nil, ErrDisabled
 }
+
+func (disabled) EventLogs(q EventQuery, qopts ...pg.QOpt) ([]Log, error) {
+	return nil, ErrDisabled
+}
+
+func (disabled) EventABI(eventSig common.Hash) (abi.Event, bool) { return abi.Event{}, false }
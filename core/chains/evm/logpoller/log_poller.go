@@ -5,18 +5,22 @@ import (
 	"context"
 	"database/sql"
 	"encoding/binary"
+	"fmt"
 	"math/big"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
@@ -29,14 +33,18 @@ import (
 type LogPoller interface {
 	services.ServiceCtx
 	Replay(ctx context.Context, fromBlock int64) error
-	RegisterFilter(filter Filter) (int, error)
-	UnregisterFilter(filterID int) error
+	RegisterFilter(filter Filter, qopts ...pg.QOpt) error
+	UnregisterFilter(name string, qopts ...pg.QOpt) error
 	LatestBlock(qopts ...pg.QOpt) (int64, error)
 	GetBlocks(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error)
 
 	// General querying
 	Logs(start, end int64, eventSig common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error)
 	LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error)
+	// LogsWithSigsAddrs returns the logs in the block range emitted by any of addresses, with any of eventSigs.
+	LogsWithSigsAddrs(start, end int64, eventSigs []common.Hash, addresses []common.Address, qopts ...pg.QOpt) ([]Log, error)
+	// LogsPage returns a page of the logs of Logs, and their total count.
+	LogsPage(start, end int64, eventSig common.Hash, address common.Address, offset, limit int, qopts ...pg.QOpt) ([]Log, int, error)
 	LatestLogByEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error)
 	LatestLogEventSigsAddrsWithConfs(fromBlock int64, eventSigs []common.Hash, addresses []common.Address, confs int, qopts ...pg.QOpt) ([]Log, error)
 
@@ -46,6 +54,10 @@ type LogPoller interface {
 	IndexedLogsTopicRange(eventSig common.Hash, address common.Address, topicIndex int, topicValueMin common.Hash, topicValueMax common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
 	LogsDataWordRange(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin, wordValueMax common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
 	LogsDataWordGreaterThan(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error)
+
+	// ABI based querying
+	EventLogs(q EventQuery, qopts ...pg.QOpt) ([]Log, error)
+	EventABI(eventSig common.Hash) (abi.Event, bool)
 }
 
 type Client interface {
@@ -60,25 +72,29 @@ var (
 	_                          LogPoller = &logPoller{}
 	ErrReplayAbortedByClient             = errors.New("replay aborted by client")
 	ErrReplayAbortedOnShutdown           = errors.New("replay aborted, log poller shutdown")
+	ErrFilterNotFound                    = errors.New("filter not found")
 )
 
 type logPoller struct {
 	utils.StartStopOnce
 	ec                Client
 	orm               *ORM
+	reorgs            reorg.Broadcaster
 	lggr              logger.Logger
 	pollPeriod        time.Duration // poll period set by block production rate
+	useFinalityTag    bool          // if set, the chain's "finalized" block tag is used instead of finalityDepth to determine finality
 	finalityDepth     int64         // finality depth is taken to mean that block (head - finality) is finalized
 	keepBlocksDepth   int64         // the number of blocks behind the head for which we keep the blocks. Must be greater than finality depth + 1.
 	backfillBatchSize int64         // batch size to use when backfilling finalized logs
 	rpcBatchSize      int64         // batch size to use for fallback RPC calls made in GetBlocks
 
-	filterMu        sync.RWMutex
-	currentFilterID int
-	filters         map[int]Filter
-	filterDirty     bool
-	cachedAddresses []common.Address
-	cachedEventSigs []common.Hash
+	filterMu          sync.RWMutex
+	filters           map[string]Filter
+	filtersLoaded     bool               // set once the persisted filters have been loaded
+	filtersToBackfill map[string]*Filter // newly registered filters whose logs still need to be backfilled
+	filterDirty       bool
+	cachedAddresses   []common.Address
+	cachedEventSigs   []common.Hash
 
 	replayStart    chan ReplayRequest
 	replayComplete chan error
@@ -101,27 +117,81 @@ type ReplayRequest struct {
 // - 1 db tx including block write and logs write to logs.
 // How fast that can be done depends largely on network speed and DB, but even for the fastest
 // support chain, polygon, which has 2s block times, we need RPCs roughly with <= 500ms latency
-func NewLogPoller(orm *ORM, ec Client, lggr logger.Logger, pollPeriod time.Duration, finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
+func NewLogPoller(orm *ORM, ec Client, reorgs reorg.Broadcaster, lggr logger.Logger, pollPeriod time.Duration, useFinalityTag bool, finalityDepth int64, backfillBatchSize int64, rpcBatchSize int64, keepBlocksDepth int64) *logPoller {
 	return &logPoller{
 		ec:                ec,
 		orm:               orm,
+		reorgs:            reorgs,
 		lggr:              lggr,
 		replayStart:       make(chan ReplayRequest),
 		replayComplete:    make(chan error),
 		done:              make(chan struct{}),
 		pollPeriod:        pollPeriod,
+		useFinalityTag:    useFinalityTag,
 		finalityDepth:     finalityDepth,
 		backfillBatchSize: backfillBatchSize,
 		rpcBatchSize:      rpcBatchSize,
 		keepBlocksDepth:   keepBlocksDepth,
-		filters:           make(map[int]Filter),
+		filters:           make(map[string]Filter),
+		filtersToBackfill: make(map[string]*Filter),
 		filterDirty:       true, // Always build filter on first call to cache an empty filter if nothing registered yet.
 	}
 }
 
+// Filter is a named set of event signatures and addresses for which the log poller saves logs.
+// Filters are persisted, so the log poller keeps saving their logs across restarts, until they are unregistered.
 type Filter struct {
+	Name      string // see FilterName(id, args) below
 	EventSigs []common.Hash
 	Addresses []common.Address
+	// Events optionally holds the ABI of the filter's events, so that their logs can be decoded.
+	// Their signatures are added to EventSigs. The ABI is persisted along with the filter.
+	Events []abi.Event
+	// Retention is the number of blocks behind the latest block for which matching logs are kept, 0 keeps them forever.
+	// If several filters match a log, it is kept for the longest of their retentions.
+	Retention int64
+	// StartBlock is the block from which the logs of a newly registered filter are backfilled.
+	// If 0, only the logs of blocks which have not been polled yet are saved, unless a Replay is issued.
+	StartBlock int64
+}
+
+// FilterName is a suggested convenience function for clients to construct unique filter names
+// to populate Name field of struct Filter
+func FilterName(id string, args ...any) string {
+	if len(args) == 0 {
+		return id
+	}
+	s := &strings.Builder{}
+	s.WriteString(id)
+	s.WriteString(" - ")
+	fmt.Fprintf(s, "%v", args[0])
+	for _, a := range args[1:] {
+		fmt.Fprintf(s, ":%v", a)
+	}
+	return s.String()
+}
+
+// contains returns true if filter matches all the event signatures and addresses of other.
+func (filter *Filter) contains(other *Filter) bool {
+	addresses := make(map[common.Address]struct{}, len(filter.Addresses))
+	for _, addr := range filter.Addresses {
+		addresses[addr] = struct{}{}
+	}
+	for _, addr := range other.Addresses {
+		if _, ok := addresses[addr]; !ok {
+			return false
+		}
+	}
+	eventSigs := make(map[common.Hash]struct{}, len(filter.EventSigs))
+	for _, eventSig := range filter.EventSigs {
+		eventSigs[eventSig] = struct{}{}
+	}
+	for _, eventSig := range other.EventSigs {
+		if _, ok := eventSigs[eventSig]; !ok {
+			return false
+		}
+	}
+	return true
 }
 
 // RegisterFilter adds the provided EventSigs and Addresses to the log poller's log filter query.
@@ -135,40 +205,111 @@ type Filter struct {
 // will result in the poller saving (event1, addr2) or (event2, addr1) as well, should it exist.
 // Generally speaking this is harmless. We enforce that EventSigs and Addresses are non-empty,
 // which means that anonymous events are not supported and log.Topics >= 1 always (log.Topics[0] is the event signature).
-// It returns an ID which can be used to unregister.
-func (lp *logPoller) RegisterFilter(filter Filter) (int, error) {
-	lp.filterMu.Lock()
-	defer lp.filterMu.Unlock()
+// The filter is persisted and replaces any filter of the same name, so it is safe to register it again on every start.
+// If the filter matches events or addresses which the filter of the same name did not, and filter.StartBlock is set,
+// the log poller backfills the logs matching it from StartBlock in the background.
+func (lp *logPoller) RegisterFilter(filter Filter, qopts ...pg.QOpt) error {
+	if len(filter.Name) == 0 {
+		return errors.Errorf("filter name must be specified")
+	}
 	if len(filter.Addresses) == 0 {
-		return 0, errors.Errorf("at least one address must be specified")
+		return errors.Errorf("at least one address must be specified")
+	}
+	for _, event := range filter.Events {
+		if !containsHash(filter.EventSigs, event.ID) {
+			filter.EventSigs = append(filter.EventSigs, event.ID)
+		}
 	}
 	if len(filter.EventSigs) == 0 {
-		return 0, errors.Errorf("at least one event must be specified")
+		return errors.Errorf("at least one event must be specified")
 	}
 	for _, eventSig := range filter.EventSigs {
 		if eventSig == [common.HashLength]byte{} {
-			return 0, errors.Errorf("empty event sig")
+			return errors.Errorf("empty event sig")
 		}
 	}
 	for _, addr := range filter.Addresses {
 		if addr == [common.AddressLength]byte{} {
-			return 0, errors.Errorf("empty address")
+			return errors.Errorf("empty address")
 		}
 	}
-	lp.currentFilterID++
-	lp.filters[lp.currentFilterID] = filter
+	if filter.Retention < 0 {
+		return errors.Errorf("negative retention %d", filter.Retention)
+	}
+	if filter.StartBlock < 0 {
+		return errors.Errorf("negative start block %d", filter.StartBlock)
+	}
+
+	lp.filterMu.Lock()
+	defer lp.filterMu.Unlock()
+	if err := lp.loadFilters(qopts...); err != nil {
+		return err
+	}
+	if existing, ok := lp.filters[filter.Name]; ok && existing.contains(&filter) {
+		// The logs matching this filter are already being saved, only keep any backfill which is still pending.
+		filter.StartBlock = 0
+		if pending, ok := lp.filtersToBackfill[filter.Name]; ok {
+			filter.StartBlock = pending.StartBlock
+		}
+	}
+	if err := lp.orm.InsertFilter(filter, qopts...); err != nil {
+		return errors.Wrapf(err, "failed to save filter %q", filter.Name)
+	}
+	lp.filters[filter.Name] = filter
+	delete(lp.filtersToBackfill, filter.Name)
+	if filter.StartBlock > 0 {
+		lp.filtersToBackfill[filter.Name] = &filter
+	}
 	lp.filterDirty = true
-	return lp.currentFilterID, nil
+	return nil
 }
 
-func (lp *logPoller) UnregisterFilter(filterID int) error {
+func containsHash(hashes []common.Hash, h common.Hash) bool {
+	for _, hash := range hashes {
+		if hash == h {
+			return true
+		}
+	}
+	return false
+}
+
+// UnregisterFilter removes the filter with the given name, so that its logs are no longer saved.
+func (lp *logPoller) UnregisterFilter(name string, qopts ...pg.QOpt) error {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
-	_, ok := lp.filters[filterID]
-	if !ok {
-		return errors.Errorf("filter %d doesn't exist", filterID)
+	if err := lp.loadFilters(qopts...); err != nil {
+		return err
+	}
+	if _, ok := lp.filters[name]; !ok {
+		return errors.Wrapf(ErrFilterNotFound, "filter %q doesn't exist", name)
 	}
-	delete(lp.filters, filterID)
+	if err := lp.orm.DeleteFilter(name, qopts...); err != nil {
+		return errors.Wrapf(err, "failed to delete filter %q", name)
+	}
+	delete(lp.filters, name)
+	delete(lp.filtersToBackfill, name)
+	lp.filterDirty = true
+	return nil
+}
+
+// loadFilters loads the persisted filters, unless they have already been loaded.
+// It must be called with filterMu held.
+func (lp *logPoller) loadFilters(qopts ...pg.QOpt) error {
+	if lp.filtersLoaded {
+		return nil
+	}
+	filters, err := lp.orm.LoadFilters(qopts...)
+	if err != nil {
+		return err
+	}
+	for name, filter := range filters {
+		filter := filter
+		lp.filters[name] = filter
+		if filter.StartBlock > 0 {
+			lp.filtersToBackfill[name] = &filter
+		}
+	}
+	lp.filtersLoaded = true
 	lp.filterDirty = true
 	return nil
 }
@@ -317,6 +458,14 @@ func (lp *logPoller) run() {
 			}
 		case <-logPollTick:
 			logPollTick = time.After(utils.WithJitter(lp.pollPeriod))
+			// Filters must be loaded before polling, so no logs are missed for filters registered before a restart.
+			lp.filterMu.Lock()
+			err := lp.loadFilters(pg.WithParentCtx(lp.ctx))
+			lp.filterMu.Unlock()
+			if err != nil {
+				lp.lggr.Errorw("Unable to load filters, retrying", "err", err)
+				continue
+			}
 			// Always start from the latest block in the db.
 			var start int64
 			lastProcessed, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(lp.ctx))
@@ -337,21 +486,33 @@ func (lp *logPoller) run() {
 				// Do not support polling chains with don't even have finality depth worth of blocks.
 				// Could conceivably support this but not worth the effort.
 				// Need finality depth + 1, no block 0.
-				if latestNum <= lp.finalityDepth {
+				if !lp.useFinalityTag && latestNum <= lp.finalityDepth {
 					lp.lggr.Warnw("insufficient number of blocks on chain, waiting for finality depth", "err", err, "latest", latestNum, "finality", lp.finalityDepth)
 					continue
 				}
 				// Starting at the first finalized block. We do not backfill the first finalized block.
-				start = latestNum - lp.finalityDepth
+				start, err = lp.latestFinalizedBlockNumber(lp.ctx, latestNum)
+				if err != nil {
+					lp.lggr.Warnw("unable to get latest finalized block for first poll", "err", err)
+					continue
+				}
+				if start <= 0 {
+					lp.lggr.Warnw("insufficient number of blocks on chain, waiting for a finalized block", "latest", latestNum, "finalized", start)
+					continue
+				}
 			} else {
 				start = lastProcessed.BlockNumber + 1
 			}
 			lp.pollAndSaveLogs(lp.ctx, start)
+			lp.backfillNewFilters(lp.ctx)
 		case <-blockPruneTick:
 			blockPruneTick = time.After(lp.pollPeriod * 1000)
 			if err := lp.pruneOldBlocks(lp.ctx); err != nil {
 				lp.lggr.Errorw("unable to prune old blocks", "err", err)
 			}
+			if err := lp.pruneExpiredLogs(lp.ctx); err != nil {
+				lp.lggr.Errorw("unable to prune expired logs", "err", err)
+			}
 		}
 	}
 }
@@ -411,6 +572,90 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 	return nil
 }
 
+// backfillNewFilters backfills the logs of newly registered filters, from their StartBlock up to
+// the latest block saved. The logs of later blocks are saved by the regular poll.
+func (lp *logPoller) backfillNewFilters(ctx context.Context) {
+	lp.filterMu.Lock()
+	pending := make([]*Filter, 0, len(lp.filtersToBackfill))
+	for _, filter := range lp.filtersToBackfill {
+		pending = append(pending, filter)
+	}
+	lp.filterMu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+	lastProcessed, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			lp.lggr.Warnw("Unable to get latest block saved for filter backfill, retrying", "err", err)
+		}
+		// Otherwise nothing has been polled yet, retry once the first block is saved.
+		return
+	}
+	for _, filter := range pending {
+		lp.lggr.Infow("Backfilling logs for new filter", "name", filter.Name, "start", filter.StartBlock, "end", lastProcessed.BlockNumber)
+		if err = lp.backfillFilter(ctx, *filter, lastProcessed.BlockNumber); err != nil {
+			lp.lggr.Warnw("Unable to backfill logs for new filter, retrying", "err", err, "name", filter.Name)
+			continue
+		}
+		lp.filterMu.Lock()
+		// Unless the filter was registered again or unregistered in the meantime, it is done.
+		if lp.filtersToBackfill[filter.Name] == filter {
+			if err = lp.orm.MarkFilterBackfilled(filter.Name, pg.WithParentCtx(ctx)); err != nil {
+				lp.lggr.Warnw("Unable to mark filter as backfilled, retrying", "err", err, "name", filter.Name)
+			} else {
+				delete(lp.filtersToBackfill, filter.Name)
+			}
+		}
+		lp.filterMu.Unlock()
+	}
+}
+
+// backfillFilter saves the logs matching filter in the block range [filter.StartBlock, end].
+// Logs of unfinalized blocks are only saved if they are on the chain the log poller has saved,
+// logs of any other fork will be replaced once the log poller detects the reorg.
+func (lp *logPoller) backfillFilter(ctx context.Context, filter Filter, end int64) error {
+	for from := filter.StartBlock; from <= end; from += lp.backfillBatchSize {
+		to := mathutil.Min(from+lp.backfillBatchSize-1, end)
+		logs, err := lp.ec.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: big.NewInt(from),
+			ToBlock:   big.NewInt(to),
+			Addresses: filter.Addresses,
+			Topics:    [][]common.Hash{filter.EventSigs},
+		})
+		if err != nil {
+			return err
+		}
+		var numbers []uint64
+		for _, l := range logs {
+			numbers = append(numbers, l.BlockNumber)
+		}
+		blocks, err := lp.orm.GetBlocks(numbers, pg.WithParentCtx(ctx))
+		if err != nil {
+			return err
+		}
+		savedHashes := make(map[int64]common.Hash, len(blocks))
+		for _, b := range blocks {
+			savedHashes[b.BlockNumber] = b.BlockHash
+		}
+		var canonical []types.Log
+		for _, l := range logs {
+			if h, ok := savedHashes[int64(l.BlockNumber)]; ok && h != l.BlockHash {
+				continue
+			}
+			canonical = append(canonical, l)
+		}
+		if len(canonical) == 0 {
+			continue
+		}
+		err = lp.orm.InsertLogs(convertLogs(lp.ec.ChainID(), canonical), pg.WithParentCtx(ctx))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // getCurrentBlockMaybeHandleReorg accepts a block number
 // and will return that block if its parent points to our last saved block.
 // One can optionally pass the block header if it has already been queried to avoid an extra RPC call.
@@ -464,6 +709,8 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 		}
 
 		lp.lggr.Infow("Reorg detected", "blockAfterLCA", blockAfterLCA.Number, "currentBlockNumber", currentBlockNumber)
+		// Blocks from blockAfterLCA up to our last saved block have been replaced.
+		r := reorg.NewReorg(*utils.NewBig(lp.ec.ChainID()), reorg.SourceLogPoller, blockAfterLCA.Number, currentBlockNumber-1, expectedParent.BlockHash, currentBlock.Hash)
 		// We truncate all the blocks and logs after the LCA.
 		// We could preserve the logs for forensics, since its possible
 		// that applications see them and take action upon it, however that
@@ -471,6 +718,12 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 		// the canonical set per read. Typically, if an application took action on a log
 		// it would be saved elsewhere e.g. eth_txes, so it seems better to just support the fast reads.
 		// Its also nicely analogous to reading from the chain itself.
+		// The reorg is recorded before the deletes, so that the affected logs can be counted.
+		// Recording is best-effort and kept out of the transaction below, so that it cannot stop the recovery.
+		// If the transaction fails, the reorg is detected and recorded again on the next poll, which updates the same record.
+		if err3 := lp.reorgs.Record(&r, []common.Hash{blockAfterLCA.Hash}, pg.WithParentCtx(ctx)); err3 != nil {
+			lp.lggr.Errorw("Unable to record reorg", "err", err3)
+		}
 		err2 = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
 			// These deletes are bounded by reorg depth, so they are
 			// fast and should not slow down the log readers.
@@ -491,6 +744,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.reorgs.Broadcast(r)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -535,7 +789,13 @@ func (lp *logPoller) pollAndSaveLogs(ctx context.Context, currentBlockNumber int
 	// E.g. 1<-2<-3(currentBlockNumber)<-4<-5<-6<-7(latestBlockNumber), finality is 2. So 3,4 can be batched.
 	// Although 5 is finalized, we still need to save it to the db for reorg detection if 6 is a reorg.
 	// start = currentBlockNumber = 3, end = latestBlockNumber - finality - 1 = 7-2-1 = 4 (inclusive range).
-	lastSafeBackfillBlock := latestBlockNumber - lp.finalityDepth - 1
+	// With the finality tag enabled, the chain's latest finalized block takes the place of latestBlockNumber - finality.
+	latestFinalizedBlockNumber, err := lp.latestFinalizedBlockNumber(ctx, latestBlockNumber)
+	if err != nil {
+		lp.lggr.Warnw("Unable to get latest finalized block", "err", err, "currentBlockNumber", currentBlockNumber)
+		return
+	}
+	lastSafeBackfillBlock := latestFinalizedBlockNumber - 1
 	if lastSafeBackfillBlock >= currentBlockNumber {
 		lp.lggr.Infow("Backfilling logs", "start", currentBlockNumber, "end", lastSafeBackfillBlock)
 		if err = lp.backfill(ctx, currentBlockNumber, lastSafeBackfillBlock); err != nil {
@@ -608,9 +868,14 @@ func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.He
 	reorgStart := parent.Number
 	// We expect reorgs up to the block after (current - finalityDepth),
 	// since the block at (current - finalityDepth) is finalized.
+	// With the finality tag enabled, we expect reorgs up to the block after the chain's latest finalized block.
 	// We loop via parent instead of current so current always holds the LCA+1.
 	// If the parent block number becomes < the first finalized block our reorg is too deep.
-	for parent.Number >= (reorgStart - lp.finalityDepth) {
+	finalized, err := lp.latestFinalizedBlockNumber(ctx, reorgStart)
+	if err != nil {
+		return nil, err
+	}
+	for parent.Number >= finalized {
 		ourParentBlockHash, err := lp.orm.SelectBlockByNumber(parent.Number, pg.WithParentCtx(ctx))
 		if err != nil {
 			return nil, err
@@ -626,10 +891,26 @@ func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.He
 			return nil, err
 		}
 	}
-	lp.lggr.Criticalw("Reorg greater than finality depth detected", "max reorg depth", lp.finalityDepth-1)
+	lp.lggr.Criticalw("Reorg greater than finality depth detected", "max reorg depth", reorgStart-finalized-1, "finalityTagEnabled", lp.useFinalityTag)
 	return nil, errors.New("Reorg greater than finality depth")
 }
 
+// latestFinalizedBlockNumber returns the number of the latest finalized block, given the latest block number.
+// If the finality tag is enabled it is fetched from the chain, otherwise it is latest - finalityDepth.
+func (lp *logPoller) latestFinalizedBlockNumber(ctx context.Context, latest int64) (int64, error) {
+	if !lp.useFinalityTag {
+		return latest - lp.finalityDepth, nil
+	}
+	finalized, err := lp.ec.HeadByNumber(ctx, big.NewInt(rpc.FinalizedBlockNumber.Int64()))
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get latest finalized block")
+	}
+	if finalized == nil {
+		return 0, errors.New("received nil finalized block from RPC")
+	}
+	return finalized.Number, nil
+}
+
 // pruneOldBlocks removes blocks that are > lp.ancientBlockDepth behind the head.
 func (lp *logPoller) pruneOldBlocks(ctx context.Context) error {
 	latest, err := lp.ec.HeadByNumber(ctx, nil)
@@ -648,39 +929,99 @@ func (lp *logPoller) pruneOldBlocks(ctx context.Context) error {
 	return lp.orm.DeleteBlocksBefore(latest.Number-lp.keepBlocksDepth, pg.WithParentCtx(ctx))
 }
 
+// pruneExpiredLogs removes the logs which are older than the retention of the filters matching them.
+func (lp *logPoller) pruneExpiredLogs(ctx context.Context) error {
+	latest, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// No-op, nothing polled yet
+			return nil
+		}
+		return err
+	}
+	return lp.orm.DeleteExpiredLogs(latest.BlockNumber, pg.WithParentCtx(ctx))
+}
+
+// readOnly marks the queries of the read API as read-only, so that they may be sent to the read replica of the database.
+// The log poller itself always reads from the main database, as it reads what it just wrote. Queries with confs are
+// relative to the latest block of the database they are sent to, so they only ever lag behind.
+func readOnly(qopts []pg.QOpt) []pg.QOpt {
+	return append(qopts[:len(qopts):len(qopts)], pg.WithReadOnly())
+}
+
+// readOnlyUpTo runs fc on the read replica, if it has replayed the blocks of the log poller up to end, and on the main
+// database otherwise. Callers read block ranges up to the LatestBlock of the main database, and move past them: the
+// replica must not silently return fewer logs for blocks it has not replayed yet. Blocks are saved along with their
+// logs, so a replica with block end has all of the logs up to it.
+func (lp *logPoller) readOnlyUpTo(end int64, qopts []pg.QOpt, fc func(qopts ...pg.QOpt) error) error {
+	return lp.orm.q.WithOpts(readOnly(qopts)...).Transaction(func(tx pg.Queryer) error {
+		latest, err := lp.orm.SelectLatestBlock(pg.WithQueryer(tx))
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		} else if err == nil && latest.BlockNumber >= end {
+			return fc(append(qopts[:len(qopts):len(qopts)], pg.WithQueryer(tx))...)
+		}
+		return fc(qopts...)
+	}, pg.OptReadOnlyTx())
+}
+
 // Logs returns logs matching topics and address (exactly) in the given block range,
 // which are canonical at time of query.
-func (lp *logPoller) Logs(start, end int64, eventSig common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error) {
-	return lp.orm.SelectLogsByBlockRangeFilter(start, end, address, eventSig, qopts...)
+func (lp *logPoller) Logs(start, end int64, eventSig common.Hash, address common.Address, qopts ...pg.QOpt) (logs []Log, err error) {
+	err = lp.readOnlyUpTo(end, qopts, func(qopts ...pg.QOpt) (err error) {
+		logs, err = lp.orm.SelectLogsByBlockRangeFilter(start, end, address, eventSig, qopts...)
+		return
+	})
+	return
 }
 
-func (lp *logPoller) LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error) {
-	return lp.orm.SelectLogsWithSigsByBlockRangeFilter(start, end, address, eventSigs, qopts...)
+func (lp *logPoller) LogsWithSigsAddrs(start, end int64, eventSigs []common.Hash, addresses []common.Address, qopts ...pg.QOpt) (logs []Log, err error) {
+	err = lp.readOnlyUpTo(end, qopts, func(qopts ...pg.QOpt) (err error) {
+		logs, err = lp.orm.SelectLogsWithSigsAddrsByBlockRange(start, end, addresses, eventSigs, qopts...)
+		return
+	})
+	return
+}
+
+func (lp *logPoller) LogsPage(start, end int64, eventSig common.Hash, address common.Address, offset, limit int, qopts ...pg.QOpt) (logs []Log, count int, err error) {
+	err = lp.readOnlyUpTo(end, qopts, func(qopts ...pg.QOpt) (err error) {
+		logs, count, err = lp.orm.SelectLogsByBlockRangeFilterPage(start, end, address, eventSig, offset, limit, qopts...)
+		return
+	})
+	return
+}
+
+func (lp *logPoller) LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) (logs []Log, err error) {
+	err = lp.readOnlyUpTo(end, qopts, func(qopts ...pg.QOpt) (err error) {
+		logs, err = lp.orm.SelectLogsWithSigsByBlockRangeFilter(start, end, address, eventSigs, qopts...)
+		return
+	})
+	return
 }
 
 // IndexedLogs finds all the logs that have a topic value in topicValues at index topicIndex.
 func (lp *logPoller) IndexedLogs(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	return lp.orm.SelectIndexedLogs(address, eventSig, topicIndex, topicValues, confs, qopts...)
+	return lp.orm.SelectIndexedLogs(address, eventSig, topicIndex, topicValues, confs, readOnly(qopts)...)
 }
 
 // LogsDataWordGreaterThan note index is 0 based.
 func (lp *logPoller) LogsDataWordGreaterThan(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	return lp.orm.SelectDataWordGreaterThan(address, eventSig, wordIndex, wordValueMin, confs, qopts...)
+	return lp.orm.SelectDataWordGreaterThan(address, eventSig, wordIndex, wordValueMin, confs, readOnly(qopts)...)
 }
 
 // LogsDataWordRange note index is 0 based.
 func (lp *logPoller) LogsDataWordRange(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin, wordValueMax common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	return lp.orm.SelectDataWordRange(address, eventSig, wordIndex, wordValueMin, wordValueMax, confs, qopts...)
+	return lp.orm.SelectDataWordRange(address, eventSig, wordIndex, wordValueMin, wordValueMax, confs, readOnly(qopts)...)
 }
 
 // IndexedLogsTopicGreaterThan finds all the logs that have a topic value greater than topicValueMin at index topicIndex.
 // Only works for integer topics.
 func (lp *logPoller) IndexedLogsTopicGreaterThan(eventSig common.Hash, address common.Address, topicIndex int, topicValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	return lp.orm.SelectIndexLogsTopicGreaterThan(address, eventSig, topicIndex, topicValueMin, confs, qopts...)
+	return lp.orm.SelectIndexLogsTopicGreaterThan(address, eventSig, topicIndex, topicValueMin, confs, readOnly(qopts)...)
 }
 
 func (lp *logPoller) IndexedLogsTopicRange(eventSig common.Hash, address common.Address, topicIndex int, topicValueMin common.Hash, topicValueMax common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	return lp.orm.SelectIndexLogsTopicRange(address, eventSig, topicIndex, topicValueMin, topicValueMax, confs, qopts...)
+	return lp.orm.SelectIndexLogsTopicRange(address, eventSig, topicIndex, topicValueMin, topicValueMax, confs, readOnly(qopts)...)
 }
 
 // LatestBlock returns the latest block the log poller is on. It tracks blocks to be able
@@ -700,11 +1041,11 @@ func (lp *logPoller) BlockByNumber(n int64, qopts ...pg.QOpt) (*LogPollerBlock,
 
 // LatestLogByEventSigWithConfs finds the latest log that has confs number of blocks on top of the log.
 func (lp *logPoller) LatestLogByEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error) {
-	return lp.orm.SelectLatestLogEventSigWithConfs(eventSig, address, confs, qopts...)
+	return lp.orm.SelectLatestLogEventSigWithConfs(eventSig, address, confs, readOnly(qopts)...)
 }
 
 func (lp *logPoller) LatestLogEventSigsAddrsWithConfs(fromBlock int64, eventSigs []common.Hash, addresses []common.Address, confs int, qopts ...pg.QOpt) ([]Log, error) {
-	return lp.orm.SelectLatestLogEventSigsAddrsWithConfs(fromBlock, addresses, eventSigs, confs, qopts...)
+	return lp.orm.SelectLatestLogEventSigsAddrsWithConfs(fromBlock, addresses, eventSigs, confs, readOnly(qopts)...)
 }
 
 // GetBlocks tries to get the specified block numbers from the log pollers
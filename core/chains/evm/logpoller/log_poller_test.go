@@ -18,10 +18,13 @@ import (
 	"github.com/leanovate/gopter/prop"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/rand"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	reorgmocks "github.com/smartcontractkit/chainlink/core/chains/evm/reorg/mocks"
 	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/log_emitter"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -109,7 +112,7 @@ func TestLogPoller_SynchronizedWithGeth(t *testing.T) {
 		}, 10e6)
 		_, _, emitter1, err := log_emitter.DeployLogEmitter(owner, ec)
 		require.NoError(t, err)
-		lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), 3, 2, 1000)
+		lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), reorg.NullBroadcaster, lggr, 15*time.Second, false, int64(finalityDepth), 3, 2, 1000)
 		for i := 0; i < finalityDepth; i++ { // Have enough blocks that we could reorg the full finalityDepth-1.
 			ec.Commit()
 		}
@@ -174,9 +177,10 @@ func TestLogPoller_PollAndSaveLogs(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 
 	// Set up a log poller listening for log emitter logs.
-	_, err := th.LogPoller.RegisterFilter(Filter{
-		[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
-		[]common.Address{th.EmitterAddress1, th.EmitterAddress2},
+	err := th.LogPoller.RegisterFilter(Filter{
+		Name:      "Test Emitter 1 & 2",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
+		Addresses: []common.Address{th.EmitterAddress1, th.EmitterAddress2},
 	})
 	require.NoError(t, err)
 
@@ -370,6 +374,32 @@ func TestLogPoller_PollAndSaveLogs(t *testing.T) {
 	assertDontHave(t, 11, 14, th.ORM) // Do not expect to save backfilled blocks.
 }
 
+func TestLogPoller_ReorgRecordFailure(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	reorgs := reorgmocks.NewBroadcaster(t)
+	th.LogPoller.reorgs = reorgs
+
+	// Chain gen <- 1 <- 2
+	th.Client.Commit()
+	newStart := th.LogPoller.PollAndSaveLogs(testutils.Context(t), 1)
+	assert.Equal(t, int64(3), newStart)
+
+	// Chain gen <- 1 <- 2
+	//                \ 2' <- 3
+	lca, err := th.Client.BlockByNumber(testutils.Context(t), big.NewInt(1))
+	require.NoError(t, err)
+	require.NoError(t, th.Client.Fork(testutils.Context(t), lca.Hash()))
+	th.Client.Commit()
+	th.Client.Commit()
+
+	// Failing to record the reorg must not stop the recovery.
+	reorgs.On("Record", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("failed to record")).Once()
+	reorgs.On("Broadcast", mock.MatchedBy(func(r reorg.Reorg) bool { return r.FromBlock == 2 })).Once()
+	newStart = th.LogPoller.PollAndSaveLogs(testutils.Context(t), newStart)
+	assert.Equal(t, int64(4), newStart)
+	assertHaveCanonical(t, 1, 3, th.Client, th.ORM)
+}
+
 func TestLogPoller_Logs(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 	event1 := EmitterABI.Events["Log1"].ID
@@ -419,7 +449,8 @@ func TestLogPoller_Logs(t *testing.T) {
 }
 
 func TestLogPoller_RegisterFilter(t *testing.T) {
-	lp := NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1, 2, 1000)
+	th := SetupTH(t, 1, 1, 2)
+	lp := th.LogPoller
 	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
 	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
 
@@ -428,52 +459,145 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	require.Equal(t, 1, len(f.Addresses))
 	assert.Equal(t, common.HexToAddress("0x0000000000000000000000000000000000000000"), f.Addresses[0])
 
-	_, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}})
+	err := lp.RegisterFilter(Filter{Name: "Emitter Log 1", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a1}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}}, lp.Filter().Topics)
 
 	// Should de-dupe EventSigs
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	err = lp.RegisterFilter(Filter{Name: "Emitter Log 1 + 2", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter().Topics)
 
 	// Should de-dupe Addresses
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	err = lp.RegisterFilter(Filter{Name: "Emitter Log 1 + 2 dupe", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter().Topics)
 
+	// Name required.
+	err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a1}})
+	require.Error(t, err)
 	// Address required.
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{}})
+	err = lp.RegisterFilter(Filter{Name: "no address", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}})
 	require.Error(t, err)
 	// Event required
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{}, []common.Address{a1}})
+	err = lp.RegisterFilter(Filter{Name: "No event", Addresses: []common.Address{a1}})
 	require.Error(t, err)
-	// ID should increment
-	id1, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+
+	// Filters are persisted.
+	filters, err := th.ORM.LoadFilters()
+	require.NoError(t, err)
+	require.Len(t, filters, 3)
+	assert.Equal(t, []common.Address{a1}, filters["Emitter Log 1"].Addresses)
+	assert.ElementsMatch(t, []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, filters["Emitter Log 1 + 2"].EventSigs)
+
+	// Registering a filter under an existing name replaces it.
+	err = lp.RegisterFilter(Filter{Name: "Emitter Log 1", EventSigs: []common.Hash{EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}, Retention: 10})
 	require.NoError(t, err)
-	id2, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	assert.Equal(t, []common.Address{a2}, lp.Filter().Addresses)
+	filters, err = th.ORM.LoadFilters()
 	require.NoError(t, err)
-	assert.Equal(t, id1+1, id2)
-	// Removing non-existence filterID should error.
-	err = lp.UnregisterFilter(id1)
+	require.Len(t, filters, 3)
+	assert.Equal(t, []common.Address{a2}, filters["Emitter Log 1"].Addresses)
+	assert.Equal(t, int64(10), filters["Emitter Log 1"].Retention)
+
+	// Removing non-existence Filter should error.
+	err = lp.UnregisterFilter("Emitter Log 1 + 2")
 	require.NoError(t, err)
-	err = lp.UnregisterFilter(id1)
+	err = lp.UnregisterFilter("Emitter Log 1 + 2")
 	require.Error(t, err)
-	// Continues to increment fine after removing.
-	id3, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	filters, err = th.ORM.LoadFilters()
+	require.NoError(t, err)
+	require.Len(t, filters, 2)
+
+	// A new log poller loads the persisted filters.
+	lp2 := NewLogPoller(th.ORM, nil, reorg.NullBroadcaster, th.Lggr, 15*time.Second, false, 1, 1, 2, 1000)
+	lp2.filterMu.Lock()
+	require.NoError(t, lp2.loadFilters())
+	lp2.filterMu.Unlock()
+	assert.Equal(t, lp.Filter(), lp2.Filter())
+}
+
+func TestLogPoller_BackfillNewFilter(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+
+	// Emit some logs in blocks 2->4.
+	for i := 0; i < 3; i++ {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+	// Poll up to block 4 without any filter.
+	require.Equal(t, int64(5), th.LogPoller.PollAndSaveLogs(testutils.Context(t), 1))
+	lgs, err := th.ORM.SelectLogsByBlockRange(1, 4)
 	require.NoError(t, err)
-	assert.Equal(t, id2+1, id3)
+	require.Len(t, lgs, 0)
+
+	filter := Filter{
+		Name:       "Backfilled",
+		EventSigs:  []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses:  []common.Address{th.EmitterAddress1},
+		StartBlock: 3,
+	}
+	require.NoError(t, th.LogPoller.RegisterFilter(filter))
+	th.LogPoller.backfillNewFilters(testutils.Context(t))
+
+	// Logs from the start block up to the latest block saved are backfilled.
+	lgs, err = th.ORM.SelectLogsByBlockRange(1, 4)
+	require.NoError(t, err)
+	require.Len(t, lgs, 2)
+	assert.Equal(t, int64(3), lgs[0].BlockNumber)
+	assert.Equal(t, int64(4), lgs[1].BlockNumber)
+	assert.Empty(t, th.LogPoller.filtersToBackfill)
+	filters, err := th.ORM.LoadFilters()
+	require.NoError(t, err)
+	assert.Zero(t, filters["Backfilled"].StartBlock)
+
+	// Registering the same filter again does not backfill it again.
+	require.NoError(t, th.LogPoller.RegisterFilter(filter))
+	assert.Empty(t, th.LogPoller.filtersToBackfill)
+}
+
+func TestLogPoller_PruneExpiredLogs(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+
+	event1 := EmitterABI.Events["Log1"].ID
+	event2 := EmitterABI.Events["Log2"].ID
+	var logs []Log
+	for i := int64(1); i <= 10; i++ {
+		require.NoError(t, th.ORM.InsertBlock(utils.NewHash(), i))
+		for j, event := range []common.Hash{event1, event2} {
+			logs = append(logs, GenLog(th.ChainID, int64(j), i, utils.NewHash().String(), event[:], th.EmitterAddress1))
+		}
+	}
+	require.NoError(t, th.ORM.InsertLogs(logs))
+
+	// Log1 is kept for 3 blocks, Log2 forever since one of its filters has no retention.
+	require.NoError(t, th.LogPoller.RegisterFilter(Filter{Name: "Log1 short", EventSigs: []common.Hash{event1}, Addresses: []common.Address{th.EmitterAddress1}, Retention: 2}))
+	require.NoError(t, th.LogPoller.RegisterFilter(Filter{Name: "Log1 long", EventSigs: []common.Hash{event1}, Addresses: []common.Address{th.EmitterAddress1}, Retention: 3}))
+	require.NoError(t, th.LogPoller.RegisterFilter(Filter{Name: "Log2 short", EventSigs: []common.Hash{event2}, Addresses: []common.Address{th.EmitterAddress1}, Retention: 2}))
+	require.NoError(t, th.LogPoller.RegisterFilter(Filter{Name: "Log2 forever", EventSigs: []common.Hash{event2}, Addresses: []common.Address{th.EmitterAddress1}}))
+
+	require.NoError(t, th.LogPoller.pruneExpiredLogs(testutils.Context(t)))
+	lgs, err := th.ORM.SelectLogsByBlockRangeFilter(1, 10, th.EmitterAddress1, event1)
+	require.NoError(t, err)
+	require.Len(t, lgs, 3)
+	assert.Equal(t, int64(8), lgs[0].BlockNumber)
+	lgs, err = th.ORM.SelectLogsByBlockRangeFilter(1, 10, th.EmitterAddress1, event2)
+	require.NoError(t, err)
+	require.Len(t, lgs, 10)
 }
 
 func TestLogPoller_GetBlocks(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 
-	_, err := th.LogPoller.RegisterFilter(Filter{[]common.Hash{
-		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}},
-	)
+	err := th.LogPoller.RegisterFilter(Filter{
+		Name:      "GetBlocks Test",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
+		Addresses: []common.Address{th.EmitterAddress1, th.EmitterAddress2},
+	})
 	require.NoError(t, err)
 
 	// LP retrieves block 1
@@ -584,9 +708,34 @@ func TestGetReplayFromBlock(t *testing.T) {
 	assert.Equal(t, requested, fromBlock)
 }
 
+func TestLogPoller_LatestFinalizedBlockNumber(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	chainID := testutils.NewRandomEVMChainID()
+	ec := backends.NewSimulatedBackend(map[common.Address]core.GenesisAccount{}, 10e6)
+	for i := 0; i < 10; i++ {
+		ec.Commit()
+	}
+	ethClient := client.NewSimulatedBackendClient(t, ec, chainID)
+
+	t.Run("finality depth", func(t *testing.T) {
+		lp := NewLogPoller(nil, ethClient, reorg.NullBroadcaster, lggr, 1*time.Hour, false, 3, 3, 2, 1000)
+		finalized, err := lp.latestFinalizedBlockNumber(testutils.Context(t), 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), finalized)
+	})
+
+	t.Run("finality tag", func(t *testing.T) {
+		// The simulated backend has instant finality, so the finalized block is the latest one.
+		lp := NewLogPoller(nil, ethClient, reorg.NullBroadcaster, lggr, 1*time.Hour, true, 3, 3, 2, 1000)
+		finalized, err := lp.latestFinalizedBlockNumber(testutils.Context(t), 7)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), finalized)
+	})
+}
+
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.TestLogger(b)
-	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, 3, 2, 1000)
+	lp := NewLogPoller(nil, nil, reorg.NullBroadcaster, lggr, 1*time.Hour, false, 2, 3, 2, 1000)
 	for i := 0; i < nFilters; i++ {
 		var addresses []common.Address
 		var events []common.Hash
@@ -596,8 +745,7 @@ func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 		for j := 0; j < nEvents; j++ {
 			events = append(events, common.BigToHash(big.NewInt(int64(j+1))))
 		}
-		_, err := lp.RegisterFilter(Filter{EventSigs: events, Addresses: addresses})
-		require.NoError(b, err)
+		lp.filters[FilterName("Bench", i)] = Filter{Name: FilterName("Bench", i), EventSigs: events, Addresses: addresses}
 	}
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
@@ -3,10 +3,12 @@
 package mocks
 
 import (
-	context "context"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	context "context"
+
 	logpoller "github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
 
 	mock "github.com/stretchr/testify/mock"
@@ -33,6 +35,57 @@ func (_m *LogPoller) Close() error {
 	return r0
 }
 
+// EventABI provides a mock function with given fields: eventSig
+func (_m *LogPoller) EventABI(eventSig common.Hash) (abi.Event, bool) {
+	ret := _m.Called(eventSig)
+
+	var r0 abi.Event
+	if rf, ok := ret.Get(0).(func(common.Hash) abi.Event); ok {
+		r0 = rf(eventSig)
+	} else {
+		r0 = ret.Get(0).(abi.Event)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(common.Hash) bool); ok {
+		r1 = rf(eventSig)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// EventLogs provides a mock function with given fields: q, qopts
+func (_m *LogPoller) EventLogs(q logpoller.EventQuery, qopts ...pg.QOpt) ([]logpoller.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, q)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []logpoller.Log
+	if rf, ok := ret.Get(0).(func(logpoller.EventQuery, ...pg.QOpt) []logpoller.Log); ok {
+		r0 = rf(q, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(logpoller.EventQuery, ...pg.QOpt) error); ok {
+		r1 = rf(q, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlocks provides a mock function with given fields: ctx, numbers, qopts
 func (_m *LogPoller) GetBlocks(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]logpoller.LogPollerBlock, error) {
 	_va := make([]interface{}, len(qopts))
@@ -284,6 +337,73 @@ func (_m *LogPoller) Logs(start int64, end int64, eventSig common.Hash, address
 	return r0, r1
 }
 
+// LogsWithSigsAddrs provides a mock function with given fields: start, end, eventSigs, addresses, qopts
+func (_m *LogPoller) LogsWithSigsAddrs(start int64, end int64, eventSigs []common.Hash, addresses []common.Address, qopts ...pg.QOpt) ([]logpoller.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, start, end, eventSigs, addresses)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []logpoller.Log
+	if rf, ok := ret.Get(0).(func(int64, int64, []common.Hash, []common.Address, ...pg.QOpt) []logpoller.Log); ok {
+		r0 = rf(start, end, eventSigs, addresses, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, []common.Hash, []common.Address, ...pg.QOpt) error); ok {
+		r1 = rf(start, end, eventSigs, addresses, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogsPage provides a mock function with given fields: start, end, eventSig, address, offset, limit, qopts
+func (_m *LogPoller) LogsPage(start int64, end int64, eventSig common.Hash, address common.Address, offset int, limit int, qopts ...pg.QOpt) ([]logpoller.Log, int, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, start, end, eventSig, address, offset, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []logpoller.Log
+	if rf, ok := ret.Get(0).(func(int64, int64, common.Hash, common.Address, int, int, ...pg.QOpt) []logpoller.Log); ok {
+		r0 = rf(start, end, eventSig, address, offset, limit, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Log)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(int64, int64, common.Hash, common.Address, int, int, ...pg.QOpt) int); ok {
+		r1 = rf(start, end, eventSig, address, offset, limit, qopts...)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int64, int64, common.Hash, common.Address, int, int, ...pg.QOpt) error); ok {
+		r2 = rf(start, end, eventSig, address, offset, limit, qopts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // LogsDataWordGreaterThan provides a mock function with given fields: eventSig, address, wordIndex, wordValueMin, confs, qopts
 func (_m *LogPoller) LogsDataWordGreaterThan(eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs int, qopts ...pg.QOpt) ([]logpoller.Log, error) {
 	_va := make([]interface{}, len(qopts))
@@ -388,25 +508,25 @@ func (_m *LogPoller) Ready() error {
 	return r0
 }
 
-// RegisterFilter provides a mock function with given fields: filter
-func (_m *LogPoller) RegisterFilter(filter logpoller.Filter) (int, error) {
-	ret := _m.Called(filter)
-
-	var r0 int
-	if rf, ok := ret.Get(0).(func(logpoller.Filter) int); ok {
-		r0 = rf(filter)
-	} else {
-		r0 = ret.Get(0).(int)
+// RegisterFilter provides a mock function with given fields: filter, qopts
+func (_m *LogPoller) RegisterFilter(filter logpoller.Filter, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
 	}
+	var _ca []interface{}
+	_ca = append(_ca, filter)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(logpoller.Filter) error); ok {
-		r1 = rf(filter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(logpoller.Filter, ...pg.QOpt) error); ok {
+		r0 = rf(filter, qopts...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
 // Replay provides a mock function with given fields: ctx, fromBlock
@@ -437,13 +557,20 @@ func (_m *LogPoller) Start(_a0 context.Context) error {
 	return r0
 }
 
-// UnregisterFilter provides a mock function with given fields: filterID
-func (_m *LogPoller) UnregisterFilter(filterID int) error {
-	ret := _m.Called(filterID)
+// UnregisterFilter provides a mock function with given fields: name, qopts
+func (_m *LogPoller) UnregisterFilter(name string, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(filterID)
+	if rf, ok := ret.Get(0).(func(string, ...pg.QOpt) error); ok {
+		r0 = rf(name, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2,7 +2,9 @@ package logpoller
 
 import (
 	"database/sql"
+	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
@@ -96,6 +98,120 @@ func (o *ORM) DeleteLogsAfter(start int64, qopts ...pg.QOpt) error {
 	return q.ExecQ(`DELETE FROM logs WHERE block_number >= $1 AND evm_chain_id = $2`, start, utils.NewBig(o.chainID))
 }
 
+// InsertFilter persists filter, replacing any filter of the same name.
+// If filter.StartBlock is set, the filter is marked as waiting to be backfilled from it.
+func (o *ORM) InsertFilter(filter Filter, qopts ...pg.QOpt) error {
+	var addresses, eventSigs pq.ByteaArray
+	for _, addr := range filter.Addresses {
+		addresses = append(addresses, addr.Bytes())
+	}
+	// The ABIs are aligned with eventSigs, empty for events registered without one.
+	var eventABIs pq.StringArray
+	for _, eventSig := range filter.EventSigs {
+		eventSigs = append(eventSigs, eventSig.Bytes())
+		var eventABI string
+		for _, event := range filter.Events {
+			if event.ID != eventSig {
+				continue
+			}
+			b, err := marshalEventABI(event)
+			if err != nil {
+				return errors.Wrapf(err, "failed to marshal the ABI of event %s", event.Sig)
+			}
+			eventABI = string(b)
+		}
+		eventABIs = append(eventABIs, eventABI)
+	}
+	var backfillStartBlock sql.NullInt64
+	if filter.StartBlock > 0 {
+		backfillStartBlock = sql.NullInt64{Int64: filter.StartBlock, Valid: true}
+	}
+	return o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`DELETE FROM log_poller_filters WHERE name = $1 AND evm_chain_id = $2`, filter.Name, utils.NewBig(o.chainID)); err != nil {
+			return errors.Wrap(err, "failed to delete filter")
+		}
+		_, err := tx.Exec(`
+INSERT INTO log_poller_filters (name, evm_chain_id, address, event, event_abi, retention, backfill_start_block, created_at)
+SELECT $1, $2, a.address, e.event, NULLIF(e.event_abi, '')::jsonb, $5, $6, NOW()
+FROM unnest($3::bytea[]) AS a(address), unnest($4::bytea[], $7::text[]) AS e(event, event_abi)
+ON CONFLICT DO NOTHING`, filter.Name, utils.NewBig(o.chainID), addresses, eventSigs, filter.Retention, backfillStartBlock, eventABIs)
+		return errors.Wrap(err, "failed to insert filter")
+	})
+}
+
+// DeleteFilter removes the filter with the given name.
+func (o *ORM) DeleteFilter(name string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`DELETE FROM log_poller_filters WHERE name = $1 AND evm_chain_id = $2`, name, utils.NewBig(o.chainID))
+}
+
+// LoadFilters returns all persisted filters, keyed by name.
+// The StartBlock of a filter is only set if it is still waiting to be backfilled.
+func (o *ORM) LoadFilters(qopts ...pg.QOpt) (map[string]Filter, error) {
+	q := o.q.WithOpts(qopts...)
+	var rows []struct {
+		Name               string
+		Addresses          pq.ByteaArray
+		EventSigs          pq.ByteaArray
+		EventABIs          pq.StringArray
+		Retention          int64
+		BackfillStartBlock sql.NullInt64
+	}
+	err := q.Select(&rows, `
+SELECT name,
+	ARRAY_AGG(DISTINCT address)::bytea[] AS addresses,
+	ARRAY_AGG(DISTINCT event)::bytea[] AS event_sigs,
+	ARRAY_AGG(DISTINCT event_abi::text) FILTER (WHERE event_abi IS NOT NULL) AS event_abis,
+	MAX(retention) AS retention,
+	MIN(backfill_start_block) AS backfill_start_block
+FROM log_poller_filters WHERE evm_chain_id = $1
+GROUP BY name`, utils.NewBig(o.chainID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load filters")
+	}
+	filters := make(map[string]Filter, len(rows))
+	for _, row := range rows {
+		filter := Filter{Name: row.Name, Retention: row.Retention, StartBlock: row.BackfillStartBlock.Int64}
+		for _, addr := range row.Addresses {
+			filter.Addresses = append(filter.Addresses, common.BytesToAddress(addr))
+		}
+		for _, eventSig := range row.EventSigs {
+			filter.EventSigs = append(filter.EventSigs, common.BytesToHash(eventSig))
+		}
+		for _, eventABI := range row.EventABIs {
+			event, err := unmarshalEventABI([]byte(eventABI))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to load the event ABIs of filter %q", row.Name)
+			}
+			filter.Events = append(filter.Events, event)
+		}
+		filters[row.Name] = filter
+	}
+	return filters, nil
+}
+
+// MarkFilterBackfilled records that the filter with the given name no longer needs to be backfilled.
+func (o *ORM) MarkFilterBackfilled(name string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`UPDATE log_poller_filters SET backfill_start_block = NULL WHERE name = $1 AND evm_chain_id = $2`, name, utils.NewBig(o.chainID))
+}
+
+// DeleteExpiredLogs removes the logs which are older than the retention of all the filters matching them,
+// given the latest block number. Logs matched by a filter without a retention are kept forever.
+func (o *ORM) DeleteExpiredLogs(latest int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`
+WITH r AS (
+	SELECT address, event, MAX(retention) AS retention FROM log_poller_filters
+	WHERE evm_chain_id = $1
+	GROUP BY address, event
+	HAVING MIN(retention) > 0
+)
+DELETE FROM logs USING r
+WHERE logs.evm_chain_id = $1 AND logs.address = r.address AND logs.event_sig = r.event
+	AND logs.block_number <= $2 - r.retention`, utils.NewBig(o.chainID), latest)
+}
+
 // InsertLogs is idempotent to support replays.
 func (o *ORM) InsertLogs(logs []Log, qopts ...pg.QOpt) error {
 	for _, log := range logs {
@@ -137,6 +253,24 @@ func (o *ORM) selectLogsByBlockRange(start, end int64) ([]Log, error) {
 }
 
 // SelectLogsByBlockRangeFilter finds the logs in a given block range.
+// SelectLogsByBlockRangeFilterPage returns a page of the logs of SelectLogsByBlockRangeFilter, and their total count.
+func (o *ORM) SelectLogsByBlockRangeFilterPage(start, end int64, address common.Address, eventSig common.Hash, offset, limit int, qopts ...pg.QOpt) (logs []Log, count int, err error) {
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, `
+		SELECT count(*) FROM logs
+			WHERE logs.block_number >= $1 AND logs.block_number <= $2 AND logs.evm_chain_id = $3
+			AND address = $4 AND event_sig = $5`, start, end, utils.NewBig(o.chainID), address, eventSig.Bytes()); err != nil {
+			return errors.Wrap(err, "failed to count logs")
+		}
+		return errors.Wrap(tx.Select(&logs, `
+		SELECT * FROM logs
+			WHERE logs.block_number >= $1 AND logs.block_number <= $2 AND logs.evm_chain_id = $3
+			AND address = $4 AND event_sig = $5
+			ORDER BY (logs.block_number, logs.log_index) LIMIT $6 OFFSET $7`, start, end, utils.NewBig(o.chainID), address, eventSig.Bytes(), limit, offset), "failed to load logs")
+	}, pg.OptReadOnlyTx())
+	return
+}
+
 func (o *ORM) SelectLogsByBlockRangeFilter(start, end int64, address common.Address, eventSig common.Hash, qopts ...pg.QOpt) ([]Log, error) {
 	var logs []Log
 	q := o.q.WithOpts(qopts...)
@@ -191,6 +325,52 @@ ORDER BY (logs.block_number, logs.log_index)`, a)
 	return logs, err
 }
 
+// SelectLogsWithSigsAddrsByBlockRange returns the logs in the block range emitted by any of addresses, with any of eventSigs.
+func (o *ORM) SelectLogsWithSigsAddrsByBlockRange(start, end int64, addresses []common.Address, eventSigs []common.Hash, qopts ...pg.QOpt) (logs []Log, err error) {
+	if len(addresses) == 0 || len(eventSigs) == 0 {
+		return nil, nil
+	}
+	q := o.q.WithOpts(qopts...)
+	sigs := make([][]byte, 0, len(eventSigs))
+	for _, sig := range eventSigs {
+		sigs = append(sigs, sig.Bytes())
+	}
+	addrs := make([][]byte, 0, len(addresses))
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.Bytes())
+	}
+	a := map[string]any{
+		"start":     start,
+		"end":       end,
+		"chainid":   utils.NewBig(o.chainID),
+		"addresses": addrs,
+		"EventSigs": sigs,
+	}
+	query, args, err := sqlx.Named(
+		`
+SELECT
+	*
+FROM logs
+WHERE logs.block_number BETWEEN :start AND :end
+	AND logs.evm_chain_id = :chainid
+	AND logs.address IN (:addresses)
+	AND logs.event_sig IN (:EventSigs)
+ORDER BY (logs.block_number, logs.log_index)`, a)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlx Named")
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlx In")
+	}
+	query = q.Rebind(query)
+	err = q.Select(&logs, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return logs, err
+}
+
 func (o *ORM) GetBlocks(blockNumbers []uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error) {
 	if len(blockNumbers) == 0 {
 		return nil, nil
@@ -343,3 +523,40 @@ func (o *ORM) SelectIndexedLogs(address common.Address, eventSig common.Hash, to
 	}
 	return logs, nil
 }
+
+// SelectLogsByTopics finds the logs of eventSig emitted by address in the block range [start, end] with confs
+// confirmations, whose topic at each of the given indexes is one of the given values. If end is 0, there is no upper bound.
+func (o *ORM) SelectLogsByTopics(address common.Address, eventSig common.Hash, start, end int64, topics map[int][]common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
+	args := []any{utils.NewBig(o.chainID), address, eventSig.Bytes(), start, confs}
+	query := `SELECT * FROM logs
+			WHERE logs.evm_chain_id = $1
+			AND address = $2 AND event_sig = $3
+			AND block_number >= $4
+			AND (block_number + $5) <= (SELECT COALESCE(block_number, 0) FROM log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1)`
+	if end > 0 {
+		args = append(args, end)
+		query += fmt.Sprintf(" AND block_number <= $%d", len(args))
+	}
+	indexes := make([]int, 0, len(topics))
+	for index := range topics {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	for _, index := range indexes {
+		var values pq.ByteaArray
+		for _, topic := range topics[index] {
+			values = append(values, topic.Bytes())
+		}
+		// Add 1 since postgresql arrays are 1-indexed.
+		args = append(args, index+1, values)
+		query += fmt.Sprintf(" AND topics[$%d] = ANY($%d)", len(args)-1, len(args))
+	}
+	query += " ORDER BY (logs.block_number, logs.log_index)"
+
+	var logs []Log
+	q := o.q.WithOpts(qopts...)
+	if err := q.Select(&logs, query, args...); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
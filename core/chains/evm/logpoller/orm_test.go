@@ -494,6 +494,47 @@ func TestORM_SelectLogsWithSigsByBlockRangeFilter(t *testing.T) {
 	}
 }
 
+func TestORM_SelectLogsWithSigsAddrsByBlockRange(t *testing.T) {
+	o1, _ := setup(t)
+
+	topic := common.HexToHash("0x1599")
+	topic2 := common.HexToHash("0x1600")
+	addr := common.HexToAddress("0x12345")
+	addr2 := common.HexToAddress("0x12346")
+	var inputLogs []Log
+	for i, l := range []struct {
+		addr common.Address
+		sig  common.Hash
+	}{{addr, topic}, {addr2, topic}, {addr, topic2}, {addr2, topic2}, {common.HexToAddress("0x12347"), topic}} {
+		inputLogs = append(inputLogs, Log{
+			EvmChainId:  utils.NewBigI(137),
+			LogIndex:    int64(i),
+			BlockHash:   common.HexToHash("0x1234"),
+			BlockNumber: int64(10 + i),
+			EventSig:    l.sig,
+			Topics:      [][]byte{l.sig[:]},
+			Address:     l.addr,
+			TxHash:      common.HexToHash("0x1888"),
+			Data:        []byte("hello"),
+		})
+	}
+	require.NoError(t, o1.InsertLogs(inputLogs))
+
+	logs, err := o1.SelectLogsWithSigsAddrsByBlockRange(10, 14, []common.Address{addr, addr2}, []common.Hash{topic})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, addr, logs[0].Address)
+	assert.Equal(t, addr2, logs[1].Address)
+
+	logs, err = o1.SelectLogsWithSigsAddrsByBlockRange(11, 14, []common.Address{addr, addr2}, []common.Hash{topic, topic2})
+	require.NoError(t, err)
+	assert.Len(t, logs, 3)
+
+	logs, err = o1.SelectLogsWithSigsAddrsByBlockRange(10, 14, nil, []common.Hash{topic})
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+}
+
 func TestORM_DeleteBlocksBefore(t *testing.T) {
 	o1, _ := setup(t)
 	require.NoError(t, o1.InsertBlock(common.HexToHash("0x1234"), 1))
@@ -22,6 +22,8 @@ import (
 
 	monitor "github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
 
+	reorg "github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+
 	txmgr "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
@@ -126,6 +128,22 @@ func (_m *Chain) HeadTracker() types.HeadTracker {
 	return r0
 }
 
+// HealthReport provides a mock function with given fields:
+func (_m *Chain) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
 // Healthy provides a mock function with given fields:
 func (_m *Chain) Healthy() error {
 	ret := _m.Called()
@@ -218,6 +236,22 @@ func (_m *Chain) Ready() error {
 	return r0
 }
 
+// ReorgBroadcaster provides a mock function with given fields:
+func (_m *Chain) ReorgBroadcaster() reorg.Broadcaster {
+	ret := _m.Called()
+
+	var r0 reorg.Broadcaster
+	if rf, ok := ret.Get(0).(func() reorg.Broadcaster); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(reorg.Broadcaster)
+		}
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Chain) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
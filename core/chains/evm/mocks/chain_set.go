@@ -12,6 +12,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	v2 "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
+
 	types "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 
 	utils "github.com/smartcontractkit/chainlink/core/utils"
@@ -346,6 +348,20 @@ func (_m *ChainSet) Ready() error {
 	return r0
 }
 
+// ReloadConfigs provides a mock function with given fields: ctx, cfgs
+func (_m *ChainSet) ReloadConfigs(ctx context.Context, cfgs []*v2.EVMConfig) error {
+	ret := _m.Called(ctx, cfgs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*v2.EVMConfig) error); ok {
+		r0 = rf(ctx, cfgs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Remove provides a mock function with given fields: id
 func (_m *ChainSet) Remove(id utils.Big) error {
 	ret := _m.Called(id)
@@ -33,6 +33,10 @@ type (
 		services.ServiceCtx
 	}
 
+	// BalanceListener is called by the balance monitor's worker when the
+	// balance of a key changes. previous is nil for the first balance of a key.
+	BalanceListener func(address gethCommon.Address, previous *assets.Eth, balance assets.Eth)
+
 	balanceMonitor struct {
 		utils.StartStopOnce
 		logger         logger.Logger
@@ -43,13 +47,14 @@ type (
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx *sync.RWMutex
 		sleeperTask    utils.SleeperTask
+		listeners      []BalanceListener
 	}
 
 	NullBalanceMonitor struct{}
 )
 
 // NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, logger logger.Logger) BalanceMonitor {
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, logger logger.Logger, listeners ...BalanceListener) BalanceMonitor {
 	bm := &balanceMonitor{
 		utils.StartStopOnce{},
 		logger,
@@ -60,6 +65,7 @@ func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, log
 		make(map[gethCommon.Address]*assets.Eth),
 		new(sync.RWMutex),
 		nil,
+		listeners,
 	}
 	bm.sleeperTask = utils.NewSleeperTask(&worker{bm: bm})
 	return bm
@@ -119,11 +125,14 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 
 	if oldBal == nil {
 		lgr.Infof("ETH balance for %s: %s", address.Hex(), ethBal.String())
+	} else if ethBal.Cmp(oldBal) != 0 {
+		lgr.Infof("New ETH balance for %s: %s", address.Hex(), ethBal.String())
+	} else {
 		return
 	}
 
-	if ethBal.Cmp(oldBal) != 0 {
-		lgr.Infof("New ETH balance for %s: %s", address.Hex(), ethBal.String())
+	for _, l := range bm.listeners {
+		l(address, oldBal, ethBal)
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -196,6 +197,46 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 	})
 }
 
+func TestBalanceMonitor_Listeners(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := newEthClientMock(t)
+
+	_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	type change struct {
+		previous *assets.Eth
+		balance  assets.Eth
+	}
+	changes := make(chan change, 3)
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.TestLogger(t), func(address gethCommon.Address, previous *assets.Eth, balance assets.Eth) {
+		assert.Equal(t, k0Addr, address)
+		changes <- change{previous, balance}
+	})
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+	require.NoError(t, bm.Start(testutils.Context(t)))
+	defer bm.Close()
+
+	// unchanged balances are not notified
+	checked := make(chan struct{})
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil).
+		Run(func(mock.Arguments) { close(checked) })
+	bm.OnNewLongestChain(testutils.Context(t), cltest.Head(0))
+	<-checked
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(7), nil)
+	bm.OnNewLongestChain(testutils.Context(t), cltest.Head(1))
+
+	bal42, bal7 := assets.NewEthValue(42), assets.NewEthValue(7)
+	assert.Equal(t, change{nil, bal42}, <-changes)
+	assert.Equal(t, change{&bal42, bal7}, <-changes)
+	assert.Empty(t, changes)
+}
+
 func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	t.Parallel()
 
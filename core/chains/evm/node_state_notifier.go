@@ -0,0 +1,66 @@
+package evm
+
+import (
+	"encoding/json"
+	"math/big"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// nodeStateNotifierCapacity is the number of state changes buffered before the
+// oldest are dropped.
+const nodeStateNotifierCapacity = 100
+
+// nodeStateNotifier notifies the state changes of a chain's RPC nodes on
+// pg.ChannelEVMNodeStateChanged. Notifications are sent in order by a
+// goroutine, so that nodes are never blocked by the database.
+type nodeStateNotifier struct {
+	chainID          string
+	eventBroadcaster pg.EventBroadcaster
+	lggr             logger.Logger
+	queue            *utils.BoundedQueue[pg.EVMNodeStateChanged]
+	worker           utils.SleeperTask
+}
+
+func newNodeStateNotifier(chainID *big.Int, eventBroadcaster pg.EventBroadcaster, lggr logger.Logger) *nodeStateNotifier {
+	n := &nodeStateNotifier{
+		chainID:          chainID.String(),
+		eventBroadcaster: eventBroadcaster,
+		lggr:             lggr.Named("NodeStateNotifier"),
+		queue:            utils.NewBoundedQueue[pg.EVMNodeStateChanged](nodeStateNotifierCapacity),
+	}
+	n.worker = utils.NewSleeperTask(utils.SleeperFuncTask(n.notifyQueued, "NodeStateNotifier"))
+	return n
+}
+
+// OnStateChange is an evmclient.NodeStateListener.
+func (n *nodeStateNotifier) OnStateChange(nodeName string, from, to evmclient.NodeState) {
+	n.queue.Add(pg.EVMNodeStateChanged{
+		EVMChainID: n.chainID,
+		Name:       nodeName,
+		From:       from.String(),
+		To:         to.String(),
+	})
+	n.worker.WakeUpIfStarted()
+}
+
+func (n *nodeStateNotifier) notifyQueued() {
+	for !n.queue.Empty() {
+		change := n.queue.Take()
+		payload, err := json.Marshal(change)
+		if err != nil {
+			n.lggr.Errorw("Failed to serialize RPC node state change", "err", err)
+			continue
+		}
+		if err = n.eventBroadcaster.Notify(pg.ChannelEVMNodeStateChanged, string(payload)); err != nil {
+			n.lggr.Warnw("Failed to notify RPC node state change", "err", err, "nodeName", change.Name, "nodeState", change.To)
+		}
+	}
+}
+
+func (n *nodeStateNotifier) Close() error {
+	return n.worker.Stop()
+}
@@ -0,0 +1,37 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+)
+
+func TestNodeStateNotifier(t *testing.T) {
+	t.Parallel()
+
+	eb := pgmocks.NewEventBroadcaster(t)
+	notified := make(chan struct{})
+	eb.On("Notify", pg.ChannelEVMNodeStateChanged, `{"evmChainID":"42","name":"primary","from":"Alive","to":"Unreachable"}`).Return(nil).Once()
+	eb.On("Notify", pg.ChannelEVMNodeStateChanged, `{"evmChainID":"42","name":"primary","from":"Unreachable","to":"Dialed"}`).Return(nil).Once().
+		Run(func(_ mock.Arguments) { close(notified) })
+
+	n := newNodeStateNotifier(big.NewInt(42), eb, logger.TestLogger(t))
+	t.Cleanup(func() { require.NoError(t, n.Close()) })
+
+	n.OnStateChange("primary", evmclient.NodeStateAlive, evmclient.NodeStateUnreachable)
+	n.OnStateChange("primary", evmclient.NodeStateUnreachable, evmclient.NodeStateDialed)
+
+	select {
+	case <-notified:
+	case <-testutils.Context(t).Done():
+		t.Fatal("timed out waiting for notifications")
+	}
+}
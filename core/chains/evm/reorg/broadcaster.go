@@ -0,0 +1,192 @@
+package reorg
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const ListenerCallbackTimeout = 5 * time.Second
+
+var (
+	promReorgs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_reorgs_total",
+		Help: "The total number of reorgs detected",
+	}, []string{"evmChainID", "source"})
+	promReorgDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_reorg_last_depth",
+		Help: "The depth of the most recently detected reorg",
+	}, []string{"evmChainID", "source"})
+)
+
+// Listener is implemented by services which need to react to reorgs explicitly.
+type Listener interface {
+	OnReorg(ctx context.Context, r Reorg)
+}
+
+// Broadcaster persists the reorgs detected by the head tracker and log poller, and notifies subscribers of them.
+//
+//go:generate mockery --quiet --name Broadcaster --output ./mocks/ --case=underscore
+type Broadcaster interface {
+	services.ServiceCtx
+	// Record persists r. Detectors running inside a database transaction should pass it via qopts,
+	// and only Broadcast once it has been committed.
+	Record(r *Reorg, newHashes []common.Hash, qopts ...pg.QOpt) error
+	// Broadcast asynchronously notifies all subscribers of r.
+	Broadcast(r Reorg)
+	// Subscribe registers listener until the Broadcaster is closed or unsubscribe is called.
+	Subscribe(listener Listener) (unsubscribe func())
+}
+
+type listenerSet map[int]Listener
+
+func (set listenerSet) values() []Listener {
+	var values []Listener
+	for _, l := range set {
+		values = append(values, l)
+	}
+	return values
+}
+
+type broadcaster struct {
+	utils.StartStopOnce
+	orm            ORM
+	lggr           logger.Logger
+	mailbox        *utils.Mailbox[Reorg]
+	mu             sync.Mutex
+	listeners      listenerSet
+	lastListenerID int
+	chStop         chan struct{}
+	wgDone         sync.WaitGroup
+}
+
+var _ Broadcaster = &broadcaster{}
+
+// NewBroadcaster creates a new Broadcaster which persists reorgs with orm.
+func NewBroadcaster(orm ORM, lggr logger.Logger) Broadcaster {
+	return &broadcaster{
+		orm:       orm,
+		lggr:      lggr.Named("ReorgBroadcaster"),
+		mailbox:   utils.NewHighCapacityMailbox[Reorg](),
+		listeners: make(listenerSet),
+		chStop:    make(chan struct{}),
+	}
+}
+
+func (b *broadcaster) Start(context.Context) error {
+	return b.StartOnce("ReorgBroadcaster", func() error {
+		b.wgDone.Add(1)
+		go b.run()
+		return nil
+	})
+}
+
+func (b *broadcaster) Close() error {
+	return b.StopOnce("ReorgBroadcaster", func() error {
+		b.mu.Lock()
+		b.listeners = make(listenerSet)
+		b.mu.Unlock()
+
+		close(b.chStop)
+		b.wgDone.Wait()
+		return nil
+	})
+}
+
+func (b *broadcaster) Record(r *Reorg, newHashes []common.Hash, qopts ...pg.QOpt) error {
+	if err := b.orm.InsertReorg(r, newHashes, qopts...); err != nil {
+		return err
+	}
+	promReorgs.WithLabelValues(r.EVMChainID.String(), string(r.Source)).Inc()
+	promReorgDepth.WithLabelValues(r.EVMChainID.String(), string(r.Source)).Set(float64(r.Depth))
+	b.lggr.Infow("Reorg recorded", "id", r.ID, "source", r.Source, "depth", r.Depth, "fromBlock", r.FromBlock,
+		"toBlock", r.ToBlock, "oldHash", r.OldHash, "newHash", r.NewHash, "affectedEthTxIDs", r.AffectedEthTxIDs,
+		"affectedLogs", r.AffectedLogs)
+	return nil
+}
+
+func (b *broadcaster) Broadcast(r Reorg) {
+	if b.mailbox.Deliver(r) {
+		b.lggr.Warnw("Reorg mailbox is over capacity, dropped the oldest reorg", "id", r.ID)
+	}
+}
+
+func (b *broadcaster) Subscribe(listener Listener) (unsubscribe func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastListenerID++
+	id := b.lastListenerID
+	b.listeners[id] = listener
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.listeners, id)
+	}
+}
+
+func (b *broadcaster) run() {
+	defer b.wgDone.Done()
+
+	for {
+		select {
+		case <-b.chStop:
+			return
+		case <-b.mailbox.Notify():
+			for _, r := range b.mailbox.RetrieveAll() {
+				b.notifyListeners(r)
+			}
+		}
+	}
+}
+
+func (b *broadcaster) notifyListeners(r Reorg) {
+	b.mu.Lock()
+	listeners := b.listeners.values()
+	b.mu.Unlock()
+
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(len(listeners))
+	for _, l := range listeners {
+		go func(l Listener) {
+			defer wg.Done()
+			start := time.Now()
+			lctx, cancel := context.WithTimeout(ctx, ListenerCallbackTimeout)
+			defer cancel()
+			l.OnReorg(lctx, r)
+			elapsed := time.Since(start)
+			b.lggr.Debugw(fmt.Sprintf("Finished reorg callback in %s", elapsed),
+				"listenerType", reflect.TypeOf(l), "id", r.ID, "time", elapsed)
+		}(l)
+	}
+	wg.Wait()
+}
+
+// NullBroadcaster does not persist or broadcast reorgs.
+var NullBroadcaster Broadcaster = &nullBroadcaster{}
+
+type nullBroadcaster struct{}
+
+func (*nullBroadcaster) Start(context.Context) error { return nil }
+func (*nullBroadcaster) Close() error                { return nil }
+func (*nullBroadcaster) Ready() error                { return nil }
+func (*nullBroadcaster) Healthy() error              { return nil }
+func (*nullBroadcaster) Record(*Reorg, []common.Hash, ...pg.QOpt) error {
+	return nil
+}
+func (*nullBroadcaster) Broadcast(Reorg)                         {}
+func (*nullBroadcaster) Subscribe(Listener) (unsubscribe func()) { return func() {} }
@@ -0,0 +1,81 @@
+package reorg_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type chanListener chan reorg.Reorg
+
+func (l chanListener) OnReorg(ctx context.Context, r reorg.Reorg) {
+	select {
+	case l <- r:
+	case <-ctx.Done():
+	}
+}
+
+func TestBroadcaster_Record(t *testing.T) {
+	t.Parallel()
+
+	r := reorg.NewReorg(*utils.NewBigI(42), reorg.SourceLogPoller, 10, 12, utils.NewHash(), utils.NewHash())
+	newHashes := []common.Hash{r.NewHash}
+
+	orm := mocks.NewORM(t)
+	b := reorg.NewBroadcaster(orm, logger.TestLogger(t))
+
+	orm.On("InsertReorg", &r, newHashes).Run(func(args mock.Arguments) {
+		args.Get(0).(*reorg.Reorg).ID = 7
+	}).Return(nil).Once()
+	require.NoError(t, b.Record(&r, newHashes))
+	assert.Equal(t, int64(7), r.ID)
+
+	orm.On("InsertReorg", &r, newHashes).Return(errors.New("boom")).Once()
+	require.EqualError(t, b.Record(&r, newHashes), "boom")
+}
+
+func TestBroadcaster_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	b := reorg.NewBroadcaster(mocks.NewORM(t), logger.TestLogger(t))
+	require.NoError(t, b.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, b.Close()) })
+
+	l1, l2 := make(chanListener, 1), make(chanListener, 1)
+	unsubscribe1 := b.Subscribe(l1)
+	b.Subscribe(l2)
+
+	r := reorg.NewReorg(*utils.NewBigI(42), reorg.SourceHeadTracker, 10, 12, utils.NewHash(), utils.NewHash())
+	r.ID = 1
+	b.Broadcast(r)
+	for _, l := range []chanListener{l1, l2} {
+		select {
+		case got := <-l:
+			assert.Equal(t, r, got)
+		case <-testutils.Context(t).Done():
+			t.Fatal("timed out waiting for reorg")
+		}
+	}
+
+	unsubscribe1()
+	r.ID = 2
+	b.Broadcast(r)
+	select {
+	case got := <-l2:
+		assert.Equal(t, r, got)
+	case <-testutils.Context(t).Done():
+		t.Fatal("timed out waiting for reorg")
+	}
+	assert.Empty(t, l1)
+}
@@ -0,0 +1,77 @@
+package reorg
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Source identifies the service which detected a reorg.
+type Source string
+
+const (
+	SourceHeadTracker Source = "head_tracker"
+	SourceLogPoller   Source = "log_poller"
+)
+
+// Reorg represents a chain reorganization. Blocks FromBlock through ToBlock
+// (inclusive) of the previously canonical chain have been replaced.
+type Reorg struct {
+	ID         int64
+	EVMChainID utils.Big `db:"evm_chain_id"`
+	Source     Source
+	// Depth is the number of replaced blocks.
+	Depth     int64
+	FromBlock int64
+	ToBlock   int64
+	// OldHash is the hash of the replaced block at ToBlock.
+	OldHash common.Hash
+	// NewHash is the hash of the block on the new canonical chain which revealed the reorg.
+	NewHash common.Hash
+	// AffectedEthTxIDs are the eth_txes which had receipts in the replaced blocks.
+	AffectedEthTxIDs pq.Int64Array `db:"affected_eth_tx_ids"`
+	// AffectedLogs is the number of logs the log poller had saved for the replaced blocks.
+	AffectedLogs int64
+	CreatedAt    time.Time
+}
+
+// NewReorg returns a Reorg for the replaced blocks from through to.
+func NewReorg(chainID utils.Big, source Source, from, to int64, oldHash, newHash common.Hash) Reorg {
+	return Reorg{
+		EVMChainID: chainID,
+		Source:     source,
+		Depth:      to - from + 1,
+		FromBlock:  from,
+		ToBlock:    to,
+		OldHash:    oldHash,
+		NewHash:    newHash,
+	}
+}
+
+// FromHeads compares the previous longest chain with the new one. If newHead is not a descendant of prevHead,
+// it returns the reorg along with the hashes of the new chain's blocks in the replaced range.
+// The common ancestor is searched for only as far back as both chains are available in memory.
+func FromHeads(chainID utils.Big, prevHead, newHead *evmtypes.Head) (r Reorg, newHashes []common.Hash, ok bool) {
+	// Find the block of the new chain at the height of the previous head.
+	newAt := newHead
+	for newAt != nil && newAt.Number > prevHead.Number {
+		newAt = newAt.Parent
+	}
+	if newAt == nil || newAt.Hash == prevHead.Hash {
+		return
+	}
+	oldAt := prevHead
+	fromBlock := prevHead.Number
+	for oldAt != nil && newAt != nil && oldAt.Hash != newAt.Hash {
+		fromBlock = oldAt.Number
+		oldAt, newAt = oldAt.Parent, newAt.Parent
+	}
+	for h := newHead; h != nil && h.Number >= fromBlock; h = h.Parent {
+		newHashes = append(newHashes, h.Hash)
+	}
+	return NewReorg(chainID, SourceHeadTracker, fromBlock, prevHead.Number, prevHead.Hash, newHead.Hash), newHashes, true
+}
@@ -0,0 +1,85 @@
+package reorg_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// chain returns a linked list of heads numbered from through to, ending on top of parent.
+func chain(parent *evmtypes.Head, from, to int64) *evmtypes.Head {
+	head := parent
+	for n := from; n <= to; n++ {
+		h := &evmtypes.Head{Number: n, Hash: utils.NewHash(), Parent: head}
+		if head != nil {
+			h.ParentHash = head.Hash
+		}
+		head = h
+	}
+	return head
+}
+
+func TestFromHeads(t *testing.T) {
+	t.Parallel()
+
+	chainID := *utils.NewBigI(42)
+	ancestor := chain(nil, 0, 10)
+
+	t.Run("descendant is not a reorg", func(t *testing.T) {
+		prev := chain(ancestor, 11, 12)
+		next := chain(prev, 13, 14)
+		_, _, ok := reorg.FromHeads(chainID, prev, next)
+		assert.False(t, ok)
+	})
+
+	t.Run("same head is not a reorg", func(t *testing.T) {
+		_, _, ok := reorg.FromHeads(chainID, ancestor, ancestor)
+		assert.False(t, ok)
+	})
+
+	t.Run("new chain too short to compare is not a reorg", func(t *testing.T) {
+		prev := chain(ancestor, 11, 12)
+		next := &evmtypes.Head{Number: 13, Hash: utils.NewHash()}
+		_, _, ok := reorg.FromHeads(chainID, prev, next)
+		assert.False(t, ok)
+	})
+
+	t.Run("fork", func(t *testing.T) {
+		prev := chain(ancestor, 11, 13)
+		next := chain(ancestor, 11, 14)
+		r, newHashes, ok := reorg.FromHeads(chainID, prev, next)
+		require.True(t, ok)
+
+		assert.Equal(t, chainID, r.EVMChainID)
+		assert.Equal(t, reorg.SourceHeadTracker, r.Source)
+		assert.Equal(t, int64(11), r.FromBlock)
+		assert.Equal(t, int64(13), r.ToBlock)
+		assert.Equal(t, int64(3), r.Depth)
+		assert.Equal(t, prev.Hash, r.OldHash)
+		assert.Equal(t, next.Hash, r.NewHash)
+
+		var expected []common.Hash
+		for h := next; h.Number >= 11; h = h.Parent {
+			expected = append(expected, h.Hash)
+		}
+		assert.Equal(t, expected, newHashes)
+	})
+
+	t.Run("replaced head of the same height", func(t *testing.T) {
+		prev := chain(ancestor, 11, 11)
+		next := chain(ancestor, 11, 11)
+		r, newHashes, ok := reorg.FromHeads(chainID, prev, next)
+		require.True(t, ok)
+
+		assert.Equal(t, int64(11), r.FromBlock)
+		assert.Equal(t, int64(11), r.ToBlock)
+		assert.Equal(t, int64(1), r.Depth)
+		assert.Equal(t, []common.Hash{next.Hash}, newHashes)
+	})
+}
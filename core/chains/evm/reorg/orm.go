@@ -0,0 +1,88 @@
+package reorg
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+type ORM interface {
+	// InsertReorg persists r, setting its ID, CreatedAt and the eth_txes and logs it affected.
+	// Receipts and logs in the replaced block range are considered affected, unless their block hash
+	// is one of newHashes, the hashes known to be on the new canonical chain.
+	// A reorg which was already inserted, with the same chain, source, FromBlock and NewHash, is updated instead.
+	InsertReorg(r *Reorg, newHashes []common.Hash, qopts ...pg.QOpt) error
+	// Reorgs returns a page of reorgs across all chains, most recent first, and the total count.
+	Reorgs(offset, limit int, qopts ...pg.QOpt) ([]Reorg, int, error)
+	// FindReorg returns the reorg with the given id.
+	FindReorg(id int64, qopts ...pg.QOpt) (Reorg, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = &orm{}
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("ReorgORM"), cfg)}
+}
+
+func (o *orm) InsertReorg(r *Reorg, newHashes []common.Hash, qopts ...pg.QOpt) error {
+	hashes := make(pq.ByteaArray, len(newHashes))
+	for i := range newHashes {
+		hashes[i] = newHashes[i].Bytes()
+	}
+	err := o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		ids := []int64{}
+		err := tx.Select(&ids, `
+SELECT DISTINCT eth_tx_attempts.eth_tx_id FROM eth_receipts
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+WHERE eth_txes.evm_chain_id = $1 AND eth_receipts.block_number BETWEEN $2 AND $3 AND NOT (eth_receipts.block_hash = ANY($4))
+ORDER BY eth_tx_attempts.eth_tx_id`, r.EVMChainID, r.FromBlock, r.ToBlock, hashes)
+		if err != nil {
+			return errors.Wrap(err, "failed to load affected eth_txes")
+		}
+		r.AffectedEthTxIDs = ids
+		err = tx.Get(&r.AffectedLogs, `
+SELECT count(*) FROM logs
+WHERE evm_chain_id = $1 AND block_number BETWEEN $2 AND $3 AND NOT (block_hash = ANY($4))`, r.EVMChainID, r.FromBlock, r.ToBlock, hashes)
+		if err != nil {
+			return errors.Wrap(err, "failed to count affected logs")
+		}
+		stmt, err := tx.PrepareNamed(`
+INSERT INTO evm_reorgs (evm_chain_id, source, depth, from_block, to_block, old_hash, new_hash, affected_eth_tx_ids, affected_logs, created_at)
+VALUES (:evm_chain_id, :source, :depth, :from_block, :to_block, :old_hash, :new_hash, :affected_eth_tx_ids, :affected_logs, NOW())
+ON CONFLICT (evm_chain_id, source, from_block, new_hash) DO UPDATE SET
+affected_eth_tx_ids = EXCLUDED.affected_eth_tx_ids, affected_logs = EXCLUDED.affected_logs
+RETURNING id, created_at`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+		return stmt.Get(r, r)
+	})
+	return errors.Wrap(err, "InsertReorg failed")
+}
+
+func (o *orm) Reorgs(offset, limit int, qopts ...pg.QOpt) (reorgs []Reorg, count int, err error) {
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, `SELECT count(*) FROM evm_reorgs`); err != nil {
+			return errors.Wrap(err, "failed to count reorgs")
+		}
+		return errors.Wrap(tx.Select(&reorgs, `SELECT * FROM evm_reorgs ORDER BY created_at DESC, id DESC LIMIT $1 OFFSET $2`, limit, offset), "failed to load reorgs")
+	}, pg.OptReadOnlyTx())
+	return
+}
+
+func (o *orm) FindReorg(id int64, qopts ...pg.QOpt) (r Reorg, err error) {
+	err = o.q.WithOpts(qopts...).Get(&r, `SELECT * FROM evm_reorgs WHERE id = $1`, id)
+	return
+}
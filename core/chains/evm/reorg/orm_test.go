@@ -0,0 +1,94 @@
+package reorg_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestORM_InsertReorg(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txmORM := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	orm := reorg.NewORM(db, logger.TestLogger(t), cfg)
+
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	// Mined in a replaced block
+	replaced := cltest.MustInsertConfirmedEthTxWithReceipt(t, txmORM, from, 0, 11)
+	// Mined in the same block of the new chain
+	kept := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txmORM, 1, 12, from)
+	keptHash := utils.NewHash()
+	cltest.MustInsertEthReceipt(t, txmORM, 12, keptHash, kept.EthTxAttempts[0].Hash)
+	// Mined before the reorg
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, txmORM, from, 2, 9)
+
+	r := reorg.NewReorg(*utils.NewBig(&cltest.FixtureChainID), reorg.SourceHeadTracker, 10, 12, utils.NewHash(), keptHash)
+	require.NoError(t, orm.InsertReorg(&r, []common.Hash{keptHash}))
+	assert.NotZero(t, r.ID)
+	assert.NotZero(t, r.CreatedAt)
+	assert.Equal(t, []int64{replaced.ID}, []int64(r.AffectedEthTxIDs))
+	assert.Zero(t, r.AffectedLogs)
+
+	found, err := orm.FindReorg(r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, r.ID, found.ID)
+	assert.Equal(t, r.Source, found.Source)
+	assert.Equal(t, int64(3), found.Depth)
+	assert.Equal(t, r.OldHash, found.OldHash)
+	assert.Equal(t, r.NewHash, found.NewHash)
+	assert.Equal(t, r.AffectedEthTxIDs, found.AffectedEthTxIDs)
+
+	_, err = orm.FindReorg(r.ID + 1)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// Detected again, e.g. because the recovery from it failed.
+	again := reorg.NewReorg(*utils.NewBig(&cltest.FixtureChainID), reorg.SourceHeadTracker, 10, 12, r.OldHash, keptHash)
+	require.NoError(t, orm.InsertReorg(&again, []common.Hash{keptHash}))
+	assert.Equal(t, r.ID, again.ID)
+	assert.Equal(t, r.CreatedAt, again.CreatedAt)
+	_, count, err := orm.Reorgs(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestORM_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	orm := reorg.NewORM(db, logger.TestLogger(t), cfg)
+	chainID := *utils.NewBig(&cltest.FixtureChainID)
+
+	var ids []int64
+	for i := int64(0); i < 3; i++ {
+		r := reorg.NewReorg(chainID, reorg.SourceLogPoller, 10*i+1, 10*i+2, utils.NewHash(), utils.NewHash())
+		require.NoError(t, orm.InsertReorg(&r, nil))
+		ids = append(ids, r.ID)
+	}
+
+	reorgs, count, err := orm.Reorgs(0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, reorgs, 2)
+	assert.Equal(t, ids[2], reorgs[0].ID)
+	assert.Equal(t, ids[1], reorgs[1].ID)
+
+	reorgs, count, err = orm.Reorgs(2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, ids[0], reorgs[0].ID)
+}
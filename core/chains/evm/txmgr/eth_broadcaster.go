@@ -112,6 +112,10 @@ type EthBroadcaster struct {
 	chStop chan struct{}
 	wg     sync.WaitGroup
 
+	// isLeader is set by the Txm, and nil unless the database is shared with
+	// other nodes
+	isLeader func() bool
+
 	utils.StartStopOnce
 }
 
@@ -240,7 +244,11 @@ func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{})
 	for {
 		pollDBTimer := time.NewTimer(utils.WithJitter(eb.config.TriggerFallbackDBPollInterval()))
 
-		err, retryable := eb.ProcessUnstartedEthTxs(ctx, k)
+		var err error
+		var retryable bool
+		if eb.isLeader == nil || eb.isLeader() {
+			err, retryable = eb.ProcessUnstartedEthTxs(ctx, k)
+		}
 		if err != nil {
 			eb.logger.Errorw("Error occurred while handling eth_tx queue in ProcessUnstartedEthTxs", "err", err)
 		}
@@ -138,6 +138,10 @@ type EthConfirmer struct {
 	wg        sync.WaitGroup
 
 	nConsecutiveBlocksChainTooShort int
+
+	// isLeader is set by the Txm, and nil unless the database is shared with
+	// other nodes
+	isLeader func() bool
 }
 
 // NewEthConfirmer instantiates a new eth confirmer
@@ -167,6 +171,7 @@ func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, key
 		cancel,
 		sync.WaitGroup{},
 		0,
+		nil,
 	}
 }
 
@@ -209,6 +214,9 @@ func (ec *EthConfirmer) runLoop() {
 				if !exists {
 					break
 				}
+				if ec.isLeader != nil && !ec.isLeader() {
+					continue
+				}
 				if err := ec.ProcessHead(ec.ctx, head); err != nil {
 					ec.lggr.Errorw("Error processing head", "err", err)
 					continue
@@ -1406,7 +1414,8 @@ func saveAttemptWithNewState(q pg.Queryer, timeout time.Duration, lggr logger.Lo
 // If any of the confirmed transactions does not have a receipt in the chain, it has been
 // re-org'd out and will be rebroadcast.
 func (ec *EthConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Context, head *evmtypes.Head) error {
-	if head.ChainLength() < ec.config.EvmFinalityDepth() {
+	// A chain reaching back to the latest finalized head covers every block that could be re-org'd.
+	if head.ChainLength() < ec.config.EvmFinalityDepth() && head.LatestFinalizedHead() == nil {
 		logArgs := []interface{}{
 			"chainLength", head.ChainLength(), "evmFinalityDepth", ec.config.EvmFinalityDepth(),
 		}
@@ -42,6 +42,10 @@ type EthResender struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 	chDone chan struct{}
+
+	// isLeader is set by the Txm, and nil unless the database is shared with
+	// other nodes
+	isLeader func() bool
 }
 
 // NewEthResender creates a new concrete EthResender
@@ -61,6 +65,7 @@ func NewEthResender(lggr logger.Logger, db *sqlx.DB, ethClient evmclient.Client,
 		ctx,
 		cancel,
 		make(chan struct{}),
+		nil,
 	}
 }
 
@@ -98,6 +103,9 @@ func (er *EthResender) runLoop() {
 }
 
 func (er *EthResender) resendUnconfirmed() error {
+	if er.isLeader != nil && !er.isLeader() {
+		return nil
+	}
 	keys, err := er.ks.EnabledKeysForChain(&er.chainID)
 	if err != nil {
 		return errors.Wrapf(err, "EthResender failed getting enabled keys for chain %s", er.chainID.String())
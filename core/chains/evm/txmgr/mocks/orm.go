@@ -6,6 +6,8 @@ import (
 	common "github.com/ethereum/go-ethereum/common"
 	mock "github.com/stretchr/testify/mock"
 
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
 	txmgr "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 )
 
@@ -127,13 +129,20 @@ func (_m *ORM) FindEthTxAttempt(hash common.Hash) (*txmgr.EthTxAttempt, error) {
 	return r0, r1
 }
 
-// FindEthTxAttemptsByEthTxIDs provides a mock function with given fields: ids
-func (_m *ORM) FindEthTxAttemptsByEthTxIDs(ids []int64) ([]txmgr.EthTxAttempt, error) {
-	ret := _m.Called(ids)
+// FindEthTxAttemptsByEthTxIDs provides a mock function with given fields: ids, qopts
+func (_m *ORM) FindEthTxAttemptsByEthTxIDs(ids []int64, qopts ...pg.QOpt) ([]txmgr.EthTxAttempt, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ids)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 []txmgr.EthTxAttempt
-	if rf, ok := ret.Get(0).(func([]int64) []txmgr.EthTxAttempt); ok {
-		r0 = rf(ids)
+	if rf, ok := ret.Get(0).(func([]int64, ...pg.QOpt) []txmgr.EthTxAttempt); ok {
+		r0 = rf(ids, qopts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]txmgr.EthTxAttempt)
@@ -141,8 +150,8 @@ func (_m *ORM) FindEthTxAttemptsByEthTxIDs(ids []int64) ([]txmgr.EthTxAttempt, e
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]int64) error); ok {
-		r1 = rf(ids)
+	if rf, ok := ret.Get(1).(func([]int64, ...pg.QOpt) error); ok {
+		r1 = rf(ids, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -19,7 +19,7 @@ type ORM interface {
 	EthTransactionsWithAttempts(offset, limit int) ([]EthTx, int, error)
 	EthTxAttempts(offset, limit int) ([]EthTxAttempt, int, error)
 	FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error)
-	FindEthTxAttemptsByEthTxIDs(ids []int64) ([]EthTxAttempt, error)
+	FindEthTxAttemptsByEthTxIDs(ids []int64, qopts ...pg.QOpt) ([]EthTxAttempt, error)
 	FindEthTxByHash(hash common.Hash) (*EthTx, error)
 	InsertEthTxAttempt(attempt *EthTxAttempt) error
 	InsertEthTx(etx *EthTx) error
@@ -161,11 +161,11 @@ func (o *orm) FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error) {
 }
 
 // FindEthTxAttemptsByEthTxIDs returns a list of attempts by ETH Tx IDs
-func (o *orm) FindEthTxAttemptsByEthTxIDs(ids []int64) ([]EthTxAttempt, error) {
+func (o *orm) FindEthTxAttemptsByEthTxIDs(ids []int64, qopts ...pg.QOpt) ([]EthTxAttempt, error) {
 	var attempts []EthTxAttempt
 
 	sql := `SELECT * FROM eth_tx_attempts WHERE eth_tx_id = ANY($1)`
-	if err := o.q.Select(&attempts, sql, ids); err != nil {
+	if err := o.q.WithOpts(qopts...).Select(&attempts, sql, ids); err != nil {
 		return nil, err
 	}
 
@@ -120,12 +120,33 @@ type Txm struct {
 	reaper      *Reaper
 	ethResender *EthResender
 	fwdMgr      *forwarders.FwdMgr
+
+	// isLeader is nil unless the database is shared with other nodes
+	isLeader func() bool
 }
 
 func (b *Txm) RegisterResumeCallback(fn ResumeCallback) {
 	b.resumeCallback = fn
 }
 
+// SetLeaderCheck makes the Txm only send, bump and resend transactions while
+// isLeader returns true, so that nodes sharing the database and keys do not
+// send conflicting transactions. It must be called before Start.
+func (b *Txm) SetLeaderCheck(isLeader func() bool) {
+	b.isLeader = isLeader
+	if b.ethResender != nil {
+		b.ethResender.isLeader = isLeader
+	}
+}
+
+func (b *Txm) newEthBroadcasterAndConfirmer(keyStates []ethkey.State) (*EthBroadcaster, *EthConfirmer) {
+	eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
+	ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+	eb.isLeader = b.isLeader
+	ec.isLeader = b.isLeader
+	return eb, ec
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm(db *sqlx.DB, ethClient evmclient.Client, cfg Config, keyStore KeyStore, eventBroadcaster pg.EventBroadcaster, lggr logger.Logger, checkerFactory TransmitCheckerFactory, logPoller logpoller.LogPoller) *Txm {
 	lggr = lggr.Named("Txm")
@@ -188,15 +209,14 @@ func (b *Txm) Start(ctx context.Context) (merr error) {
 			b.logger.Warnf("Chain %s does not have any eth keys, no transactions will be sent on this chain", b.chainID.String())
 		}
 
-		if b.config.EvmNonceAutoSync() {
+		if b.config.EvmNonceAutoSync() && (b.isLeader == nil || b.isLeader()) {
 			syncer := NewNonceSyncer(b.db, b.logger, b.config, b.ethClient, b.keyStore)
 			if err = syncer.SyncAll(ctx, keyStates); err != nil {
 				return errors.Wrap(err, "Txm: failed to sync with on-chain nonce")
 			}
 		}
 		var ms services.MultiStart
-		eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
-		ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+		eb, ec := b.newEthBroadcasterAndConfirmer(keyStates)
 		if err = ms.Start(ctx, eb); err != nil {
 			return errors.Wrap(err, "Txm: EthBroadcaster failed to start")
 		}
@@ -316,8 +336,7 @@ func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer, keyStates []ethkey.S
 			close(r.done)
 		}
 
-		eb = NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
-		ec = NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+		eb, ec = b.newEthBroadcasterAndConfirmer(keyStates)
 
 		var wg sync.WaitGroup
 		// two goroutines to handle independent backoff retries starting:
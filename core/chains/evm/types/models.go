@@ -38,6 +38,9 @@ type Head struct {
 	StateRoot        common.Hash
 	Difficulty       *utils.Big
 	TotalDifficulty  *utils.Big
+	// IsFinalized is set by the head tracker on heads at or below the latest
+	// finalized block when EVM.FinalityTagEnabled is set. It is not persisted.
+	IsFinalized bool `db:"-"`
 }
 
 // NewHead returns a Head instance.
@@ -111,6 +114,18 @@ func (h *Head) ChainLength() uint32 {
 	return l
 }
 
+// LatestFinalizedHead returns the first head in the chain which is marked as
+// finalized, or nil if there is none.
+func (h *Head) LatestFinalizedHead() *Head {
+	for h != nil && !h.IsFinalized {
+		if h == h.Parent {
+			panic("circular reference detected")
+		}
+		h = h.Parent
+	}
+	return h
+}
+
 // ChainHashes returns an array of block hashes by recursively looking up parents
 func (h *Head) ChainHashes() []common.Hash {
 	var hashes []common.Hash
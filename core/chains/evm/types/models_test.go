@@ -250,6 +250,29 @@ func TestHead_IsInChain(t *testing.T) {
 	assert.False(t, head.IsInChain(common.Hash{}))
 }
 
+func TestHead_LatestFinalizedHead(t *testing.T) {
+	head := evmtypes.Head{
+		Number: 3,
+		Parent: &evmtypes.Head{
+			Number: 2,
+			Parent: &evmtypes.Head{
+				Number:      1,
+				IsFinalized: true,
+			},
+		},
+	}
+
+	finalized := head.LatestFinalizedHead()
+	require.NotNil(t, finalized)
+	assert.Equal(t, int64(1), finalized.Number)
+
+	head.Parent.IsFinalized = true
+	assert.Equal(t, int64(2), head.LatestFinalizedHead().Number)
+
+	assert.Nil(t, (&evmtypes.Head{Number: 4}).LatestFinalizedHead())
+	assert.Nil(t, (*evmtypes.Head)(nil).LatestFinalizedHead())
+}
+
 func TestTxReceipt_ReceiptIndicatesRunLogFulfillment(t *testing.T) {
 	tests := []struct {
 		name string
@@ -4,13 +4,17 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -22,9 +26,13 @@ type AdminUsersPresenter struct {
 var adminUsersTableHeaders = []string{"Email", "Role", "Has API token", "Created At", "Updated at"}
 
 func (p *AdminUsersPresenter) ToRow() []string {
+	role := string(p.Role)
+	if p.CustomRole != "" {
+		role = p.CustomRole
+	}
 	row := []string{
 		p.ID,
-		string(p.Role),
+		role,
 		p.HasActiveApiToken,
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
@@ -157,6 +165,30 @@ func (cli *Client) ChangeRole(c *cli.Context) (err error) {
 	return cli.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully updated API user")
 }
 
+// LinkLDAPUser links an API user to their LDAP directory entry
+func (cli *Client) LinkLDAPUser(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(web.LinkLDAPRequest{
+		Email: c.String("email"),
+		DN:    c.String("dn"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := cli.HTTP.Patch("/v2/users/ldap", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully linked API user")
+}
+
 // DeleteUser deletes an API user by email
 func (cli *Client) DeleteUser(c *cli.Context) (err error) {
 	response, err := cli.HTTP.Delete(fmt.Sprintf("/v2/users/%s", c.String("email")))
@@ -171,3 +203,373 @@ func (cli *Client) DeleteUser(c *cli.Context) (err error) {
 
 	return cli.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
+
+type AdminRolesPresenter struct {
+	JAID
+	presenters.RoleResource
+}
+
+var adminRolesTableHeaders = []string{"Name", "Permissions", "Created At", "Updated at"}
+
+func (p *AdminRolesPresenter) ToRow() []string {
+	row := []string{
+		p.Name,
+		strings.Join(p.Permissions, "\n"),
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminRolesPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(adminRolesTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminRolesPresenters []AdminRolesPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminRolesPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Roles\n")); err != nil {
+		return err
+	}
+	renderList(adminRolesTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListRoles renders all custom roles and their permissions
+func (cli *Client) ListRoles(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/roles", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &AdminRolesPresenters{})
+}
+
+// CreateRole creates a new custom role
+func (cli *Client) CreateRole(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(web.RoleRequest{
+		Name:        c.String("name"),
+		Permissions: c.StringSlice("permission"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := cli.HTTP.Post("/v2/roles", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &AdminRolesPresenter{}, "Successfully created new role")
+}
+
+// UpdateRole replaces the permissions of a custom role
+func (cli *Client) UpdateRole(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(web.RoleRequest{
+		Permissions: c.StringSlice("permission"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := cli.HTTP.Patch(fmt.Sprintf("/v2/roles/%s", url.PathEscape(c.String("name"))), buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &AdminRolesPresenter{}, "Successfully updated role")
+}
+
+// DeleteRole deletes a custom role by name
+func (cli *Client) DeleteRole(c *cli.Context) (err error) {
+	response, err := cli.HTTP.Delete(fmt.Sprintf("/v2/roles/%s", url.PathEscape(c.String("name"))))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(response); err != nil {
+		return err
+	}
+
+	fmt.Printf("Role %s deleted\n", c.String("name"))
+	return nil
+}
+
+type AdminTokensPresenter struct {
+	JAID
+	presenters.NamedAPITokenResource
+}
+
+var adminTokensTableHeaders = []string{"Name", "Access Key", "Permissions", "Allowed IPs", "Expires At", "Last Used", "Created At"}
+
+func (p *AdminTokensPresenter) ToRow() []string {
+	expiresAt, lastUsed := "never", "never"
+	if p.ExpiresAt != nil {
+		expiresAt = p.ExpiresAt.String()
+	}
+	if p.LastUsed != nil {
+		lastUsed = p.LastUsed.String()
+	}
+	permissions := "all"
+	if len(p.Permissions) > 0 {
+		permissions = strings.Join(p.Permissions, "\n")
+	}
+	allowedIPs := "any"
+	if len(p.AllowedIPs) > 0 {
+		allowedIPs = strings.Join(p.AllowedIPs, "\n")
+	}
+	row := []string{
+		p.Name,
+		p.AccessKey,
+		permissions,
+		allowedIPs,
+		expiresAt,
+		lastUsed,
+		p.CreatedAt.String(),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminTokensPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(adminTokensTableHeaders, rows, rt.Writer)
+
+	if p.Secret != "" {
+		if _, err := rt.Write([]byte(fmt.Sprintf("\nSecret: %s\nStore it securely, it will not be shown again.\n", p.Secret))); err != nil {
+			return err
+		}
+	}
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminTokensPresenters []AdminTokensPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminTokensPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API Tokens\n")); err != nil {
+		return err
+	}
+	renderList(adminTokensTableHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders the named API tokens of the logged in user
+func (cli *Client) ListAPITokens(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/user/tokens", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &AdminTokensPresenters{})
+}
+
+// CreateAPIToken creates a named API token for the logged in user
+func (cli *Client) CreateAPIToken(c *cli.Context) (err error) {
+	request := sessions.APITokenRequest{
+		Name:        c.String("name"),
+		Permissions: c.StringSlice("permission"),
+		AllowedIPs:  c.StringSlice("allowed-ip"),
+	}
+	if expiresIn := c.Duration("expires-in"); expiresIn > 0 {
+		expiresAt := time.Now().Add(expiresIn)
+		request.ExpiresAt = &expiresAt
+	}
+
+	fmt.Println("Password of your user:")
+	request.Password = cli.PasswordPrompter.Prompt()
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := cli.HTTP.Post("/v2/user/tokens", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &AdminTokensPresenter{}, "Successfully created new API token")
+}
+
+// RevokeAPIToken deletes a named API token of the logged in user
+func (cli *Client) RevokeAPIToken(c *cli.Context) (err error) {
+	fmt.Println("Password of your user:")
+	requestData, err := json.Marshal(sessions.ChangeAuthTokenRequest{Password: cli.PasswordPrompter.Prompt()})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := cli.HTTP.Post(fmt.Sprintf("/v2/user/tokens/%s/revoke", url.PathEscape(c.String("name"))), buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(response); err != nil {
+		return err
+	}
+
+	fmt.Printf("API token %s revoked\n", c.String("name"))
+	return nil
+}
+
+type ConfigReloadPresenter struct {
+	web.ConfigReloadResource
+}
+
+var configReloadTableHeaders = []string{"Field", "Status"}
+
+// RenderTable implements TableRenderer
+func (p *ConfigReloadPresenter) RenderTable(rt RendererTable) error {
+	if len(p.Applied) == 0 && len(p.RestartRequired) == 0 {
+		return utils.JustError(rt.Write([]byte("No configuration changes\n")))
+	}
+	var rows [][]string
+	for _, field := range p.Applied {
+		rows = append(rows, []string{field, "applied"})
+	}
+	for _, field := range p.RestartRequired {
+		rows = append(rows, []string{field, "restart required"})
+	}
+	renderList(configReloadTableHeaders, rows, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ReloadConfig reloads the TOML configuration of the remote node
+func (cli *Client) ReloadConfig(c *cli.Context) (err error) {
+	response, err := cli.HTTP.Post("/v2/config/reload", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &ConfigReloadPresenter{}, "Configuration reloaded")
+}
+
+type DatabaseQueryPresenter struct {
+	JAID
+	presenters.DatabaseQueryResource
+}
+
+var databaseQueryTableHeaders = []string{"Query", "Calls", "Errors", "Total (ms)", "Mean (ms)", "Max (ms)", "Call Sites"}
+
+// ToRow presents the DatabaseQueryResource as a slice of strings.
+func (p *DatabaseQueryPresenter) ToRow() []string {
+	var sites []string
+	for _, s := range p.CallSites {
+		sites = append(sites, fmt.Sprintf("%s (%s:%d) x%d", s.Function, s.File, s.Line, s.Calls))
+	}
+	return []string{
+		p.Query,
+		fmt.Sprint(p.Calls),
+		fmt.Sprint(p.Errors),
+		fmt.Sprintf("%.1f", p.TotalMS),
+		fmt.Sprintf("%.1f", p.MeanMS),
+		fmt.Sprintf("%.1f", p.MaxMS),
+		strings.Join(sites, "\n"),
+	}
+}
+
+type DatabaseQueryPresenters []DatabaseQueryPresenter
+
+// RenderTable implements TableRenderer
+func (ps DatabaseQueryPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(databaseQueryTableHeaders, rows, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListDatabaseQueries lists the slowest SQL statements executed by the remote
+// node since it started, with the functions which issued them
+func (cli *Client) ListDatabaseQueries(c *cli.Context) (err error) {
+	q := url.Values{}
+	if limit := c.Int("limit"); limit > 0 {
+		q.Set("limit", fmt.Sprint(limit))
+	}
+	if sort := c.String("sort"); sort != "" {
+		q.Set("sort", sort)
+	}
+	uri := "/v2/database/queries"
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+	response, err := cli.HTTP.Get(uri)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(response, &DatabaseQueryPresenters{})
+}
@@ -4,11 +4,13 @@ import (
 	"flag"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
 
+	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 )
 
@@ -84,3 +86,88 @@ func TestClient_ChangeRole(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_Roles(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("name", "bridge-admins", "")
+	permissions := cli.StringSlice{"bridges:admin"}
+	set.Var(&permissions, "permission", "")
+	require.NoError(t, client.CreateRole(cli.NewContext(nil, set, nil)))
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.SessionORM().CreateUser(&user))
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", user.Email, "")
+	set.String("newrole", "bridge-admins", "")
+	require.NoError(t, client.ChangeRole(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("name", "bridge-admins", "")
+	permissions = cli.StringSlice{"bridges:edit", "jobs:view"}
+	set.Var(&permissions, "permission", "")
+	require.NoError(t, client.UpdateRole(cli.NewContext(nil, set, nil)))
+
+	require.NoError(t, client.ListRoles(cltest.EmptyCLIContext()))
+	roles := *r.Renders[len(r.Renders)-1].(*cmd.AdminRolesPresenters)
+	require.Len(t, roles, 1)
+	assert.Equal(t, []string{"bridges:edit", "jobs:view"}, roles[0].Permissions)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("name", "bridge-admins", "")
+	assert.ErrorContains(t, client.DeleteRole(cli.NewContext(nil, set, nil)), "role is assigned to users")
+
+	require.NoError(t, app.SessionORM().DeleteUser(user.Email))
+	require.NoError(t, client.DeleteRole(cli.NewContext(nil, set, nil)))
+}
+
+func TestClient_APITokens(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{
+		Password: cltest.Password,
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("name", "ci", "")
+	permissions := cli.StringSlice{"jobs:edit"}
+	set.Var(&permissions, "permission", "")
+	allowedIPs := cli.StringSlice{"10.0.0.0/8"}
+	set.Var(&allowedIPs, "allowed-ip", "")
+	set.Duration("expires-in", time.Hour, "")
+	require.NoError(t, client.CreateAPIToken(cli.NewContext(nil, set, nil)))
+	created := *r.Renders[len(r.Renders)-1].(*cmd.AdminTokensPresenter)
+	assert.Equal(t, "ci", created.Name)
+	assert.NotEmpty(t, created.Secret)
+	assert.Equal(t, []string{"jobs:edit"}, created.Permissions)
+	assert.Equal(t, []string{"10.0.0.0/8"}, created.AllowedIPs)
+	require.NotNil(t, created.ExpiresAt)
+
+	require.NoError(t, client.ListAPITokens(cltest.EmptyCLIContext()))
+	tokens := *r.Renders[len(r.Renders)-1].(*cmd.AdminTokensPresenters)
+	require.Len(t, tokens, 1)
+	assert.Empty(t, tokens[0].Secret)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("name", "ci", "")
+	require.NoError(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)))
+	assert.Error(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)))
+}
+
+func TestClient_ListDatabaseQueries(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Int("limit", 3, "")
+	set.String("sort", "mean", "")
+	require.NoError(t, client.ListDatabaseQueries(cli.NewContext(nil, set, nil)))
+	queries := *r.Renders[len(r.Renders)-1].(*cmd.DatabaseQueryPresenters)
+	require.NotEmpty(t, queries)
+	assert.LessOrEqual(t, len(queries), 3)
+
+	require.NoError(t, set.Set("sort", "calls"))
+	assert.Error(t, client.ListDatabaseQueries(cli.NewContext(nil, set, nil)))
+}
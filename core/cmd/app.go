@@ -82,36 +82,11 @@ func NewApp(client *Client) *cli.App {
 	app.Before = func(c *cli.Context) error {
 		if c.IsSet("config") {
 			// TOML
-			var opts chainlink.GeneralConfigOpts
-			if configTOML := v2.EnvConfig.Get(); configTOML != "" {
-				if err := opts.ParseConfig(configTOML); err != nil {
-					return errors.Wrapf(err, "failed to parse env var %q", v2.EnvConfig)
-				}
-			} else {
-				fileNames := c.StringSlice("config")
-				for _, fileName := range fileNames {
-					b, err := os.ReadFile(fileName)
-					if err != nil {
-						return errors.Wrapf(err, "failed to read config file: %s", fileName)
-					}
-					if err := opts.ParseConfig(string(b)); err != nil {
-						return errors.Wrapf(err, "failed to parse file: %s", fileName)
-					}
-				}
-			}
-
-			secretsTOML := ""
-			if c.IsSet("secrets") {
-				secretsFileName := c.String("secrets")
-				b, err := os.ReadFile(secretsFileName)
-				if err != nil {
-					return errors.Wrapf(err, "failed to read secrets file: %s", secretsFileName)
-				}
-				secretsTOML = string(b)
-			}
-			if err := opts.ParseSecrets(secretsTOML); err != nil {
+			opts, err := loadOpts(c)
+			if err != nil {
 				return err
 			}
+			opts.Loader = func() (chainlink.GeneralConfigOpts, error) { return loadOpts(c) }
 
 			if cfg, lggr, closeLggr, err := opts.NewAndLogger(); err != nil {
 				return err
@@ -219,7 +194,7 @@ func NewApp(client *Client) *cli.App {
 								},
 								cli.StringFlag{
 									Name:     "role",
-									Usage:    "Permission level of new user. Options: 'admin', 'edit', 'run', 'view'.",
+									Usage:    "Permission level of new user. Options: 'admin', 'edit', 'run', 'view', or the name of a custom role.",
 									Required: true,
 								},
 							},
@@ -236,11 +211,27 @@ func NewApp(client *Client) *cli.App {
 								},
 								cli.StringFlag{
 									Name:     "newrole",
-									Usage:    "optional new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view'.",
+									Usage:    "optional new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view', or the name of a custom role.",
 									Required: false,
 								},
 							},
 						},
+						{
+							Name:   "link-ldap",
+							Usage:  "Links an API user to their LDAP directory entry, so that they log in with the directory. Directory users are never linked to existing users automatically.",
+							Action: client.LinkLDAPUser,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "email",
+									Usage:    "email of the user to link",
+									Required: true,
+								},
+								cli.StringFlag{
+									Name:  "dn",
+									Usage: "DN of the user's LDAP directory entry. Leave empty to unlink the user.",
+								},
+							},
+						},
 						{
 							Name:   "delete",
 							Usage:  "Delete an API user",
@@ -255,6 +246,176 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:  "tokens",
+					Usage: "Create, list, or revoke named API tokens of your user, e.g. for automation",
+					Subcommands: cli.Commands{
+						{
+							Name:   "list",
+							Usage:  "Lists your named API tokens",
+							Action: client.ListAPITokens,
+						},
+						{
+							Name:   "create",
+							Usage:  "Create a new named API token, prompting for your password",
+							Action: client.CreateAPIToken,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "name",
+									Usage:    "Name of the new token",
+									Required: true,
+								},
+								cli.StringSliceFlag{
+									Name:  "permission",
+									Usage: "Permission of your user to limit the token to, as <resource>:<role>[:<scope>], e.g. 'jobs:edit'. May be repeated. Defaults to all of your permissions.",
+								},
+								cli.StringSliceFlag{
+									Name:  "allowed-ip",
+									Usage: "IP address or CIDR network the token may be used from. May be repeated. Defaults to any.",
+								},
+								cli.DurationFlag{
+									Name:  "expires-in",
+									Usage: "Duration after which the token expires, e.g. '720h'. Defaults to never.",
+								},
+							},
+						},
+						{
+							Name:   "revoke",
+							Usage:  "Revoke a named API token, prompting for your password",
+							Action: client.RevokeAPIToken,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "name",
+									Usage:    "Name of the token to revoke",
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:  "roles",
+					Usage: "Create, edit, or delete custom roles, which grant a set of permissions to API users",
+					Subcommands: cli.Commands{
+						{
+							Name:   "list",
+							Usage:  "Lists all custom roles and their permissions",
+							Action: client.ListRoles,
+						},
+						{
+							Name:   "create",
+							Usage:  "Create a new custom role",
+							Action: client.CreateRole,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "name",
+									Usage:    "Name of new role to create",
+									Required: true,
+								},
+								cli.StringSliceFlag{
+									Name:     "permission",
+									Usage:    "Permission granted by the role, as <resource>:<role>[:<scope>], e.g. 'bridges:edit' or 'job_proposals:edit:evm/5'. May be repeated.",
+									Required: true,
+								},
+							},
+						},
+						{
+							Name:   "update",
+							Usage:  "Replaces the permissions of a custom role",
+							Action: client.UpdateRole,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "name",
+									Usage:    "Name of role to update",
+									Required: true,
+								},
+								cli.StringSliceFlag{
+									Name:     "permission",
+									Usage:    "Permission granted by the role, as <resource>:<role>[:<scope>]. May be repeated.",
+									Required: true,
+								},
+							},
+						},
+						{
+							Name:   "delete",
+							Usage:  "Delete a custom role, which must not be assigned to any user",
+							Action: client.DeleteRole,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:     "name",
+									Usage:    "Name of role to delete",
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:  "audit-log",
+					Usage: "List or verify the tamper-evident audit log of the node",
+					Subcommands: cli.Commands{
+						{
+							Name:   "list",
+							Usage:  "Lists audit events in descending order",
+							Action: client.ListAuditEvents,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "user",
+									Usage: "only list events concerning the user with this email",
+								},
+								cli.StringFlag{
+									Name:  "event",
+									Usage: "only list events of this type, e.g. 'JOB_CREATED'",
+								},
+								cli.StringFlag{
+									Name:  "from",
+									Usage: "only list events created at or after this RFC3339 time",
+								},
+								cli.StringFlag{
+									Name:  "to",
+									Usage: "only list events created before this RFC3339 time",
+								},
+								cli.IntFlag{
+									Name:  "page",
+									Usage: "page of results to display",
+								},
+							},
+						},
+						{
+							Name:   "verify",
+							Usage:  "Verify the hash chain of the audit log, failing if any event was modified, removed or reordered",
+							Action: client.VerifyAuditLog,
+						},
+					},
+				},
+				{
+					Name:  "config",
+					Usage: "Commands for the configuration of the running node",
+					Subcommands: cli.Commands{
+						{
+							Name:   "reload",
+							Usage:  "Reload the TOML configuration files of the node, apply the changes which are safe to apply live, and list the changes which require a restart. Sending SIGHUP to the node does the same. [Only supported with TOML]",
+							Action: client.ReloadConfig,
+						},
+					},
+				},
+				{
+					Name:   "db-queries",
+					Usage:  "List the slowest SQL statements executed by the node since it started, with the functions which issued them",
+					Action: client.ListDatabaseQueries,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "limit",
+							Usage: "number of statements to list",
+							Value: 20,
+						},
+						cli.StringFlag{
+							Name:  "sort",
+							Usage: "sort statements by their 'max', 'mean' or 'total' duration",
+							Value: "max",
+						},
+					},
+				},
 			},
 		},
 
@@ -405,6 +566,16 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Validate provided TOML config file, and print the full effective configuration, with defaults included [Only supported with TOML]",
 					Action: client.ConfigFileValidate,
 				},
+				{
+					Name:   "diff",
+					Usage:  "Compare the effective configuration of the given TOML config file with the running node's, and list the fields which differ",
+					Action: client.ConfigDiff,
+				},
+				{
+					Name:   "lint",
+					Usage:  "Report deprecated fields, values equal to their defaults, unsafe values and unknown chain IDs in the given TOML config file, or in the provided TOML config",
+					Action: client.ConfigLint,
+				},
 			},
 		},
 
@@ -443,6 +614,21 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "members",
+					Usage:  "List the nodes sharing jobs, starting with the leader",
+					Action: client.ListJobShardMembers,
+				},
+				{
+					Name:   "assign",
+					Usage:  "Assign a job to a node sharing jobs, by job ID and member name",
+					Action: client.AssignJob,
+				},
+				{
+					Name:   "unassign",
+					Usage:  "Remove the assignment of a job to a node sharing jobs",
+					Action: client.UnassignJob,
+				},
 			},
 		},
 		{
@@ -846,6 +1032,65 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:  "rotate-password",
+					Usage: "Re-encrypt the whole keystore with a new password. The old ciphertext is kept as a backup.",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword",
+							Usage: "`FILE` containing the current keystore password (required)",
+						},
+						cli.StringFlag{
+							Name:  "newpassword",
+							Usage: "`FILE` containing the new keystore password (required)",
+						},
+						cli.IntFlag{
+							Name:  "scryptN",
+							Usage: "Optional scrypt N parameter for the new encryption. Defaults to the node's configuration.",
+						},
+						cli.IntFlag{
+							Name:  "scryptP",
+							Usage: "Optional scrypt P parameter for the new encryption. Defaults to the node's configuration.",
+						},
+					},
+					Action: client.RotateKeystorePassword,
+				},
+				{
+					Name:  "backup",
+					Usage: "Remote commands for backing up and restoring the node's whole keystore",
+					Subcommands: cli.Commands{
+						{
+							Name:  "export",
+							Usage: "Export all keys, with the eth key states and nonces, to an encrypted backup file",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "newpassword, p",
+									Usage: "`FILE` containing the password to encrypt the backup (required)",
+								},
+								cli.StringFlag{
+									Name:  "output, o",
+									Usage: "`FILE` where the backup will be saved (required)",
+								},
+							},
+							Action: client.ExportKeystoreBackup,
+						},
+						{
+							Name:  "import",
+							Usage: "Import the keys, and eth key states and nonces, of a backup file. Nothing is imported if any of them conflict with the node's.",
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "oldpassword, p",
+									Usage: "`FILE` containing the password used to encrypt the backup",
+								},
+								cli.BoolFlag{
+									Name:  "dry-run",
+									Usage: "only report what would be imported, and any conflicts",
+								},
+							},
+							Action: client.ImportKeystoreBackup,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -921,7 +1166,7 @@ func NewApp(client *Client) *cli.App {
 				},
 				{
 					Name:   "profile",
-					Usage:  "Collects profile metrics from the node.",
+					Usage:  "Collects profile metrics and a support archive from the node, into a single zip archive.",
 					Action: client.Profile,
 					Flags: []cli.Flag{
 						cli.Uint64Flag{
@@ -931,7 +1176,7 @@ func NewApp(client *Client) *cli.App {
 						},
 						cli.StringFlag{
 							Name:  "output_dir, o",
-							Usage: "output directory of the captured profile",
+							Usage: "output directory of the captured archive",
 							Value: "/tmp/",
 						},
 					},
@@ -989,6 +1234,27 @@ func NewApp(client *Client) *cli.App {
 							Action: client.RollbackDatabase,
 							Flags:  []cli.Flag{},
 						},
+						{
+							Name:   "restore",
+							Usage:  "Restore a database backup taken by the node into an empty database, or one with the schema version of the backup.",
+							Action: client.RestoreDatabase,
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "remote",
+									Usage: "download the named backup from the configured object storage first",
+								},
+								cli.StringFlag{
+									Name:  "yes, y",
+									Usage: "skip the confirmation prompt",
+								},
+							},
+						},
+						{
+							Name:   "backups",
+							Usage:  "List the local and uploaded database backups.",
+							Action: client.ListDatabaseBackups,
+							Flags:  []cli.Flag{},
+						},
 						{
 							Name:   "create-migration",
 							Usage:  "Create a new migration.",
@@ -1217,6 +1483,39 @@ func NewApp(client *Client) *cli.App {
 	return app
 }
 
+// loadOpts reads the TOML configuration and secrets, from the env var or the
+// files of the config and secrets flags. It is used again to reload them.
+func loadOpts(c *cli.Context) (opts chainlink.GeneralConfigOpts, err error) {
+	if configTOML := v2.EnvConfig.Get(); configTOML != "" {
+		if err = opts.ParseConfig(configTOML); err != nil {
+			return opts, errors.Wrapf(err, "failed to parse env var %q", v2.EnvConfig)
+		}
+	} else {
+		fileNames := c.StringSlice("config")
+		for _, fileName := range fileNames {
+			b, err2 := os.ReadFile(fileName)
+			if err2 != nil {
+				return opts, errors.Wrapf(err2, "failed to read config file: %s", fileName)
+			}
+			if err = opts.ParseConfig(string(b)); err != nil {
+				return opts, errors.Wrapf(err, "failed to parse file: %s", fileName)
+			}
+		}
+	}
+
+	secretsTOML := ""
+	if c.IsSet("secrets") {
+		secretsFileName := c.String("secrets")
+		b, err2 := os.ReadFile(secretsFileName)
+		if err2 != nil {
+			return opts, errors.Wrapf(err2, "failed to read secrets file: %s", secretsFileName)
+		}
+		secretsTOML = string(b)
+	}
+	err = opts.ParseSecrets(secretsTOML)
+	return opts, err
+}
+
 var whitespace = regexp.MustCompile(`\s+`)
 
 // format returns result of replacing all whitespace in s with a single space
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type AuditEventPresenter struct {
+	JAID
+	presenters.AuditEventResource
+}
+
+type AuditEventPresenters []AuditEventPresenter
+
+// RenderTable implements TableRenderer
+func (ps AuditEventPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Event", "User", "Created At", "Data"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.ID,
+			p.EventID,
+			p.User,
+			p.CreatedAt.String(),
+			string(p.Data),
+		})
+	}
+
+	render("Audit Log", table)
+	return nil
+}
+
+type AuditLogVerificationPresenter struct {
+	JAID
+	presenters.AuditLogVerificationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *AuditLogVerificationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Valid", "Events", "Head ID", "Head Hash", "Invalid Event ID", "Error"})
+	table.Append([]string{
+		fmt.Sprint(p.Valid),
+		fmt.Sprint(p.Events),
+		fmt.Sprint(p.HeadID),
+		p.HeadHash,
+		fmt.Sprint(p.InvalidEventID),
+		p.Error,
+	})
+
+	render("Audit Log Verification", table)
+	return nil
+}
+
+// ListAuditEvents lists the audit log, most recent first, optionally filtered
+// by user, event type and time range
+func (cli *Client) ListAuditEvents(c *cli.Context) error {
+	q := url.Values{}
+	if user := c.String("user"); user != "" {
+		q.Set("user", user)
+	}
+	if event := c.String("event"); event != "" {
+		q.Set("event", event)
+	}
+	for _, name := range []string{"from", "to"} {
+		if s := c.String(name); s != "" {
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				return cli.errorOut(errors.Wrapf(err, "invalid %s", name))
+			}
+			q.Set(name, s)
+		}
+	}
+	uri := "/v2/audit_log"
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+	return cli.getPage(uri, c.Int("page"), &AuditEventPresenters{})
+}
+
+// VerifyAuditLog checks the hash chain of the audit log, and fails if any
+// event was tampered with
+func (cli *Client) VerifyAuditLog(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/audit_log/verify")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var verification AuditLogVerificationPresenter
+	if err = cli.renderAPIResponse(resp, &verification); err != nil {
+		return err
+	}
+	if !verification.Valid {
+		return cli.errorOut(errors.Errorf("audit log was tampered with: %s", verification.Error))
+	}
+	return nil
+}
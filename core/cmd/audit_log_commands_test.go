@@ -0,0 +1,43 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+)
+
+func TestClient_AuditLog(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+
+	app.GetAuditLogger().Audit(audit.JobDeleted, audit.Data{"id": 1})
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("event", string(audit.JobDeleted), "")
+	set.String("user", "", "")
+	set.String("from", "", "")
+	set.String("to", "", "")
+	set.Int("page", 0, "")
+	// events are persisted asynchronously
+	require.Eventually(t, func() bool {
+		require.NoError(t, client.ListAuditEvents(cli.NewContext(nil, set, nil)))
+		return len(*r.Renders[len(r.Renders)-1].(*cmd.AuditEventPresenters)) > 0
+	}, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+	events := *r.Renders[len(r.Renders)-1].(*cmd.AuditEventPresenters)
+	assert.Equal(t, string(audit.JobDeleted), events[0].EventID)
+
+	require.NoError(t, set.Set("from", "yesterday"))
+	assert.Error(t, client.ListAuditEvents(cli.NewContext(nil, set, nil)))
+
+	require.NoError(t, client.VerifyAuditLog(cltest.EmptyCLIContext()))
+	verification := r.Renders[len(r.Renders)-1].(*cmd.AuditLogVerificationPresenter)
+	assert.True(t, verification.Valid)
+}
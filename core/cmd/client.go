@@ -38,8 +38,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/sharding"
 	"github.com/smartcontractkit/chainlink/core/services/versioning"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/sessions"
@@ -100,7 +102,13 @@ type ChainlinkAppFactory struct{}
 // NewApplication returns a new instance of the node with the given config.
 func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.GeneralConfig, appLggr logger.Logger, db *sqlx.DB) (app chainlink.Application, err error) {
 
-	keyStore := keystore.New(db, utils.GetScryptParams(cfg), appLggr, cfg)
+	var keyStore keystore.Master
+	if u := cfg.RemoteSignerURL(); u != nil {
+		signer := remotesigner.NewClient(*u, cfg.RemoteSignerBearerToken(), appLggr)
+		keyStore = keystore.NewWithRemoteSigner(db, utils.GetScryptParams(cfg), appLggr, cfg, signer)
+	} else {
+		keyStore = keystore.New(db, utils.GetScryptParams(cfg), appLggr, cfg)
+	}
 
 	// Set up the versioning ORM
 	verORM := versioning.NewORM(db, appLggr, cfg.DatabaseDefaultQueryTimeout())
@@ -173,6 +181,11 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 		EventBroadcaster: eventBroadcaster,
 		MailMon:          mailMon,
 	}
+	var jobSharding *sharding.Coordinator
+	if cfg.JobShardingEnabled() {
+		jobSharding = sharding.NewCoordinator(cfg, sharding.NewORM(db, appLggr, cfg), appLggr)
+		ccOpts.IsLeader = jobSharding.IsLeader
+	}
 	var chains chainlink.Chains
 	chains.EVM, err = evm.LoadChainSet(ctx, ccOpts)
 	if err != nil {
@@ -305,6 +318,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 		RestrictedHTTPClient:     restrictedClient,
 		UnrestrictedHTTPClient:   unrestrictedClient,
 		SecretGenerator:          chainlink.FilePersistedSecretGenerator{},
+		JobSharding:              jobSharding,
 	})
 }
 
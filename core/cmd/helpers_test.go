@@ -16,3 +16,11 @@ func (cli *Client) ConfigDumpStr() (string, error) {
 func (cli *Client) ConfigV2Str(userOnly bool) (string, error) {
 	return cli.configV2Str(userOnly)
 }
+
+// WriteProfileArchive exposes writeProfileArchive for testing.
+func WriteProfileArchive(path string, vitals []string, collected map[string][]byte, support []byte, supportErr error) error {
+	return writeProfileArchive(path, vitals, collected, support, supportErr)
+}
+
+// SupportErrorEntry exposes supportErrorEntry for testing.
+const SupportErrorEntry = supportErrorEntry
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type JobShardMemberPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobShardMemberResource
+}
+
+var jobShardMembersHeaders = []string{"Name", "Leader", "Jobs", "Joined At", "Expires At"}
+
+// ToRow presents the JobShardMemberResource as a slice of strings.
+func (p *JobShardMemberPresenter) ToRow() []string {
+	return []string{
+		p.Name,
+		strconv.FormatBool(p.Leader),
+		strconv.FormatInt(p.Jobs, 10),
+		p.JoinedAt.Format(time.RFC3339),
+		p.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+// JobShardMemberPresenters implements TableRenderer for a slice of JobShardMemberPresenter.
+type JobShardMemberPresenters []JobShardMemberPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobShardMemberPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(jobShardMembersHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListJobShardMembers lists the nodes sharing jobs, starting with the leader.
+func (cli *Client) ListJobShardMembers(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/job_shard_members", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobShardMemberPresenters{})
+}
+
+// AssignJob pins a job to a member of the shard.
+func (cli *Client) AssignJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and the member name"))
+	}
+	request, err := json.Marshal(web.AssignJobRequest{MemberName: c.Args().Get(1)})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Put("/v2/jobs/"+c.Args().First()+"/assignment", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Job %v assigned to %v\n", c.Args().First(), c.Args().Get(1))
+	return nil
+}
+
+// UnassignJob removes the assignment of a job to a member of the shard.
+func (cli *Client) UnassignJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/jobs/" + c.Args().First() + "/assignment")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Job %v unassigned\n", c.Args().First())
+	return nil
+}
@@ -0,0 +1,51 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestJobShardMemberPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		joinedAt  = time.Now()
+		expiresAt = joinedAt.Add(30 * time.Second)
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.JobShardMemberPresenters{
+		{JobShardMemberResource: presenters.JobShardMemberResource{
+			JAID:      presenters.NewJAID("node-a"),
+			Name:      "node-a",
+			Leader:    true,
+			Jobs:      12,
+			JoinedAt:  joinedAt,
+			ExpiresAt: expiresAt,
+		}},
+		{JobShardMemberResource: presenters.JobShardMemberResource{
+			JAID:      presenters.NewJAID("node-b"),
+			Name:      "node-b",
+			Jobs:      7,
+			JoinedAt:  joinedAt,
+			ExpiresAt: expiresAt,
+		}},
+	}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "node-a")
+	assert.Contains(t, output, "node-b")
+	assert.Contains(t, output, "true")
+	assert.Contains(t, output, "12")
+	assert.Contains(t, output, joinedAt.Format(time.RFC3339))
+	assert.Contains(t, output, expiresAt.Format(time.RFC3339))
+}
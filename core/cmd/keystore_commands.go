@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// RotateKeystorePassword re-encrypts the node's whole keystore with a new
+// password. The previous ciphertext is kept as a backup by the node.
+func (cli *Client) RotateKeystorePassword(c *cli.Context) (err error) {
+	oldPasswordFile := c.String("oldpassword")
+	if len(oldPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --oldpassword flag"))
+	}
+	newPasswordFile := c.String("newpassword")
+	if len(newPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --newpassword flag"))
+	}
+	oldPassword, err := utils.PasswordFromFile(oldPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read old password file"))
+	}
+	newPassword, err := utils.PasswordFromFile(newPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read new password file"))
+	}
+
+	requestData, err := json.Marshal(web.RotatePasswordRequest{
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+		ScryptN:     c.Int("scryptN"),
+		ScryptP:     c.Int("scryptP"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keys/rotate_password", bytes.NewReader(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		fmt.Println("Keystore password rotated. Update the node's keystore password file before restarting it.")
+	case http.StatusConflict:
+		return cli.errorOut(errors.New("Old password did not match."))
+	default:
+		return cli.printResponseBody(resp)
+	}
+	return nil
+}
+
+type KeystoreBackupImportPresenter struct {
+	JAID
+	presenters.KeystoreBackupImportResource
+}
+
+// RenderTable implements TableRenderer
+func (p *KeystoreBackupImportPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Key", "Result", "Reason"}
+	imported := "imported"
+	if p.DryRun {
+		imported = "would be imported"
+	}
+	rows := [][]string{}
+	for _, id := range p.Imported {
+		rows = append(rows, []string{id, imported, ""})
+	}
+	for _, id := range p.Skipped {
+		rows = append(rows, []string{id, "already present", ""})
+	}
+	for _, c := range p.Conflicts {
+		rows = append(rows, []string{c.ID, "conflict", c.Reason})
+	}
+
+	if _, err := rt.Write([]byte("🔑 Keystore Backup\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ExportKeystoreBackup exports the whole keystore, including the eth key
+// states and their nonces, to an encrypted file
+func (cli *Client) ExportKeystoreBackup(c *cli.Context) (err error) {
+	newPasswordFile := c.String("newpassword")
+	if len(newPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --newpassword/-p flag"))
+	}
+	newPassword, err := os.ReadFile(newPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return cli.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	normalizedPassword := normalizePassword(string(newPassword))
+	resp, err := cli.HTTP.Post("/v2/keys/backup/export?newpassword="+normalizedPassword, nil)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return cli.printResponseBody(resp)
+	}
+
+	backupJSON, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, backupJSON, 0600)
+	if err != nil {
+		return cli.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("🔑 Exported keystore backup to %s\n", filepath))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	return nil
+}
+
+// ImportKeystoreBackup imports a keystore backup file. With --dry-run it only
+// reports what would be imported, and any conflicts.
+func (cli *Client) ImportKeystoreBackup(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the backup to be imported"))
+	}
+
+	oldPasswordFile := c.String("oldpassword")
+	if len(oldPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --oldpassword/-p flag"))
+	}
+	oldPassword, err := os.ReadFile(oldPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.Args().Get(0)
+	backupJSON, err := os.ReadFile(filepath)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	normalizedPassword := normalizePassword(string(oldPassword))
+	query := fmt.Sprintf("?oldpassword=%s&dryrun=%t", normalizedPassword, c.Bool("dry-run"))
+	resp, err := cli.HTTP.Post("/v2/keys/backup/import"+query, bytes.NewReader(backupJSON))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode == http.StatusConflict {
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "Could not read response body"))
+		}
+		var report KeystoreBackupImportPresenter
+		if err = web.ParseJSONAPIResponse(b, &report); err != nil {
+			return cli.errorOut(err)
+		}
+		if err = cli.Render(&report); err != nil {
+			return cli.errorOut(err)
+		}
+		return cli.errorOut(errors.New("Backup conflicts with the keystore, nothing was imported"))
+	}
+
+	return cli.renderAPIResponse(resp, &KeystoreBackupImportPresenter{})
+}
@@ -0,0 +1,98 @@
+package cmd_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestClient_RotateKeystorePassword(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewClientAndRenderer()
+
+	newPasswordFile := filepath.Join(t.TempDir(), "new_password.txt")
+	require.NoError(t, os.WriteFile(newPasswordFile, []byte("n3wP4ssw0rdIsL0ngEnough!\n"), 0600))
+
+	// Missing flags
+	set := flag.NewFlagSet("test rotate", 0)
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	c := cli.NewContext(nil, set, nil)
+	assert.EqualError(t, client.RotateKeystorePassword(c), "Must specify --newpassword flag")
+
+	// Wrong old password
+	set = flag.NewFlagSet("test rotate", 0)
+	set.String("oldpassword", "../internal/fixtures/incorrect_password.txt", "")
+	set.String("newpassword", newPasswordFile, "")
+	c = cli.NewContext(nil, set, nil)
+	assert.EqualError(t, client.RotateKeystorePassword(c), "Old password did not match.")
+
+	set = flag.NewFlagSet("test rotate", 0)
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	set.String("newpassword", newPasswordFile, "")
+	set.Int("scryptN", 2, "")
+	set.Int("scryptP", 1, "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.RotateKeystorePassword(c))
+
+	cltest.AssertCount(t, app.GetSqlxDB(), "encrypted_key_ring_backups", 1)
+	err := app.GetKeyStore().ChangePassword(testutils.Password, testutils.Password, utils.FastScryptParams)
+	assert.ErrorIs(t, err, keystore.ErrWrongPassword)
+}
+
+func TestClient_ExportImportKeystoreBackup(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+	key, err := app.GetKeyStore().P2P().Create()
+	require.NoError(t, err)
+	backupFile := filepath.Join(t.TempDir(), "backup.json")
+
+	// Missing output
+	set := flag.NewFlagSet("test backup export", 0)
+	set.String("newpassword", "../internal/fixtures/correct_password.txt", "")
+	c := cli.NewContext(nil, set, nil)
+	assert.EqualError(t, client.ExportKeystoreBackup(c), "Must specify --output/-o flag")
+
+	set = flag.NewFlagSet("test backup export", 0)
+	set.String("newpassword", "../internal/fixtures/correct_password.txt", "")
+	set.String("output", backupFile, "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ExportKeystoreBackup(c))
+	require.NoError(t, utils.JustError(os.Stat(backupFile)))
+
+	require.NoError(t, utils.JustError(app.GetKeyStore().P2P().Delete(key.PeerID())))
+	requireP2PKeyCount(t, app, 0)
+
+	set = flag.NewFlagSet("test backup import", 0)
+	set.Parse([]string{backupFile})
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	set.Bool("dry-run", true, "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ImportKeystoreBackup(c))
+	requireP2PKeyCount(t, app, 0)
+	require.Len(t, r.Renders, 1)
+	report := r.Renders[0].(*cmd.KeystoreBackupImportPresenter)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, []string{"P2P/" + key.ID()}, report.Imported)
+
+	set = flag.NewFlagSet("test backup import", 0)
+	set.Parse([]string{backupFile})
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ImportKeystoreBackup(c))
+	requireP2PKeyCount(t, app, 1)
+}
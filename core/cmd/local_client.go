@@ -10,11 +10,13 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path"
 	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -32,6 +34,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/shutdown"
@@ -253,6 +257,13 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		return nil
 	})
 
+	if _, ok := cli.Config.(chainlink.ConfigReloader); ok {
+		grp.Go(func() error {
+			reloadConfigOnSIGHUP(grpCtx, app, lggr)
+			return nil
+		})
+	}
+
 	cli.Config.LogConfiguration(lggr.Debug)
 
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
@@ -271,6 +282,31 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 	return grp.Wait()
 }
 
+// reloadConfigOnSIGHUP reloads the configuration of app whenever SIGHUP is
+// received, until ctx is done.
+func reloadConfigOnSIGHUP(ctx context.Context, app chainlink.Application, lggr logger.Logger) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			lggr.Info("Reloading configuration due to SIGHUP signal received...")
+			report, err := app.ReloadConfig(ctx)
+			if err != nil {
+				lggr.Errorw("Failed to reload configuration", "err", err)
+				continue
+			}
+			if len(report.RestartRequired) > 0 {
+				lggr.Warnw("Some configuration changes require a restart to take effect", "fields", report.RestartRequired)
+			}
+		}
+	}
+}
+
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
 	// Ensure `$CLROOT/tls` directory (and children) permissions are <= `ownerPermsMask``
 	tlsDir := filepath.Join(rootDir, "tls")
@@ -611,6 +647,78 @@ func (cli *Client) StatusDatabase(c *clipkg.Context) error {
 	return nil
 }
 
+// RestoreDatabase restores a backup taken by the node, after verifying it against its manifest and checking that
+// its schema version is supported.
+func (cli *Client) RestoreDatabase(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the path to a backup file, or its name with --remote"))
+	}
+	ctx := context.Background()
+	path := c.Args().First()
+	if c.Bool("remote") {
+		var err error
+		path, err = periodicbackup.DownloadBackup(ctx, cli.Config, path)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "failed to download backup"))
+		}
+		cli.Logger.Infof("Downloaded backup to %s", path)
+	}
+	dbURL := cli.Config.DatabaseURL()
+	fmt.Printf("Restoring %s will overwrite the data of the tables it contains in %s.\n", path, dbURL.Redacted())
+	if !confirmAction(c) {
+		return nil
+	}
+	db, err := newConnection(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to connect to the database"))
+	}
+	defer db.Close()
+	if err := periodicbackup.RestoreBackup(ctx, cli.Config, cli.Logger, db.DB, path); err != nil {
+		return cli.errorOut(err)
+	}
+	cli.Logger.Info("Backup restored")
+	return nil
+}
+
+// DatabaseBackupPresenter is a database backup, stored locally or uploaded.
+type DatabaseBackupPresenter struct {
+	Name      string    `json:"name"`
+	Location  string    `json:"location"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type DatabaseBackupPresenters []DatabaseBackupPresenter
+
+// RenderTable implements TableRenderer
+func (ps DatabaseBackupPresenters) RenderTable(rt RendererTable) error {
+	if len(ps) == 0 {
+		return utils.JustError(rt.Write([]byte("No backups found\n")))
+	}
+	table := rt.newTable([]string{"Name", "Location", "Size", "Created At"})
+	for _, p := range ps {
+		table.Append([]string{p.Name, p.Location, strconv.FormatInt(p.Size, 10), p.CreatedAt.UTC().Format(time.RFC3339)})
+	}
+	render("Backups", table)
+	return nil
+}
+
+// ListDatabaseBackups lists the local and uploaded database backups.
+func (cli *Client) ListDatabaseBackups(c *clipkg.Context) error {
+	local, remote, err := periodicbackup.ListBackups(context.Background(), cli.Config)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	ps := DatabaseBackupPresenters{}
+	for _, b := range local {
+		ps = append(ps, DatabaseBackupPresenter{Name: b.Name, Location: "local", Size: b.Size, CreatedAt: b.CreatedAt})
+	}
+	for _, b := range remote {
+		ps = append(ps, DatabaseBackupPresenter{Name: b.Name, Location: "remote", Size: b.Size, CreatedAt: b.CreatedAt})
+	}
+	return cli.errorOut(cli.Render(&ps))
+}
+
 // CreateMigration displays the database migration status
 func (cli *Client) CreateMigration(c *clipkg.Context) error {
 	if !c.Args().Present() {
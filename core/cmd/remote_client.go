@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"archive/zip"
 	"bytes"
 	"encoding/json"
 	"fmt"
@@ -235,7 +236,9 @@ func (cli *Client) ChangePassword(c *clipkg.Context) (err error) {
 	return nil
 }
 
-// Profile will collect pprof metrics and store them in a folder.
+// Profile will collect pprof metrics, and the support archive of the node, and store them in a zip archive. Vitals
+// which fail to be collected are left out, and a support archive which fails to be collected, like on nodes without
+// one or for users other than admins, is replaced by the reason why.
 func (cli *Client) Profile(c *clipkg.Context) error {
 	seconds := c.Uint("seconds")
 	baseDir := c.String("output_dir")
@@ -243,13 +246,9 @@ func (cli *Client) Profile(c *clipkg.Context) error {
 		return cli.errorOut(errors.New("profile duration should be less than server write timeout"))
 	}
 
-	genDir := filepath.Join(baseDir, fmt.Sprintf("debuginfo-%s", time.Now().Format(time.RFC3339)))
+	archivePath := filepath.Join(baseDir, fmt.Sprintf("debuginfo-%s.zip", time.Now().Format(time.RFC3339)))
 
-	err := os.Mkdir(genDir, 0755)
-	if err != nil {
-		return cli.errorOut(err)
-	}
-	cli.Logger.Infof("writing pprof to %s", genDir)
+	cli.Logger.Infof("writing pprof to %s", archivePath)
 	var wgPprof sync.WaitGroup
 	vitals := []string{
 		"allocs",       // A sampling of all past memory allocations
@@ -263,45 +262,114 @@ func (cli *Client) Profile(c *clipkg.Context) error {
 		"trace",        // A trace of execution of the current program.
 	}
 	wgPprof.Add(len(vitals))
-	errs := make(chan error)
+	errs := make(chan error, len(vitals))
+	var collectedMu sync.Mutex
+	collected := make(map[string][]byte, len(vitals))
 	for _, vt := range vitals {
 		go func(vt string) {
 			defer wgPprof.Done()
 			uri := fmt.Sprintf("/v2/debug/pprof/%s?seconds=%d", vt, seconds)
 			cli.Logger.Infof("Collecting %s ", uri)
-			resp, err := cli.HTTP.Get(uri)
+			b, err := cli.collect(uri)
 			if err != nil {
 				cli.Logger.Errorf("error collecting vt %s: %s", vt, err.Error())
 				errs <- err
 				return
 			}
-			defer resp.Body.Close()
-
-			// write to file
-			f, err := os.Create(filepath.Join(genDir, vt))
-			if err != nil {
-				cli.Logger.Errorf("error creating file for %s: %s", vt, err.Error())
-				errs <- err
-				return
-			}
-			defer f.Close()
-
-			_, err = io.Copy(f, resp.Body)
-			if err != nil {
-				cli.Logger.Errorf("error writing to file for %s: %s", vt, err.Error())
-				errs <- err
-				return
-			}
+			collectedMu.Lock()
+			collected[vt] = b
+			collectedMu.Unlock()
 			cli.Logger.Infof("Collected %s", vt)
 		}(vt)
 	}
 	wgPprof.Wait()
+
+	cli.Logger.Infof("Collecting support archive")
+	support, supportErr := cli.collect("/v2/debug/support")
+	if supportErr != nil {
+		cli.Logger.Warnf("error collecting support archive: %s", supportErr.Error())
+	}
+
+	if err := writeProfileArchive(archivePath, vitals, collected, support, supportErr); err != nil {
+		return cli.errorOut(err)
+	}
 	if len(errs) > 0 {
 		return cli.errorOut(fmt.Errorf("%d profile collections failed", len(errs)))
 	}
 	return nil
 }
 
+// collect returns the body of a successful GET of uri.
+func (cli *Client) collect(uri string) ([]byte, error) {
+	resp, err := cli.HTTP.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("%s: %s", resp.Status, bytes.TrimSpace(b))
+	}
+	return b, nil
+}
+
+// supportErrorEntry is the entry of the profile archive holding why the support archive is missing.
+const supportErrorEntry = "support-error.txt"
+
+// writeProfileArchive writes the collected pprof vitals under pprof/, and the entries of the support archive, to a zip
+// archive at path. If the support archive could not be collected, supportErr is written in its place.
+func writeProfileArchive(path string, vitals []string, collected map[string][]byte, support []byte, supportErr error) error {
+	var zr *zip.Reader
+	if supportErr == nil {
+		var err error
+		if zr, err = zip.NewReader(bytes.NewReader(support), int64(len(support))); err != nil {
+			supportErr = errors.Wrap(err, "invalid support archive")
+		}
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for _, vt := range vitals {
+		b, ok := collected[vt]
+		if !ok {
+			continue
+		}
+		w, err := zw.Create("pprof/" + vt)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(b); err != nil {
+			return err
+		}
+	}
+	if supportErr != nil {
+		w, err := zw.Create(supportErrorEntry)
+		if err != nil {
+			return err
+		}
+		if _, err = fmt.Fprintf(w, "failed to collect the support archive: %s\n", supportErr); err != nil {
+			return err
+		}
+	} else {
+		for _, zf := range zr.File {
+			if err = zw.Copy(zf); err != nil {
+				return err
+			}
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
 func (cli *Client) buildSessionRequest(flag string) (sessions.SessionRequest, error) {
 	if len(flag) > 0 {
 		return cli.FileSessionRequestBuilder.Build(flag)
@@ -515,6 +583,103 @@ func (cli *Client) ConfigFileValidate(c *clipkg.Context) error {
 	return nil
 }
 
+// ConfigDiffPresenter is a field which differs between a local config file and the running node.
+type ConfigDiffPresenter struct {
+	Field string `json:"field"`
+	Local string `json:"local"`
+	Node  string `json:"node"`
+}
+
+type ConfigDiffPresenters []ConfigDiffPresenter
+
+// RenderTable implements TableRenderer
+func (ps ConfigDiffPresenters) RenderTable(rt RendererTable) error {
+	if len(ps) == 0 {
+		return utils.JustError(rt.Write([]byte("No differences\n")))
+	}
+	table := rt.newTable([]string{"Field", "Local", "Node"})
+	for _, p := range ps {
+		table.Append([]string{p.Field, p.Local, p.Node})
+	}
+	render("Configuration differences", table)
+	return nil
+}
+
+// ConfigDiff compares the effective configuration of a local TOML config file with the running node's
+func (cli *Client) ConfigDiff(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the path to a TOML config file"))
+	}
+	b, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	local, err := chainlink.EffectiveConfigTOML(string(b))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	node, err := cli.configV2Str(false)
+	if err != nil {
+		return err
+	}
+	diffs, err := chainlink.DiffConfigTOML(local, node)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	ps := ConfigDiffPresenters{}
+	for _, d := range diffs {
+		ps = append(ps, ConfigDiffPresenter{Field: d.Field, Local: d.A, Node: d.B})
+	}
+	return cli.errorOut(cli.Render(&ps))
+}
+
+// ConfigLintPresenter is an issue found in a TOML config.
+type ConfigLintPresenter struct {
+	Field string `json:"field"`
+	Issue string `json:"issue"`
+}
+
+type ConfigLintPresenters []ConfigLintPresenter
+
+// RenderTable implements TableRenderer
+func (ps ConfigLintPresenters) RenderTable(rt RendererTable) error {
+	if len(ps) == 0 {
+		return utils.JustError(rt.Write([]byte("No issues found\n")))
+	}
+	table := rt.newTable([]string{"Field", "Issue"})
+	for _, p := range ps {
+		table.Append([]string{p.Field, p.Issue})
+	}
+	render("Configuration issues", table)
+	return nil
+}
+
+// ConfigLint reports likely mistakes in the given TOML config file, or in the TOML config of the client, which are
+// not reported by validation
+func (cli *Client) ConfigLint(c *clipkg.Context) error {
+	var input string
+	if c.Args().Present() {
+		b, err := os.ReadFile(c.Args().First())
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		input = string(b)
+	} else if cfg, ok := cli.Config.(chainlink.ConfigV2); ok {
+		input, _ = cfg.ConfigTOML()
+	} else {
+		return cli.errorOut(errors.New("must pass the path to a TOML config file, unsupported with legacy ENV config"))
+	}
+	issues, err := chainlink.LintConfig(input)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	ps := ConfigLintPresenters{}
+	for _, i := range issues {
+		ps = append(ps, ConfigLintPresenter{Field: i.Field, Issue: i.Msg})
+	}
+	return cli.errorOut(cli.Render(&ps))
+}
+
 func normalizePassword(password string) string {
 	return url.QueryEscape(strings.TrimSpace(password))
 }
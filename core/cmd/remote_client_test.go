@@ -1,6 +1,7 @@
 package cmd_test
 
 import (
+	"archive/zip"
 	"bytes"
 	"errors"
 	"flag"
@@ -8,7 +9,9 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -497,11 +500,105 @@ func TestClient_Profile(t *testing.T) {
 	err := client.RemoteLogin(c)
 	require.NoError(t, err)
 
+	dir := t.TempDir()
 	set.Uint("seconds", 8, "")
-	set.String("output_dir", t.TempDir(), "")
+	set.String("output_dir", dir, "")
 
 	err = client.Profile(cli.NewContext(nil, set, nil))
 	require.NoError(t, err)
+
+	archives, err := filepath.Glob(filepath.Join(dir, "debuginfo-*.zip"))
+	require.NoError(t, err)
+	require.Len(t, archives, 1)
+	zr, err := zip.OpenReader(archives[0])
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, zr.Close()) })
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Contains(t, names, "pprof/heap")
+	assert.Contains(t, names, "config.toml")
+}
+
+func TestClient_Profile_SupportForbidden(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewClientAndRenderer()
+	client.HTTP = cltest.NewMockAuthenticatedHTTPClient(app.Logger, app.NewClientOpts(), app.MustSeedNewSession(cltest.APIEmailViewOnly))
+
+	dir := t.TempDir()
+	set := flag.NewFlagSet("test", 0)
+	set.Uint("seconds", 1, "")
+	set.String("output_dir", dir, "")
+
+	require.NoError(t, client.Profile(cli.NewContext(nil, set, nil)))
+
+	names, files := readProfileArchive(t, dir)
+	assert.Contains(t, names, "pprof/heap")
+	assert.NotContains(t, names, "config.toml")
+	assert.Contains(t, files[cmd.SupportErrorEntry], "403 Forbidden")
+}
+
+func TestWriteProfileArchive(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("config.toml")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("[Log]"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	vitals := []string{"heap", "profile"}
+	collected := map[string][]byte{"heap": []byte("heap")}
+
+	t.Run("support archive", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, cmd.WriteProfileArchive(filepath.Join(dir, "debuginfo-1.zip"), vitals, collected, buf.Bytes(), nil))
+		names, files := readProfileArchive(t, dir)
+		assert.Equal(t, []string{"pprof/heap", "config.toml"}, names, "failed vitals are left out")
+		assert.Equal(t, "[Log]", files["config.toml"])
+	})
+
+	t.Run("support failed", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, cmd.WriteProfileArchive(filepath.Join(dir, "debuginfo-1.zip"), vitals, collected, nil, errors.New("404 Not Found")))
+		names, files := readProfileArchive(t, dir)
+		assert.Equal(t, []string{"pprof/heap", cmd.SupportErrorEntry}, names)
+		assert.Contains(t, files[cmd.SupportErrorEntry], "404 Not Found")
+	})
+
+	t.Run("support invalid", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, cmd.WriteProfileArchive(filepath.Join(dir, "debuginfo-1.zip"), vitals, collected, []byte("<html>"), nil))
+		names, files := readProfileArchive(t, dir)
+		assert.Equal(t, []string{"pprof/heap", cmd.SupportErrorEntry}, names)
+		assert.Contains(t, files[cmd.SupportErrorEntry], "invalid support archive")
+	})
+}
+
+// readProfileArchive returns the names of the entries of the profile archive in dir, and their contents.
+func readProfileArchive(t *testing.T, dir string) (names []string, files map[string]string) {
+	archives, err := filepath.Glob(filepath.Join(dir, "debuginfo-*.zip"))
+	require.NoError(t, err)
+	require.Len(t, archives, 1)
+	zr, err := zip.OpenReader(archives[0])
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, zr.Close()) })
+	files = make(map[string]string)
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		r, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		files[f.Name] = string(b)
+	}
+	return
 }
 
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
@@ -618,6 +715,40 @@ func TestClient_ConfigV2(t *testing.T) {
 	})
 }
 
+func TestClient_ConfigDiff(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewClientAndRenderer()
+	cfg, ok := app.Config.(chainlink.ConfigV2)
+	require.True(t, ok)
+	_, effective := cfg.ConfigTOML()
+
+	diffFile := func(t *testing.T, toml string) cmd.ConfigDiffPresenters {
+		path := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(path, []byte(toml), 0600))
+		set := flag.NewFlagSet("test", 0)
+		require.NoError(t, set.Parse([]string{path}))
+		require.NoError(t, client.ConfigDiff(cli.NewContext(nil, set, nil)))
+		require.NotEmpty(t, r.Renders)
+		return *r.Renders[len(r.Renders)-1].(*cmd.ConfigDiffPresenters)
+	}
+
+	t.Run("same", func(t *testing.T) {
+		assert.Empty(t, diffFile(t, effective))
+	})
+	t.Run("changed", func(t *testing.T) {
+		changed := strings.Replace(effective, "MaxRunDuration = '10m0s'", "MaxRunDuration = '1h0m0s'", 1)
+		require.NotEqual(t, effective, changed)
+		assert.Equal(t, cmd.ConfigDiffPresenters{
+			{Field: "JobPipeline.MaxRunDuration", Local: `"1h0m0s"`, Node: `"10m0s"`},
+		}, diffFile(t, changed))
+	})
+	t.Run("missing", func(t *testing.T) {
+		require.Error(t, client.ConfigDiff(cltest.EmptyCLIContext()))
+	})
+}
+
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 func TestClient_ConfigDump(t *testing.T) {
 	t.Parallel()
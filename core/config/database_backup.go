@@ -0,0 +1,19 @@
+package config
+
+import "net/url"
+
+// DatabaseBackupUpload is the S3 compatible object storage which database
+// backups are uploaded to. Its access keys are secrets.
+type DatabaseBackupUpload struct {
+	Endpoint        *url.URL
+	Bucket          string
+	Prefix          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// Enabled returns true if backups are uploaded.
+func (u DatabaseBackupUpload) Enabled() bool {
+	return u.Endpoint != nil && u.Endpoint.String() != "" && u.Bucket != ""
+}
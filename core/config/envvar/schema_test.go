@@ -72,6 +72,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmBalanceMonitorBlockDelay":                    "ETH_BALANCE_MONITOR_BLOCK_DELAY",
 		"EvmEIP1559DynamicFees":                          "EVM_EIP1559_DYNAMIC_FEES",
 		"EvmFinalityDepth":                               "ETH_FINALITY_DEPTH",
+		"EvmFinalityTagEnabled":                          "ETH_FINALITY_TAG_ENABLED",
 		"EvmGasBumpPercent":                              "ETH_GAS_BUMP_PERCENT",
 		"EvmGasBumpThreshold":                            "ETH_GAS_BUMP_THRESHOLD",
 		"EvmGasBumpTxDepth":                              "ETH_GAS_BUMP_TX_DEPTH",
@@ -95,6 +96,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmLogBackfillBatchSize":                        "ETH_LOG_BACKFILL_BATCH_SIZE",
 		"EvmLogPollInterval":                             "ETH_LOG_POLL_INTERVAL",
 		"EvmLogKeepBlocksDepth":                          "ETH_LOG_KEEP_BLOCKS_DEPTH",
+		"EvmLogBroadcasterUseLogPoller":                  "ETH_LOG_BROADCASTER_USE_LOG_POLLER",
 		"EvmMaxGasPriceWei":                              "ETH_MAX_GAS_PRICE_WEI",
 		"EvmMaxInFlightTransactions":                     "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
 		"EvmMaxQueuedTransactions":                       "ETH_MAX_QUEUED_TRANSACTIONS",
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -72,6 +73,8 @@ type BasicConfig interface {
 	SetLogLevel(lvl zapcore.Level) error
 	SetLogSQL(logSQL bool)
 	SetPasswords(keystore, vrf *string)
+	// RefreshSecrets reloads the rotatable secrets from the secrets provider, if any.
+	RefreshSecrets(ctx context.Context) error
 
 	FeatureFlags
 	audit.Config
@@ -93,22 +96,38 @@ type BasicConfig interface {
 	AutoPprofMutexProfileFraction() int
 	AutoPprofPollInterval() models.Duration
 	AutoPprofProfileRoot() string
+	AutoPprofUploadAuthToken() string
+	AutoPprofUploadCPUDuration() models.Duration
+	AutoPprofUploadInterval() models.Duration
+	AutoPprofUploadProfileTypes() []string
+	AutoPprofUploadTimeout() models.Duration
+	AutoPprofUploadURL() *url.URL
 	BlockBackfillDepth() uint64
 	BlockBackfillSkip() bool
+	BridgeBearerToken(name string) string
 	BridgeResponseURL() *url.URL
 	BridgeCacheTTL() time.Duration
 	CertFile() string
 	DatabaseBackupDir() string
+	DatabaseBackupEncryptionKey() string
+	DatabaseBackupExcludeTableData() []string
 	DatabaseBackupFrequency() time.Duration
+	DatabaseBackupMaxAge() time.Duration
+	DatabaseBackupMaxBackups() uint32
 	DatabaseBackupMode() DatabaseBackupMode
 	DatabaseBackupOnVersionUpgrade() bool
+	DatabaseBackupTables() []string
 	DatabaseBackupURL() *url.URL
+	DatabaseBackupUpload() DatabaseBackupUpload
 	DatabaseDefaultIdleInTxSessionTimeout() time.Duration
 	DatabaseDefaultLockTimeout() time.Duration
 	DatabaseDefaultQueryTimeout() time.Duration
 	DatabaseListenerMaxReconnectDuration() time.Duration
 	DatabaseListenerMinReconnectInterval() time.Duration
 	DatabaseLockingMode() string
+	DatabaseReadReplicaCheckInterval() time.Duration
+	DatabaseReadReplicaMaxLag() time.Duration
+	DatabaseReadReplicaURL() *url.URL
 	DatabaseURL() url.URL
 	DefaultChainID() *big.Int
 	DefaultHTTPLimit() int64
@@ -128,12 +147,18 @@ type BasicConfig interface {
 	GetAdvisoryLockIDConfiguredOrDefault() int64
 	GetDatabaseDialectConfiguredOrDefault() dialects.DialectName
 	HTTPServerWriteTimeout() time.Duration
+	HealthReadinessGates() []string
 	InsecureFastScrypt() bool
 	JSONConsole() bool
 	JobPipelineMaxRunDuration() time.Duration
 	JobPipelineReaperInterval() time.Duration
 	JobPipelineReaperThreshold() time.Duration
 	JobPipelineResultWriteQueueDepth() uint64
+	JobShardingEnabled() bool
+	JobShardingHeartbeatInterval() time.Duration
+	JobShardingJobTypes() []string
+	JobShardingLeaseDuration() time.Duration
+	JobShardingMemberName() string
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperGasPriceBufferPercent() uint16
 	KeeperGasTipCapBufferPercent() uint16
@@ -147,6 +172,24 @@ type BasicConfig interface {
 	KeeperTurnLookBack() int64
 	KeyFile() string
 	KeystorePassword() string
+	TrustedProxies() []string
+	LDAPActiveAttribute() string
+	LDAPActiveAttributeAllowedValue() string
+	LDAPAdminGroups() []string
+	LDAPBaseGroupDN() string
+	LDAPBaseUserDN() string
+	LDAPBindCacheDuration() time.Duration
+	LDAPEditGroups() []string
+	LDAPEmailAttribute() string
+	LDAPEnabled() bool
+	LDAPGroupMemberAttribute() string
+	LDAPQueryTimeout() time.Duration
+	LDAPReadOnlyUserDN() string
+	LDAPReadOnlyUserPassword() string
+	LDAPRunGroups() []string
+	LDAPServerURL() *url.URL
+	LDAPSyncInterval() time.Duration
+	LDAPViewGroups() []string
 	LeaseLockDuration() time.Duration
 	LeaseLockRefreshInterval() time.Duration
 	LogFileDir() string
@@ -158,6 +201,24 @@ type BasicConfig interface {
 	LogUnixTimestamps() bool
 	MercuryCredentials(url string) (username, password string, err error)
 	MigrateDatabase() bool
+	NotificationsEnabled() bool
+	NotificationsJobErrorThreshold() uint32
+	NotificationsKeyBalanceThreshold() *assets.Wei
+	NotificationsMaxAttempts() uint32
+	NotificationsRequestTimeout() time.Duration
+	NotificationsRetryBackoff() time.Duration
+	NotificationsWebhooks() []NotificationWebhook
+	OIDCAdminGroups() []string
+	OIDCClientID() string
+	OIDCClientSecret() string
+	OIDCEditGroups() []string
+	OIDCEnabled() bool
+	OIDCGroupsClaim() string
+	OIDCIssuerURL() *url.URL
+	OIDCRedirectURL() *url.URL
+	OIDCRunGroups() []string
+	OIDCScopes() []string
+	OIDCViewGroups() []string
 	ORMMaxIdleConns() int
 	ORMMaxOpenConns() int
 	Port() uint16
@@ -167,7 +228,11 @@ type BasicConfig interface {
 	RPID() string
 	RPOrigin() string
 	ReaperExpiration() models.Duration
+	RemoteSignerBearerToken() string
+	RemoteSignerURL() *url.URL
 	RootDir() string
+	SecretsProviderBackend() string
+	SecretsProviderRefreshInterval() time.Duration
 	SecureCookies() bool
 	SentryDSN() string
 	SentryDebug() bool
@@ -225,6 +290,7 @@ type GlobalConfig interface {
 	GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 	GlobalEvmEIP1559DynamicFees() (bool, bool)
 	GlobalEvmFinalityDepth() (uint32, bool)
+	GlobalEvmFinalityTagEnabled() (bool, bool)
 	GlobalEvmGasBumpPercent() (uint16, bool)
 	GlobalEvmGasBumpThreshold() (uint64, bool)
 	GlobalEvmGasBumpTxDepth() (uint16, bool)
@@ -248,6 +314,7 @@ type GlobalConfig interface {
 	GlobalEvmLogBackfillBatchSize() (uint32, bool)
 	GlobalEvmLogPollInterval() (time.Duration, bool)
 	GlobalEvmLogKeepBlocksDepth() (uint32, bool)
+	GlobalEvmLogBroadcasterUseLogPoller() (bool, bool)
 	GlobalEvmMaxGasPriceWei() (*assets.Wei, bool)
 	GlobalEvmMaxInFlightTransactions() (uint32, bool)
 	GlobalEvmMaxQueuedTransactions() (uint64, bool)
@@ -606,6 +673,30 @@ func (c *generalConfig) AutoPprofGoroutineThreshold() int {
 	return c.viper.GetInt(envvar.Name("AutoPprofGoroutineThreshold"))
 }
 
+func (c *generalConfig) AutoPprofUploadAuthToken() string {
+	return ""
+}
+
+func (c *generalConfig) AutoPprofUploadCPUDuration() models.Duration {
+	return models.MustMakeDuration(10 * time.Second)
+}
+
+func (c *generalConfig) AutoPprofUploadInterval() models.Duration {
+	return models.MustMakeDuration(time.Minute)
+}
+
+func (c *generalConfig) AutoPprofUploadProfileTypes() []string {
+	return []string{"cpu", "heap", "goroutine"}
+}
+
+func (c *generalConfig) AutoPprofUploadTimeout() models.Duration {
+	return models.MustMakeDuration(30 * time.Second)
+}
+
+func (c *generalConfig) AutoPprofUploadURL() *url.URL {
+	return nil
+}
+
 // PyroscopeAuthToken specifies the Auth Token used to send profiling info to Pyroscope
 func (c *generalConfig) PyroscopeAuthToken() string {
 	return c.viper.GetString(envvar.Name("PyroscopeAuthToken"))
@@ -693,6 +784,43 @@ func (c *generalConfig) DatabaseBackupDir() string {
 	return c.viper.GetString(envvar.Name("DatabaseBackupDir"))
 }
 
+func (c *generalConfig) DatabaseBackupEncryptionKey() string {
+	return ""
+}
+
+func (c *generalConfig) DatabaseBackupExcludeTableData() []string {
+	return nil
+}
+
+func (c *generalConfig) DatabaseBackupMaxAge() time.Duration {
+	return 0
+}
+
+func (c *generalConfig) DatabaseBackupMaxBackups() uint32 {
+	return 0
+}
+
+func (c *generalConfig) DatabaseBackupTables() []string {
+	return nil
+}
+
+func (c *generalConfig) DatabaseBackupUpload() DatabaseBackupUpload {
+	return DatabaseBackupUpload{Region: "us-east-1"}
+}
+
+func (c *generalConfig) DatabaseReadReplicaCheckInterval() time.Duration {
+	return 5 * time.Second
+}
+
+func (c *generalConfig) DatabaseReadReplicaMaxLag() time.Duration {
+	return 10 * time.Second
+}
+
+// DatabaseReadReplicaURL is only supported with TOML secrets.
+func (c *generalConfig) DatabaseReadReplicaURL() *url.URL {
+	return nil
+}
+
 func (c *generalConfig) DatabaseDefaultIdleInTxSessionTimeout() time.Duration {
 	return pg.DefaultIdleInTxSessionTimeout
 }
@@ -1133,6 +1261,204 @@ func (c *generalConfig) MercuryCredentials(url string) (username, password strin
 	return "", "", errors.New("legacy config does not support Mercury credentials; use V2 TOML config to enable this feature")
 }
 
+func (c *generalConfig) BridgeBearerToken(name string) string {
+	return ""
+}
+
+func (c *generalConfig) SecretsProviderBackend() string {
+	return ""
+}
+
+func (c *generalConfig) SecretsProviderRefreshInterval() time.Duration {
+	return 0
+}
+
+// RefreshSecrets is a no-op, since the legacy config does not support secrets providers.
+func (c *generalConfig) RefreshSecrets(ctx context.Context) error {
+	return nil
+}
+
+func (c *generalConfig) HealthReadinessGates() []string {
+	return nil
+}
+
+func (c *generalConfig) JobShardingEnabled() bool {
+	return false
+}
+
+func (c *generalConfig) JobShardingHeartbeatInterval() time.Duration {
+	return 5 * time.Second
+}
+
+func (c *generalConfig) JobShardingJobTypes() []string {
+	return []string{"cron"}
+}
+
+func (c *generalConfig) JobShardingLeaseDuration() time.Duration {
+	return 30 * time.Second
+}
+
+func (c *generalConfig) JobShardingMemberName() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return hostname
+}
+
+func (c *generalConfig) NotificationsEnabled() bool {
+	return false
+}
+
+func (c *generalConfig) NotificationsJobErrorThreshold() uint32 {
+	return 3
+}
+
+func (c *generalConfig) NotificationsKeyBalanceThreshold() *assets.Wei {
+	return assets.GWei(100_000_000)
+}
+
+func (c *generalConfig) NotificationsMaxAttempts() uint32 {
+	return 10
+}
+
+func (c *generalConfig) NotificationsRequestTimeout() time.Duration {
+	return 10 * time.Second
+}
+
+func (c *generalConfig) NotificationsRetryBackoff() time.Duration {
+	return 30 * time.Second
+}
+
+func (c *generalConfig) NotificationsWebhooks() []NotificationWebhook {
+	return nil
+}
+
+func (c *generalConfig) RemoteSignerBearerToken() string {
+	return ""
+}
+
+func (c *generalConfig) RemoteSignerURL() *url.URL {
+	return nil
+}
+
+// TrustedProxies is only supported with TOML config.
+func (c *generalConfig) TrustedProxies() []string {
+	return nil
+}
+
+func (c *generalConfig) LDAPActiveAttribute() string {
+	return ""
+}
+
+func (c *generalConfig) LDAPActiveAttributeAllowedValue() string {
+	return ""
+}
+
+func (c *generalConfig) LDAPAdminGroups() []string {
+	return nil
+}
+
+func (c *generalConfig) LDAPBaseGroupDN() string {
+	return ""
+}
+
+func (c *generalConfig) LDAPBaseUserDN() string {
+	return ""
+}
+
+func (c *generalConfig) LDAPBindCacheDuration() time.Duration {
+	return time.Minute
+}
+
+func (c *generalConfig) LDAPEditGroups() []string {
+	return nil
+}
+
+func (c *generalConfig) LDAPEmailAttribute() string {
+	return "mail"
+}
+
+func (c *generalConfig) LDAPEnabled() bool {
+	return false
+}
+
+func (c *generalConfig) LDAPGroupMemberAttribute() string {
+	return "member"
+}
+
+func (c *generalConfig) LDAPQueryTimeout() time.Duration {
+	return 5 * time.Second
+}
+
+func (c *generalConfig) LDAPReadOnlyUserDN() string {
+	return ""
+}
+
+func (c *generalConfig) LDAPReadOnlyUserPassword() string {
+	return ""
+}
+
+func (c *generalConfig) LDAPRunGroups() []string {
+	return nil
+}
+
+func (c *generalConfig) LDAPServerURL() *url.URL {
+	return nil
+}
+
+func (c *generalConfig) LDAPSyncInterval() time.Duration {
+	return 15 * time.Minute
+}
+
+func (c *generalConfig) LDAPViewGroups() []string {
+	return nil
+}
+
+func (c *generalConfig) OIDCEnabled() bool {
+	return false
+}
+
+func (c *generalConfig) OIDCIssuerURL() *url.URL {
+	return nil
+}
+
+func (c *generalConfig) OIDCClientID() string {
+	return ""
+}
+
+func (c *generalConfig) OIDCClientSecret() string {
+	return ""
+}
+
+func (c *generalConfig) OIDCRedirectURL() *url.URL {
+	return nil
+}
+
+func (c *generalConfig) OIDCScopes() []string {
+	return []string{"openid", "email", "profile"}
+}
+
+func (c *generalConfig) OIDCGroupsClaim() string {
+	return "groups"
+}
+
+func (c *generalConfig) OIDCAdminGroups() []string {
+	return nil
+}
+
+func (c *generalConfig) OIDCEditGroups() []string {
+	return nil
+}
+
+func (c *generalConfig) OIDCRunGroups() []string {
+	return nil
+}
+
+func (c *generalConfig) OIDCViewGroups() []string {
+	return nil
+}
+
 // Port represents the port Chainlink should listen on for client requests.
 func (c *generalConfig) Port() uint16 {
 	return getEnvWithFallback(c, envvar.NewUint16("Port"))
@@ -1359,6 +1685,9 @@ func (c *generalConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 func (c *generalConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmFinalityDepth"), parse.Uint32)
 }
+func (c *generalConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmFinalityTagEnabled"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	return lookupEnv(c, envvar.Name("EvmGasBumpPercent"), parse.Uint16)
 }
@@ -1431,6 +1760,9 @@ func (c *generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 func (c *generalConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmLogKeepBlocksDepth"), parse.Uint32)
 }
+func (c *generalConfig) GlobalEvmLogBroadcasterUseLogPoller() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmLogBroadcasterUseLogPoller"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) {
 	return lookupEnv(c, envvar.Name("EvmMaxGasPriceWei"), parse.Wei)
 }
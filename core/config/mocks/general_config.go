@@ -3,7 +3,10 @@
 package mocks
 
 import (
+	context "context"
+
 	assets "github.com/smartcontractkit/chainlink/core/assets"
+
 	audit "github.com/smartcontractkit/chainlink/core/logger/audit"
 
 	big "math/big"
@@ -384,6 +387,94 @@ func (_m *GeneralConfig) AutoPprofProfileRoot() string {
 	return r0
 }
 
+// AutoPprofUploadAuthToken provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofUploadAuthToken() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AutoPprofUploadCPUDuration provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofUploadCPUDuration() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// AutoPprofUploadInterval provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofUploadInterval() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// AutoPprofUploadProfileTypes provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofUploadProfileTypes() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// AutoPprofUploadTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofUploadTimeout() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// AutoPprofUploadURL provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofUploadURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // BlockBackfillDepth provides a mock function with given fields:
 func (_m *GeneralConfig) BlockBackfillDepth() uint64 {
 	ret := _m.Called()
@@ -412,6 +503,20 @@ func (_m *GeneralConfig) BlockBackfillSkip() bool {
 	return r0
 }
 
+// BridgeBearerToken provides a mock function with given fields: name
+func (_m *GeneralConfig) BridgeBearerToken(name string) string {
+	ret := _m.Called(name)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // BridgeCacheTTL provides a mock function with given fields:
 func (_m *GeneralConfig) BridgeCacheTTL() time.Duration {
 	ret := _m.Called()
@@ -470,6 +575,36 @@ func (_m *GeneralConfig) DatabaseBackupDir() string {
 	return r0
 }
 
+// DatabaseBackupEncryptionKey provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupEncryptionKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// DatabaseBackupExcludeTableData provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupExcludeTableData() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseBackupFrequency provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupFrequency() time.Duration {
 	ret := _m.Called()
@@ -484,6 +619,34 @@ func (_m *GeneralConfig) DatabaseBackupFrequency() time.Duration {
 	return r0
 }
 
+// DatabaseBackupMaxAge provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupMaxAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseBackupMaxBackups provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupMaxBackups() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // DatabaseBackupMode provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupMode() config.DatabaseBackupMode {
 	ret := _m.Called()
@@ -512,6 +675,22 @@ func (_m *GeneralConfig) DatabaseBackupOnVersionUpgrade() bool {
 	return r0
 }
 
+// DatabaseBackupTables provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupTables() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseBackupURL provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupURL() *url.URL {
 	ret := _m.Called()
@@ -528,6 +707,20 @@ func (_m *GeneralConfig) DatabaseBackupURL() *url.URL {
 	return r0
 }
 
+// DatabaseBackupUpload provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupUpload() config.DatabaseBackupUpload {
+	ret := _m.Called()
+
+	var r0 config.DatabaseBackupUpload
+	if rf, ok := ret.Get(0).(func() config.DatabaseBackupUpload); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(config.DatabaseBackupUpload)
+	}
+
+	return r0
+}
+
 // DatabaseDefaultIdleInTxSessionTimeout provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseDefaultIdleInTxSessionTimeout() time.Duration {
 	ret := _m.Called()
@@ -612,6 +805,50 @@ func (_m *GeneralConfig) DatabaseLockingMode() string {
 	return r0
 }
 
+// DatabaseReadReplicaCheckInterval provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseReadReplicaCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseReadReplicaMaxLag provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseReadReplicaMaxLag() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseReadReplicaURL provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseReadReplicaURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseURL provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseURL() url.URL {
 	ret := _m.Called()
@@ -1285,6 +1522,27 @@ func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmGasBumpPercent provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	ret := _m.Called()
@@ -1736,6 +1994,27 @@ func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmLogBroadcasterUseLogPoller provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogBroadcasterUseLogPoller() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmLogKeepBlocksDepth provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
 	ret := _m.Called()
@@ -2281,6 +2560,22 @@ func (_m *GeneralConfig) HTTPServerWriteTimeout() time.Duration {
 	return r0
 }
 
+// HealthReadinessGates provides a mock function with given fields:
+func (_m *GeneralConfig) HealthReadinessGates() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // InsecureFastScrypt provides a mock function with given fields:
 func (_m *GeneralConfig) InsecureFastScrypt() bool {
 	ret := _m.Called()
@@ -2365,13 +2660,85 @@ func (_m *GeneralConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return r0
 }
 
-// KeeperBaseFeeBufferPercent provides a mock function with given fields:
-func (_m *GeneralConfig) KeeperBaseFeeBufferPercent() uint16 {
+// JobShardingEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) JobShardingEnabled() bool {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
-		r0 = rf()
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// JobShardingHeartbeatInterval provides a mock function with given fields:
+func (_m *GeneralConfig) JobShardingHeartbeatInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobShardingJobTypes provides a mock function with given fields:
+func (_m *GeneralConfig) JobShardingJobTypes() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// JobShardingLeaseDuration provides a mock function with given fields:
+func (_m *GeneralConfig) JobShardingLeaseDuration() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobShardingMemberName provides a mock function with given fields:
+func (_m *GeneralConfig) JobShardingMemberName() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// KeeperBaseFeeBufferPercent provides a mock function with given fields:
+func (_m *GeneralConfig) KeeperBaseFeeBufferPercent() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
 	} else {
 		r0 = ret.Get(0).(uint16)
 	}
@@ -2561,6 +2928,254 @@ func (_m *GeneralConfig) KeystorePassword() string {
 	return r0
 }
 
+// LDAPActiveAttribute provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPActiveAttribute() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPActiveAttributeAllowedValue provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPActiveAttributeAllowedValue() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPAdminGroups provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPAdminGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// LDAPBaseGroupDN provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPBaseGroupDN() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPBaseUserDN provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPBaseUserDN() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPBindCacheDuration provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPBindCacheDuration() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LDAPEditGroups provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPEditGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// LDAPEmailAttribute provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPEmailAttribute() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// LDAPGroupMemberAttribute provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPGroupMemberAttribute() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPQueryTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPQueryTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LDAPReadOnlyUserDN provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPReadOnlyUserDN() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPReadOnlyUserPassword provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPReadOnlyUserPassword() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// LDAPRunGroups provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPRunGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// LDAPServerURL provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPServerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// LDAPSyncInterval provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPSyncInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LDAPViewGroups provides a mock function with given fields:
+func (_m *GeneralConfig) LDAPViewGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // LeaseLockDuration provides a mock function with given fields:
 func (_m *GeneralConfig) LeaseLockDuration() time.Duration {
 	ret := _m.Called()
@@ -2686,49 +3301,151 @@ func (_m *GeneralConfig) LogUnixTimestamps() bool {
 	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MercuryCredentials provides a mock function with given fields: _a0
+func (_m *GeneralConfig) MercuryCredentials(_a0 string) (string, string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string) string); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MigrateDatabase provides a mock function with given fields:
+func (_m *GeneralConfig) MigrateDatabase() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NotificationsEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) NotificationsEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NotificationsJobErrorThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) NotificationsJobErrorThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NotificationsKeyBalanceThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) NotificationsKeyBalanceThreshold() *assets.Wei {
+	ret := _m.Called()
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// NotificationsMaxAttempts provides a mock function with given fields:
+func (_m *GeneralConfig) NotificationsMaxAttempts() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NotificationsRequestTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) NotificationsRequestTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	return r0
 }
 
-// MercuryCredentials provides a mock function with given fields: _a0
-func (_m *GeneralConfig) MercuryCredentials(_a0 string) (string, string, error) {
-	ret := _m.Called(_a0)
-
-	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(_a0)
-	} else {
-		r0 = ret.Get(0).(string)
-	}
-
-	var r1 string
-	if rf, ok := ret.Get(1).(func(string) string); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Get(1).(string)
-	}
+// NotificationsRetryBackoff provides a mock function with given fields:
+func (_m *GeneralConfig) NotificationsRetryBackoff() time.Duration {
+	ret := _m.Called()
 
-	var r2 error
-	if rf, ok := ret.Get(2).(func(string) error); ok {
-		r2 = rf(_a0)
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
-	return r0, r1, r2
+	return r0
 }
 
-// MigrateDatabase provides a mock function with given fields:
-func (_m *GeneralConfig) MigrateDatabase() bool {
+// NotificationsWebhooks provides a mock function with given fields:
+func (_m *GeneralConfig) NotificationsWebhooks() []config.NotificationWebhook {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 []config.NotificationWebhook
+	if rf, ok := ret.Get(0).(func() []config.NotificationWebhook); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.NotificationWebhook)
+		}
 	}
 
 	return r0
@@ -2993,6 +3710,174 @@ func (_m *GeneralConfig) OCRTransmitterAddress() (ethkey.EIP55Address, error) {
 	return r0, r1
 }
 
+// OIDCAdminGroups provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCAdminGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCClientID provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCClientID() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCClientSecret provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCClientSecret() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCEditGroups provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCEditGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// OIDCGroupsClaim provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCGroupsClaim() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCIssuerURL provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCIssuerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRedirectURL provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCRedirectURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// OIDCRunGroups provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCRunGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCScopes provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCScopes() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCViewGroups provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCViewGroups() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // ORMMaxIdleConns provides a mock function with given fields:
 func (_m *GeneralConfig) ORMMaxIdleConns() int {
 	ret := _m.Called()
@@ -3476,6 +4361,50 @@ func (_m *GeneralConfig) ReaperExpiration() models.Duration {
 	return r0
 }
 
+// RefreshSecrets provides a mock function with given fields: ctx
+func (_m *GeneralConfig) RefreshSecrets(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoteSignerBearerToken provides a mock function with given fields:
+func (_m *GeneralConfig) RemoteSignerBearerToken() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// RemoteSignerURL provides a mock function with given fields:
+func (_m *GeneralConfig) RemoteSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
@@ -3490,6 +4419,34 @@ func (_m *GeneralConfig) RootDir() string {
 	return r0
 }
 
+// SecretsProviderBackend provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsProviderBackend() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SecretsProviderRefreshInterval provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsProviderRefreshInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // SecureCookies provides a mock function with given fields:
 func (_m *GeneralConfig) SecureCookies() bool {
 	ret := _m.Called()
@@ -3936,6 +4893,22 @@ func (_m *GeneralConfig) TriggerFallbackDBPollInterval() time.Duration {
 	return r0
 }
 
+// TrustedProxies provides a mock function with given fields:
+func (_m *GeneralConfig) TrustedProxies() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // UnAuthenticatedRateLimit provides a mock function with given fields:
 func (_m *GeneralConfig) UnAuthenticatedRateLimit() int64 {
 	ret := _m.Called()
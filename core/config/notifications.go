@@ -0,0 +1,42 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// NotificationEvent is a node event which webhooks may be notified of
+type NotificationEvent string
+
+// nolint
+const (
+	NotificationJobErrors        NotificationEvent = "JobErrors"
+	NotificationKeyBalanceLow    NotificationEvent = "KeyBalanceLow"
+	NotificationRPCNodeOutOfSync NotificationEvent = "RPCNodeOutOfSync"
+	NotificationTxFatal          NotificationEvent = "TxFatal"
+	NotificationJobProposed      NotificationEvent = "JobProposed"
+)
+
+var ErrInvalidNotificationEvent = fmt.Errorf("must be one of %s", strings.Join([]string{
+	string(NotificationJobErrors), string(NotificationKeyBalanceLow), string(NotificationRPCNodeOutOfSync),
+	string(NotificationTxFatal), string(NotificationJobProposed),
+}, ", "))
+
+// IsValid returns true if the NotificationEvent value is known.
+func (e NotificationEvent) IsValid() bool {
+	switch e {
+	case NotificationJobErrors, NotificationKeyBalanceLow, NotificationRPCNodeOutOfSync, NotificationTxFatal, NotificationJobProposed:
+		return true
+	}
+	return false
+}
+
+// NotificationWebhook is a webhook notified of node events. Its URL and
+// SigningKey are secrets.
+type NotificationWebhook struct {
+	Name       string
+	URL        *url.URL
+	SigningKey string
+	Events     []NotificationEvent
+}
@@ -0,0 +1,34 @@
+package secretsprovider
+
+import (
+	"context"
+	"os"
+	"strings"
+)
+
+var _ Provider = (*envProvider)(nil)
+
+type envProvider struct {
+	prefix string
+}
+
+// NewEnvProvider returns a Provider of the environment variables starting
+// with prefix. The rest of the name is the path of the secret, with `_` in
+// place of `.`, like `MERCURY_CREDENTIALS_0_PASSWORD`.
+func NewEnvProvider(prefix string) Provider {
+	return &envProvider{prefix: prefix}
+}
+
+func (e *envProvider) Name() string { return "env" }
+
+func (e *envProvider) Values(context.Context) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(k, e.prefix) || k == e.prefix {
+			continue
+		}
+		values[strings.ReplaceAll(strings.TrimPrefix(k, e.prefix), "_", ".")] = v
+	}
+	return values, nil
+}
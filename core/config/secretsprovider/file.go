@@ -0,0 +1,53 @@
+package secretsprovider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var _ Provider = (*fileProvider)(nil)
+
+type fileProvider struct {
+	dir string
+}
+
+// NewFileProvider returns a Provider of the files in dir, named after the
+// paths of the secrets, like a mounted Kubernetes secret. Hidden files and
+// directories are ignored, and trailing newlines are trimmed.
+func NewFileProvider(dir string) Provider {
+	return &fileProvider{dir: dir}
+}
+
+func (f *fileProvider) Name() string { return "file" }
+
+func (f *fileProvider) Values(context.Context) (map[string]string, error) {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read secrets directory")
+	}
+	values := make(map[string]string, len(entries))
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(f.dir, e.Name())
+		// Stat follows the symlinks of mounted secrets
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to stat secret file %s", e.Name())
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read secret file %s", e.Name())
+		}
+		values[e.Name()] = strings.TrimRight(string(b), "\r\n")
+	}
+	return values, nil
+}
@@ -0,0 +1,96 @@
+package secretsprovider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+
+	v2 "github.com/smartcontractkit/chainlink/core/config/v2"
+)
+
+// Provider is an external backend of secrets. Secrets are identified by their
+// path in the secrets TOML, like `Database.URL`, with list entries identified
+// by their index, like `Mercury.Credentials.0.Password`. Paths are matched
+// case insensitively.
+type Provider interface {
+	// Name returns the name of the backend, for logging.
+	Name() string
+	// Values returns the values of all the secrets held by the backend, by
+	// path.
+	Values(ctx context.Context) (map[string]string, error)
+}
+
+// Load returns the secrets held by p. Secrets which it does not hold are left
+// nil.
+func Load(ctx context.Context, p Provider) (s v2.Secrets, err error) {
+	values, err := p.Values(ctx)
+	if err != nil {
+		return s, errors.Wrapf(err, "failed to load secrets from %s backend", p.Name())
+	}
+	s, err = Decode(values)
+	return s, errors.Wrapf(err, "invalid secrets from %s backend", p.Name())
+}
+
+// Decode returns the secrets with the given values, by path.
+func Decode(values map[string]string) (s v2.Secrets, err error) {
+	doc := make(map[string]any)
+	for path, value := range values {
+		if err = set(doc, strings.Split(path, "."), value); err != nil {
+			return s, errors.Wrapf(err, "invalid path %q", path)
+		}
+	}
+	b, err := toml.Marshal(doc)
+	if err != nil {
+		return s, err
+	}
+	err = v2.DecodeTOML(strings.NewReader(string(b)), &s)
+	return s, err
+}
+
+// set sets the value at path in table. List entries missing before the index
+// are left empty, and so do not change the secrets they are merged with.
+func set(table map[string]any, path []string, value string) error {
+	key := path[0]
+	if key == "" {
+		return errors.New("empty key")
+	}
+	for k := range table {
+		if strings.EqualFold(k, key) {
+			key = k
+			break
+		}
+	}
+	if len(path) == 1 {
+		if _, ok := table[key]; ok {
+			return errors.Errorf("%s is set more than once", key)
+		}
+		table[key] = value
+		return nil
+	}
+	if i, err := strconv.Atoi(path[1]); err == nil {
+		if i < 0 || len(path) == 2 {
+			return errors.Errorf("%s must be a list of tables", key)
+		}
+		list, ok := table[key].([]map[string]any)
+		if !ok && table[key] != nil {
+			return errors.Errorf("%s is both a list and a value", key)
+		}
+		for len(list) <= i {
+			list = append(list, make(map[string]any))
+		}
+		table[key] = list
+		return set(list[i], path[2:], value)
+	}
+	sub, ok := table[key].(map[string]any)
+	if !ok {
+		if table[key] != nil {
+			return errors.Errorf("%s is both a table and a value", key)
+		}
+		sub = make(map[string]any)
+		table[key] = sub
+	}
+	return set(sub, path[1:], value)
+}
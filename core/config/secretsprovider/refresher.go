@@ -0,0 +1,80 @@
+package secretsprovider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Config is the configuration of the Refresher.
+type Config interface {
+	SecretsProviderRefreshInterval() time.Duration
+	// RefreshSecrets reloads the rotatable secrets from the provider.
+	RefreshSecrets(ctx context.Context) error
+}
+
+// Refresher periodically reloads the rotatable secrets, so that they can be
+// rotated without restarting the node. Failures are logged, and the previous
+// secrets are kept until the next refresh succeeds.
+type Refresher struct {
+	utils.StartStopOnce
+
+	cfg  Config
+	lggr logger.Logger
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+var _ services.ServiceCtx = (*Refresher)(nil)
+
+// NewRefresher returns a Refresher of the secrets of cfg.
+func NewRefresher(cfg Config, lggr logger.Logger) *Refresher {
+	return &Refresher{
+		cfg:    cfg,
+		lggr:   lggr.Named("SecretsRefresher"),
+		chStop: make(chan struct{}),
+	}
+}
+
+func (r *Refresher) Start(context.Context) error {
+	return r.StartOnce("SecretsRefresher", func() error {
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *Refresher) Close() error {
+	return r.StopOnce("SecretsRefresher", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return nil
+	})
+}
+
+func (r *Refresher) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(r.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(r.cfg.SecretsProviderRefreshInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+			if err := r.cfg.RefreshSecrets(ctx); err != nil {
+				r.lggr.Errorw("Failed to refresh secrets, keeping the previous ones", "err", err)
+				continue
+			}
+			r.lggr.Debug("Refreshed secrets")
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package secretsprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultVaultTimeout bounds every request made to the Vault server.
+const DefaultVaultTimeout = 10 * time.Second
+
+// maxResponseSize limits how much of a response body is read.
+const maxResponseSize = 1 << 20
+
+var _ Provider = (*vaultProvider)(nil)
+
+type vaultProvider struct {
+	url   url.URL
+	token string
+	http  *http.Client
+}
+
+// NewVaultProvider returns a Provider of the fields of the secret at path, on
+// the HashiCorp Vault compatible server at u. The fields are named after the
+// paths of the secrets. Both KV version 1 and 2 secrets engines are supported.
+func NewVaultProvider(u url.URL, path, token string) Provider {
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/v1/" + strings.TrimPrefix(path, "/")
+	return &vaultProvider{
+		url:   u,
+		token: token,
+		http:  &http.Client{Timeout: DefaultVaultTimeout},
+	}
+}
+
+func (v *vaultProvider) Name() string { return "vault" }
+
+// vaultResponse is the response of the read secret endpoints of the KV
+// secrets engines. Version 2 nests the fields in another data object, along
+// with its metadata.
+type vaultResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []string                   `json:"errors"`
+}
+
+func (v *vaultProvider) Values(ctx context.Context) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if v.token != "" {
+		req.Header.Set("X-Vault-Token", v.token)
+	}
+	res, err := v.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request Vault")
+	}
+	defer res.Body.Close()
+	b, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read Vault response")
+	}
+	var resp vaultResponse
+	if err = json.Unmarshal(b, &resp); err != nil && res.StatusCode == http.StatusOK {
+		return nil, errors.Wrap(err, "failed to decode Vault response")
+	}
+	if res.StatusCode != http.StatusOK {
+		msg := fmt.Sprintf("Vault responded with status %d", res.StatusCode)
+		if len(resp.Errors) > 0 {
+			msg += ": " + strings.Join(resp.Errors, ", ")
+		}
+		return nil, errors.New(msg)
+	}
+
+	fields := resp.Data
+	if nested, ok := fields["data"]; ok {
+		if _, ok := fields["metadata"]; ok {
+			fields = nil
+			if err = json.Unmarshal(nested, &fields); err != nil {
+				return nil, errors.Wrap(err, "failed to decode Vault KV version 2 secret")
+			}
+		}
+	}
+	values := make(map[string]string, len(fields))
+	for k, raw := range fields {
+		var s string
+		if err = json.Unmarshal(raw, &s); err != nil {
+			return nil, errors.Errorf("Vault secret field %s must be a string", k)
+		}
+		values[k] = s
+	}
+	return values, nil
+}
@@ -0,0 +1,64 @@
+package secretsprovider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+func TestVaultProvider(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/chainlink":
+			_, _ = w.Write([]byte(`{"data":{"data":{"Mercury.Credentials.0.Password":"v2-password"},"metadata":{"version":3}}}`))
+		case "/v1/kv/chainlink":
+			_, _ = w.Write([]byte(`{"data":{"Mercury.Credentials.0.Password":"v1-password"}}`))
+		case "/v1/kv/invalid":
+			_, _ = w.Write([]byte(`{"data":{"Mercury.Credentials.0.Password":1}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name, path, token string
+		exp               map[string]string
+		expErr            string
+	}{
+		{name: "kv v2", path: "secret/data/chainlink", token: "vault-token",
+			exp: map[string]string{"Mercury.Credentials.0.Password": "v2-password"}},
+		{name: "kv v1", path: "/kv/chainlink", token: "vault-token",
+			exp: map[string]string{"Mercury.Credentials.0.Password": "v1-password"}},
+		{name: "not a string", path: "kv/invalid", token: "vault-token", expErr: "must be a string"},
+		{name: "not found", path: "kv/missing", token: "vault-token", expErr: "Vault responded with status 404"},
+		{name: "forbidden", path: "kv/chainlink", token: "wrong", expErr: "Vault responded with status 403: permission denied"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := NewVaultProvider(*u, tt.path, tt.token).Values(testutils.Context(t))
+			if tt.expErr != "" {
+				assert.ErrorContains(t, err, tt.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, values)
+		})
+	}
+}
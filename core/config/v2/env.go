@@ -14,11 +14,13 @@ var (
 	EnvDatabaseAllowSimplePasswords = Env("CL_DATABASE_ALLOW_SIMPLE_PASSWORDS")
 	EnvDatabaseURL                  = EnvSecret("CL_DATABASE_URL")
 	EnvDatabaseBackupURL            = EnvSecret("CL_DATABASE_BACKUP_URL")
+	EnvDatabaseReadReplicaURL       = EnvSecret("CL_DATABASE_READ_REPLICA_URL")
 	EnvExplorerAccessKey            = EnvSecret("CL_EXPLORER_ACCESS_KEY")
 	EnvExplorerSecret               = EnvSecret("CL_EXPLORER_SECRET")
 	EnvPasswordKeystore             = EnvSecret("CL_PASSWORD_KEYSTORE")
 	EnvPasswordVRF                  = EnvSecret("CL_PASSWORD_VRF")
 	EnvPyroscopeAuthToken           = EnvSecret("CL_PYROSCOPE_AUTH_TOKEN")
+	EnvAutoPprofUploadAuthToken     = EnvSecret("CL_AUTO_PPROF_UPLOAD_AUTH_TOKEN")
 )
 
 type Env string
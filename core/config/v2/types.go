@@ -12,10 +12,12 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
+	"golang.org/x/exp/slices"
 
 	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
 	ocrnetworking "github.com/smartcontractkit/libocr/networking"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink/cfgtest"
@@ -53,6 +55,10 @@ type Core struct {
 	AutoPprof        AutoPprof               `toml:",omitempty"`
 	Pyroscope        Pyroscope               `toml:",omitempty"`
 	Sentry           Sentry                  `toml:",omitempty"`
+	Notifications    Notifications           `toml:",omitempty"`
+	JobSharding      JobSharding             `toml:",omitempty"`
+	SecretsProvider  SecretsProvider         `toml:",omitempty"`
+	Health           Health                  `toml:",omitempty"`
 }
 
 var (
@@ -65,6 +71,8 @@ func init() {
 	if err := cfgtest.DocDefaultsOnly(strings.NewReader(defaultsTOML), &defaults, DecodeTOML); err != nil {
 		log.Fatalf("Failed to initialize defaults from docs: %v", err)
 	}
+	// webhooks are examples only
+	defaults.Notifications.Webhooks = nil
 }
 
 func CoreDefaults() (c Core) {
@@ -106,14 +114,48 @@ func (c *Core) SetFrom(f *Core) {
 	c.AutoPprof.setFrom(&f.AutoPprof)
 	c.Pyroscope.setFrom(&f.Pyroscope)
 	c.Sentry.setFrom(&f.Sentry)
+	c.Notifications.setFrom(&f.Notifications)
+	c.JobSharding.setFrom(&f.JobSharding)
+	c.SecretsProvider.setFrom(&f.SecretsProvider)
+	c.Health.setFrom(&f.Health)
+}
+
+func (c *Core) ValidateConfig() (err error) {
+	if c.JobSharding.Enabled != nil && *c.JobSharding.Enabled && c.Database.Lock.Enabled != nil && *c.Database.Lock.Enabled {
+		err = multierr.Append(err, ErrInvalid{Name: "JobSharding.Enabled", Value: true, Msg: "requires Database.Lock.Enabled = false, since members share the database"})
+	}
+	return
 }
 
 type Secrets struct {
-	Database  DatabaseSecrets  `toml:",omitempty"`
-	Explorer  ExplorerSecrets  `toml:",omitempty"`
-	Password  Passwords        `toml:",omitempty"`
-	Pyroscope PyroscopeSecrets `toml:",omitempty"`
-	Mercury   MercurySecrets   `toml:",omitempty"`
+	Database        DatabaseSecrets        `toml:",omitempty"`
+	Explorer        ExplorerSecrets        `toml:",omitempty"`
+	Password        Passwords              `toml:",omitempty"`
+	Pyroscope       PyroscopeSecrets       `toml:",omitempty"`
+	AutoPprof       AutoPprofSecrets       `toml:",omitempty"`
+	Mercury         MercurySecrets         `toml:",omitempty"`
+	RemoteSigner    RemoteSignerSecrets    `toml:",omitempty"`
+	OIDC            OIDCSecrets            `toml:",omitempty"`
+	LDAP            LDAPSecrets            `toml:",omitempty"`
+	Notifications   NotificationsSecrets   `toml:",omitempty"`
+	Bridge          BridgeSecrets          `toml:",omitempty"`
+	SecretsProvider SecretsProviderSecrets `toml:",omitempty"`
+}
+
+// SetFrom updates s with any non-nil values from f. List entries are merged by index.
+func (s *Secrets) SetFrom(f *Secrets) {
+	s.Database.setFrom(&f.Database)
+	s.Explorer.setFrom(&f.Explorer)
+	s.Password.setFrom(&f.Password)
+	s.Pyroscope.setFrom(&f.Pyroscope)
+	s.AutoPprof.setFrom(&f.AutoPprof)
+	s.Mercury.setFrom(&f.Mercury)
+	s.RemoteSigner.setFrom(&f.RemoteSigner)
+	s.OIDC.setFrom(&f.OIDC)
+	s.LDAP.setFrom(&f.LDAP)
+	s.Notifications.setFrom(&f.Notifications)
+	s.Bridge.setFrom(&f.Bridge)
+	s.SecretsProvider.setFrom(&f.SecretsProvider)
 }
 
 func dbURLPasswordComplexity(err error) string {
@@ -121,9 +163,13 @@ func dbURLPasswordComplexity(err error) string {
 }
 
 type DatabaseSecrets struct {
-	URL                  *models.SecretURL
-	BackupURL            *models.SecretURL
-	AllowSimplePasswords bool
+	URL                   *models.SecretURL
+	BackupURL             *models.SecretURL
+	ReadReplicaURL        *models.SecretURL
+	AllowSimplePasswords  bool
+	BackupEncryptionKey   *models.Secret
+	BackupAccessKeyID     *models.Secret
+	BackupSecretAccessKey *models.Secret
 }
 
 func (d *DatabaseSecrets) ValidateConfig() (err error) {
@@ -139,14 +185,55 @@ func (d *DatabaseSecrets) ValidateConfig() (err error) {
 			err = multierr.Append(err, ErrInvalid{Name: "BackupURL", Value: "*****", Msg: dbURLPasswordComplexity(verr)})
 		}
 	}
+	if d.ReadReplicaURL != nil && !d.AllowSimplePasswords {
+		if verr := config.ValidateDBURL((url.URL)(*d.ReadReplicaURL)); verr != nil {
+			err = multierr.Append(err, ErrInvalid{Name: "ReadReplicaURL", Value: "*****", Msg: dbURLPasswordComplexity(verr)})
+		}
+	}
+	if (d.BackupAccessKeyID == nil) != (d.BackupSecretAccessKey == nil) {
+		err = multierr.Append(err, ErrMissing{Name: "BackupAccessKeyID", Msg: "must be set together with BackupSecretAccessKey"})
+	}
 	return err
 }
 
+func (d *DatabaseSecrets) setFrom(f *DatabaseSecrets) {
+	if v := f.URL; v != nil {
+		d.URL = v
+	}
+	if v := f.BackupURL; v != nil {
+		d.BackupURL = v
+	}
+	if v := f.ReadReplicaURL; v != nil {
+		d.ReadReplicaURL = v
+	}
+	if f.AllowSimplePasswords {
+		d.AllowSimplePasswords = true
+	}
+	if v := f.BackupEncryptionKey; v != nil {
+		d.BackupEncryptionKey = v
+	}
+	if v := f.BackupAccessKeyID; v != nil {
+		d.BackupAccessKeyID = v
+	}
+	if v := f.BackupSecretAccessKey; v != nil {
+		d.BackupSecretAccessKey = v
+	}
+}
+
 type ExplorerSecrets struct {
 	AccessKey *models.Secret
 	Secret    *models.Secret
 }
 
+func (e *ExplorerSecrets) setFrom(f *ExplorerSecrets) {
+	if v := f.AccessKey; v != nil {
+		e.AccessKey = v
+	}
+	if v := f.Secret; v != nil {
+		e.Secret = v
+	}
+}
+
 type Passwords struct {
 	Keystore *models.Secret
 	VRF      *models.Secret
@@ -159,16 +246,53 @@ func (p *Passwords) ValidateConfig() (err error) {
 	return err
 }
 
+func (p *Passwords) setFrom(f *Passwords) {
+	if v := f.Keystore; v != nil {
+		p.Keystore = v
+	}
+	if v := f.VRF; v != nil {
+		p.VRF = v
+	}
+}
+
+type AutoPprofSecrets struct {
+	UploadAuthToken *models.Secret
+}
+
+func (a *AutoPprofSecrets) setFrom(f *AutoPprofSecrets) {
+	if v := f.UploadAuthToken; v != nil {
+		a.UploadAuthToken = v
+	}
+}
+
 type PyroscopeSecrets struct {
 	AuthToken *models.Secret
 }
 
+func (p *PyroscopeSecrets) setFrom(f *PyroscopeSecrets) {
+	if v := f.AuthToken; v != nil {
+		p.AuthToken = v
+	}
+}
+
 type MercuryCredentials struct {
 	URL      *models.SecretURL
 	Username *models.Secret
 	Password *models.Secret
 }
 
+func (m *MercuryCredentials) setFrom(f *MercuryCredentials) {
+	if v := f.URL; v != nil {
+		m.URL = v
+	}
+	if v := f.Username; v != nil {
+		m.Username = v
+	}
+	if v := f.Password; v != nil {
+		m.Password = v
+	}
+}
+
 type MercurySecrets struct {
 	Credentials []MercuryCredentials
 }
@@ -188,6 +312,173 @@ func (m *MercurySecrets) ValidateConfig() (err error) {
 	return nil
 }
 
+func (m *MercurySecrets) setFrom(f *MercurySecrets) {
+	if len(f.Credentials) == 0 {
+		return
+	}
+	// copied, since the credentials may be shared with another MercurySecrets
+	creds := append([]MercuryCredentials(nil), m.Credentials...)
+	for i := range f.Credentials {
+		if i == len(creds) {
+			creds = append(creds, MercuryCredentials{})
+		}
+		creds[i].setFrom(&f.Credentials[i])
+	}
+	m.Credentials = creds
+}
+
+type RemoteSignerSecrets struct {
+	URL         *models.SecretURL
+	BearerToken *models.Secret
+}
+
+func (r *RemoteSignerSecrets) ValidateConfig() (err error) {
+	if r.URL == nil && r.BearerToken != nil {
+		err = multierr.Append(err, ErrEmpty{Name: "URL", Msg: "must be provided when BearerToken is set"})
+	}
+	return err
+}
+
+func (r *RemoteSignerSecrets) setFrom(f *RemoteSignerSecrets) {
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.BearerToken; v != nil {
+		r.BearerToken = v
+	}
+}
+
+type OIDCSecrets struct {
+	ClientSecret *models.Secret
+}
+
+func (o *OIDCSecrets) setFrom(f *OIDCSecrets) {
+	if v := f.ClientSecret; v != nil {
+		o.ClientSecret = v
+	}
+}
+
+type LDAPSecrets struct {
+	ReadOnlyUserPassword *models.Secret
+}
+
+func (l *LDAPSecrets) setFrom(f *LDAPSecrets) {
+	if v := f.ReadOnlyUserPassword; v != nil {
+		l.ReadOnlyUserPassword = v
+	}
+}
+
+type NotificationWebhookSecrets struct {
+	Name       *string
+	URL        *models.SecretURL
+	SigningKey *models.Secret
+}
+
+func (w *NotificationWebhookSecrets) setFrom(f *NotificationWebhookSecrets) {
+	if v := f.Name; v != nil {
+		w.Name = v
+	}
+	if v := f.URL; v != nil {
+		w.URL = v
+	}
+	if v := f.SigningKey; v != nil {
+		w.SigningKey = v
+	}
+}
+
+type NotificationsSecrets struct {
+	Webhooks []NotificationWebhookSecrets
+}
+
+func (n *NotificationsSecrets) ValidateConfig() (err error) {
+	names := UniqueStrings{}
+	for i, w := range n.Webhooks {
+		if w.Name == nil || *w.Name == "" {
+			err = multierr.Append(err, ErrMissing{Name: fmt.Sprintf("Webhooks.%d.Name", i), Msg: "required for all webhooks"})
+		} else if names.IsDupe(w.Name) {
+			err = multierr.Append(err, NewErrDuplicate(fmt.Sprintf("Webhooks.%d.Name", i), *w.Name))
+		}
+		if w.URL == nil || w.URL.URL().String() == "" {
+			err = multierr.Append(err, ErrMissing{Name: fmt.Sprintf("Webhooks.%d.URL", i), Msg: "required for all webhooks"})
+		}
+		if w.SigningKey == nil || *w.SigningKey == "" {
+			err = multierr.Append(err, ErrMissing{Name: fmt.Sprintf("Webhooks.%d.SigningKey", i), Msg: "required for all webhooks"})
+		}
+	}
+	return
+}
+
+func (n *NotificationsSecrets) setFrom(f *NotificationsSecrets) {
+	if len(f.Webhooks) == 0 {
+		return
+	}
+	webhooks := append([]NotificationWebhookSecrets(nil), n.Webhooks...)
+	for i := range f.Webhooks {
+		if i == len(webhooks) {
+			webhooks = append(webhooks, NotificationWebhookSecrets{})
+		}
+		webhooks[i].setFrom(&f.Webhooks[i])
+	}
+	n.Webhooks = webhooks
+}
+
+type BridgeCredentials struct {
+	Name        *string
+	BearerToken *models.Secret
+}
+
+func (b *BridgeCredentials) setFrom(f *BridgeCredentials) {
+	if v := f.Name; v != nil {
+		b.Name = v
+	}
+	if v := f.BearerToken; v != nil {
+		b.BearerToken = v
+	}
+}
+
+type BridgeSecrets struct {
+	Credentials []BridgeCredentials
+}
+
+func (b *BridgeSecrets) ValidateConfig() (err error) {
+	names := UniqueStrings{}
+	for i, c := range b.Credentials {
+		if c.Name == nil || *c.Name == "" {
+			err = multierr.Append(err, ErrMissing{Name: fmt.Sprintf("Credentials.%d.Name", i), Msg: "required for all bridge credentials"})
+		} else if names.IsDupe(c.Name) {
+			err = multierr.Append(err, NewErrDuplicate(fmt.Sprintf("Credentials.%d.Name", i), *c.Name))
+		}
+		if c.BearerToken == nil || *c.BearerToken == "" {
+			err = multierr.Append(err, ErrMissing{Name: fmt.Sprintf("Credentials.%d.BearerToken", i), Msg: "required for all bridge credentials"})
+		}
+	}
+	return
+}
+
+func (b *BridgeSecrets) setFrom(f *BridgeSecrets) {
+	if len(f.Credentials) == 0 {
+		return
+	}
+	creds := append([]BridgeCredentials(nil), b.Credentials...)
+	for i := range f.Credentials {
+		if i == len(creds) {
+			creds = append(creds, BridgeCredentials{})
+		}
+		creds[i].setFrom(&f.Credentials[i])
+	}
+	b.Credentials = creds
+}
+
+type SecretsProviderSecrets struct {
+	VaultToken *models.Secret
+}
+
+func (s *SecretsProviderSecrets) setFrom(f *SecretsProviderSecrets) {
+	if v := f.VaultToken; v != nil {
+		s.VaultToken = v
+	}
+}
+
 type Feature struct {
 	FeedsManager *bool
 	LogPoller    *bool
@@ -216,9 +507,10 @@ type Database struct {
 	MaxOpenConns                  *int64
 	MigrateOnStartup              *bool
 
-	Backup   DatabaseBackup   `toml:",omitempty"`
-	Listener DatabaseListener `toml:",omitempty"`
-	Lock     DatabaseLock     `toml:",omitempty"`
+	Backup      DatabaseBackup      `toml:",omitempty"`
+	Listener    DatabaseListener    `toml:",omitempty"`
+	Lock        DatabaseLock        `toml:",omitempty"`
+	ReadReplica DatabaseReadReplica `toml:",omitempty"`
 }
 
 func (d *Database) LockingMode() string {
@@ -254,6 +546,7 @@ func (d *Database) setFrom(f *Database) {
 	d.Backup.setFrom(&f.Backup)
 	d.Listener.setFrom(&f.Listener)
 	d.Lock.setFrom(&f.Lock)
+	d.ReadReplica.setFrom(&f.ReadReplica)
 }
 
 type DatabaseListener struct {
@@ -300,6 +593,31 @@ func (l *DatabaseLock) setFrom(f *DatabaseLock) {
 	}
 }
 
+// Note: url is stored in Secrets.DatabaseReadReplicaURL
+type DatabaseReadReplica struct {
+	MaxLag        *models.Duration
+	CheckInterval *models.Duration
+}
+
+func (r *DatabaseReadReplica) ValidateConfig() (err error) {
+	if r.MaxLag != nil && r.MaxLag.Duration() <= 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "MaxLag", Value: r.MaxLag.String(), Msg: "must be greater than zero"})
+	}
+	if r.CheckInterval != nil && r.CheckInterval.Duration() <= 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "CheckInterval", Value: r.CheckInterval.String(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
+func (r *DatabaseReadReplica) setFrom(f *DatabaseReadReplica) {
+	if v := f.MaxLag; v != nil {
+		r.MaxLag = v
+	}
+	if v := f.CheckInterval; v != nil {
+		r.CheckInterval = v
+	}
+}
+
 // DatabaseBackup
 //
 // Note: url is stored in Secrets.DatabaseBackupURL
@@ -308,6 +626,12 @@ type DatabaseBackup struct {
 	Frequency        *models.Duration
 	Mode             *config.DatabaseBackupMode
 	OnVersionUpgrade *bool
+	Tables           *[]string
+	ExcludeTableData *[]string
+	MaxBackups       *uint32
+	MaxAge           *models.Duration
+
+	Upload DatabaseBackupUpload `toml:",omitempty"`
 }
 
 func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
@@ -323,6 +647,54 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.OnVersionUpgrade; v != nil {
 		d.OnVersionUpgrade = v
 	}
+	if v := f.Tables; v != nil {
+		d.Tables = v
+	}
+	if v := f.ExcludeTableData; v != nil {
+		d.ExcludeTableData = v
+	}
+	if v := f.MaxBackups; v != nil {
+		d.MaxBackups = v
+	}
+	if v := f.MaxAge; v != nil {
+		d.MaxAge = v
+	}
+	d.Upload.setFrom(&f.Upload)
+}
+
+type DatabaseBackupUpload struct {
+	Endpoint *models.URL
+	Bucket   *string
+	Prefix   *string
+	Region   *string
+}
+
+func (u *DatabaseBackupUpload) ValidateConfig() (err error) {
+	if u.Endpoint == nil || u.Endpoint.IsZero() {
+		return
+	}
+	if s := u.Endpoint.Scheme; s != "http" && s != "https" {
+		err = multierr.Append(err, ErrInvalid{Name: "Endpoint", Value: u.Endpoint.String(), Msg: "must be an http or https URL"})
+	}
+	if u.Bucket == nil || *u.Bucket == "" {
+		err = multierr.Append(err, ErrMissing{Name: "Bucket", Msg: "required when Endpoint is set"})
+	}
+	return
+}
+
+func (u *DatabaseBackupUpload) setFrom(f *DatabaseBackupUpload) {
+	if v := f.Endpoint; v != nil {
+		u.Endpoint = v
+	}
+	if v := f.Bucket; v != nil {
+		u.Bucket = v
+	}
+	if v := f.Prefix; v != nil {
+		u.Prefix = v
+	}
+	if v := f.Region; v != nil {
+		u.Region = v
+	}
 }
 
 type TelemetryIngress struct {
@@ -451,12 +823,30 @@ type WebServer struct {
 	SecureCookies           *bool
 	SessionTimeout          *models.Duration
 	SessionReaperExpiration *models.Duration
+	TrustedProxies          *[]string
 
+	LDAP      WebServerLDAP      `toml:",omitempty"`
 	MFA       WebServerMFA       `toml:",omitempty"`
+	OIDC      WebServerOIDC      `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
 }
 
+func (w *WebServer) ValidateConfig() (err error) {
+	if w.TrustedProxies == nil {
+		return
+	}
+	for _, p := range *w.TrustedProxies {
+		if net.ParseIP(p) != nil {
+			continue
+		}
+		if _, _, perr := net.ParseCIDR(p); perr != nil {
+			err = multierr.Append(err, ErrInvalid{Name: "TrustedProxies", Value: p, Msg: "must be an IP address or CIDR network"})
+		}
+	}
+	return
+}
+
 func (w *WebServer) setFrom(f *WebServer) {
 	if v := f.AllowOrigins; v != nil {
 		w.AllowOrigins = v
@@ -482,12 +872,108 @@ func (w *WebServer) setFrom(f *WebServer) {
 	if v := f.SessionReaperExpiration; v != nil {
 		w.SessionReaperExpiration = v
 	}
+	if v := f.TrustedProxies; v != nil {
+		w.TrustedProxies = v
+	}
 
+	w.LDAP.setFrom(&f.LDAP)
 	w.MFA.setFrom(&f.MFA)
+	w.OIDC.setFrom(&f.OIDC)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
 }
 
+type WebServerLDAP struct {
+	Enabled                     *bool
+	ServerURL                   *models.URL
+	ReadOnlyUserDN              *string
+	BaseUserDN                  *string
+	BaseGroupDN                 *string
+	EmailAttribute              *string
+	GroupMemberAttribute        *string
+	ActiveAttribute             *string
+	ActiveAttributeAllowedValue *string
+	AdminGroups                 *[]string
+	EditGroups                  *[]string
+	RunGroups                   *[]string
+	ViewGroups                  *[]string
+	QueryTimeout                *models.Duration
+	BindCacheDuration           *models.Duration
+	SyncInterval                *models.Duration
+}
+
+func (w *WebServerLDAP) ValidateConfig() (err error) {
+	if w.Enabled == nil || !*w.Enabled {
+		return
+	}
+	if w.ServerURL == nil || w.ServerURL.String() == "" {
+		err = multierr.Append(err, ErrMissing{Name: "ServerURL", Msg: "required when LDAP is enabled"})
+	} else if s := w.ServerURL.Scheme; s != "ldap" && s != "ldaps" {
+		err = multierr.Append(err, ErrInvalid{Name: "ServerURL", Value: w.ServerURL.String(), Msg: "must be an ldap:// or ldaps:// URL"})
+	}
+	if w.ReadOnlyUserDN == nil || *w.ReadOnlyUserDN == "" {
+		err = multierr.Append(err, ErrMissing{Name: "ReadOnlyUserDN", Msg: "required when LDAP is enabled"})
+	}
+	if w.BaseUserDN == nil || *w.BaseUserDN == "" {
+		err = multierr.Append(err, ErrMissing{Name: "BaseUserDN", Msg: "required when LDAP is enabled"})
+	}
+	if w.BaseGroupDN == nil || *w.BaseGroupDN == "" {
+		err = multierr.Append(err, ErrMissing{Name: "BaseGroupDN", Msg: "required when LDAP is enabled"})
+	}
+	return
+}
+
+func (w *WebServerLDAP) setFrom(f *WebServerLDAP) {
+	if v := f.Enabled; v != nil {
+		w.Enabled = v
+	}
+	if v := f.ServerURL; v != nil {
+		w.ServerURL = v
+	}
+	if v := f.ReadOnlyUserDN; v != nil {
+		w.ReadOnlyUserDN = v
+	}
+	if v := f.BaseUserDN; v != nil {
+		w.BaseUserDN = v
+	}
+	if v := f.BaseGroupDN; v != nil {
+		w.BaseGroupDN = v
+	}
+	if v := f.EmailAttribute; v != nil {
+		w.EmailAttribute = v
+	}
+	if v := f.GroupMemberAttribute; v != nil {
+		w.GroupMemberAttribute = v
+	}
+	if v := f.ActiveAttribute; v != nil {
+		w.ActiveAttribute = v
+	}
+	if v := f.ActiveAttributeAllowedValue; v != nil {
+		w.ActiveAttributeAllowedValue = v
+	}
+	if v := f.AdminGroups; v != nil {
+		w.AdminGroups = v
+	}
+	if v := f.EditGroups; v != nil {
+		w.EditGroups = v
+	}
+	if v := f.RunGroups; v != nil {
+		w.RunGroups = v
+	}
+	if v := f.ViewGroups; v != nil {
+		w.ViewGroups = v
+	}
+	if v := f.QueryTimeout; v != nil {
+		w.QueryTimeout = v
+	}
+	if v := f.BindCacheDuration; v != nil {
+		w.BindCacheDuration = v
+	}
+	if v := f.SyncInterval; v != nil {
+		w.SyncInterval = v
+	}
+}
+
 type WebServerMFA struct {
 	RPID     *string
 	RPOrigin *string
@@ -502,6 +988,68 @@ func (w *WebServerMFA) setFrom(f *WebServerMFA) {
 	}
 }
 
+type WebServerOIDC struct {
+	Enabled     *bool
+	IssuerURL   *models.URL
+	ClientID    *string
+	RedirectURL *models.URL
+	Scopes      *[]string
+	GroupsClaim *string
+	AdminGroups *[]string
+	EditGroups  *[]string
+	RunGroups   *[]string
+	ViewGroups  *[]string
+}
+
+func (w *WebServerOIDC) ValidateConfig() (err error) {
+	if w.Enabled == nil || !*w.Enabled {
+		return
+	}
+	if w.IssuerURL == nil || w.IssuerURL.String() == "" {
+		err = multierr.Append(err, ErrMissing{Name: "IssuerURL", Msg: "required when OIDC is enabled"})
+	}
+	if w.ClientID == nil || *w.ClientID == "" {
+		err = multierr.Append(err, ErrMissing{Name: "ClientID", Msg: "required when OIDC is enabled"})
+	}
+	if w.RedirectURL == nil || w.RedirectURL.String() == "" {
+		err = multierr.Append(err, ErrMissing{Name: "RedirectURL", Msg: "required when OIDC is enabled"})
+	}
+	return
+}
+
+func (w *WebServerOIDC) setFrom(f *WebServerOIDC) {
+	if v := f.Enabled; v != nil {
+		w.Enabled = v
+	}
+	if v := f.IssuerURL; v != nil {
+		w.IssuerURL = v
+	}
+	if v := f.ClientID; v != nil {
+		w.ClientID = v
+	}
+	if v := f.RedirectURL; v != nil {
+		w.RedirectURL = v
+	}
+	if v := f.Scopes; v != nil {
+		w.Scopes = v
+	}
+	if v := f.GroupsClaim; v != nil {
+		w.GroupsClaim = v
+	}
+	if v := f.AdminGroups; v != nil {
+		w.AdminGroups = v
+	}
+	if v := f.EditGroups; v != nil {
+		w.EditGroups = v
+	}
+	if v := f.RunGroups; v != nil {
+		w.RunGroups = v
+	}
+	if v := f.ViewGroups; v != nil {
+		w.ViewGroups = v
+	}
+}
+
 type WebServerRateLimit struct {
 	Authenticated         *int64
 	AuthenticatedPeriod   *models.Duration
@@ -896,6 +1444,8 @@ type AutoPprof struct {
 	MutexProfileFraction *int64 // runtime.SetMutexProfileFraction
 	MemThreshold         *utils.FileSize
 	GoroutineThreshold   *int64
+
+	Upload AutoPprofUpload `toml:",omitempty"`
 }
 
 func (p *AutoPprof) setFrom(f *AutoPprof) {
@@ -935,6 +1485,57 @@ func (p *AutoPprof) setFrom(f *AutoPprof) {
 	if v := f.GoroutineThreshold; v != nil {
 		p.GoroutineThreshold = v
 	}
+	p.Upload.setFrom(&f.Upload)
+}
+
+// uploadProfileTypes are the profiles which may be uploaded periodically.
+var uploadProfileTypes = []string{"cpu", "allocs", "block", "goroutine", "heap", "mutex", "threadcreate"}
+
+type AutoPprofUpload struct {
+	URL          *models.URL
+	Interval     *models.Duration
+	CPUDuration  *models.Duration
+	ProfileTypes *[]string
+	Timeout      *models.Duration
+}
+
+func (u *AutoPprofUpload) ValidateConfig() (err error) {
+	if u.URL == nil || u.URL.IsZero() {
+		return
+	}
+	if s := u.URL.Scheme; s != "http" && s != "https" {
+		err = multierr.Append(err, ErrInvalid{Name: "URL", Value: u.URL.String(), Msg: "must be an http or https URL"})
+	}
+	if u.Interval != nil && u.CPUDuration != nil && u.CPUDuration.Duration() >= u.Interval.Duration() {
+		err = multierr.Append(err, ErrInvalid{Name: "CPUDuration", Value: u.CPUDuration.String(),
+			Msg: fmt.Sprintf("must be less than Interval (%s)", u.Interval.String())})
+	}
+	if u.ProfileTypes != nil {
+		for _, t := range *u.ProfileTypes {
+			if !slices.Contains(uploadProfileTypes, t) {
+				err = multierr.Append(err, ErrInvalid{Name: "ProfileTypes", Value: t, Msg: fmt.Sprintf("must be one of %s", strings.Join(uploadProfileTypes, ", "))})
+			}
+		}
+	}
+	return
+}
+
+func (u *AutoPprofUpload) setFrom(f *AutoPprofUpload) {
+	if v := f.URL; v != nil {
+		u.URL = v
+	}
+	if v := f.Interval; v != nil {
+		u.Interval = v
+	}
+	if v := f.CPUDuration; v != nil {
+		u.CPUDuration = v
+	}
+	if v := f.ProfileTypes; v != nil {
+		u.ProfileTypes = v
+	}
+	if v := f.Timeout; v != nil {
+		u.Timeout = v
+	}
 }
 
 type Pyroscope struct {
@@ -972,3 +1573,224 @@ func (s *Sentry) setFrom(f *Sentry) {
 		s.Release = f.Release
 	}
 }
+
+type Notifications struct {
+	Enabled             *bool
+	JobErrorThreshold   *uint32
+	KeyBalanceThreshold *assets.Wei
+	MaxAttempts         *uint32
+	RequestTimeout      *models.Duration
+	RetryBackoff        *models.Duration
+
+	Webhooks []NotificationWebhook `toml:",omitempty"`
+}
+
+func (n *Notifications) ValidateConfig() (err error) {
+	if n.JobErrorThreshold != nil && *n.JobErrorThreshold == 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "JobErrorThreshold", Value: 0, Msg: "must be greater than zero"})
+	}
+	if n.MaxAttempts != nil && *n.MaxAttempts == 0 {
+		err = multierr.Append(err, ErrInvalid{Name: "MaxAttempts", Value: 0, Msg: "must be greater than zero"})
+	}
+	names := UniqueStrings{}
+	for i, w := range n.Webhooks {
+		if names.IsDupe(w.Name) {
+			err = multierr.Append(err, NewErrDuplicate(fmt.Sprintf("Webhooks.%d.Name", i), *w.Name))
+		}
+	}
+	return
+}
+
+func (n *Notifications) setFrom(f *Notifications) {
+	if v := f.Enabled; v != nil {
+		n.Enabled = v
+	}
+	if v := f.JobErrorThreshold; v != nil {
+		n.JobErrorThreshold = v
+	}
+	if v := f.KeyBalanceThreshold; v != nil {
+		n.KeyBalanceThreshold = v
+	}
+	if v := f.MaxAttempts; v != nil {
+		n.MaxAttempts = v
+	}
+	if v := f.RequestTimeout; v != nil {
+		n.RequestTimeout = v
+	}
+	if v := f.RetryBackoff; v != nil {
+		n.RetryBackoff = v
+	}
+	for _, v := range f.Webhooks {
+		if i := slices.IndexFunc(n.Webhooks, func(w NotificationWebhook) bool {
+			return v.Name != nil && w.Name != nil && *w.Name == *v.Name
+		}); i == -1 {
+			n.Webhooks = append(n.Webhooks, v)
+		} else {
+			n.Webhooks[i].setFrom(&v)
+		}
+	}
+}
+
+type NotificationWebhook struct {
+	Name   *string
+	Events *[]config.NotificationEvent
+}
+
+func (w *NotificationWebhook) ValidateConfig() (err error) {
+	if w.Name == nil || *w.Name == "" {
+		err = multierr.Append(err, ErrMissing{Name: "Name", Msg: "required for all webhooks"})
+	}
+	if w.Events == nil || len(*w.Events) == 0 {
+		err = multierr.Append(err, ErrMissing{Name: "Events", Msg: "required for all webhooks"})
+	} else {
+		for _, e := range *w.Events {
+			if !e.IsValid() {
+				err = multierr.Append(err, ErrInvalid{Name: "Events", Value: e, Msg: config.ErrInvalidNotificationEvent.Error()})
+			}
+		}
+	}
+	return
+}
+
+func (w *NotificationWebhook) setFrom(f *NotificationWebhook) {
+	if v := f.Events; v != nil {
+		w.Events = v
+	}
+}
+
+// shardableJobTypes are the job types which may be split between members.
+// Webhook jobs are excluded, since they run on every member.
+var shardableJobTypes = []string{"blockhashstore", "bootstrap", "cron", "directrequest", "fluxmonitor", "keeper",
+	"offchainreporting", "offchainreporting2", "vrf"}
+
+type JobSharding struct {
+	Enabled           *bool
+	MemberName        *string
+	JobTypes          *[]string
+	HeartbeatInterval *models.Duration
+	LeaseDuration     *models.Duration
+}
+
+func (j *JobSharding) ValidateConfig() (err error) {
+	if j.JobTypes != nil {
+		for _, t := range *j.JobTypes {
+			if !slices.Contains(shardableJobTypes, t) {
+				err = multierr.Append(err, ErrInvalid{Name: "JobTypes", Value: t, Msg: fmt.Sprintf("must be one of %s", strings.Join(shardableJobTypes, ", "))})
+			}
+		}
+	}
+	if j.HeartbeatInterval != nil && j.LeaseDuration != nil && j.HeartbeatInterval.Duration() > j.LeaseDuration.Duration()/2 {
+		err = multierr.Append(err, ErrInvalid{Name: "HeartbeatInterval", Value: j.HeartbeatInterval.String(),
+			Msg: fmt.Sprintf("must be less than or equal to half of LeaseDuration (%s)", j.LeaseDuration.String())})
+	}
+	return
+}
+
+func (j *JobSharding) setFrom(f *JobSharding) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.MemberName; v != nil {
+		j.MemberName = v
+	}
+	if v := f.JobTypes; v != nil {
+		j.JobTypes = v
+	}
+	if v := f.HeartbeatInterval; v != nil {
+		j.HeartbeatInterval = v
+	}
+	if v := f.LeaseDuration; v != nil {
+		j.LeaseDuration = v
+	}
+}
+
+// secretsProviderBackends are the supported SecretsProvider.Backend values.
+var secretsProviderBackends = []string{"", "env", "file", "vault"}
+
+type SecretsProvider struct {
+	Backend         *string
+	RefreshInterval *models.Duration
+
+	File  SecretsProviderFile  `toml:",omitempty"`
+	Vault SecretsProviderVault `toml:",omitempty"`
+	Env   SecretsProviderEnv   `toml:",omitempty"`
+}
+
+func (s *SecretsProvider) ValidateConfig() (err error) {
+	if s.Backend == nil {
+		return
+	}
+	switch b := *s.Backend; b {
+	case "file":
+		if s.File.Dir == nil || *s.File.Dir == "" {
+			err = multierr.Append(err, ErrMissing{Name: "File.Dir", Msg: "required for the file backend"})
+		}
+	case "vault":
+		if s.Vault.URL == nil || s.Vault.URL.String() == "" {
+			err = multierr.Append(err, ErrMissing{Name: "Vault.URL", Msg: "required for the vault backend"})
+		}
+		if s.Vault.Path == nil || *s.Vault.Path == "" {
+			err = multierr.Append(err, ErrMissing{Name: "Vault.Path", Msg: "required for the vault backend"})
+		}
+	default:
+		if !slices.Contains(secretsProviderBackends, b) {
+			err = multierr.Append(err, ErrInvalid{Name: "Backend", Value: b, Msg: `must be one of "", "env", "file" or "vault"`})
+		}
+	}
+	return
+}
+
+func (s *SecretsProvider) setFrom(f *SecretsProvider) {
+	if v := f.Backend; v != nil {
+		s.Backend = v
+	}
+	if v := f.RefreshInterval; v != nil {
+		s.RefreshInterval = v
+	}
+	if v := f.File.Dir; v != nil {
+		s.File.Dir = v
+	}
+	if v := f.Vault.URL; v != nil {
+		s.Vault.URL = v
+	}
+	if v := f.Vault.Path; v != nil {
+		s.Vault.Path = v
+	}
+	if v := f.Env.Prefix; v != nil {
+		s.Env.Prefix = v
+	}
+}
+
+type SecretsProviderFile struct {
+	Dir *string
+}
+
+type SecretsProviderVault struct {
+	URL  *models.URL
+	Path *string
+}
+
+type SecretsProviderEnv struct {
+	Prefix *string
+}
+
+type Health struct {
+	ReadinessGates *[]string
+}
+
+func (h *Health) ValidateConfig() (err error) {
+	if h.ReadinessGates != nil {
+		for i, gate := range *h.ReadinessGates {
+			if gate == "" {
+				err = multierr.Append(err, ErrEmpty{Name: fmt.Sprintf("ReadinessGates.%d", i), Msg: "must be the name of a component"})
+			}
+		}
+	}
+	return
+}
+
+func (h *Health) setFrom(f *Health) {
+	if v := f.ReadinessGates; v != nil {
+		h.ReadinessGates = v
+	}
+}
@@ -29,6 +29,8 @@ import (
 
 	pipeline "github.com/smartcontractkit/chainlink/core/services/pipeline"
 
+	reorg "github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+
 	services "github.com/smartcontractkit/chainlink/core/services"
 
 	sessions "github.com/smartcontractkit/chainlink/core/sessions"
@@ -65,6 +67,22 @@ func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	return r0
 }
 
+// AuditLogORM provides a mock function with given fields:
+func (_m *Application) AuditLogORM() audit.ORM {
+	ret := _m.Called()
+
+	var r0 audit.ORM
+	if rf, ok := ret.Get(0).(func() audit.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.ORM)
+		}
+	}
+
+	return r0
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -368,6 +386,43 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// ReloadConfig provides a mock function with given fields: ctx
+func (_m *Application) ReloadConfig(ctx context.Context) (chainlink.ConfigReloadReport, error) {
+	ret := _m.Called(ctx)
+
+	var r0 chainlink.ConfigReloadReport
+	if rf, ok := ret.Get(0).(func(context.Context) chainlink.ConfigReloadReport); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(chainlink.ConfigReloadReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReorgORM provides a mock function with given fields:
+func (_m *Application) ReorgORM() reorg.ORM {
+	ret := _m.Called()
+
+	var r0 reorg.ORM
+	if rf, ok := ret.Get(0).(func() reorg.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(reorg.ORM)
+		}
+	}
+
+	return r0
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
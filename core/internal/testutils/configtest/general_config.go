@@ -61,6 +61,7 @@ type GeneralConfigOverrides struct {
 	GlobalEthTxResendAfterThreshold                 *time.Duration
 	GlobalEvmEIP1559DynamicFees                     null.Bool
 	GlobalEvmFinalityDepth                          null.Int
+	GlobalEvmFinalityTagEnabled                     null.Bool
 	GlobalEvmGasBumpPercent                         null.Int
 	GlobalEvmGasBumpTxDepth                         null.Int
 	GlobalEvmGasBumpWei                             *assets.Wei
@@ -81,6 +82,7 @@ type GeneralConfigOverrides struct {
 	GlobalEvmHeadTrackerSamplingInterval            *time.Duration
 	GlobalEvmLogBackfillBatchSize                   null.Int
 	GlobalEvmLogPollInterval                        *time.Duration
+	GlobalEvmLogBroadcasterUseLogPoller             null.Bool
 	GlobalEvmMaxGasPriceWei                         *assets.Wei
 	GlobalEvmMinGasPriceWei                         *assets.Wei
 	GlobalEvmNonceAutoSync                          null.Bool
@@ -673,6 +675,13 @@ func (c *TestGeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return c.GeneralConfig.GlobalEvmFinalityDepth()
 }
 
+func (c *TestGeneralConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	if c.Overrides.GlobalEvmFinalityTagEnabled.Valid {
+		return c.Overrides.GlobalEvmFinalityTagEnabled.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmFinalityTagEnabled()
+}
+
 func (c *TestGeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	if c.Overrides.GlobalEvmLogBackfillBatchSize.Valid {
 		return uint32(c.Overrides.GlobalEvmLogBackfillBatchSize.Int64), true
@@ -687,6 +696,13 @@ func (c *TestGeneralConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	return c.GeneralConfig.GlobalEvmLogPollInterval()
 }
 
+func (c *TestGeneralConfig) GlobalEvmLogBroadcasterUseLogPoller() (bool, bool) {
+	if c.Overrides.GlobalEvmLogBroadcasterUseLogPoller.Valid {
+		return c.Overrides.GlobalEvmLogBroadcasterUseLogPoller.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmLogBroadcasterUseLogPoller()
+}
+
 func (c *TestGeneralConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) {
 	if c.Overrides.GlobalEvmMaxGasPriceWei != nil {
 		return c.Overrides.GlobalEvmMaxGasPriceWei, true
@@ -11,15 +11,40 @@ const (
 	AuthLoginSuccessNo2FA   EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
 	Auth2FAEnrolled         EventID = "AUTH_2FA_ENROLLED"
 	AuthSessionDeleted      EventID = "SESSION_DELETED"
+	AuthLoginFailedOIDC     EventID = "AUTH_LOGIN_FAILED_OIDC"
+	AuthLoginSuccessOIDC    EventID = "AUTH_LOGIN_SUCCESS_OIDC"
+	OIDCUserProvisioned     EventID = "OIDC_USER_PROVISIONED"
+	AuthLoginFailedLDAP     EventID = "AUTH_LOGIN_FAILED_LDAP"
+	AuthLoginSuccessLDAP    EventID = "AUTH_LOGIN_SUCCESS_LDAP"
+	LDAPUserProvisioned     EventID = "LDAP_USER_PROVISIONED"
+	LDAPUserReactivated     EventID = "LDAP_USER_REACTIVATED"
+	LDAPUserDeactivated     EventID = "LDAP_USER_DEACTIVATED"
+	LDAPUserRoleUpdated     EventID = "LDAP_USER_ROLE_UPDATED"
+	LDAPUserLinked          EventID = "LDAP_USER_LINKED"
+
+	AuthLoginFailedDeactivated EventID = "AUTH_LOGIN_FAILED_DEACTIVATED"
 
 	PasswordResetAttemptFailedMismatch EventID = "PASSWORD_RESET_ATTEMPT_FAILED_MISMATCH"
 	PasswordResetSuccess               EventID = "PASSWORD_RESET_SUCCESS"
 
+	UserCreated EventID = "USER_CREATED"
+	UserDeleted EventID = "USER_DELETED"
+
+	RoleCreated     EventID = "ROLE_CREATED"
+	RoleUpdated     EventID = "ROLE_UPDATED"
+	RoleDeleted     EventID = "ROLE_DELETED"
+	UserRoleUpdated EventID = "USER_ROLE_UPDATED"
+
 	APITokenCreateAttemptPasswordMismatch EventID = "API_TOKEN_CREATE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenCreated                       EventID = "API_TOKEN_CREATED"
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
 
+	NamedAPITokenCreateAttemptPasswordMismatch EventID = "NAMED_API_TOKEN_CREATE_ATTEMPT_PASSWORD_MISMATCH"
+	NamedAPITokenCreated                       EventID = "NAMED_API_TOKEN_CREATED"
+	NamedAPITokenRevokeAttemptPasswordMismatch EventID = "NAMED_API_TOKEN_REVOKE_ATTEMPT_PASSWORD_MISMATCH"
+	NamedAPITokenRevoked                       EventID = "NAMED_API_TOKEN_REVOKED"
+
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
@@ -48,13 +73,22 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystorePasswordRotationAttemptFailedMismatch EventID = "KEYSTORE_PASSWORD_ROTATION_ATTEMPT_FAILED_MISMATCH"
+	KeystorePasswordRotated                       EventID = "KEYSTORE_PASSWORD_ROTATED"
+	KeystoreBackupExported                        EventID = "KEYSTORE_BACKUP_EXPORTED"
+	KeystoreBackupImported                        EventID = "KEYSTORE_BACKUP_IMPORTED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	TerraTransactionCreated  EventID = "TERRA_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
 	JobCreated EventID = "JOB_CREATED"
+	JobUpdated EventID = "JOB_UPDATED"
 	JobDeleted EventID = "JOB_DELETED"
 
+	JobShardAssigned   EventID = "JOB_SHARD_ASSIGNED"
+	JobShardUnassigned EventID = "JOB_SHARD_UNASSIGNED"
+
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
 	ChainDeleted     EventID = "CHAIN_DELETED"
@@ -72,12 +106,14 @@ const (
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
+	JobProposalReceived     EventID = "JOB_PROPOSAL_RECEIVED"
 	JobProposalSpecApproved EventID = "JOB_PROPOSAL_SPEC_APPROVED"
 	JobProposalSpecUpdated  EventID = "JOB_PROPOSAL_SPEC_UPDATED"
 	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
 	JobProposalSpecRejected EventID = "JOB_PROPOSAL_SPEC_REJECTED"
 
 	ConfigUpdated            EventID = "CONFIG_UPDATED"
+	ConfigReloaded           EventID = "CONFIG_RELOADED"
 	ConfigSqlLoggingEnabled  EventID = "CONFIG_SQL_LOGGING_ENABLED"
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
 	GlobalLogLevelSet        EventID = "GLOBAL_LOG_LEVEL_SET"
@@ -88,4 +124,6 @@ const (
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
+
+	AuditLogVerified EventID = "AUDIT_LOG_VERIFIED"
 )
@@ -0,0 +1,191 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// verifyBatchSize is the number of events loaded at a time when verifying the audit log.
+const verifyBatchSize = 1000
+
+// Event is an audit event persisted in the local audit log. Each event holds
+// the hash of the event before it, so that changing, removing or reordering
+// events breaks the chain. The hashes are HMACs keyed with a secret of the
+// node, so that the chain cannot be recomputed with access to the database
+// alone.
+type Event struct {
+	ID        int64
+	EventID   EventID     `db:"event_id"`
+	User      null.String `db:"user_email"`
+	Data      string
+	CreatedAt time.Time
+	PrevHash  []byte
+	Hash      []byte
+}
+
+// computeHash returns the HMAC of the event with key, which covers all its
+// fields but the ID and Hash.
+func (e Event) computeHash(key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	writeField(h, e.PrevHash)
+	writeField(h, []byte(e.EventID))
+	writeField(h, []byte(e.User.String))
+	writeField(h, []byte(e.CreatedAt.UTC().Format(time.RFC3339Nano)))
+	writeField(h, []byte(e.Data))
+	return h.Sum(nil)
+}
+
+// writeField writes b prefixed by its length, so that the boundaries of the
+// fields are part of the hash.
+func writeField(h hash.Hash, b []byte) {
+	var l [8]byte
+	binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+	h.Write(l[:])
+	h.Write(b)
+}
+
+// genesisHash is the previous hash of the first event.
+var genesisHash = make([]byte, sha256.Size)
+
+// EventFilter selects audit events. Zero fields match all events.
+type EventFilter struct {
+	User    string
+	EventID EventID
+	// From and To select events created in [From, To).
+	From, To time.Time
+}
+
+// Verification is the result of verifying the hash chain of the audit log.
+type Verification struct {
+	// Events is the number of events which were verified.
+	Events int64
+	// HeadID and HeadHash identify the last valid event. Recording them
+	// outside of the node detects the removal of the latest events.
+	HeadID   int64
+	HeadHash []byte
+	// InvalidEventID is the first event which was tampered with, or 0.
+	InvalidEventID int64
+	Error          string
+}
+
+// Valid returns true if no event was tampered with.
+func (v Verification) Valid() bool {
+	return v.InvalidEventID == 0
+}
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+type ORM interface {
+	// AppendEvents persists events at the end of the audit log, setting their ID and hashes.
+	AppendEvents(events []*Event, qopts ...pg.QOpt) error
+	// Events returns a page of the events matching filter, most recent first, and the total count.
+	Events(filter EventFilter, offset, limit int, qopts ...pg.QOpt) ([]Event, int, error)
+	// Verify checks the hash chain of the whole audit log.
+	Verify(qopts ...pg.QOpt) (Verification, error)
+}
+
+type orm struct {
+	q   pg.Q
+	key []byte
+}
+
+var _ ORM = &orm{}
+
+// NewORM returns an ORM which keys the hash chain with a key derived from
+// secret. Events hashed with another secret fail verification.
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig, secret []byte) ORM {
+	return &orm{pg.NewQ(db, lggr.Named("AuditLogORM"), cfg), deriveKey(secret)}
+}
+
+// deriveKey derives the key of the hash chain from secret, so that the secret
+// itself, which may have other uses, is not used as is.
+func deriveKey(secret []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte("chainlink audit log"))
+	return h.Sum(nil)
+}
+
+func (o *orm) AppendEvents(events []*Event, qopts ...pg.QOpt) error {
+	err := o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		// Serialize writers, so that the chain does not fork
+		if _, err := tx.Exec(`LOCK TABLE audit_log IN EXCLUSIVE MODE`); err != nil {
+			return errors.Wrap(err, "failed to lock audit log")
+		}
+		prevHash := genesisHash
+		err := tx.Get(&prevHash, `SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1`)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "failed to load last event")
+		}
+		for _, e := range events {
+			e.PrevHash = prevHash
+			e.Hash = e.computeHash(o.key)
+			err = tx.Get(&e.ID, `INSERT INTO audit_log (event_id, user_email, data, created_at, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`, e.EventID, e.User, e.Data, e.CreatedAt, e.PrevHash, e.Hash)
+			if err != nil {
+				return errors.Wrapf(err, "failed to insert event %s", e.EventID)
+			}
+			prevHash = e.Hash
+		}
+		return nil
+	})
+	return errors.Wrap(err, "AppendEvents failed")
+}
+
+func (o *orm) Events(filter EventFilter, offset, limit int, qopts ...pg.QOpt) (events []Event, count int, err error) {
+	const where = `WHERE ($1 = '' OR user_email = lower($1)) AND ($2 = '' OR event_id = $2)
+AND ($3::timestamptz IS NULL OR created_at >= $3) AND ($4::timestamptz IS NULL OR created_at < $4)`
+	args := []interface{}{filter.User, filter.EventID, nullTime(filter.From), nullTime(filter.To)}
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, `SELECT count(*) FROM audit_log `+where, args...); err != nil {
+			return errors.Wrap(err, "failed to count events")
+		}
+		return errors.Wrap(tx.Select(&events, `SELECT * FROM audit_log `+where+` ORDER BY id DESC LIMIT $5 OFFSET $6`, append(args, limit, offset)...), "failed to load events")
+	}, pg.OptReadOnlyTx())
+	return
+}
+
+func nullTime(t time.Time) null.Time {
+	return null.NewTime(t, !t.IsZero())
+}
+
+func (o *orm) Verify(qopts ...pg.QOpt) (v Verification, err error) {
+	q := o.q.WithOpts(qopts...)
+	v.HeadHash = genesisHash
+	for {
+		var events []Event
+		if err = q.Select(&events, `SELECT * FROM audit_log WHERE id > $1 ORDER BY id LIMIT $2`, v.HeadID, verifyBatchSize); err != nil {
+			return v, errors.Wrap(err, "failed to load events")
+		}
+		for _, e := range events {
+			switch {
+			case !bytes.Equal(e.PrevHash, v.HeadHash):
+				v.InvalidEventID = e.ID
+				v.Error = fmt.Sprintf("event %d does not follow event %d: an event was removed or reordered", e.ID, v.HeadID)
+				return v, nil
+			case !hmac.Equal(e.computeHash(o.key), e.Hash):
+				v.InvalidEventID = e.ID
+				v.Error = fmt.Sprintf("event %d was modified", e.ID)
+				return v, nil
+			}
+			v.Events++
+			v.HeadID = e.ID
+			v.HeadHash = e.Hash
+		}
+		if len(events) < verifyBatchSize {
+			return v, nil
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/sqlx"
+
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+)
+
+var testSecret = []byte("node secret")
+
+func setupORM(t *testing.T) (*sqlx.DB, audit.ORM, []*audit.Event) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	orm := audit.NewORM(db, logger.TestLogger(t), cfg, testSecret)
+
+	start := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	events := []*audit.Event{
+		{EventID: audit.UserCreated, User: null.StringFrom("alice@example.com"), Data: `{"user":"alice@example.com"}`, CreatedAt: start},
+		{EventID: audit.JobCreated, Data: `{"job":"{}"}`, CreatedAt: start.Add(time.Minute)},
+		{EventID: audit.UserDeleted, User: null.StringFrom("alice@example.com"), Data: `{"user":"alice@example.com"}`, CreatedAt: start.Add(2 * time.Minute)},
+	}
+	require.NoError(t, orm.AppendEvents(events[:1]))
+	require.NoError(t, orm.AppendEvents(events[1:]))
+	return db, orm, events
+}
+
+func TestORM_AppendEvents(t *testing.T) {
+	t.Parallel()
+
+	db, _, events := setupORM(t)
+
+	assert.Equal(t, make([]byte, 32), events[0].PrevHash)
+	for i := 1; i < len(events); i++ {
+		assert.Greater(t, events[i].ID, events[i-1].ID)
+		assert.Equal(t, events[i-1].Hash, events[i].PrevHash)
+	}
+
+	_, err := db.Exec(`UPDATE audit_log SET data = '{}' WHERE id = $1`, events[0].ID)
+	require.ErrorContains(t, err, "audit_log is append-only")
+}
+
+func TestORM_Events(t *testing.T) {
+	t.Parallel()
+
+	_, orm, events := setupORM(t)
+
+	found, count, err := orm.Events(audit.EventFilter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, found, 2)
+	assert.Equal(t, events[2].ID, found[0].ID)
+	assert.Equal(t, events[2].Hash, found[0].Hash)
+
+	found, count, err = orm.Events(audit.EventFilter{User: "Alice@example.com"}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Len(t, found, 2)
+
+	found, count, err = orm.Events(audit.EventFilter{EventID: audit.JobCreated}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, found, 1)
+	assert.Equal(t, events[1].ID, found[0].ID)
+
+	found, count, err = orm.Events(audit.EventFilter{From: events[1].CreatedAt, To: events[2].CreatedAt}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, found, 1)
+	assert.Equal(t, events[1].ID, found[0].ID)
+}
+
+func TestORM_Verify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		_, orm, events := setupORM(t)
+
+		v, err := orm.Verify()
+		require.NoError(t, err)
+		assert.True(t, v.Valid())
+		assert.Equal(t, int64(3), v.Events)
+		assert.Equal(t, events[2].ID, v.HeadID)
+		assert.Equal(t, events[2].Hash, v.HeadHash)
+	})
+
+	// the append-only triggers can be disabled by the owner of the table
+	tamper := func(t *testing.T, db *sqlx.DB, query string, args ...interface{}) {
+		pgtest.MustExec(t, db, `ALTER TABLE audit_log DISABLE TRIGGER USER`)
+		pgtest.MustExec(t, db, query, args...)
+		pgtest.MustExec(t, db, `ALTER TABLE audit_log ENABLE TRIGGER USER`)
+	}
+
+	t.Run("modified", func(t *testing.T) {
+		db, orm, events := setupORM(t)
+		tamper(t, db, `UPDATE audit_log SET user_email = 'mallory@example.com' WHERE id = $1`, events[1].ID)
+
+		v, err := orm.Verify()
+		require.NoError(t, err)
+		assert.False(t, v.Valid())
+		assert.Equal(t, events[1].ID, v.InvalidEventID)
+		assert.Equal(t, events[0].ID, v.HeadID)
+		assert.Contains(t, v.Error, "was modified")
+	})
+
+	t.Run("rehashed without the secret", func(t *testing.T) {
+		db, _, events := setupORM(t)
+		// an attacker with database access only can append a valid looking chain with another key
+		other := audit.NewORM(db, logger.TestLogger(t), configtest.NewGeneralConfig(t, nil), []byte("guessed secret"))
+		require.NoError(t, other.AppendEvents([]*audit.Event{{EventID: audit.JobDeleted, Data: `{}`, CreatedAt: events[2].CreatedAt}}))
+
+		v, err := audit.NewORM(db, logger.TestLogger(t), configtest.NewGeneralConfig(t, nil), testSecret).Verify()
+		require.NoError(t, err)
+		assert.False(t, v.Valid())
+		assert.Equal(t, events[2].ID, v.HeadID)
+		assert.Contains(t, v.Error, "was modified")
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		db, orm, events := setupORM(t)
+		tamper(t, db, `DELETE FROM audit_log WHERE id = $1`, events[0].ID)
+
+		v, err := orm.Verify()
+		require.NoError(t, err)
+		assert.False(t, v.Valid())
+		assert.Equal(t, events[1].ID, v.InvalidEventID)
+		assert.Contains(t, v.Error, "removed or reordered")
+	})
+}
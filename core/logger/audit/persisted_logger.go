@@ -0,0 +1,200 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// persistBatchSize is the maximum number of events appended to the audit log at once.
+const persistBatchSize = 100
+
+var promAuditEventsLost = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "audit_log_events_lost",
+	Help: "The number of audit events which could not be persisted in the local audit log before the node stopped",
+})
+
+type persistedAuditLogger struct {
+	utils.StartStopOnce
+
+	forwarder AuditLogger
+	orm       ORM
+	lggr      logger.Logger
+
+	events chan Event
+	chStop chan struct{}
+	wg     sync.WaitGroup
+
+	persistErrMu sync.RWMutex
+	persistErr   error
+}
+
+// NewPersistedAuditLogger returns an AuditLogger which appends every event to
+// the local audit log, and passes it on to forwarder.
+//
+// Events are buffered and persisted by a goroutine, which retries failed
+// appends until they succeed. Events are never dropped: when the buffer is
+// full, Audit blocks until there is room, and the logger reports itself
+// unhealthy. The buffered events are persisted on Close, and those which still
+// cannot be persisted are counted by the audit_log_events_lost metric.
+func NewPersistedAuditLogger(forwarder AuditLogger, orm ORM, lggr logger.Logger) AuditLogger {
+	return &persistedAuditLogger{
+		forwarder: forwarder,
+		orm:       orm,
+		lggr:      lggr.Named("PersistedAuditLogger"),
+		events:    make(chan Event, bufferCapacity),
+		chStop:    make(chan struct{}),
+	}
+}
+
+func (l *persistedAuditLogger) Audit(eventID EventID, data Data) {
+	l.forwarder.Audit(eventID, data)
+
+	e := Event{
+		EventID:   eventID,
+		User:      eventUser(data),
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		l.lggr.Errorw("Unable to serialize audit event data", "err", err, "eventID", eventID)
+		b, _ = json.Marshal(map[string]string{"error": err.Error()})
+	}
+	e.Data = string(b)
+
+	select {
+	case l.events <- e:
+		return
+	default:
+	}
+	l.lggr.Warnw("Audit log buffer is full, waiting for events to be persisted", "eventID", eventID)
+	select {
+	case l.events <- e:
+	case <-l.chStop:
+		l.persist([]*Event{&e})
+	}
+}
+
+// eventUser returns the user the event concerns, if the data names one.
+func eventUser(data Data) null.String {
+	for _, k := range []string{"user", "email"} {
+		if s, ok := data[k].(string); ok && s != "" {
+			return null.StringFrom(strings.ToLower(s))
+		}
+	}
+	return null.String{}
+}
+
+func (l *persistedAuditLogger) Start(ctx context.Context) error {
+	return l.StartOnce("PersistedAuditLogger", func() error {
+		if l.forwarder.Ready() == nil {
+			if err := l.forwarder.Start(ctx); err != nil {
+				return err
+			}
+		}
+		l.wg.Add(1)
+		go l.run()
+		return nil
+	})
+}
+
+func (l *persistedAuditLogger) Close() error {
+	return l.StopOnce("PersistedAuditLogger", func() (err error) {
+		close(l.chStop)
+		l.wg.Wait()
+		if l.forwarder.Ready() == nil {
+			err = l.forwarder.Close()
+		}
+		return
+	})
+}
+
+func (l *persistedAuditLogger) Healthy() error {
+	if len(l.events) == bufferCapacity {
+		return errors.New("buffer is full")
+	}
+	l.persistErrMu.RLock()
+	defer l.persistErrMu.RUnlock()
+	if l.persistErr != nil {
+		return errors.Wrap(l.persistErr, "failed to persist audit events")
+	}
+	return l.StartStopOnce.Healthy()
+}
+
+func (l *persistedAuditLogger) setPersistErr(err error) {
+	l.persistErrMu.Lock()
+	defer l.persistErrMu.Unlock()
+	l.persistErr = err
+}
+
+func (l *persistedAuditLogger) run() {
+	defer l.wg.Done()
+	for {
+		select {
+		case <-l.chStop:
+			l.persist(l.drain(nil, len(l.events)))
+			return
+		case e := <-l.events:
+			l.persist(l.drain([]*Event{&e}, persistBatchSize-1))
+		}
+	}
+}
+
+// drain appends up to max buffered events to batch, without blocking.
+func (l *persistedAuditLogger) drain(batch []*Event, max int) []*Event {
+	for i := 0; i < max; i++ {
+		select {
+		case e := <-l.events:
+			batch = append(batch, &e)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (l *persistedAuditLogger) persist(events []*Event) {
+	for len(events) > 0 {
+		n := len(events)
+		if n > persistBatchSize {
+			n = persistBatchSize
+		}
+		l.appendEvents(events[:n])
+		events = events[n:]
+	}
+}
+
+// appendEvents appends the batch to the audit log, retrying with backoff until
+// it succeeds or the logger is stopped.
+func (l *persistedAuditLogger) appendEvents(batch []*Event) {
+	b := utils.NewRedialBackoff()
+	for {
+		err := l.orm.AppendEvents(batch)
+		l.setPersistErr(err)
+		if err == nil {
+			return
+		}
+		select {
+		case <-l.chStop:
+			promAuditEventsLost.Add(float64(len(batch)))
+			l.lggr.Criticalw("Failed to persist audit events, they are lost", "err", err, "events", len(batch))
+			return
+		default:
+		}
+		l.lggr.Errorw("Failed to persist audit events, retrying", "err", err, "events", len(batch))
+		select {
+		case <-l.chStop:
+		case <-time.After(b.Duration()):
+		}
+	}
+}
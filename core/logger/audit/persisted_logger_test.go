@@ -0,0 +1,70 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/logger/audit/mocks"
+)
+
+func TestPersistedAuditLogger(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	var persisted []*audit.Event
+	orm.On("AppendEvents", mock.Anything).Run(func(args mock.Arguments) {
+		persisted = append(persisted, args.Get(0).([]*audit.Event)...)
+	}).Return(nil)
+
+	auditLogger := audit.NewPersistedAuditLogger(audit.NoopLogger, orm, logger.TestLogger(t))
+	require.NoError(t, auditLogger.Start(testutils.Context(t)))
+	auditLogger.Audit(audit.UserCreated, audit.Data{"user": "Alice@example.com", "role": "admin"})
+	auditLogger.Audit(audit.JobDeleted, audit.Data{"id": 1})
+	// buffered events are persisted on close
+	require.NoError(t, auditLogger.Close())
+
+	require.Len(t, persisted, 2)
+	assert.Equal(t, audit.UserCreated, persisted[0].EventID)
+	assert.Equal(t, "alice@example.com", persisted[0].User.String)
+	assert.JSONEq(t, `{"user":"Alice@example.com","role":"admin"}`, persisted[0].Data)
+	assert.Equal(t, audit.JobDeleted, persisted[1].EventID)
+	assert.False(t, persisted[1].User.Valid)
+	assert.JSONEq(t, `{"id":1}`, persisted[1].Data)
+}
+
+func TestPersistedAuditLogger_Retry(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	failed := make(chan struct{})
+	orm.On("AppendEvents", mock.Anything).Return(errors.New("db down")).Run(func(mock.Arguments) { close(failed) }).Once()
+	persisted := make(chan []*audit.Event, 1)
+	orm.On("AppendEvents", mock.Anything).Run(func(args mock.Arguments) {
+		persisted <- args.Get(0).([]*audit.Event)
+	}).Return(nil).Once()
+
+	auditLogger := audit.NewPersistedAuditLogger(audit.NoopLogger, orm, logger.TestLogger(t))
+	require.NoError(t, auditLogger.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, auditLogger.Close()) })
+	auditLogger.Audit(audit.UserCreated, audit.Data{"user": "alice@example.com"})
+
+	<-failed
+	assert.Eventually(t, func() bool { return auditLogger.Healthy() != nil }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	// the failed event is not dropped
+	select {
+	case events := <-persisted:
+		require.Len(t, events, 1)
+		assert.Equal(t, audit.UserCreated, events[0].EventID)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("event was not retried")
+	}
+	assert.Eventually(t, func() bool { return auditLogger.Healthy() == nil }, testutils.WaitTimeout(t), 10*time.Millisecond)
+}
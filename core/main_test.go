@@ -93,10 +93,15 @@ func ExampleRun_admin() {
 	//    core.test admin command [command options] [arguments...]
 	//
 	// COMMANDS:
-	//    chpass  Change your API password remotely
-	//    login   Login to remote client by creating a session cookie
-	//    logout  Delete any local sessions
-	//    users   Create, edit permissions, or delete API users
+	//    chpass      Change your API password remotely
+	//    login       Login to remote client by creating a session cookie
+	//    logout      Delete any local sessions
+	//    users       Create, edit permissions, or delete API users
+	//    tokens      Create, list, or revoke named API tokens of your user, e.g. for automation
+	//    roles       Create, edit, or delete custom roles, which grant a set of permissions to API users
+	//    audit-log   List or verify the tamper-evident audit log of the node
+	//    config      Commands for the configuration of the running node
+	//    db-queries  List the slowest SQL statements executed by the node since it started, with the functions which issued them
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -170,6 +175,8 @@ func ExampleRun_config() {
 	//    loglevel     Set log level
 	//    logsql       Enable/disable sql statement logging
 	//    validate     Validate provided TOML config file, and print the full effective configuration, with defaults included [Only supported with TOML]
+	//    diff         Compare the effective configuration of the given TOML config file with the running node's, and list the fields which differ
+	//    lint         Report deprecated fields, values equal to their defaults, unsafe values and unknown chain IDs in the given TOML config file, or in the provided TOML config
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -185,11 +192,14 @@ func ExampleRun_jobs() {
 	//    core.test jobs command [command options] [arguments...]
 	//
 	// COMMANDS:
-	//    list    List all jobs
-	//    show    Show a job
-	//    create  Create a job
-	//    delete  Delete a job
-	//    run     Trigger a job run
+	//    list      List all jobs
+	//    show      Show a job
+	//    create    Create a job
+	//    delete    Delete a job
+	//    run       Trigger a job run
+	//    members   List the nodes sharing jobs, starting with the leader
+	//    assign    Assign a job to a node sharing jobs, by job ID and member name
+	//    unassign  Remove the assignment of a job to a node sharing jobs
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -205,17 +215,19 @@ func ExampleRun_keys() {
 	//    core.test keys command [command options] [arguments...]
 	//
 	// COMMANDS:
-	//    eth         Remote commands for administering the node's Ethereum keys
-	//    p2p         Remote commands for administering the node's p2p keys
-	//    csa         Remote commands for administering the node's CSA keys
-	//    ocr         Remote commands for administering the node's legacy off chain reporting keys
-	//    ocr2        Remote commands for administering the node's off chain reporting keys
-	//    solana      Remote commands for administering the node's Solana keys
-	//    terra       Remote commands for administering the node's Terra keys
-	//    starknet    Remote commands for administering the node's StarkNet keys
-	//    dkgsign     Remote commands for administering the node's DKGSign keys
-	//    dkgencrypt  Remote commands for administering the node's DKGEncrypt keys
-	//    vrf         Remote commands for administering the node's vrf keys
+	//    eth              Remote commands for administering the node's Ethereum keys
+	//    p2p              Remote commands for administering the node's p2p keys
+	//    csa              Remote commands for administering the node's CSA keys
+	//    ocr              Remote commands for administering the node's legacy off chain reporting keys
+	//    ocr2             Remote commands for administering the node's off chain reporting keys
+	//    solana           Remote commands for administering the node's Solana keys
+	//    terra            Remote commands for administering the node's Terra keys
+	//    starknet         Remote commands for administering the node's StarkNet keys
+	//    dkgsign          Remote commands for administering the node's DKGSign keys
+	//    dkgencrypt       Remote commands for administering the node's DKGEncrypt keys
+	//    vrf              Remote commands for administering the node's vrf keys
+	//    rotate-password  Re-encrypt the whole keystore with a new password. The old ciphertext is kept as a backup.
+	//    backup           Remote commands for backing up and restoring the node's whole keystore
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -455,7 +467,7 @@ func ExampleRun_node() {
 	//    start, node, n            Run the Chainlink node
 	//    rebroadcast-transactions  Manually rebroadcast txs matching nonce range with the specified gas price. This is useful in emergencies e.g. high gas prices and/or network congestion to forcibly clear out the pending TX queue
 	//    status                    Displays the health of various services running inside the node.
-	//    profile                   Collects profile metrics from the node.
+	//    profile                   Collects profile metrics and a support archive from the node, into a single zip archive.
 	//    db                        Commands for managing the database.
 	//
 	// OPTIONS:
@@ -492,6 +504,8 @@ func ExampleRun_node_db() {
 	//    status    Display the current database migration status.
 	//    migrate   Migrate the database to the latest version.
 	//    rollback  Roll back the database to a previous <version>. Rolls back a single migration if no version specified.
+	//    restore   Restore a database backup taken by the node into an empty database, or one with the schema version of the backup.
+	//    backups   List the local and uploaded database backups.
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -501,14 +515,14 @@ func ExampleRun_node_profile() {
 	Run("node", "profile", "--help")
 	// Output:
 	// NAME:
-	//    core.test node profile - Collects profile metrics from the node.
+	//    core.test node profile - Collects profile metrics and a support archive from the node, into a single zip archive.
 	//
 	// USAGE:
 	//    core.test node profile [command options] [arguments...]
 	//
 	// OPTIONS:
 	//    --seconds value, -s value     duration of profile capture (default: 8)
-	//    --output_dir value, -o value  output directory of the captured profile (default: "/tmp/")
+	//    --output_dir value, -o value  output directory of the captured archive (default: "/tmp/")
 }
 
 func ExampleRun_txs() {
@@ -26,12 +26,15 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmcfg "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/chains/solana"
 	"github.com/smartcontractkit/chainlink/core/chains/starknet"
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/config/secretsprovider"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services"
@@ -43,6 +46,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
@@ -53,11 +57,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/promreporter"
 	"github.com/smartcontractkit/chainlink/core/services/relay"
 	evmrelay "github.com/smartcontractkit/chainlink/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/core/services/sharding"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -75,6 +81,8 @@ type Application interface {
 	// ConfigDump returns a TOML configuration from the current environment and database configuration.
 	ConfigDump(context.Context) (string, error)
 	SetLogLevel(lvl zapcore.Level) error
+	// ReloadConfig reloads the TOML configuration, and applies the changes which are safe to apply live.
+	ReloadConfig(ctx context.Context) (ConfigReloadReport, error)
 	GetKeyStore() keystore.Master
 	GetEventBroadcaster() pg.EventBroadcaster
 	WakeSessionReaper()
@@ -91,6 +99,8 @@ type Application interface {
 	BridgeORM() bridges.ORM
 	SessionORM() sessions.ORM
 	TxmORM() txmgr.ORM
+	ReorgORM() reorg.ORM
+	AuditLogORM() audit.ORM
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
@@ -124,6 +134,8 @@ type ChainlinkApplication struct {
 	bridgeORM                bridges.ORM
 	sessionORM               sessions.ORM
 	txmORM                   txmgr.ORM
+	reorgORM                 reorg.ORM
+	auditLogORM              audit.ORM
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   config.GeneralConfig
@@ -161,6 +173,7 @@ type ApplicationOpts struct {
 	RestrictedHTTPClient     *http.Client
 	UnrestrictedHTTPClient   *http.Client
 	SecretGenerator          SecretGenerator
+	JobSharding              *sharding.Coordinator // nil if disabled
 }
 
 // Chains holds a ChainSet for each type of chain.
@@ -194,7 +207,6 @@ func (c *Chains) services() (s []services.ServiceCtx) {
 // TODO: Inject more dependencies here to save booting up useless stuff in tests
 func NewApplication(opts ApplicationOpts) (Application, error) {
 	var srvcs []services.ServiceCtx
-	auditLogger := opts.AuditLogger
 	db := opts.SqlxDB
 	cfg := opts.Config
 	chains := opts.Chains
@@ -206,9 +218,18 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	restrictedHTTPClient := opts.RestrictedHTTPClient
 	unrestrictedHTTPClient := opts.UnrestrictedHTTPClient
 
-	// If the audit logger is enabled
-	if auditLogger.Ready() == nil {
-		srvcs = append(srvcs, auditLogger)
+	// Every audit event is persisted in the local audit log, and forwarded if the audit logger is enabled
+	secret, err := opts.SecretGenerator.Generate(cfg.RootDir())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the node secret for the audit log")
+	}
+	auditLogORM := audit.NewORM(db, globalLogger, cfg, secret)
+	auditLogger := audit.NewPersistedAuditLogger(opts.AuditLogger, auditLogORM, globalLogger)
+	srvcs = append(srvcs, auditLogger)
+
+	if cfg.SecretsProviderBackend() != "" && cfg.SecretsProviderRefreshInterval() > 0 {
+		globalLogger.Infow("SecretsProvider: rotatable secrets are refreshed periodically", "backend", cfg.SecretsProviderBackend(), "refreshInterval", cfg.SecretsProviderRefreshInterval())
+		srvcs = append(srvcs, secretsprovider.NewRefresher(cfg, globalLogger))
 	}
 
 	var profiler *pyroscope.Profiler
@@ -235,7 +256,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Info("Nurse service (automatic pprof profiling) is disabled")
 	}
 
-	healthChecker := services.NewChecker()
+	healthChecker := services.NewChecker(cfg.HealthReadinessGates()...)
 
 	telemetryIngressClient := synchronization.TelemetryIngressClient(&synchronization.NoopTelemetryIngressClient{})
 	telemetryIngressBatchClient := synchronization.TelemetryIngressBatchClient(&synchronization.NoopTelemetryIngressBatchClient{})
@@ -278,7 +299,18 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Info("DatabaseBackup: periodic database backups are disabled. To enable automatic backups, set DATABASE_BACKUP_MODE=lite or DATABASE_BACKUP_MODE=full")
 	}
 
-	srvcs = append(srvcs, eventBroadcaster, mailMon)
+	if replicaURL := cfg.DatabaseReadReplicaURL(); replicaURL != nil {
+		srvcs = append(srvcs, pg.NewReadReplica(db, *replicaURL, cfg, globalLogger))
+	}
+
+	srvcs = append(srvcs, pg.NewStatsReporter(db, cfg, globalLogger), eventBroadcaster, mailMon)
+	// The coordinator joins the shard before the chains start, so that they
+	// know whether this node is the leader.
+	var sharder job.Sharder
+	if opts.JobSharding != nil {
+		sharder = opts.JobSharding
+		srvcs = append(srvcs, opts.JobSharding)
+	}
 	srvcs = append(srvcs, chains.services()...)
 	promReporter := promreporter.NewPromReporter(db.DB, globalLogger)
 	srvcs = append(srvcs, promReporter)
@@ -290,8 +322,16 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
 		jobORM         = job.NewORM(db, chains.EVM, pipelineORM, bridgeORM, keyStore, globalLogger, cfg)
 		txmORM         = txmgr.NewORM(db, globalLogger, cfg)
+		reorgORM       = reorg.NewORM(db, globalLogger, cfg)
+		sessionReaper  = sessions.NewSessionReaper(db.DB, cfg, globalLogger)
 	)
 
+	if cfg.LDAPEnabled() {
+		ldapORM := ldapauth.NewORM(sessionORM, cfg, sessionReaper, globalLogger, auditLogger)
+		srvcs = append(srvcs, ldapORM)
+		sessionORM = ldapORM
+	}
+
 	for _, chain := range chains.EVM.Chains() {
 		chain.HeadBroadcaster().Subscribe(promReporter)
 		chain.TxManager().RegisterResumeCallback(pipelineRunner.ResumeRun)
@@ -433,13 +473,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	for _, c := range chains.EVM.Chains() {
 		lbs = append(lbs, c.LogBroadcaster())
 	}
-	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, db, globalLogger, lbs)
+	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, db, globalLogger, lbs, sharder)
 	srvcs = append(srvcs, jobSpawner, pipelineRunner)
 
 	// We start the log poller after the job spawner
 	// so jobs have a chance to apply their initial log filters.
-	if cfg.FeatureLogPoller() {
-		for _, c := range chains.EVM.Chains() {
+	for _, c := range chains.EVM.Chains() {
+		if cfg.FeatureLogPoller() || c.Config().EvmLogBroadcasterUseLogPoller() {
 			srvcs = append(srvcs, c.LogPoller())
 		}
 	}
@@ -454,12 +494,24 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			globalLogger.Warnw("Unable to load feeds service; no default chain available", "err", err)
 			feedsService = &feeds.NullService{}
 		} else {
-			feedsService = feeds.NewService(feedsORM, jobORM, db, jobSpawner, keyStore, chain.Config(), chains.EVM, globalLogger, opts.Version)
+			feedsService = feeds.NewService(feedsORM, jobORM, db, jobSpawner, keyStore, chain.Config(), chains.EVM, globalLogger, auditLogger, opts.Version)
 		}
 	} else {
 		feedsService = &feeds.NullService{}
 	}
 
+	if cfg.NotificationsEnabled() {
+		notifier, err := notifications.NewNotifier(cfg, notifications.NewORM(db, globalLogger, cfg), eventBroadcaster, jobORM, txmORM,
+			feeds.NewORM(db, globalLogger, cfg), unrestrictedHTTPClient, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize notifications")
+		}
+		if opts.JobSharding != nil {
+			notifier.SetLeaderCheck(opts.JobSharding.IsLeader)
+		}
+		srvcs = append(srvcs, notifier)
+	}
+
 	app := &ChainlinkApplication{
 		Chains:                   chains,
 		EventBroadcaster:         eventBroadcaster,
@@ -470,11 +522,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		bridgeORM:                bridgeORM,
 		sessionORM:               sessionORM,
 		txmORM:                   txmORM,
+		reorgORM:                 reorgORM,
+		auditLogORM:              auditLogORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
 		KeyStore:                 keyStore,
-		SessionReaper:            sessions.NewSessionReaper(db.DB, cfg, globalLogger),
+		SessionReaper:            sessionReaper,
 		ExternalInitiatorManager: externalInitiatorManager,
 		explorerClient:           explorerClient,
 		HealthChecker:            healthChecker,
@@ -509,6 +563,31 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+// ReloadConfig reloads the TOML configuration, and applies the live changes to
+// the logger and the EVM chains. See ConfigReloader.
+func (app *ChainlinkApplication) ReloadConfig(ctx context.Context) (report ConfigReloadReport, err error) {
+	reloader, ok := app.Config.(ConfigReloader)
+	if !ok {
+		return report, errors.New("configuration reload is unsupported with legacy ENV config")
+	}
+	report, err = reloader.ReloadConfig()
+	if err != nil {
+		return report, err
+	}
+	for _, path := range report.Applied {
+		if path == "Log.Level" {
+			app.logger.SetLogLevel(app.Config.LogLevel())
+		}
+	}
+	if h, ok := app.Config.(evmcfg.HasEVMConfigs); ok && app.Chains.EVM != nil {
+		if err = app.Chains.EVM.ReloadConfigs(ctx, h.EVMConfigs()); err != nil {
+			return report, errors.Wrap(err, "failed to apply EVM configuration")
+		}
+	}
+	app.logger.Infow("Reloaded configuration", "applied", report.Applied, "restartRequired", report.RestartRequired)
+	return report, nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -660,6 +739,14 @@ func (app *ChainlinkApplication) TxmORM() txmgr.ORM {
 	return app.txmORM
 }
 
+func (app *ChainlinkApplication) ReorgORM() reorg.ORM {
+	return app.reorgORM
+}
+
+func (app *ChainlinkApplication) AuditLogORM() audit.ORM {
+	return app.auditLogORM
+}
+
 func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	return app.ExternalInitiatorManager
 }
@@ -777,13 +864,12 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	if err != nil {
 		return err
 	}
-	chain.LogBroadcaster().ReplayFromBlock(int64(number), forceBroadcast)
-	if app.Config.FeatureLogPoller() {
-		if err := chain.LogPoller().Replay(context.Background(), int64(number)); err != nil {
-			return err
-		}
+	if app.Config.FeatureLogPoller() || chain.Config().EvmLogBroadcasterUseLogPoller() {
+		// The log poller is replayed first, so that a log broadcaster reading from it finds the replayed logs.
+		err = chain.LogPoller().Replay(context.Background(), int64(number))
 	}
-	return nil
+	chain.LogBroadcaster().ReplayFromBlock(int64(number), forceBroadcast)
+	return err
 }
 
 // GetChains returns Chains.
@@ -130,6 +130,12 @@ func (s *Secrets) setEnv() error {
 			return err
 		}
 	}
+	if dbReadReplicaURL := config.EnvDatabaseReadReplicaURL.Get(); dbReadReplicaURL != "" {
+		s.Database.ReadReplicaURL = new(models.SecretURL)
+		if err := s.Database.ReadReplicaURL.UnmarshalText([]byte(dbReadReplicaURL)); err != nil {
+			return err
+		}
+	}
 	if config.EnvDatabaseAllowSimplePasswords.IsTrue() {
 		s.Database.AllowSimplePasswords = true
 	}
@@ -148,5 +154,8 @@ func (s *Secrets) setEnv() error {
 	if pyroscopeAuthToken := config.EnvPyroscopeAuthToken.Get(); pyroscopeAuthToken != "" {
 		s.Pyroscope.AuthToken = &pyroscopeAuthToken
 	}
+	if uploadAuthToken := config.EnvAutoPprofUploadAuthToken.Get(); uploadAuthToken != "" {
+		s.AutoPprof.UploadAuthToken = &uploadAuthToken
+	}
 	return nil
 }
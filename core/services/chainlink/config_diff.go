@@ -0,0 +1,111 @@
+package chainlink
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+
+	config "github.com/smartcontractkit/chainlink/core/config/v2"
+)
+
+// ConfigFieldDiff is a field which differs between two TOML configurations.
+type ConfigFieldDiff struct {
+	// Field is the dotted TOML path, like EVM.0.GasEstimator.PriceMax.
+	Field string
+	// A and B are the TOML encoded values, or empty if unset.
+	A, B string
+}
+
+// EffectiveConfigTOML returns the effective TOML config, with defaults applied, for the given TOML config.
+func EffectiveConfigTOML(s string) (string, error) {
+	var c Config
+	if err := config.DecodeTOML(strings.NewReader(s), &c); err != nil {
+		return "", fmt.Errorf("failed to decode config TOML: %w", err)
+	}
+	c.setDefaults()
+	return c.TOMLString()
+}
+
+// DiffConfigTOML returns the fields which differ between the TOML documents a and b, sorted by path.
+func DiffConfigTOML(a, b string) ([]ConfigFieldDiff, error) {
+	fa, err := flattenTOML(a)
+	if err != nil {
+		return nil, err
+	}
+	fb, err := flattenTOML(b)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []ConfigFieldDiff
+	for k, va := range fa {
+		if vb, ok := fb[k]; !ok || va != vb {
+			diffs = append(diffs, ConfigFieldDiff{Field: k, A: va, B: vb})
+		}
+	}
+	for k, vb := range fb {
+		if _, ok := fa[k]; !ok {
+			diffs = append(diffs, ConfigFieldDiff{Field: k, B: vb})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Field < diffs[j].Field })
+	return diffs, nil
+}
+
+// flattenTOML returns the TOML encoded values of the document by dotted path. Arrays of tables are indexed, like
+// EVM.0.ChainID.
+func flattenTOML(doc string) (map[string]string, error) {
+	var m map[string]any
+	if err := toml.Unmarshal([]byte(doc), &m); err != nil {
+		return nil, errors.Wrap(err, "failed to decode TOML")
+	}
+	flat := make(map[string]string)
+	flatten("", m, flat)
+	return flat, nil
+}
+
+func flatten(path string, v any, flat map[string]string) {
+	join := func(k string) string {
+		if path == "" {
+			return k
+		}
+		return path + "." + k
+	}
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			flatten(join(k), e, flat)
+		}
+	case []any:
+		if len(t) > 0 {
+			if _, ok := t[0].(map[string]any); ok {
+				for i, e := range t {
+					flatten(join(strconv.Itoa(i)), e, flat)
+				}
+				return
+			}
+		}
+		flat[path] = tomlValue(t)
+	default:
+		flat[path] = tomlValue(t)
+	}
+}
+
+// tomlValue returns v formatted like a TOML value.
+func tomlValue(v any) string {
+	switch t := v.(type) {
+	case string:
+		return strconv.Quote(t)
+	case []any:
+		vs := make([]string, len(t))
+		for i, e := range t {
+			vs[i] = tomlValue(e)
+		}
+		return "[" + strings.Join(vs, ", ") + "]"
+	default:
+		return fmt.Sprint(t)
+	}
+}
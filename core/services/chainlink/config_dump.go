@@ -217,6 +217,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].FinalityDepth = e
 		}
 	}
+	if e := envvar.NewBool("EvmFinalityTagEnabled").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].FinalityTagEnabled = e
+		}
+	}
 	if e := envvar.NewUint32("EvmHeadTrackerHistoryDepth").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].HeadTracker.HistoryDepth = e
@@ -249,6 +254,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].LogKeepBlocksDepth = e
 		}
 	}
+	if e := envvar.NewBool("EvmLogBroadcasterUseLogPoller").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].LogBroadcasterUseLogPoller = e
+		}
+	}
 	if e := envvar.NewUint32("EvmRPCDefaultBatchSize").ParsePtr(); e != nil {
 		for i := range c.EVM {
 			c.EVM[i].RPCDefaultBatchSize = e
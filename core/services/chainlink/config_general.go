@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"context"
 	"crypto/rand"
 	_ "embed"
 	"fmt"
@@ -25,12 +26,14 @@ import (
 
 	simplelogger "github.com/smartcontractkit/chainlink-relay/pkg/logger"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	evmcfg "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
 	"github.com/smartcontractkit/chainlink/core/chains/solana"
 	"github.com/smartcontractkit/chainlink/core/chains/starknet"
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	coreconfig "github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/config/parse"
+	"github.com/smartcontractkit/chainlink/core/config/secretsprovider"
 	v2 "github.com/smartcontractkit/chainlink/core/config/v2"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
@@ -66,7 +69,15 @@ type generalConfig struct {
 
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
+	liveMu   sync.RWMutex // for the fields applied live by ReloadConfig, and the TOML strings
+	reloadMu sync.Mutex   // serializes ReloadConfig
+	loader   func() (GeneralConfigOpts, error)
+
 	passwordMu sync.RWMutex // passwords are set after initialization
+
+	secretsMu       sync.RWMutex // for the rotatable secrets, refreshed from the secretsProvider
+	secretsBase     Secrets      // without the values from the secretsProvider
+	secretsProvider secretsprovider.Provider
 }
 
 // GeneralConfigOpts holds configuration options for creating a coreconfig.GeneralConfig via New().
@@ -79,6 +90,10 @@ type GeneralConfigOpts struct {
 	// OverrideFn is a *test-only* hook to override effective values.
 	OverrideFn func(*Config, *Secrets)
 
+	// Loader re-reads the options from their original source, for ReloadConfig.
+	// Optional - reloading is unsupported without it.
+	Loader func() (GeneralConfigOpts, error)
+
 	SkipEnv bool
 }
 
@@ -159,6 +174,21 @@ func (o *GeneralConfigOpts) init() (*generalConfig, error) {
 		}
 	}
 
+	secretsBase := o.Secrets
+	secretsProvider, err := newSecretsProvider(o.Config.SecretsProvider, o.Secrets.SecretsProvider)
+	if err != nil {
+		return nil, err
+	}
+	if secretsProvider != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), secretsprovider.DefaultVaultTimeout)
+		defer cancel()
+		s, err2 := secretsprovider.Load(ctx, secretsProvider)
+		if err2 != nil {
+			return nil, err2
+		}
+		o.Secrets.SetFrom(&s)
+	}
+
 	if fn := o.OverrideFn; fn != nil {
 		fn(&o.Config, &o.Secrets)
 	}
@@ -176,6 +206,8 @@ func (o *GeneralConfigOpts) init() (*generalConfig, error) {
 	cfg := &generalConfig{
 		inputTOML: input, effectiveTOML: effective, secretsTOML: secrets,
 		c: &o.Config, secrets: &o.Secrets,
+		secretsBase: secretsBase, secretsProvider: secretsProvider,
+		loader: o.Loader,
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
@@ -189,6 +221,8 @@ func (o *GeneralConfigOpts) init() (*generalConfig, error) {
 }
 
 func (g *generalConfig) EVMConfigs() evmcfg.EVMConfigs {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return g.c.EVM
 }
 
@@ -266,6 +300,8 @@ func validateEnv() (err error) {
 }
 
 func (g *generalConfig) LogConfiguration(log coreconfig.LogFn) {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	log("Secrets:\n", g.secretsTOML)
 	log("Input Configuration:\n", g.inputTOML)
 	log("Effective Configuration, with defaults applied:\n", g.effectiveTOML)
@@ -273,6 +309,8 @@ func (g *generalConfig) LogConfiguration(log coreconfig.LogFn) {
 
 // ConfigTOML implements chainlink.ConfigV2
 func (g *generalConfig) ConfigTOML() (user, effective string) {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return g.inputTOML, g.effectiveTOML
 }
 
@@ -309,7 +347,7 @@ func (g *generalConfig) AutoPprofEnabled() bool {
 }
 
 func (g *generalConfig) EVMEnabled() bool {
-	for _, c := range g.c.EVM {
+	for _, c := range g.EVMConfigs() {
 		if c.IsEnabled() {
 			return true
 		}
@@ -318,7 +356,7 @@ func (g *generalConfig) EVMEnabled() bool {
 }
 
 func (g *generalConfig) EVMRPCEnabled() bool {
-	for _, c := range g.c.EVM {
+	for _, c := range g.EVMConfigs() {
 		if c.IsEnabled() {
 			if len(c.Nodes) > 0 {
 				return true
@@ -329,7 +367,7 @@ func (g *generalConfig) EVMRPCEnabled() bool {
 }
 
 func (g *generalConfig) DefaultChainID() *big.Int {
-	for _, c := range g.c.EVM {
+	for _, c := range g.EVMConfigs() {
 		if c.IsEnabled() {
 			return (*big.Int)(c.ChainID)
 		}
@@ -338,7 +376,7 @@ func (g *generalConfig) DefaultChainID() *big.Int {
 }
 
 func (g *generalConfig) EthereumHTTPURL() *url.URL {
-	for _, c := range g.c.EVM {
+	for _, c := range g.EVMConfigs() {
 		if c.IsEnabled() {
 			for _, n := range c.Nodes {
 				if n.SendOnly == nil || !*n.SendOnly {
@@ -351,7 +389,7 @@ func (g *generalConfig) EthereumHTTPURL() *url.URL {
 
 }
 func (g *generalConfig) EthereumSecondaryURLs() (us []url.URL) {
-	for _, c := range g.c.EVM {
+	for _, c := range g.EVMConfigs() {
 		if c.IsEnabled() {
 			for _, n := range c.Nodes {
 				if n.HTTPURL != nil {
@@ -364,7 +402,7 @@ func (g *generalConfig) EthereumSecondaryURLs() (us []url.URL) {
 
 }
 func (g *generalConfig) EthereumURL() string {
-	for _, c := range g.c.EVM {
+	for _, c := range g.EVMConfigs() {
 		if c.IsEnabled() {
 			for _, n := range c.Nodes {
 				if n.SendOnly == nil || !*n.SendOnly {
@@ -442,10 +480,14 @@ func (g *generalConfig) AuditLoggerJsonWrapperKey() string {
 }
 
 func (g *generalConfig) AuthenticatedRateLimit() int64 {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return *g.c.WebServer.RateLimit.Authenticated
 }
 
 func (g *generalConfig) AuthenticatedRateLimitPeriod() models.Duration {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return *g.c.WebServer.RateLimit.AuthenticatedPeriod
 }
 
@@ -497,6 +539,29 @@ func (g *generalConfig) AutoPprofProfileRoot() string {
 	return s
 }
 
+func (g *generalConfig) AutoPprofUploadCPUDuration() models.Duration {
+	return *g.c.AutoPprof.Upload.CPUDuration
+}
+
+func (g *generalConfig) AutoPprofUploadInterval() models.Duration {
+	return *g.c.AutoPprof.Upload.Interval
+}
+
+func (g *generalConfig) AutoPprofUploadProfileTypes() []string {
+	return *g.c.AutoPprof.Upload.ProfileTypes
+}
+
+func (g *generalConfig) AutoPprofUploadTimeout() models.Duration {
+	return *g.c.AutoPprof.Upload.Timeout
+}
+
+func (g *generalConfig) AutoPprofUploadURL() *url.URL {
+	if g.c.AutoPprof.Upload.URL.IsZero() {
+		return nil
+	}
+	return g.c.AutoPprof.Upload.URL.URL()
+}
+
 func (g *generalConfig) BlockBackfillDepth() uint64 { panic(v2.ErrUnsupported) }
 
 func (g *generalConfig) BlockBackfillSkip() bool { panic(v2.ErrUnsupported) }
@@ -524,10 +589,25 @@ func (g *generalConfig) DatabaseBackupDir() string {
 	return *g.c.Database.Backup.Dir
 }
 
+func (g *generalConfig) DatabaseBackupExcludeTableData() []string {
+	if v := g.c.Database.Backup.ExcludeTableData; v != nil {
+		return *v
+	}
+	return nil
+}
+
 func (g *generalConfig) DatabaseBackupFrequency() time.Duration {
 	return g.c.Database.Backup.Frequency.Duration()
 }
 
+func (g *generalConfig) DatabaseBackupMaxAge() time.Duration {
+	return g.c.Database.Backup.MaxAge.Duration()
+}
+
+func (g *generalConfig) DatabaseBackupMaxBackups() uint32 {
+	return *g.c.Database.Backup.MaxBackups
+}
+
 func (g *generalConfig) DatabaseBackupMode() coreconfig.DatabaseBackupMode {
 	return *g.c.Database.Backup.Mode
 }
@@ -536,6 +616,21 @@ func (g *generalConfig) DatabaseBackupOnVersionUpgrade() bool {
 	return *g.c.Database.Backup.OnVersionUpgrade
 }
 
+func (g *generalConfig) DatabaseBackupTables() []string {
+	if v := g.c.Database.Backup.Tables; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) DatabaseReadReplicaCheckInterval() time.Duration {
+	return g.c.Database.ReadReplica.CheckInterval.Duration()
+}
+
+func (g *generalConfig) DatabaseReadReplicaMaxLag() time.Duration {
+	return g.c.Database.ReadReplica.MaxLag.Duration()
+}
+
 func (g *generalConfig) DatabaseListenerMaxReconnectDuration() time.Duration {
 	return g.c.Database.Listener.MaxReconnectDuration.Duration()
 }
@@ -548,6 +643,30 @@ func (g *generalConfig) MigrateDatabase() bool {
 	return *g.c.Database.MigrateOnStartup
 }
 
+func (g *generalConfig) NotificationsEnabled() bool {
+	return *g.c.Notifications.Enabled
+}
+
+func (g *generalConfig) NotificationsJobErrorThreshold() uint32 {
+	return *g.c.Notifications.JobErrorThreshold
+}
+
+func (g *generalConfig) NotificationsKeyBalanceThreshold() *assets.Wei {
+	return g.c.Notifications.KeyBalanceThreshold
+}
+
+func (g *generalConfig) NotificationsMaxAttempts() uint32 {
+	return *g.c.Notifications.MaxAttempts
+}
+
+func (g *generalConfig) NotificationsRequestTimeout() time.Duration {
+	return g.c.Notifications.RequestTimeout.Duration()
+}
+
+func (g *generalConfig) NotificationsRetryBackoff() time.Duration {
+	return g.c.Notifications.RetryBackoff.Duration()
+}
+
 func (g *generalConfig) ORMMaxIdleConns() int {
 	return int(*g.c.Database.MaxIdleConns)
 }
@@ -569,10 +688,14 @@ func (g *generalConfig) DatabaseDefaultIdleInTxSessionTimeout() time.Duration {
 }
 
 func (g *generalConfig) DefaultHTTPLimit() int64 {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return int64(*g.c.JobPipeline.HTTPRequest.MaxSize)
 }
 
 func (g *generalConfig) DefaultHTTPTimeout() models.Duration {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return *g.c.JobPipeline.HTTPRequest.DefaultTimeout
 }
 
@@ -613,6 +736,8 @@ func (g *generalConfig) JSONConsole() bool {
 }
 
 func (g *generalConfig) JobPipelineMaxRunDuration() time.Duration {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return g.c.JobPipeline.MaxRunDuration.Duration()
 }
 
@@ -628,6 +753,49 @@ func (g *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return uint64(*g.c.JobPipeline.ResultWriteQueueDepth)
 }
 
+func (g *generalConfig) SecretsProviderBackend() string {
+	return *g.c.SecretsProvider.Backend
+}
+
+func (g *generalConfig) SecretsProviderRefreshInterval() time.Duration {
+	return g.c.SecretsProvider.RefreshInterval.Duration()
+}
+
+func (g *generalConfig) HealthReadinessGates() []string {
+	if len(*g.c.Health.ReadinessGates) == 0 {
+		return nil
+	}
+	return *g.c.Health.ReadinessGates
+}
+
+func (g *generalConfig) JobShardingEnabled() bool {
+	return *g.c.JobSharding.Enabled
+}
+
+func (g *generalConfig) JobShardingHeartbeatInterval() time.Duration {
+	return g.c.JobSharding.HeartbeatInterval.Duration()
+}
+
+func (g *generalConfig) JobShardingJobTypes() []string {
+	return *g.c.JobSharding.JobTypes
+}
+
+func (g *generalConfig) JobShardingLeaseDuration() time.Duration {
+	return g.c.JobSharding.LeaseDuration.Duration()
+}
+
+// JobShardingMemberName defaults to the hostname.
+func (g *generalConfig) JobShardingMemberName() string {
+	if v := g.c.JobSharding.MemberName; v != nil && *v != "" {
+		return *v
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return hostname
+}
+
 func (g *generalConfig) KeeperDefaultTransactionQueueDepth() uint32 {
 	return *g.c.Keeper.DefaultTransactionQueueDepth
 }
@@ -943,6 +1111,171 @@ func (g *generalConfig) Port() uint16 {
 	return *g.c.WebServer.HTTPPort
 }
 
+func (g *generalConfig) TrustedProxies() []string {
+	if v := g.c.WebServer.TrustedProxies; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) LDAPActiveAttribute() string {
+	if v := g.c.WebServer.LDAP.ActiveAttribute; v != nil {
+		return *v
+	}
+	return ""
+}
+
+func (g *generalConfig) LDAPActiveAttributeAllowedValue() string {
+	if v := g.c.WebServer.LDAP.ActiveAttributeAllowedValue; v != nil {
+		return *v
+	}
+	return ""
+}
+
+func (g *generalConfig) LDAPAdminGroups() []string {
+	if v := g.c.WebServer.LDAP.AdminGroups; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) LDAPBaseGroupDN() string {
+	if v := g.c.WebServer.LDAP.BaseGroupDN; v != nil {
+		return *v
+	}
+	return ""
+}
+
+func (g *generalConfig) LDAPBaseUserDN() string {
+	if v := g.c.WebServer.LDAP.BaseUserDN; v != nil {
+		return *v
+	}
+	return ""
+}
+
+func (g *generalConfig) LDAPBindCacheDuration() time.Duration {
+	return g.c.WebServer.LDAP.BindCacheDuration.Duration()
+}
+
+func (g *generalConfig) LDAPEditGroups() []string {
+	if v := g.c.WebServer.LDAP.EditGroups; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) LDAPEmailAttribute() string {
+	return *g.c.WebServer.LDAP.EmailAttribute
+}
+
+func (g *generalConfig) LDAPEnabled() bool {
+	return *g.c.WebServer.LDAP.Enabled
+}
+
+func (g *generalConfig) LDAPGroupMemberAttribute() string {
+	return *g.c.WebServer.LDAP.GroupMemberAttribute
+}
+
+func (g *generalConfig) LDAPQueryTimeout() time.Duration {
+	return g.c.WebServer.LDAP.QueryTimeout.Duration()
+}
+
+func (g *generalConfig) LDAPReadOnlyUserDN() string {
+	if v := g.c.WebServer.LDAP.ReadOnlyUserDN; v != nil {
+		return *v
+	}
+	return ""
+}
+
+func (g *generalConfig) LDAPRunGroups() []string {
+	if v := g.c.WebServer.LDAP.RunGroups; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) LDAPServerURL() *url.URL {
+	if g.c.WebServer.LDAP.ServerURL.IsZero() {
+		return nil
+	}
+	return g.c.WebServer.LDAP.ServerURL.URL()
+}
+
+func (g *generalConfig) LDAPSyncInterval() time.Duration {
+	return g.c.WebServer.LDAP.SyncInterval.Duration()
+}
+
+func (g *generalConfig) LDAPViewGroups() []string {
+	if v := g.c.WebServer.LDAP.ViewGroups; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) OIDCEnabled() bool {
+	return *g.c.WebServer.OIDC.Enabled
+}
+
+func (g *generalConfig) OIDCIssuerURL() *url.URL {
+	if g.c.WebServer.OIDC.IssuerURL.IsZero() {
+		return nil
+	}
+	return g.c.WebServer.OIDC.IssuerURL.URL()
+}
+
+func (g *generalConfig) OIDCClientID() string {
+	if v := g.c.WebServer.OIDC.ClientID; v != nil {
+		return *v
+	}
+	return ""
+}
+
+func (g *generalConfig) OIDCRedirectURL() *url.URL {
+	if g.c.WebServer.OIDC.RedirectURL.IsZero() {
+		return nil
+	}
+	return g.c.WebServer.OIDC.RedirectURL.URL()
+}
+
+func (g *generalConfig) OIDCScopes() []string {
+	if v := g.c.WebServer.OIDC.Scopes; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) OIDCGroupsClaim() string {
+	return *g.c.WebServer.OIDC.GroupsClaim
+}
+
+func (g *generalConfig) OIDCAdminGroups() []string {
+	if v := g.c.WebServer.OIDC.AdminGroups; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) OIDCEditGroups() []string {
+	if v := g.c.WebServer.OIDC.EditGroups; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) OIDCRunGroups() []string {
+	if v := g.c.WebServer.OIDC.RunGroups; v != nil {
+		return *v
+	}
+	return nil
+}
+
+func (g *generalConfig) OIDCViewGroups() []string {
+	if v := g.c.WebServer.OIDC.ViewGroups; v != nil {
+		return *v
+	}
+	return nil
+}
+
 func (g *generalConfig) RPID() string {
 	return *g.c.WebServer.MFA.RPID
 }
@@ -1066,10 +1399,14 @@ func (g *generalConfig) TriggerFallbackDBPollInterval() time.Duration {
 }
 
 func (g *generalConfig) UnAuthenticatedRateLimit() int64 {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return *g.c.WebServer.RateLimit.Unauthenticated
 }
 
 func (g *generalConfig) UnAuthenticatedRateLimitPeriod() models.Duration {
+	g.liveMu.RLock()
+	defer g.liveMu.RUnlock()
 	return *g.c.WebServer.RateLimit.UnauthenticatedPeriod
 }
 
@@ -44,6 +44,7 @@ func (g *generalConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 }
 func (g *generalConfig) GlobalEvmEIP1559DynamicFees() (bool, bool)      { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmFinalityDepth() (uint32, bool)         { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmFinalityTagEnabled() (bool, bool)      { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpPercent() (uint16, bool)        { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpThreshold() (uint64, bool)      { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmGasBumpTxDepth() (uint16, bool)        { panic(v2.ErrUnsupported) }
@@ -72,6 +73,9 @@ func (g *generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 func (g *generalConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
 	panic(v2.ErrUnsupported)
 }
+func (g *generalConfig) GlobalEvmLogBroadcasterUseLogPoller() (bool, bool) {
+	panic(v2.ErrUnsupported)
+}
 func (g *generalConfig) GlobalEvmMaxGasPriceWei() (*assets.Wei, bool) { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	panic(v2.ErrUnsupported)
@@ -0,0 +1,164 @@
+package chainlink
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+
+	evmcfg "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
+)
+
+// ConfigReloader is implemented by the TOML configuration, which can be
+// reloaded while the node is running.
+type ConfigReloader interface {
+	// ReloadConfig re-reads and validates the configuration, and applies the
+	// changes which are safe to apply live. The other changes are reported,
+	// and take effect after a restart.
+	ReloadConfig() (ConfigReloadReport, error)
+}
+
+// ConfigReloadReport lists the fields changed by a reload, by their dotted
+// TOML paths, like EVM.0.GasEstimator.PriceMax.
+type ConfigReloadReport struct {
+	// Applied are the changed fields which took effect immediately.
+	Applied []string
+	// RestartRequired are the changed fields which take effect after a restart.
+	RestartRequired []string
+}
+
+// liveFields are the paths of the Core fields which are applied live. A
+// trailing dot includes all of the nested fields.
+var liveFields = []string{
+	"Database.LogQueries",
+	"JobPipeline.HTTPRequest.DefaultTimeout",
+	"JobPipeline.HTTPRequest.MaxSize",
+	"JobPipeline.MaxRunDuration",
+	"Log.Level",
+	"WebServer.RateLimit.",
+}
+
+// isLive returns true if the field at path is applied live. EVM fields are
+// only applied when the chains are unchanged, since they are matched by index.
+func isLive(path string, chainsChanged bool) bool {
+	if strings.HasPrefix(path, "EVM.") {
+		if chainsChanged {
+			return false
+		}
+		_, field, _ := strings.Cut(strings.TrimPrefix(path, "EVM."), ".")
+		if field == "Nodes" || strings.HasPrefix(field, "Nodes.") {
+			return true
+		}
+		return strings.HasPrefix(field, "GasEstimator.") && field != "GasEstimator.Mode" &&
+			!strings.HasPrefix(field, "GasEstimator.BlockHistory.")
+	}
+	for _, f := range liveFields {
+		if path == f || strings.HasSuffix(f, ".") && strings.HasPrefix(path, f) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generalConfig) ReloadConfig() (report ConfigReloadReport, err error) {
+	if g.loader == nil {
+		return report, errors.New("configuration reload is unsupported without a configuration source")
+	}
+	g.reloadMu.Lock()
+	defer g.reloadMu.Unlock()
+
+	opts, err := g.loader()
+	if err != nil {
+		return report, errors.Wrap(err, "failed to load configuration")
+	}
+	opts.Loader = g.loader
+	n, err := opts.init()
+	if err != nil {
+		return report, err
+	}
+	n.lggr = g.lggr
+	if err = n.Validate(); err != nil {
+		return report, err
+	}
+
+	g.liveMu.RLock()
+	effective := g.effectiveTOML
+	chainsChanged := !sameChains(g.c.EVM, n.c.EVM)
+	g.liveMu.RUnlock()
+
+	diffs, err := DiffConfigTOML(effective, n.effectiveTOML)
+	if err != nil {
+		return report, err
+	}
+	for _, d := range diffs {
+		if isLive(d.Field, chainsChanged) {
+			report.Applied = append(report.Applied, d.Field)
+		} else {
+			report.RestartRequired = append(report.RestartRequired, d.Field)
+		}
+	}
+	if len(report.Applied) > 0 {
+		err = g.applyLive(n.c, report.Applied, chainsChanged)
+	}
+	return report, err
+}
+
+// applyLive sets the live fields from c. The EVM configs are replaced rather
+// than modified, since they are shared with the running chains.
+func (g *generalConfig) applyLive(c *Config, applied []string, chainsChanged bool) error {
+	g.liveMu.Lock()
+	defer g.liveMu.Unlock()
+
+	// Log fields are mutable via the API too, so they are only set if changed.
+	g.logMu.Lock()
+	for _, path := range applied {
+		switch path {
+		case "Log.Level":
+			g.c.Log.Level = c.Log.Level
+			g.logLevelDefault = zapcore.Level(*c.Log.Level)
+		case "Database.LogQueries":
+			g.c.Database.LogQueries = c.Database.LogQueries
+		}
+	}
+	g.logMu.Unlock()
+
+	g.c.JobPipeline.MaxRunDuration = c.JobPipeline.MaxRunDuration
+	g.c.JobPipeline.HTTPRequest.DefaultTimeout = c.JobPipeline.HTTPRequest.DefaultTimeout
+	g.c.JobPipeline.HTTPRequest.MaxSize = c.JobPipeline.HTTPRequest.MaxSize
+	g.c.WebServer.RateLimit = c.WebServer.RateLimit
+
+	if !chainsChanged {
+		chains := make(evmcfg.EVMConfigs, len(g.c.EVM))
+		for i, old := range g.c.EVM {
+			chain := *old
+			chain.GasEstimator = c.EVM[i].GasEstimator
+			chain.GasEstimator.Mode = old.GasEstimator.Mode
+			chain.GasEstimator.BlockHistory = old.GasEstimator.BlockHistory
+			chain.Nodes = c.EVM[i].Nodes
+			chains[i] = &chain
+		}
+		g.c.EVM = chains
+	}
+
+	g.logMu.RLock()
+	effective, err := g.c.TOMLString()
+	g.logMu.RUnlock()
+	if err != nil {
+		return err
+	}
+	g.effectiveTOML = effective
+	return nil
+}
+
+// sameChains returns true if a and b have the same chain IDs, in the same order.
+func sameChains(a, b evmcfg.EVMConfigs) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ChainID.String() != b[i].ChainID.String() {
+			return false
+		}
+	}
+	return true
+}
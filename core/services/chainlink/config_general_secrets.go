@@ -1,11 +1,17 @@
 package chainlink
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
 
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	coreconfig "github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/config/secretsprovider"
+	v2 "github.com/smartcontractkit/chainlink/core/config/v2"
 )
 
 func (g *generalConfig) DatabaseURL() url.URL {
@@ -19,6 +25,40 @@ func (g *generalConfig) DatabaseBackupURL() *url.URL {
 	return g.secrets.Database.BackupURL.URL()
 }
 
+func (g *generalConfig) DatabaseReadReplicaURL() *url.URL {
+	return g.secrets.Database.ReadReplicaURL.URL()
+}
+
+func (g *generalConfig) DatabaseBackupEncryptionKey() string {
+	if g.secrets.Database.BackupEncryptionKey == nil {
+		return ""
+	}
+	return string(*g.secrets.Database.BackupEncryptionKey)
+}
+
+// DatabaseBackupUpload returns the upload configuration, with the access keys
+// from the secrets.
+func (g *generalConfig) DatabaseBackupUpload() coreconfig.DatabaseBackupUpload {
+	u := g.c.Database.Backup.Upload
+	upload := coreconfig.DatabaseBackupUpload{
+		Prefix: *u.Prefix,
+		Region: *u.Region,
+	}
+	if !u.Endpoint.IsZero() {
+		upload.Endpoint = u.Endpoint.URL()
+	}
+	if u.Bucket != nil {
+		upload.Bucket = *u.Bucket
+	}
+	if v := g.secrets.Database.BackupAccessKeyID; v != nil {
+		upload.AccessKeyID = string(*v)
+	}
+	if v := g.secrets.Database.BackupSecretAccessKey; v != nil {
+		upload.SecretAccessKey = string(*v)
+	}
+	return upload
+}
+
 func (g *generalConfig) ExplorerAccessKey() string {
 	if g.secrets.Explorer.AccessKey == nil {
 		return ""
@@ -32,6 +72,12 @@ func (g *generalConfig) ExplorerSecret() string {
 	}
 	return string(*g.secrets.Explorer.Secret)
 }
+func (g *generalConfig) AutoPprofUploadAuthToken() string {
+	if g.secrets.AutoPprof.UploadAuthToken == nil {
+		return ""
+	}
+	return string(*g.secrets.AutoPprof.UploadAuthToken)
+}
 func (g *generalConfig) PyroscopeAuthToken() string {
 	if g.secrets.Pyroscope.AuthToken == nil {
 		return ""
@@ -40,6 +86,8 @@ func (g *generalConfig) PyroscopeAuthToken() string {
 }
 
 func (g *generalConfig) MercuryCredentials(url string) (username, password string, err error) {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
 	if g.secrets.Mercury.Credentials == nil {
 		return "", "", errors.New("no Mercury credentials were specified in the config")
 	}
@@ -64,3 +112,112 @@ func (g *generalConfig) MercuryCredentials(url string) (username, password strin
 	}
 	return "", "", errors.New(msg)
 }
+
+func (g *generalConfig) RemoteSignerURL() *url.URL {
+	return g.secrets.RemoteSigner.URL.URL()
+}
+
+func (g *generalConfig) RemoteSignerBearerToken() string {
+	if g.secrets.RemoteSigner.BearerToken == nil {
+		return ""
+	}
+	return string(*g.secrets.RemoteSigner.BearerToken)
+}
+
+func (g *generalConfig) OIDCClientSecret() string {
+	if g.secrets.OIDC.ClientSecret == nil {
+		return ""
+	}
+	return string(*g.secrets.OIDC.ClientSecret)
+}
+
+func (g *generalConfig) LDAPReadOnlyUserPassword() string {
+	if g.secrets.LDAP.ReadOnlyUserPassword == nil {
+		return ""
+	}
+	return string(*g.secrets.LDAP.ReadOnlyUserPassword)
+}
+
+// NotificationsWebhooks returns the configured webhooks, with their URL and
+// signing key from the secrets. Secrets missing for a webhook are left empty.
+func (g *generalConfig) NotificationsWebhooks() []coreconfig.NotificationWebhook {
+	if len(g.c.Notifications.Webhooks) == 0 {
+		return nil
+	}
+	webhooks := make([]coreconfig.NotificationWebhook, len(g.c.Notifications.Webhooks))
+	for i, w := range g.c.Notifications.Webhooks {
+		webhooks[i].Name = *w.Name
+		webhooks[i].Events = *w.Events
+		for _, s := range g.secrets.Notifications.Webhooks {
+			if s.Name == nil || *s.Name != *w.Name {
+				continue
+			}
+			webhooks[i].URL = s.URL.URL()
+			if s.SigningKey != nil {
+				webhooks[i].SigningKey = string(*s.SigningKey)
+			}
+		}
+	}
+	return webhooks
+}
+
+// BridgeBearerToken returns the bearer token of the bridge, or "" if it has none.
+func (g *generalConfig) BridgeBearerToken(name string) string {
+	g.secretsMu.RLock()
+	defer g.secretsMu.RUnlock()
+	for _, creds := range g.secrets.Bridge.Credentials {
+		if creds.Name != nil && *creds.Name == name && creds.BearerToken != nil {
+			return string(*creds.BearerToken)
+		}
+	}
+	return ""
+}
+
+// RefreshSecrets reloads the Mercury and bridge credentials from the secrets
+// provider. The previous credentials are kept if the new ones are invalid.
+func (g *generalConfig) RefreshSecrets(ctx context.Context) error {
+	if g.secretsProvider == nil {
+		return nil
+	}
+	s, err := secretsprovider.Load(ctx, g.secretsProvider)
+	if err != nil {
+		return err
+	}
+	next := g.secretsBase
+	next.SetFrom(&s)
+	if err = multierr.Combine(next.Mercury.ValidateConfig(), next.Bridge.ValidateConfig()); err != nil {
+		return errors.Wrap(err, "invalid secrets")
+	}
+	g.secretsMu.Lock()
+	g.secrets.Mercury = next.Mercury
+	g.secrets.Bridge = next.Bridge
+	g.secretsMu.Unlock()
+	return nil
+}
+
+// newSecretsProvider returns the configured secrets provider, or nil if none
+// is.
+func newSecretsProvider(c v2.SecretsProvider, s v2.SecretsProviderSecrets) (secretsprovider.Provider, error) {
+	switch b := *c.Backend; b {
+	case "":
+		return nil, nil
+	case "env":
+		return secretsprovider.NewEnvProvider(*c.Env.Prefix), nil
+	case "file":
+		if c.File.Dir == nil || *c.File.Dir == "" {
+			return nil, errors.New("SecretsProvider.File.Dir is required for the file backend")
+		}
+		return secretsprovider.NewFileProvider(*c.File.Dir), nil
+	case "vault":
+		if c.Vault.URL == nil || c.Vault.Path == nil || *c.Vault.Path == "" {
+			return nil, errors.New("SecretsProvider.Vault.URL and SecretsProvider.Vault.Path are required for the vault backend")
+		}
+		var token string
+		if s.VaultToken != nil {
+			token = string(*s.VaultToken)
+		}
+		return secretsprovider.NewVaultProvider(*c.Vault.URL.URL(), *c.Vault.Path, token), nil
+	default:
+		return nil, errors.Errorf("unknown SecretsProvider.Backend %q", b)
+	}
+}
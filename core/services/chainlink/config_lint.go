@@ -0,0 +1,102 @@
+package chainlink
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	evmcfg "github.com/smartcontractkit/chainlink/core/chains/evm/config/v2"
+	config "github.com/smartcontractkit/chainlink/core/config/v2"
+)
+
+// ConfigLintIssue is a likely mistake in a valid TOML configuration.
+type ConfigLintIssue struct {
+	// Field is the dotted TOML path, like EVM.0.FinalityDepth.
+	Field string
+	Msg   string
+}
+
+// deprecatedFields are the deprecated fields, by path prefix, with a message describing their replacement. Disabling
+// them, with an Enabled field, is not reported.
+var deprecatedFields = []struct{ prefix, msg string }{
+	{"P2P.V1.", "the V1 networking stack is deprecated and will be removed in a future release - use P2P.V2"},
+}
+
+// LintConfig returns the issues found in the TOML config s, beyond those reported by validation: deprecated fields,
+// values equal to their defaults, unsafe values, and unknown chain IDs.
+func LintConfig(s string) ([]ConfigLintIssue, error) {
+	var c Config
+	if err := config.DecodeTOML(strings.NewReader(s), &c); err != nil {
+		return nil, fmt.Errorf("failed to decode config TOML: %w", err)
+	}
+	// re-encode so that values are compared in their canonical form
+	input, err := c.TOMLString()
+	if err != nil {
+		return nil, err
+	}
+	user, err := flattenTOML(input)
+	if err != nil {
+		return nil, err
+	}
+
+	d := Config{Core: config.CoreDefaults()}
+	for _, chain := range c.EVM {
+		d.EVM = append(d.EVM, &evmcfg.EVMConfig{ChainID: chain.ChainID, Chain: evmcfg.Defaults(chain.ChainID)})
+	}
+	defaultsTOML, err := d.TOMLString()
+	if err != nil {
+		return nil, err
+	}
+	defaults, err := flattenTOML(defaultsTOML)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []ConfigLintIssue
+	for path, v := range user {
+		for _, dep := range deprecatedFields {
+			if strings.HasPrefix(path, dep.prefix) && !(path == dep.prefix+"Enabled" && v == "false") {
+				issues = append(issues, ConfigLintIssue{Field: path, Msg: "deprecated: " + dep.msg})
+			}
+		}
+		if strings.HasPrefix(path, "EVM.") && strings.HasSuffix(path, ".ChainID") {
+			continue
+		}
+		if dv, ok := defaults[path]; ok && dv == v && v != "[]" { // empty arrays are encoded for unset fields too
+			issues = append(issues, ConfigLintIssue{Field: path, Msg: fmt.Sprintf("redundant: equal to the default %s", dv)})
+		}
+	}
+	for i, chain := range c.EVM {
+		issues = append(issues, lintEVMChain(fmt.Sprintf("EVM.%d.", i), chain)...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Field < issues[j].Field })
+	return issues, nil
+}
+
+// lintEVMChain returns the issues found in chain, with fields prefixed by path.
+func lintEVMChain(path string, chain *evmcfg.EVMConfig) (issues []ConfigLintIssue) {
+	if chain.ChainID == nil {
+		return // invalid
+	}
+	def, name := evmcfg.DefaultsNamed(chain.ChainID)
+	c := evmcfg.Defaults(chain.ChainID, &chain.Chain)
+	if name == "" {
+		issues = append(issues, ConfigLintIssue{Field: path + "ChainID",
+			Msg: fmt.Sprintf("unknown chain ID %s: generic defaults are used, which may not suit this chain", chain.ChainID)})
+	} else {
+		if *c.FinalityDepth < *def.FinalityDepth {
+			issues = append(issues, ConfigLintIssue{Field: path + "FinalityDepth",
+				Msg: fmt.Sprintf("unsafe: lower than the default %d for %s, so transactions may be considered final before they are", *def.FinalityDepth, name)})
+		}
+		if *c.MinIncomingConfirmations < *def.MinIncomingConfirmations {
+			issues = append(issues, ConfigLintIssue{Field: path + "MinIncomingConfirmations",
+				Msg: fmt.Sprintf("unsafe: lower than the default %d for %s, so logs may be consumed before they are final", *def.MinIncomingConfirmations, name)})
+		}
+	}
+	if *c.LogKeepBlocksDepth <= *c.FinalityDepth+1 {
+		issues = append(issues, ConfigLintIssue{Field: path + "LogKeepBlocksDepth",
+			Msg: fmt.Sprintf("unsafe: must be greater than FinalityDepth+1 (%d), or the log poller may prune logs which are not final", *c.FinalityDepth+1)})
+	}
+	return
+}
@@ -0,0 +1,117 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		config string
+		exp    []ConfigLintIssue
+	}{
+		{"empty", ``, nil},
+		{"custom", `
+[Log]
+Level = 'warn'
+
+[[EVM]]
+ChainID = '1'
+FinalityDepth = 100
+MinContractPayment = '0.2 link'`, nil},
+		{"redundant", `
+[Log]
+Level = 'info'
+
+[[EVM]]
+ChainID = '1'
+FinalityDepth = 50
+MinContractPayment = '100000000000000000 juels'`, []ConfigLintIssue{
+			{Field: "EVM.0.FinalityDepth", Msg: "redundant: equal to the default 50"},
+			{Field: "EVM.0.MinContractPayment", Msg: `redundant: equal to the default "0.1 link"`},
+			{Field: "Log.Level", Msg: `redundant: equal to the default "info"`},
+		}},
+		{"deprecated", `
+[P2P.V1]
+Enabled = true
+ListenPort = 1337`, []ConfigLintIssue{
+			{Field: "P2P.V1.Enabled", Msg: "deprecated: the V1 networking stack is deprecated and will be removed in a future release - use P2P.V2"},
+			{Field: "P2P.V1.Enabled", Msg: "redundant: equal to the default true"},
+			{Field: "P2P.V1.ListenPort", Msg: "deprecated: the V1 networking stack is deprecated and will be removed in a future release - use P2P.V2"},
+		}},
+		{"deprecated-disabled", `
+[P2P.V1]
+Enabled = false`, nil},
+		{"unsafe", `
+[[EVM]]
+ChainID = '1'
+FinalityDepth = 10
+MinIncomingConfirmations = 1
+LogKeepBlocksDepth = 11`, []ConfigLintIssue{
+			{Field: "EVM.0.FinalityDepth", Msg: "unsafe: lower than the default 50 for Ethereum Mainnet, so transactions may be considered final before they are"},
+			{Field: "EVM.0.LogKeepBlocksDepth", Msg: "unsafe: must be greater than FinalityDepth+1 (11), or the log poller may prune logs which are not final"},
+			{Field: "EVM.0.MinIncomingConfirmations", Msg: "unsafe: lower than the default 3 for Ethereum Mainnet, so logs may be consumed before they are final"},
+		}},
+		{"unknown-chain", `
+[[EVM]]
+ChainID = '1234567'`, []ConfigLintIssue{
+			{Field: "EVM.0.ChainID", Msg: "unknown chain ID 1234567: generic defaults are used, which may not suit this chain"},
+		}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := LintConfig(tt.config)
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, issues)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := LintConfig(`[Log]
+Foo = 'bar'`)
+		require.Error(t, err)
+	})
+}
+
+func TestDiffConfigTOML(t *testing.T) {
+	a, err := EffectiveConfigTOML(`
+[Log]
+Level = 'warn'
+
+[[EVM]]
+ChainID = '1'
+
+[[EVM.Nodes]]
+Name = 'primary'
+WSURL = 'wss://primary.test/ws'`)
+	require.NoError(t, err)
+	b, err := EffectiveConfigTOML(`
+[[EVM]]
+ChainID = '1'
+FinalityDepth = 100
+
+[[EVM.Nodes]]
+Name = 'primary'
+WSURL = 'wss://primary.test/ws'
+
+[[EVM.Nodes]]
+Name = 'secondary'
+WSURL = 'wss://secondary.test/ws'`)
+	require.NoError(t, err)
+
+	diffs, err := DiffConfigTOML(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, []ConfigFieldDiff{
+		{Field: "EVM.0.FinalityDepth", A: "50", B: "100"},
+		{Field: "EVM.0.Nodes.1.Name", B: `"secondary"`},
+		{Field: "EVM.0.Nodes.1.WSURL", B: `"wss://secondary.test/ws"`},
+		{Field: "Log.Level", A: `"warn"`, B: `"info"`},
+	}, diffs)
+
+	diffs, err = DiffConfigTOML(a, a)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+}
@@ -2,8 +2,11 @@ package chainlink
 
 import (
 	_ "embed"
+	"fmt"
 	"math"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -28,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	legacy "github.com/smartcontractkit/chainlink/core/config"
 	config "github.com/smartcontractkit/chainlink/core/config/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink/cfgtest"
@@ -262,6 +266,20 @@ func TestConfig_Marshal(t *testing.T) {
 			Frequency:        &hour,
 			Mode:             &legacy.DatabaseBackupModeFull,
 			OnVersionUpgrade: ptr(true),
+			Tables:           &[]string{"jobs", "bridge_types"},
+			ExcludeTableData: &[]string{"logs"},
+			MaxBackups:       ptr[uint32](7),
+			MaxAge:           models.MustNewDuration(30 * 24 * time.Hour),
+			Upload: config.DatabaseBackupUpload{
+				Endpoint: mustURL("https://s3.test"),
+				Bucket:   ptr("test-bucket"),
+				Prefix:   ptr("node-1/"),
+				Region:   ptr("eu-west-1"),
+			},
+		},
+		ReadReplica: config.DatabaseReadReplica{
+			MaxLag:        models.MustNewDuration(30 * time.Second),
+			CheckInterval: models.MustNewDuration(2 * time.Second),
 		},
 	}
 	full.TelemetryIngress = config.TelemetryIngress{
@@ -295,10 +313,41 @@ func TestConfig_Marshal(t *testing.T) {
 		SecureCookies:           ptr(true),
 		SessionTimeout:          models.MustNewDuration(time.Hour),
 		SessionReaperExpiration: models.MustNewDuration(7 * 24 * time.Hour),
+		TrustedProxies:          &[]string{"10.0.0.0/8"},
+		LDAP: config.WebServerLDAP{
+			Enabled:                     ptr(true),
+			ServerURL:                   mustURL("ldaps://ldap.example.com:636"),
+			ReadOnlyUserDN:              ptr("cn=chainlink,ou=services,dc=example,dc=com"),
+			BaseUserDN:                  ptr("ou=users,dc=example,dc=com"),
+			BaseGroupDN:                 ptr("ou=groups,dc=example,dc=com"),
+			EmailAttribute:              ptr("userPrincipalName"),
+			GroupMemberAttribute:        ptr("uniqueMember"),
+			ActiveAttribute:             ptr("organizationalStatus"),
+			ActiveAttributeAllowedValue: ptr("active"),
+			AdminGroups:                 &[]string{"cl-admins"},
+			EditGroups:                  &[]string{"cl-editors"},
+			RunGroups:                   &[]string{"cl-runners"},
+			ViewGroups:                  &[]string{"cl-viewers", "cl-auditors"},
+			QueryTimeout:                models.MustNewDuration(3 * time.Second),
+			BindCacheDuration:           models.MustNewDuration(30 * time.Second),
+			SyncInterval:                models.MustNewDuration(5 * time.Minute),
+		},
 		MFA: config.WebServerMFA{
 			RPID:     ptr("test-rpid"),
 			RPOrigin: ptr("test-rp-origin"),
 		},
+		OIDC: config.WebServerOIDC{
+			Enabled:     ptr(true),
+			IssuerURL:   mustURL("https://accounts.example.com"),
+			ClientID:    ptr("test-client-id"),
+			RedirectURL: mustURL("https://node.example.com/oidc/callback"),
+			Scopes:      &[]string{"openid", "email", "groups"},
+			GroupsClaim: ptr("roles"),
+			AdminGroups: &[]string{"cl-admins"},
+			EditGroups:  &[]string{"cl-editors"},
+			RunGroups:   &[]string{"cl-runners"},
+			ViewGroups:  &[]string{"cl-viewers", "cl-auditors"},
+		},
 		RateLimit: config.WebServerRateLimit{
 			Authenticated:         ptr[int64](42),
 			AuthenticatedPeriod:   models.MustNewDuration(time.Second),
@@ -408,6 +457,13 @@ func TestConfig_Marshal(t *testing.T) {
 		MutexProfileFraction: ptr[int64](2),
 		MemThreshold:         ptr[utils.FileSize](utils.GB),
 		GoroutineThreshold:   ptr[int64](999),
+		Upload: config.AutoPprofUpload{
+			URL:          mustURL("https://profiles.test"),
+			Interval:     models.MustNewDuration(2 * time.Minute),
+			CPUDuration:  models.MustNewDuration(20 * time.Second),
+			ProfileTypes: &[]string{"cpu", "heap"},
+			Timeout:      models.MustNewDuration(time.Minute),
+		},
 	}
 	full.Pyroscope = config.Pyroscope{
 		ServerAddress: ptr("http://localhost:4040"),
@@ -419,6 +475,44 @@ func TestConfig_Marshal(t *testing.T) {
 		Environment: ptr("dev"),
 		Release:     ptr("v1.2.3"),
 	}
+	full.Notifications = config.Notifications{
+		Enabled:             ptr(true),
+		JobErrorThreshold:   ptr[uint32](5),
+		KeyBalanceThreshold: assets.NewWeiI(1_000_000_000_000_000_000),
+		MaxAttempts:         ptr[uint32](7),
+		RequestTimeout:      models.MustNewDuration(3 * time.Second),
+		RetryBackoff:        models.MustNewDuration(time.Minute),
+		Webhooks: []config.NotificationWebhook{
+			{
+				Name:   ptr("ops"),
+				Events: &[]legacy.NotificationEvent{legacy.NotificationTxFatal, legacy.NotificationKeyBalanceLow},
+			},
+			{
+				Name:   ptr("proposals"),
+				Events: &[]legacy.NotificationEvent{legacy.NotificationJobProposed},
+			},
+		},
+	}
+	full.JobSharding = config.JobSharding{
+		Enabled:           ptr(true),
+		MemberName:        ptr("node-1"),
+		JobTypes:          &[]string{"cron", "directrequest"},
+		HeartbeatInterval: models.MustNewDuration(2 * time.Second),
+		LeaseDuration:     models.MustNewDuration(10 * time.Second),
+	}
+	full.SecretsProvider = config.SecretsProvider{
+		Backend:         ptr("env"),
+		RefreshInterval: models.MustNewDuration(time.Minute),
+		File:            config.SecretsProviderFile{Dir: ptr("/run/secrets/chainlink")},
+		Vault: config.SecretsProviderVault{
+			URL:  models.MustParseURL("https://vault.example.com:8200"),
+			Path: ptr("secret/data/chainlink"),
+		},
+		Env: config.SecretsProviderEnv{Prefix: ptr("CL_TEST_SECRET_")},
+	}
+	full.Health = config.Health{
+		ReadinessGates: &[]string{"EVM.1.Nodes", "JobSpawner"},
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: utils.NewBigI(1),
@@ -431,6 +525,7 @@ func TestConfig_Marshal(t *testing.T) {
 				BlockBackfillSkip:    ptr(true),
 				ChainType:            ptr("Optimism"),
 				FinalityDepth:        ptr[uint32](42),
+				FinalityTagEnabled:   ptr(true),
 				FlagsContractAddress: mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
 
 				GasEstimator: evmcfg.GasEstimator{
@@ -478,17 +573,18 @@ func TestConfig_Marshal(t *testing.T) {
 					},
 				},
 
-				LinkContractAddress:      mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
-				LogBackfillBatchSize:     ptr[uint32](17),
-				LogPollInterval:          &minute,
-				LogKeepBlocksDepth:       ptr[uint32](100000),
-				MinContractPayment:       assets.NewLinkFromJuels(math.MaxInt64),
-				MinIncomingConfirmations: ptr[uint32](13),
-				NonceAutoSync:            ptr(true),
-				NoNewHeadsThreshold:      &minute,
-				OperatorFactoryAddress:   mustAddress("0xa5B85635Be42F21f94F28034B7DA440EeFF0F418"),
-				RPCDefaultBatchSize:      ptr[uint32](17),
-				RPCBlockQueryDelay:       ptr[uint16](10),
+				LinkContractAddress:        mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
+				LogBackfillBatchSize:       ptr[uint32](17),
+				LogPollInterval:            &minute,
+				LogKeepBlocksDepth:         ptr[uint32](100000),
+				LogBroadcasterUseLogPoller: ptr(true),
+				MinContractPayment:         assets.NewLinkFromJuels(math.MaxInt64),
+				MinIncomingConfirmations:   ptr[uint32](13),
+				NonceAutoSync:              ptr(true),
+				NoNewHeadsThreshold:        &minute,
+				OperatorFactoryAddress:     mustAddress("0xa5B85635Be42F21f94F28034B7DA440EeFF0F418"),
+				RPCDefaultBatchSize:        ptr[uint32](17),
+				RPCBlockQueryDelay:         ptr[uint16](10),
 
 				Transactions: evmcfg.Transactions{
 					MaxInFlight:          ptr[uint32](19),
@@ -641,6 +737,16 @@ Dir = 'test/backup/dir'
 Frequency = '1h0m0s'
 Mode = 'full'
 OnVersionUpgrade = true
+Tables = ['jobs', 'bridge_types']
+ExcludeTableData = ['logs']
+MaxBackups = 7
+MaxAge = '720h0m0s'
+
+[Database.Backup.Upload]
+Endpoint = 'https://s3.test'
+Bucket = 'test-bucket'
+Prefix = 'node-1/'
+Region = 'eu-west-1'
 
 [Database.Listener]
 MaxReconnectDuration = '1m0s'
@@ -651,6 +757,10 @@ FallbackPollInterval = '2m0s'
 Enabled = false
 LeaseDuration = '1m0s'
 LeaseRefreshInterval = '1s'
+
+[Database.ReadReplica]
+MaxLag = '30s'
+CheckInterval = '2s'
 `},
 		{"TelemetryIngress", Config{Core: config.Core{TelemetryIngress: full.TelemetryIngress}}, `[TelemetryIngress]
 UniConn = true
@@ -683,11 +793,42 @@ HTTPPort = 56
 SecureCookies = true
 SessionTimeout = '1h0m0s'
 SessionReaperExpiration = '168h0m0s'
+TrustedProxies = ['10.0.0.0/8']
+
+[WebServer.LDAP]
+Enabled = true
+ServerURL = 'ldaps://ldap.example.com:636'
+ReadOnlyUserDN = 'cn=chainlink,ou=services,dc=example,dc=com'
+BaseUserDN = 'ou=users,dc=example,dc=com'
+BaseGroupDN = 'ou=groups,dc=example,dc=com'
+EmailAttribute = 'userPrincipalName'
+GroupMemberAttribute = 'uniqueMember'
+ActiveAttribute = 'organizationalStatus'
+ActiveAttributeAllowedValue = 'active'
+AdminGroups = ['cl-admins']
+EditGroups = ['cl-editors']
+RunGroups = ['cl-runners']
+ViewGroups = ['cl-viewers', 'cl-auditors']
+QueryTimeout = '3s'
+BindCacheDuration = '30s'
+SyncInterval = '5m0s'
 
 [WebServer.MFA]
 RPID = 'test-rpid'
 RPOrigin = 'test-rp-origin'
 
+[WebServer.OIDC]
+Enabled = true
+IssuerURL = 'https://accounts.example.com'
+ClientID = 'test-client-id'
+RedirectURL = 'https://node.example.com/oidc/callback'
+Scopes = ['openid', 'email', 'groups']
+GroupsClaim = 'roles'
+AdminGroups = ['cl-admins']
+EditGroups = ['cl-editors']
+RunGroups = ['cl-runners']
+ViewGroups = ['cl-viewers', 'cl-auditors']
+
 [WebServer.RateLimit]
 Authenticated = 42
 AuthenticatedPeriod = '1s'
@@ -793,6 +934,13 @@ BlockProfileRate = 5
 MutexProfileFraction = 2
 MemThreshold = '1.00gb'
 GoroutineThreshold = 999
+
+[AutoPprof.Upload]
+URL = 'https://profiles.test'
+Interval = '2m0s'
+CPUDuration = '20s'
+ProfileTypes = ['cpu', 'heap']
+Timeout = '1m0s'
 `},
 		{"Pyroscope", Config{Core: config.Core{Pyroscope: full.Pyroscope}}, `[Pyroscope]
 ServerAddress = 'http://localhost:4040'
@@ -803,6 +951,46 @@ Debug = true
 DSN = 'sentry-dsn'
 Environment = 'dev'
 Release = 'v1.2.3'
+`},
+		{"Notifications", Config{Core: config.Core{Notifications: full.Notifications}}, `[Notifications]
+Enabled = true
+JobErrorThreshold = 5
+KeyBalanceThreshold = '1 ether'
+MaxAttempts = 7
+RequestTimeout = '3s'
+RetryBackoff = '1m0s'
+
+[[Notifications.Webhooks]]
+Name = 'ops'
+Events = ['TxFatal', 'KeyBalanceLow']
+
+[[Notifications.Webhooks]]
+Name = 'proposals'
+Events = ['JobProposed']
+`},
+		{"JobSharding", Config{Core: config.Core{JobSharding: full.JobSharding}}, `[JobSharding]
+Enabled = true
+MemberName = 'node-1'
+JobTypes = ['cron', 'directrequest']
+HeartbeatInterval = '2s'
+LeaseDuration = '10s'
+`},
+		{"SecretsProvider", Config{Core: config.Core{SecretsProvider: full.SecretsProvider}}, `[SecretsProvider]
+Backend = 'env'
+RefreshInterval = '1m0s'
+
+[SecretsProvider.File]
+Dir = '/run/secrets/chainlink'
+
+[SecretsProvider.Vault]
+URL = 'https://vault.example.com:8200'
+Path = 'secret/data/chainlink'
+
+[SecretsProvider.Env]
+Prefix = 'CL_TEST_SECRET_'
+`},
+		{"Health", Config{Core: config.Core{Health: full.Health}}, `[Health]
+ReadinessGates = ['EVM.1.Nodes', 'JobSpawner']
 `},
 		{"EVM", Config{EVM: full.EVM}, `[[EVM]]
 ChainID = '1'
@@ -811,11 +999,13 @@ BlockBackfillDepth = 100
 BlockBackfillSkip = true
 ChainType = 'Optimism'
 FinalityDepth = 42
+FinalityTagEnabled = true
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
 LogPollInterval = '1m0s'
 LogKeepBlocksDepth = 100000
+LogBroadcasterUseLogPoller = true
 MinIncomingConfirmations = 13
 MinContractPayment = '9.223372036854775807 link'
 NonceAutoSync = true
@@ -1020,8 +1210,41 @@ func TestConfig_Validate(t *testing.T) {
 		toml string
 		exp  string
 	}{
-		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 5 errors:
-	- Database.Lock.LeaseRefreshInterval: invalid value (6s): must be less than or equal to half of LeaseDuration (10s)
+		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 12 errors:
+	- JobSharding.Enabled: invalid value (true): requires Database.Lock.Enabled = false, since members share the database
+	- Database: 3 errors:
+		- Backup.Upload: 2 errors:
+				- Endpoint: invalid value (ftp://backups.test): must be an http or https URL
+				- Bucket: missing: required when Endpoint is set
+		- Lock.LeaseRefreshInterval: invalid value (6s): must be less than or equal to half of LeaseDuration (10s)
+		- ReadReplica.MaxLag: invalid value (0s): must be greater than zero
+	- WebServer: 3 errors:
+		- TrustedProxies: invalid value (10.0.0.0/33): must be an IP address or CIDR network
+		- LDAP: 3 errors:
+			- ServerURL: invalid value (https://ldap.example.com): must be an ldap:// or ldaps:// URL
+			- ReadOnlyUserDN: missing: required when LDAP is enabled
+			- BaseGroupDN: missing: required when LDAP is enabled
+		- OIDC: 2 errors:
+			- IssuerURL: missing: required when OIDC is enabled
+			- RedirectURL: missing: required when OIDC is enabled
+	- AutoPprof.Upload: 3 errors:
+			- URL: invalid value (ftp://profiles.test): must be an http or https URL
+			- CPUDuration: invalid value (1m0s): must be less than Interval (1m0s)
+			- ProfileTypes: invalid value (trace): must be one of cpu, allocs, block, goroutine, heap, mutex, threadcreate
+	- Notifications: 2 errors:
+		- MaxAttempts: invalid value (0): must be greater than zero
+		- Webhooks: 2 errors:
+			- 0.Events: invalid value (Unknown): must be one of JobErrors, KeyBalanceLow, RPCNodeOutOfSync, TxFatal, JobProposed
+			- 1: 2 errors:
+				- Name: missing: required for all webhooks
+				- Events: missing: required for all webhooks
+	- JobSharding: 2 errors:
+		- JobTypes: invalid value (webhook): must be one of blockhashstore, bootstrap, cron, directrequest, fluxmonitor, keeper, offchainreporting, offchainreporting2, vrf
+		- HeartbeatInterval: invalid value (20s): must be less than or equal to half of LeaseDuration (30s)
+	- SecretsProvider: 2 errors:
+		- Vault.URL: missing: required for the vault backend
+		- Vault.Path: missing: required for the vault backend
+	- Health.ReadinessGates.1: empty: must be the name of a component
 	- EVM: 8 errors:
 		- 1.ChainID: invalid value (1): duplicate - must be unique
 		- 0.Nodes.1.Name: invalid value (foo): duplicate - must be unique
@@ -1226,6 +1449,50 @@ func TestNewGeneralConfig_SecretsOverrides(t *testing.T) {
 	assert.Equal(t, DBURL_OVERRIDE, (&dbURL).String())
 }
 
+func TestNewGeneralConfig_SecretsProvider(t *testing.T) {
+	dir := t.TempDir()
+	writeSecret := func(path, value string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(value+"\n"), 0600))
+	}
+	writeSecret("Mercury.Credentials.0.Password", "provided-password")
+	writeSecret("Bridge.Credentials.0.BearerToken", "provided-token")
+
+	var opts GeneralConfigOpts
+	require.NoError(t, opts.ParseTOML(fmt.Sprintf(`[SecretsProvider]
+Backend = 'file'
+RefreshInterval = '1m'
+[SecretsProvider.File]
+Dir = '%s'`, dir), secretsFullTOML))
+	c, err := opts.New(logger.TestLogger(t))
+	require.NoError(t, err)
+	assert.Equal(t, "file", c.SecretsProviderBackend())
+	assert.Equal(t, time.Minute, c.SecretsProviderRefreshInterval())
+
+	username, password, err := c.MercuryCredentials("http://example.com/reports")
+	require.NoError(t, err)
+	assert.Equal(t, "exampleusername", username)
+	assert.Equal(t, "provided-password", password)
+	assert.Equal(t, "provided-token", c.BridgeBearerToken("my-bridge"))
+	assert.Equal(t, "", c.BridgeBearerToken("other-bridge"))
+
+	t.Run("refresh", func(t *testing.T) {
+		writeSecret("Mercury.Credentials.0.Password", "rotated-password")
+		writeSecret("Bridge.Credentials.0.BearerToken", "rotated-token")
+		require.NoError(t, c.RefreshSecrets(testutils.Context(t)))
+
+		_, password, err = c.MercuryCredentials("http://example.com/reports")
+		require.NoError(t, err)
+		assert.Equal(t, "rotated-password", password)
+		assert.Equal(t, "rotated-token", c.BridgeBearerToken("my-bridge"))
+	})
+
+	t.Run("invalid refresh keeps previous secrets", func(t *testing.T) {
+		writeSecret("Bridge.Credentials.1.BearerToken", "unnamed-token")
+		require.ErrorContains(t, c.RefreshSecrets(testutils.Context(t)), "Credentials.1.Name: missing")
+		assert.Equal(t, "rotated-token", c.BridgeBearerToken("my-bridge"))
+	})
+}
+
 func TestSecrets_Validate(t *testing.T) {
 	for _, tt := range []struct {
 		name string
@@ -1272,6 +1539,14 @@ URL = "postgresql://user:passlocalhost:5432/asdf"
 BackupURL = "foo-bar?password=asdf"
 AllowSimplePasswords = true`,
 			exp: `invalid secrets: Password.Keystore: empty: must be provided and non-empty`},
+		{name: "backup-access-key-without-secret",
+			toml: `[Database]
+URL = "postgresql://user:passlocalhost:5432/asdf"
+AllowSimplePasswords = true
+BackupAccessKeyID = "access-key"`,
+			exp: `invalid secrets: 2 errors:
+	- Database.BackupAccessKeyID: missing: must be set together with BackupSecretAccessKey
+	- Password.Keystore: empty: must be provided and non-empty`},
 		{name: "duplicate-mercury-credentials-disallowed",
 			toml: `
 [Mercury]
@@ -1283,6 +1558,44 @@ URL = "http://example.COM/foo"`,
 	- Database.URL: empty: must be provided and non-empty
 	- Password.Keystore: empty: must be provided and non-empty
 	- Mercury.Credentials: may not contain duplicate URLs`},
+		{name: "remote-signer-token-without-url",
+			toml: `
+[RemoteSigner]
+BearerToken = "signer-token"`,
+			exp: `invalid secrets: 3 errors:
+	- Database.URL: empty: must be provided and non-empty
+	- Password.Keystore: empty: must be provided and non-empty
+	- RemoteSigner.URL: empty: must be provided when BearerToken is set`},
+		{name: "notification-webhooks",
+			toml: `
+[Notifications]
+[[Notifications.Webhooks]]
+Name = "ops"
+URL = "https://hooks.example.com/ops"
+[[Notifications.Webhooks]]
+Name = "ops"
+SigningKey = "key"`,
+			exp: `invalid secrets: 3 errors:
+	- Database.URL: empty: must be provided and non-empty
+	- Password.Keystore: empty: must be provided and non-empty
+	- Notifications: 3 errors:
+		- Webhooks.0.SigningKey: missing: required for all webhooks
+		- Webhooks.1.Name: invalid value (ops): duplicate - must be unique
+		- Webhooks.1.URL: missing: required for all webhooks`},
+		{name: "bridge-credentials",
+			toml: `
+[Bridge]
+[[Bridge.Credentials]]
+Name = "my-bridge"
+[[Bridge.Credentials]]
+Name = "my-bridge"
+BearerToken = "bridge-token"`,
+			exp: `invalid secrets: 3 errors:
+	- Database.URL: empty: must be provided and non-empty
+	- Password.Keystore: empty: must be provided and non-empty
+	- Bridge: 2 errors:
+		- Credentials.0.BearerToken: missing: required for all bridge credentials
+		- Credentials.1.Name: invalid value (my-bridge): duplicate - must be unique`},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			var s Secrets
@@ -17,6 +17,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	pb "github.com/smartcontractkit/chainlink/core/services/feeds/proto"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -96,6 +97,7 @@ type service struct {
 	connMgr      ConnectionsManager
 	chainSet     evm.ChainSet
 	lggr         logger.Logger
+	auditLogger  audit.AuditLogger
 	version      string
 }
 
@@ -109,6 +111,7 @@ func NewService(
 	cfg Config,
 	chainSet evm.ChainSet,
 	lggr logger.Logger,
+	auditLogger audit.AuditLogger,
 	version string,
 ) *service {
 	lggr = lggr.Named("Feeds")
@@ -125,6 +128,7 @@ func NewService(
 		connMgr:      newConnectionsManager(lggr),
 		chainSet:     chainSet,
 		lggr:         lggr,
+		auditLogger:  auditLogger,
 		version:      version,
 	}
 
@@ -476,6 +480,12 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 
 	// Track the given job proposal request
 	promJobProposalRequest.Inc()
+	s.auditLogger.Audit(audit.JobProposalReceived, map[string]interface{}{
+		"id":             id,
+		"feedsManagerID": args.FeedsManagerID,
+		"remoteUUID":     args.RemoteUUID,
+		"version":        args.Version,
+	})
 
 	return id, nil
 }
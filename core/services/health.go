@@ -1,6 +1,8 @@
 package services
 
 import (
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +28,8 @@ type (
 		// IsHealthy returns the current health of the system.
 		// A system is considered healthy if all checks are passing (no errors)
 		IsHealthy() (healthy bool, errors map[string]error)
+		// Report returns the health of the application, as a tree of the services and their components.
+		Report() Health
 
 		Start() error
 		Close() error
@@ -36,6 +40,9 @@ type (
 		services   map[string]Checkable
 		stateMutex sync.RWMutex
 		state      map[string]State
+		components map[string]componentState
+		report     Health
+		gates      []string
 
 		chStop chan struct{}
 		chDone chan struct{}
@@ -48,6 +55,44 @@ type (
 		healthy error
 	}
 
+	// HealthReporter is implemented by services composed of other components, like chains and jobs, to report the
+	// health of each one.
+	HealthReporter interface {
+		Checkable
+		// Name returns the name of the service in the health report of Report, like "EVM". IsReady and IsHealthy
+		// report it under the name it was registered with.
+		Name() string
+		// HealthReport returns the health of the service under the empty key, and of its components under their
+		// dot-separated path relative to the service, like "1.HeadTracker". Components of other services, like the jobs
+		// of a chain, are reported under their path relative to the application, starting with a dot, like
+		// ".EVM.1.Jobs.5". A nil error means healthy.
+		HealthReport() map[string]error
+	}
+
+	// Health is the health of a component of the application, and of its own components.
+	Health struct {
+		// Name is the dot-separated path of the component, like "EVM.1.HeadTracker".
+		Name       string
+		Status     Status
+		Output     string
+		Since      time.Time // of the last change of Status
+		Components []Health
+	}
+
+	componentState struct {
+		err    error
+		ready  error // of services
+		status Status
+		since  time.Time
+	}
+
+	// healthNode is a component in the tree built by update.
+	healthNode struct {
+		err      error
+		ready    error
+		children map[string]*healthNode
+	}
+
 	Status string
 )
 
@@ -55,7 +100,9 @@ var _ Checker = (*checker)(nil)
 
 const (
 	StatusPassing Status = "passing"
-	StatusFailing Status = "failing"
+	// StatusDegraded is the status of passing components which have failing components.
+	StatusDegraded Status = "degraded"
+	StatusFailing  Status = "failing"
 
 	interval = 15 * time.Second
 )
@@ -68,6 +115,20 @@ var (
 		},
 		[]string{"service_id"},
 	)
+	healthComponentStatus = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "health_component_status",
+			Help: "Health status by component, set to 1 for the current status: passing, degraded or failing",
+		},
+		[]string{"component", "status"},
+	)
+	healthComponentTransition = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "health_component_last_transition_timestamp_seconds",
+			Help: "Unix time of the last change of health status by component",
+		},
+		[]string{"component"},
+	)
 	uptimeSeconds = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "uptime_seconds",
@@ -83,12 +144,17 @@ var (
 	)
 )
 
-func NewChecker() Checker {
+// NewChecker returns a Checker. If readinessGates is not empty, only these components can make the system not ready:
+// when they are not ready, or failing. Degraded components, and failing components outside of the gates, do not.
+func NewChecker(readinessGates ...string) Checker {
 	c := &checker{
-		services: make(map[string]Checkable, 10),
-		state:    make(map[string]State, 10),
-		chStop:   make(chan struct{}),
-		chDone:   make(chan struct{}),
+		services:   make(map[string]Checkable, 10),
+		state:      make(map[string]State, 10),
+		components: make(map[string]componentState),
+		report:     Health{Name: "Application", Status: StatusPassing},
+		gates:      readinessGates,
+		chStop:     make(chan struct{}),
+		chDone:     make(chan struct{}),
 	}
 
 	return c
@@ -143,11 +209,34 @@ func (c *checker) update() {
 	c.srvMutex.RUnlock()
 
 	// now, do all the checks
+	root := &healthNode{}
 	for name, s := range services {
 		ready := s.Ready()
 		healthy := s.Healthy()
 
 		state[name] = State{ready, healthy}
+
+		r, isReporter := s.(HealthReporter)
+		path := name
+		if isReporter {
+			path = r.Name()
+		}
+		n := root.child(path)
+		n.err, n.ready = healthy, ready
+		if isReporter {
+			for path, err := range r.HealthReport() {
+				m := n
+				if strings.HasPrefix(path, ".") {
+					m, path = root, path[1:]
+				}
+				if path != "" {
+					for _, seg := range strings.Split(path, ".") {
+						m = m.child(seg)
+					}
+				}
+				m.err = err
+			}
+		}
 	}
 
 	// we use a separate lock to avoid holding the lock over state while talking
@@ -166,9 +255,97 @@ func (c *checker) update() {
 		// report metrics to prometheus
 		healthStatus.WithLabelValues(name).Set(float64(value))
 	}
+
+	components := make(map[string]componentState, len(c.components))
+	c.report = c.health("", root, time.Now(), components)
+	c.report.Name = "Application"
+	for path := range c.components {
+		if _, ok := components[path]; !ok {
+			for _, status := range []Status{StatusPassing, StatusDegraded, StatusFailing} {
+				healthComponentStatus.DeleteLabelValues(path, string(status))
+			}
+			healthComponentTransition.DeleteLabelValues(path)
+		}
+	}
+	c.components = components
+
 	uptimeSeconds.Add(interval.Seconds())
 }
 
+// child returns the component of n named name, adding it if needed.
+func (n *healthNode) child(name string) *healthNode {
+	if n.children == nil {
+		n.children = make(map[string]*healthNode)
+	}
+	m, ok := n.children[name]
+	if !ok {
+		m = &healthNode{}
+		n.children[name] = m
+	}
+	return m
+}
+
+// health returns the Health of n at path, and records the state of n and its components in components. Components
+// are failing if they have an error, and degraded if any of their own components are not passing. The application, at
+// the root, is failing if any of its services is.
+// Must be called with stateMutex held.
+func (c *checker) health(path string, n *healthNode, now time.Time, components map[string]componentState) Health {
+	h := Health{Name: path, Status: StatusPassing}
+
+	names := make([]string, 0, len(n.children))
+	for name := range n.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		childPath := name
+		if path != "" {
+			childPath = path + "." + name
+		}
+		child := c.health(childPath, n.children[name], now, components)
+		if child.Status != StatusPassing {
+			h.Status = StatusDegraded
+		}
+		h.Components = append(h.Components, child)
+	}
+	if n.err != nil {
+		h.Status = StatusFailing
+		h.Output = n.err.Error()
+	} else if path == "" {
+		// the application is failing if any of its services is
+		var failing []string
+		for _, child := range h.Components {
+			if child.Status == StatusFailing {
+				failing = append(failing, child.Name)
+			}
+		}
+		if len(failing) > 0 {
+			h.Status = StatusFailing
+			h.Output = "failing: " + strings.Join(failing, ", ")
+		}
+	}
+
+	h.Since = now
+	if prev, ok := c.components[path]; ok && prev.status == h.Status {
+		h.Since = prev.since
+	}
+	components[path] = componentState{err: n.err, ready: n.ready, status: h.Status, since: h.Since}
+	if path == "" {
+		return h
+	}
+
+	// report metrics to prometheus
+	for _, status := range []Status{StatusPassing, StatusDegraded, StatusFailing} {
+		var value float64
+		if status == h.Status {
+			value = 1
+		}
+		healthComponentStatus.WithLabelValues(path, string(status)).Set(value)
+	}
+	healthComponentTransition.WithLabelValues(path).Set(float64(h.Since.Unix()))
+	return h
+}
+
 func (c *checker) Register(name string, service Checkable) error {
 	if service == nil || name == "" {
 		return errors.Errorf("misconfigured check %#v for %v", name, service)
@@ -197,6 +374,28 @@ func (c *checker) IsReady() (ready bool, errors map[string]error) {
 	defer c.stateMutex.RUnlock()
 
 	ready = true
+
+	if len(c.gates) > 0 {
+		// Gated components which are not registered, like a chain which was removed, do not make the system not ready.
+		errors = make(map[string]error, len(c.gates))
+		for _, gate := range c.gates {
+			var err error
+			if state, ok := c.state[gate]; ok && state.ready != nil {
+				err = state.ready
+			} else if cs, ok := c.components[gate]; ok && cs.ready != nil {
+				err = cs.ready
+			} else if ok && cs.status == StatusFailing {
+				err = cs.err
+			}
+			errors[gate] = err
+
+			if err != nil {
+				ready = false
+			}
+		}
+		return
+	}
+
 	errors = make(map[string]error, len(c.services))
 
 	for name, state := range c.state {
@@ -227,3 +426,10 @@ func (c *checker) IsHealthy() (healthy bool, errors map[string]error) {
 
 	return
 }
+
+func (c *checker) Report() Health {
+	c.stateMutex.RLock()
+	defer c.stateMutex.RUnlock()
+
+	return c.report
+}
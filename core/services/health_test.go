@@ -62,3 +62,157 @@ func TestCheck(t *testing.T) {
 		assert.Equal(t, test.expected, results, "case %d", i)
 	}
 }
+
+type reporter map[string]error
+
+func (r reporter) Ready() error                   { return nil }
+func (r reporter) Healthy() error                 { return nil }
+func (r reporter) Name() string                   { return "EVM" }
+func (r reporter) HealthReport() map[string]error { return r }
+
+func TestChecker_Report(t *testing.T) {
+	c := services.NewChecker()
+	require.NoError(t, c.Register("*evm.chainSet", reporter{
+		"1":             nil,
+		"1.Nodes":       nil,
+		"1.Nodes.a":     nil,
+		"1.Nodes.b":     errors.New("node is Unreachable"),
+		"1.HeadTracker": nil,
+		"2.Nodes":       errors.New("no live RPC nodes"),
+	}))
+	require.NoError(t, c.Register("other", boolCheck(true)))
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+	report := c.Report()
+	assert.Equal(t, "Application", report.Name)
+	assert.Equal(t, services.StatusDegraded, report.Status)
+	require.Len(t, report.Components, 2)
+
+	evm := report.Components[0]
+	assert.Equal(t, "EVM", evm.Name)
+	assert.Equal(t, services.StatusDegraded, evm.Status)
+	require.Len(t, evm.Components, 2)
+
+	one := evm.Components[0]
+	assert.Equal(t, "EVM.1", one.Name)
+	assert.Equal(t, services.StatusDegraded, one.Status)
+	require.Len(t, one.Components, 2)
+	assert.Equal(t, "EVM.1.HeadTracker", one.Components[0].Name)
+	assert.Equal(t, services.StatusPassing, one.Components[0].Status)
+	nodes := one.Components[1]
+	assert.Equal(t, services.StatusDegraded, nodes.Status)
+	assert.Equal(t, "EVM.1.Nodes.b", nodes.Components[1].Name)
+	assert.Equal(t, services.StatusFailing, nodes.Components[1].Status)
+	assert.Equal(t, "node is Unreachable", nodes.Components[1].Output)
+	assert.False(t, nodes.Components[1].Since.IsZero())
+
+	two := evm.Components[1]
+	assert.Equal(t, services.StatusDegraded, two.Status)
+	assert.Equal(t, services.StatusFailing, two.Components[0].Status)
+
+	assert.Equal(t, "other", report.Components[1].Name)
+	assert.Equal(t, services.StatusPassing, report.Components[1].Status)
+
+	// services keep the names they were registered with in the flat reports
+	_, errs := c.IsHealthy()
+	assert.Contains(t, errs, "*evm.chainSet")
+	assert.NotContains(t, errs, "EVM")
+}
+
+type jobsReporter map[string]error
+
+func (r jobsReporter) Ready() error                   { return nil }
+func (r jobsReporter) Healthy() error                 { return nil }
+func (r jobsReporter) Name() string                   { return "JobSpawner" }
+func (r jobsReporter) HealthReport() map[string]error { return r }
+
+func TestChecker_Report_componentsOfOtherServices(t *testing.T) {
+	c := services.NewChecker()
+	require.NoError(t, c.Register("*evm.chainSet", reporter{"1.Nodes": nil}))
+	require.NoError(t, c.Register("*job.spawner", jobsReporter{
+		"":              nil,
+		"7":             nil,
+		".EVM.1.Jobs.5": errors.New("job failed"),
+	}))
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+	report := c.Report()
+	require.Len(t, report.Components, 2)
+
+	one := report.Components[0].Components[0]
+	assert.Equal(t, "EVM.1", one.Name)
+	assert.Equal(t, services.StatusDegraded, one.Status)
+	require.Len(t, one.Components, 2)
+	jobs := one.Components[0]
+	assert.Equal(t, "EVM.1.Jobs", jobs.Name)
+	require.Len(t, jobs.Components, 1)
+	assert.Equal(t, "EVM.1.Jobs.5", jobs.Components[0].Name)
+	assert.Equal(t, services.StatusFailing, jobs.Components[0].Status)
+	assert.Equal(t, "job failed", jobs.Components[0].Output)
+
+	spawner := report.Components[1]
+	assert.Equal(t, "JobSpawner", spawner.Name)
+	assert.Equal(t, services.StatusPassing, spawner.Status)
+	require.Len(t, spawner.Components, 1)
+	assert.Equal(t, "JobSpawner.7", spawner.Components[0].Name)
+}
+
+func TestChecker_IsReady_gates(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		gates []string
+		ready bool
+	}{
+		{"all", nil, false},
+		{"degraded", []string{"EVM", "EVM.1", "EVM.1.Nodes"}, true},
+		{"failing", []string{"EVM.1.Nodes", "EVM.2.Nodes"}, false},
+		{"not ready", []string{"unready"}, false},
+		{"missing", []string{"EVM.3"}, true},
+		{"registered name", []string{"*evm.chainSet"}, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := services.NewChecker(test.gates...)
+			require.NoError(t, c.Register("*evm.chainSet", reporter{
+				"1.Nodes.a": errors.New("node is Unreachable"),
+				"1.Nodes.b": nil,
+				"2.Nodes":   errors.New("no live RPC nodes"),
+			}))
+			require.NoError(t, c.Register("unready", boolCheck(false)))
+			require.NoError(t, c.Start())
+			t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+			ready, _ := c.IsReady()
+			assert.Equal(t, test.ready, ready)
+		})
+	}
+}
+
+func TestChecker_Report_failing(t *testing.T) {
+	c := services.NewChecker()
+	require.NoError(t, c.Register("*evm.chainSet", reporter{"2.Nodes": errors.New("no live RPC nodes")}))
+	require.NoError(t, c.Register("failing", boolCheck(false)))
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+	report := c.Report()
+	assert.Equal(t, services.StatusFailing, report.Status)
+	assert.Equal(t, "failing: failing", report.Output)
+	assert.Equal(t, services.StatusDegraded, report.Components[0].Status)
+}
+
+type unreadyReporter struct{ reporter }
+
+func (r unreadyReporter) Ready() error { return errors.New("not started") }
+
+func TestChecker_IsReady_gateByReportName(t *testing.T) {
+	c := services.NewChecker("EVM")
+	require.NoError(t, c.Register("*evm.chainSet", unreadyReporter{reporter{"1.Nodes": nil}}))
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+	ready, errs := c.IsReady()
+	assert.False(t, ready)
+	assert.EqualError(t, errs["EVM"], "not started")
+}
@@ -299,13 +299,20 @@ func (_m *ORM) FindJobs(offset int, limit int) ([]job.Job, int, error) {
 	return r0, r1, r2
 }
 
-// FindJobsByPipelineSpecIDs provides a mock function with given fields: ids
-func (_m *ORM) FindJobsByPipelineSpecIDs(ids []int32) ([]job.Job, error) {
-	ret := _m.Called(ids)
+// FindJobsByPipelineSpecIDs provides a mock function with given fields: ids, qopts
+func (_m *ORM) FindJobsByPipelineSpecIDs(ids []int32, qopts ...pg.QOpt) ([]job.Job, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ids)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 []job.Job
-	if rf, ok := ret.Get(0).(func([]int32) []job.Job); ok {
-		r0 = rf(ids)
+	if rf, ok := ret.Get(0).(func([]int32, ...pg.QOpt) []job.Job); ok {
+		r0 = rf(ids, qopts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]job.Job)
@@ -313,8 +320,8 @@ func (_m *ORM) FindJobsByPipelineSpecIDs(ids []int32) ([]job.Job, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]int32) error); ok {
-		r1 = rf(ids)
+	if rf, ok := ret.Get(1).(func([]int32, ...pg.QOpt) error); ok {
+		r1 = rf(ids, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -322,20 +329,27 @@ func (_m *ORM) FindJobsByPipelineSpecIDs(ids []int32) ([]job.Job, error) {
 	return r0, r1
 }
 
-// FindPipelineRunByID provides a mock function with given fields: id
-func (_m *ORM) FindPipelineRunByID(id int64) (pipeline.Run, error) {
-	ret := _m.Called(id)
+// FindPipelineRunByID provides a mock function with given fields: id, qopts
+func (_m *ORM) FindPipelineRunByID(id int64, qopts ...pg.QOpt) (pipeline.Run, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 pipeline.Run
-	if rf, ok := ret.Get(0).(func(int64) pipeline.Run); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) pipeline.Run); ok {
+		r0 = rf(id, qopts...)
 	} else {
 		r0 = ret.Get(0).(pipeline.Run)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(id, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -366,13 +380,20 @@ func (_m *ORM) FindPipelineRunIDsByJobID(jobID int32, offset int, limit int) ([]
 	return r0, r1
 }
 
-// FindPipelineRunsByIDs provides a mock function with given fields: ids
-func (_m *ORM) FindPipelineRunsByIDs(ids []int64) ([]pipeline.Run, error) {
-	ret := _m.Called(ids)
+// FindPipelineRunsByIDs provides a mock function with given fields: ids, qopts
+func (_m *ORM) FindPipelineRunsByIDs(ids []int64, qopts ...pg.QOpt) ([]pipeline.Run, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ids)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 []pipeline.Run
-	if rf, ok := ret.Get(0).(func([]int64) []pipeline.Run); ok {
-		r0 = rf(ids)
+	if rf, ok := ret.Get(0).(func([]int64, ...pg.QOpt) []pipeline.Run); ok {
+		r0 = rf(ids, qopts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]pipeline.Run)
@@ -380,8 +401,8 @@ func (_m *ORM) FindPipelineRunsByIDs(ids []int64) ([]pipeline.Run, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]int64) error); ok {
-		r1 = rf(ids)
+	if rf, ok := ret.Get(1).(func([]int64, ...pg.QOpt) error); ok {
+		r1 = rf(ids, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -512,13 +533,20 @@ func (_m *ORM) InsertWebhookSpec(webhookSpec *job.WebhookSpec, qopts ...pg.QOpt)
 	return r0
 }
 
-// PipelineRuns provides a mock function with given fields: jobID, offset, size
-func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run, int, error) {
-	ret := _m.Called(jobID, offset, size)
+// PipelineRuns provides a mock function with given fields: jobID, offset, size, qopts
+func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int, qopts ...pg.QOpt) ([]pipeline.Run, int, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, offset, size)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 []pipeline.Run
-	if rf, ok := ret.Get(0).(func(*int32, int, int) []pipeline.Run); ok {
-		r0 = rf(jobID, offset, size)
+	if rf, ok := ret.Get(0).(func(*int32, int, int, ...pg.QOpt) []pipeline.Run); ok {
+		r0 = rf(jobID, offset, size, qopts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]pipeline.Run)
@@ -526,15 +554,15 @@ func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run,
 	}
 
 	var r1 int
-	if rf, ok := ret.Get(1).(func(*int32, int, int) int); ok {
-		r1 = rf(jobID, offset, size)
+	if rf, ok := ret.Get(1).(func(*int32, int, int, ...pg.QOpt) int); ok {
+		r1 = rf(jobID, offset, size, qopts...)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(*int32, int, int) error); ok {
-		r2 = rf(jobID, offset, size)
+	if rf, ok := ret.Get(2).(func(*int32, int, int, ...pg.QOpt) error); ok {
+		r2 = rf(jobID, offset, size, qopts...)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -214,7 +214,8 @@ type OCROracleSpec struct {
 	EncryptedOCRKeyBundleIDEnv                bool
 	TransmitterAddress                        *ethkey.EIP55Address `toml:"transmitterAddress"`
 	TransmitterAddressEnv                     bool
-	ObservationTimeout                        models.Interval `toml:"observationTimeout"`
+	OnchainSigningAddress                     *ethkey.EIP55Address `toml:"onchainSigningAddress"`
+	ObservationTimeout                        models.Interval      `toml:"observationTimeout"`
 	ObservationTimeoutEnv                     bool
 	BlockchainTimeout                         models.Interval `toml:"blockchainTimeout"`
 	BlockchainTimeoutEnv                      bool
@@ -295,21 +296,22 @@ const (
 // OCR2OracleSpec defines the job spec for OCR2 jobs.
 // Relay config is chain specific config for a relay (chain adapter).
 type OCR2OracleSpec struct {
-	ID                                int32           `toml:"-"`
-	ContractID                        string          `toml:"contractID"`
-	Relay                             relay.Network   `toml:"relay"`
-	RelayConfig                       JSONConfig      `toml:"relayConfig"`
-	P2PV2Bootstrappers                pq.StringArray  `toml:"p2pv2Bootstrappers"`
-	OCRKeyBundleID                    null.String     `toml:"ocrKeyBundleID"`
-	MonitoringEndpoint                null.String     `toml:"monitoringEndpoint"`
-	TransmitterID                     null.String     `toml:"transmitterID"`
-	BlockchainTimeout                 models.Interval `toml:"blockchainTimeout"`
-	ContractConfigTrackerPollInterval models.Interval `toml:"contractConfigTrackerPollInterval"`
-	ContractConfigConfirmations       uint16          `toml:"contractConfigConfirmations"`
-	PluginConfig                      JSONConfig      `toml:"pluginConfig"`
-	PluginType                        OCR2PluginType  `toml:"pluginType"`
-	CreatedAt                         time.Time       `toml:"-"`
-	UpdatedAt                         time.Time       `toml:"-"`
+	ID                                int32                `toml:"-"`
+	ContractID                        string               `toml:"contractID"`
+	Relay                             relay.Network        `toml:"relay"`
+	RelayConfig                       JSONConfig           `toml:"relayConfig"`
+	P2PV2Bootstrappers                pq.StringArray       `toml:"p2pv2Bootstrappers"`
+	OCRKeyBundleID                    null.String          `toml:"ocrKeyBundleID"`
+	MonitoringEndpoint                null.String          `toml:"monitoringEndpoint"`
+	TransmitterID                     null.String          `toml:"transmitterID"`
+	OnchainSigningAddress             *ethkey.EIP55Address `toml:"onchainSigningAddress"`
+	BlockchainTimeout                 models.Interval      `toml:"blockchainTimeout"`
+	ContractConfigTrackerPollInterval models.Interval      `toml:"contractConfigTrackerPollInterval"`
+	ContractConfigConfirmations       uint16               `toml:"contractConfigConfirmations"`
+	PluginConfig                      JSONConfig           `toml:"pluginConfig"`
+	PluginType                        OCR2PluginType       `toml:"pluginType"`
+	CreatedAt                         time.Time            `toml:"-"`
+	UpdatedAt                         time.Time            `toml:"-"`
 }
 
 // GetID is a getter function that returns the ID of the spec.
@@ -58,14 +58,14 @@ type ORM interface {
 	DismissError(ctx context.Context, errorID int64) error
 	FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error)
 	Close() error
-	PipelineRuns(jobID *int32, offset, size int) ([]pipeline.Run, int, error)
+	PipelineRuns(jobID *int32, offset, size int, qopts ...pg.QOpt) ([]pipeline.Run, int, error)
 
 	FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []int64, err error)
-	FindPipelineRunsByIDs(ids []int64) (runs []pipeline.Run, err error)
+	FindPipelineRunsByIDs(ids []int64, qopts ...pg.QOpt) (runs []pipeline.Run, err error)
 	CountPipelineRunsByJobID(jobID int32) (count int32, err error)
 
-	FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error)
-	FindPipelineRunByID(id int64) (pipeline.Run, error)
+	FindJobsByPipelineSpecIDs(ids []int32, qopts ...pg.QOpt) ([]Job, error)
+	FindPipelineRunByID(id int64, qopts ...pg.QOpt) (pipeline.Run, error)
 
 	FindSpecErrorsByJobIDs(ids []int32, qopts ...pg.QOpt) ([]SpecError, error)
 	FindJobWithoutSpecErrors(id int32) (jb Job, err error)
@@ -219,10 +219,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 
 			sql := `INSERT INTO ocr_oracle_specs (contract_address, p2p_bootstrap_peers, p2pv2_bootstrappers, is_bootstrap_peer, encrypted_ocr_key_bundle_id, transmitter_address,
 					observation_timeout, blockchain_timeout, contract_config_tracker_subscribe_interval, contract_config_tracker_poll_interval, contract_config_confirmations, evm_chain_id,
-					created_at, updated_at, database_timeout, observation_grace_period, contract_transmitter_transmit_timeout)
+					created_at, updated_at, database_timeout, observation_grace_period, contract_transmitter_transmit_timeout, onchain_signing_address)
 			VALUES (:contract_address, :p2p_bootstrap_peers, :p2pv2_bootstrappers, :is_bootstrap_peer, :encrypted_ocr_key_bundle_id, :transmitter_address,
 					:observation_timeout, :blockchain_timeout, :contract_config_tracker_subscribe_interval, :contract_config_tracker_poll_interval, :contract_config_confirmations, :evm_chain_id,
-					NOW(), NOW(), :database_timeout, :observation_grace_period, :contract_transmitter_transmit_timeout)
+					NOW(), NOW(), :database_timeout, :observation_grace_period, :contract_transmitter_transmit_timeout, :onchain_signing_address)
 			RETURNING id;`
 			err = pg.PrepareQueryRowx(tx, sql, &specID, jb.OCROracleSpec)
 			if err != nil {
@@ -280,10 +280,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			}
 
 			sql := `INSERT INTO ocr2_oracle_specs (contract_id, relay, relay_config, plugin_type, plugin_config, p2pv2_bootstrappers, ocr_key_bundle_id, transmitter_id,
-					blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations,
+					blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations, onchain_signing_address,
 					created_at, updated_at)
 			VALUES (:contract_id, :relay, :relay_config, :plugin_type, :plugin_config, :p2pv2_bootstrappers, :ocr_key_bundle_id, :transmitter_id,
-					 :blockchain_timeout, :contract_config_tracker_poll_interval, :contract_config_confirmations,
+					 :blockchain_timeout, :contract_config_tracker_poll_interval, :contract_config_confirmations, :onchain_signing_address,
 					NOW(), NOW())
 			RETURNING id;`
 			err := pg.PrepareQueryRowx(tx, sql, &specID, jb.OCR2OracleSpec)
@@ -979,8 +979,8 @@ func (o *orm) loadPipelineRunsByID(ids []int64, tx pg.Queryer) (runs []pipeline.
 }
 
 // FindPipelineRunsByIDs returns pipeline runs with the ids.
-func (o *orm) FindPipelineRunsByIDs(ids []int64) (runs []pipeline.Run, err error) {
-	err = o.q.Transaction(func(tx pg.Queryer) error {
+func (o *orm) FindPipelineRunsByIDs(ids []int64, qopts ...pg.QOpt) (runs []pipeline.Run, err error) {
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
 		runs, err = o.loadPipelineRunsByID(ids, tx)
 		return err
 	})
@@ -989,10 +989,10 @@ func (o *orm) FindPipelineRunsByIDs(ids []int64) (runs []pipeline.Run, err error
 }
 
 // FindPipelineRunByID returns pipeline run with the id.
-func (o *orm) FindPipelineRunByID(id int64) (pipeline.Run, error) {
+func (o *orm) FindPipelineRunByID(id int64, qopts ...pg.QOpt) (pipeline.Run, error) {
 	var run pipeline.Run
 
-	err := o.q.Transaction(func(tx pg.Queryer) error {
+	err := o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
 		stmt := `
 SELECT pipeline_runs.*
 FROM pipeline_runs
@@ -1027,10 +1027,10 @@ func (o *orm) CountPipelineRunsByJobID(jobID int32) (count int32, err error) {
 	return count, errors.Wrap(err, "PipelineRunsByJobsIDs failed")
 }
 
-func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
+func (o *orm) FindJobsByPipelineSpecIDs(ids []int32, qopts ...pg.QOpt) ([]Job, error) {
 	var jbs []Job
 
-	err := o.q.Transaction(func(tx pg.Queryer) error {
+	err := o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
 		stmt := `SELECT * FROM jobs WHERE jobs.pipeline_spec_id = ANY($1) ORDER BY id ASC
 `
 		if err := tx.Select(&jbs, stmt, ids); err != nil {
@@ -1056,12 +1056,12 @@ func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
 
 // PipelineRuns returns pipeline runs for a job, with spec and taskruns loaded, latest first
 // If jobID is nil, returns all pipeline runs
-func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run, count int, err error) {
+func (o *orm) PipelineRuns(jobID *int32, offset, size int, qopts ...pg.QOpt) (runs []pipeline.Run, count int, err error) {
 	var filter string
 	if jobID != nil {
 		filter = fmt.Sprintf("JOIN jobs USING(pipeline_spec_id) WHERE jobs.id = %d", *jobID)
 	}
-	err = o.q.Transaction(func(tx pg.Queryer) error {
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
 		sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs %s`, filter)
 		if err = tx.QueryRowx(sql).Scan(&count); err != nil {
 			return errors.Wrap(err, "error counting runs")
@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -40,6 +44,28 @@ type (
 		StartService(ctx context.Context, spec Job) error
 	}
 
+	// Sharder splits jobs between the nodes sharing the database. Exclusive
+	// jobs are only run by the node which owns them, while it holds a lease
+	// on them.
+	Sharder interface {
+		// Owns returns true if this node should run the job.
+		Owns(jb Job) bool
+		// Exclusive returns true if the job must not run on several nodes at
+		// once.
+		Exclusive(jb Job) bool
+		// AcquireLeases takes or renews the leases of this node on the jobs,
+		// and returns the IDs of the jobs it holds leases on.
+		AcquireLeases(ctx context.Context, jobIDs []int32) ([]int32, error)
+		// ReleaseLeases releases the leases of this node on the jobs.
+		ReleaseLeases(ctx context.Context, jobIDs []int32) error
+		// LeaseDuration returns how long a lease lasts without being
+		// renewed.
+		LeaseDuration() time.Duration
+		// Rebalance is notified when jobs should be rebalanced, and their
+		// leases renewed.
+		Rebalance() <-chan struct{}
+	}
+
 	spawner struct {
 		orm              ORM
 		config           Config
@@ -49,8 +75,16 @@ type (
 		q                pg.Q
 		lggr             logger.Logger
 
+		// sharder is nil unless jobs are sharded, in which case shardMu
+		// serializes the starting and stopping of jobs, and guards
+		// leasesRenewedAt.
+		sharder         Sharder
+		shardMu         sync.Mutex
+		leasesRenewedAt time.Time
+
 		utils.StartStopOnce
 		chStop              chan struct{}
+		wgDone              sync.WaitGroup
 		lbDependentAwaiters []utils.DependentAwaiter
 	}
 
@@ -72,12 +106,15 @@ type (
 		delegate Delegate
 		spec     Job
 		services []ServiceCtx
+		err      error // set if the services could not be created
 	}
 )
 
 var _ Spawner = (*spawner)(nil)
 
-func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, db *sqlx.DB, lggr logger.Logger, lbDependentAwaiters []utils.DependentAwaiter) *spawner {
+// NewSpawner returns a Spawner. If sharder is not nil, the spawner only runs
+// the jobs it owns, and rebalances them when notified.
+func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, db *sqlx.DB, lggr logger.Logger, lbDependentAwaiters []utils.DependentAwaiter, sharder Sharder) *spawner {
 	namedLogger := lggr.Named("JobSpawner")
 	s := &spawner{
 		orm:                 orm,
@@ -86,6 +123,7 @@ func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, db *
 		q:                   pg.NewQ(db, namedLogger, config),
 		lggr:                namedLogger,
 		activeJobs:          make(map[int32]activeJob),
+		sharder:             sharder,
 		chStop:              make(chan struct{}),
 		lbDependentAwaiters: lbDependentAwaiters,
 	}
@@ -95,7 +133,18 @@ func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, db *
 // Start starts Spawner.
 func (js *spawner) Start(ctx context.Context) error {
 	return js.StartOnce("JobSpawner", func() error {
-		js.startAllServices(ctx)
+		if js.sharder == nil {
+			js.startAllServices(ctx)
+		} else {
+			js.rebalance(ctx)
+			js.wgDone.Add(1)
+			go js.rebalanceLoop()
+		}
+		// Log Broadcaster fully starts after all initial Register calls are done from other starting services
+		// to make sure the initial backfill covers those subscribers.
+		for _, lbd := range js.lbDependentAwaiters {
+			lbd.DependentReady()
+		}
 		return nil
 
 	})
@@ -104,6 +153,7 @@ func (js *spawner) Start(ctx context.Context) error {
 func (js *spawner) Close() error {
 	return js.StopOnce("JobSpawner", func() error {
 		close(js.chStop)
+		js.wgDone.Wait()
 		js.stopAllServices()
 		return nil
 
@@ -123,10 +173,105 @@ func (js *spawner) startAllServices(ctx context.Context) {
 			js.lggr.Errorf("Couldn't start service %q: %v", spec.Name.ValueOrZero(), err)
 		}
 	}
-	// Log Broadcaster fully starts after all initial Register calls are done from other starting services
-	// to make sure the initial backfill covers those subscribers.
-	for _, lbd := range js.lbDependentAwaiters {
-		lbd.DependentReady()
+}
+
+func (js *spawner) rebalanceLoop() {
+	defer js.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(js.chStop)
+	defer cancel()
+
+	for {
+		select {
+		case <-js.chStop:
+			return
+		case <-js.sharder.Rebalance():
+			js.rebalance(ctx)
+		}
+	}
+}
+
+// rebalance starts the jobs this node owns and holds leases on, and stops
+// the others, which includes jobs deleted by other nodes. The leases of the
+// running jobs are renewed. If they cannot be, the exclusive jobs keep
+// running until their leases expire, after which another node may take them
+// over.
+func (js *spawner) rebalance(ctx context.Context) {
+	js.shardMu.Lock()
+	defer js.shardMu.Unlock()
+
+	specs, _, err := js.orm.FindJobs(0, math.MaxUint32)
+	if err != nil {
+		js.lggr.Errorw("Failed to load jobs to rebalance", "err", err)
+		return
+	}
+
+	run := make(map[int32]Job)
+	var exclusive []int32
+	for _, jb := range specs {
+		if !js.sharder.Owns(jb) {
+			continue
+		}
+		if js.sharder.Exclusive(jb) {
+			exclusive = append(exclusive, jb.ID)
+		} else {
+			run[jb.ID] = jb
+		}
+	}
+	if len(exclusive) > 0 {
+		// The time is taken before the query, so that this node never
+		// considers its leases held for longer than the other nodes do.
+		start := time.Now()
+		leased, err := js.sharder.AcquireLeases(ctx, exclusive)
+		if err != nil {
+			js.lggr.Errorw("Failed to acquire job leases", "err", err)
+			if !js.leasesRenewedAt.IsZero() && time.Since(js.leasesRenewedAt) < js.sharder.LeaseDuration() {
+				// Keep running the jobs which are already running, while
+				// their leases have not expired.
+				active := js.ActiveJobs()
+				for _, id := range exclusive {
+					if jb, ok := active[id]; ok {
+						run[id] = jb
+					}
+				}
+			} else if !js.leasesRenewedAt.IsZero() {
+				js.lggr.Criticalw("Job leases expired, stopping the exclusive jobs", "leasesRenewedAt", js.leasesRenewedAt)
+				js.leasesRenewedAt = time.Time{}
+			}
+		} else {
+			js.leasesRenewedAt = start
+			owned := make(map[int32]Job, len(specs))
+			for _, jb := range specs {
+				owned[jb.ID] = jb
+			}
+			for _, id := range leased {
+				run[id] = owned[id]
+			}
+		}
+	}
+
+	var stopped []int32
+	for _, id := range js.activeJobIDs() {
+		if _, ok := run[id]; !ok {
+			js.lggr.Infow("Stopping job run by another node", "jobID", id)
+			js.stopService(id)
+			stopped = append(stopped, id)
+		}
+	}
+	if len(stopped) > 0 {
+		if err = js.sharder.ReleaseLeases(ctx, stopped); err != nil {
+			js.lggr.Warnw("Failed to release job leases", "err", err, "jobIDs", stopped)
+		}
+	}
+
+	active := js.ActiveJobs()
+	for id, jb := range run {
+		if _, ok := active[id]; ok {
+			continue
+		}
+		js.lggr.Infow("Starting job run by this node", "jobID", id)
+		if err = js.StartService(ctx, jb); err != nil {
+			js.lggr.Errorf("Couldn't start service %q: %v", jb.Name.ValueOrZero(), err)
+		}
 	}
 }
 
@@ -189,6 +334,7 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 		cctx, cancel := utils.ContextFromChan(js.chStop)
 		defer cancel()
 		js.orm.TryRecordError(jb.ID, err.Error(), pg.WithParentCtx(cctx))
+		aj.err = errors.Wrap(err, "failed to create services")
 		js.activeJobs[jb.ID] = aj
 		return errors.Wrapf(err, "failed to create services for job: %d", jb.ID)
 	}
@@ -211,6 +357,11 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 
 // Should not get called before Start()
 func (js *spawner) CreateJob(jb *Job, qopts ...pg.QOpt) (err error) {
+	if js.sharder != nil {
+		js.shardMu.Lock()
+		defer js.shardMu.Unlock()
+	}
+
 	delegate, exists := js.jobTypeDelegates[jb.Type]
 	if !exists {
 		js.lggr.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
@@ -239,11 +390,15 @@ func (js *spawner) CreateJob(jb *Job, qopts ...pg.QOpt) (err error) {
 	js.lggr.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
 
 	delegate.BeforeJobCreated(*jb)
-	err = js.StartService(q.ParentCtx, *jb)
-	if err != nil {
-		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "error", err)
+	if js.runsCreatedJob(q.ParentCtx, *jb) {
+		err = js.StartService(q.ParentCtx, *jb)
+		if err != nil {
+			js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "error", err)
+		} else {
+			js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
+		}
 	} else {
-		js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
+		js.lggr.Infow("Job is run by another node", "type", jb.Type, "jobID", jb.ID)
 	}
 
 	delegate.AfterJobCreated(*jb)
@@ -251,11 +406,37 @@ func (js *spawner) CreateJob(jb *Job, qopts ...pg.QOpt) (err error) {
 	return err
 }
 
+// runsCreatedJob returns true if this node runs the job it just created,
+// taking its lease if necessary. Jobs which cannot be leased yet, e.g. because
+// they were created in a transaction which is not committed, are started by a
+// later rebalance.
+func (js *spawner) runsCreatedJob(ctx context.Context, jb Job) bool {
+	if js.sharder == nil {
+		return true
+	}
+	if !js.sharder.Owns(jb) {
+		return false
+	}
+	if !js.sharder.Exclusive(jb) {
+		return true
+	}
+	leased, err := js.sharder.AcquireLeases(ctx, []int32{jb.ID})
+	if err != nil {
+		js.lggr.Errorw("Failed to acquire job lease", "jobID", jb.ID, "err", err)
+		return false
+	}
+	return len(leased) == 1
+}
+
 // Should not get called before Start()
 func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	if jobID == 0 {
 		return errors.New("will not delete job with 0 ID")
 	}
+	if js.sharder != nil {
+		js.shardMu.Lock()
+		defer js.shardMu.Unlock()
+	}
 
 	lggr := js.lggr.With("jobID", jobID)
 	lggr.Debugw("Deleting job")
@@ -318,6 +499,60 @@ func (js *spawner) ActiveJobs() map[int32]Job {
 	return m
 }
 
+// Name implements services.HealthReporter.
+func (js *spawner) Name() string { return "JobSpawner" }
+
+// HealthReport implements services.HealthReporter, with the health of the services of each active job under its
+// chain, like "EVM.1.Jobs.5", or under its ID for jobs which are not bound to a chain.
+func (js *spawner) HealthReport() map[string]error {
+	report := map[string]error{"": js.StartStopOnce.Healthy()}
+
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+	for _, aj := range js.activeJobs {
+		err := aj.err
+		for _, srv := range aj.services {
+			if c, ok := srv.(services.Checkable); ok {
+				err = multierr.Append(err, c.Healthy())
+			}
+		}
+		report[healthPath(aj.spec)] = err
+	}
+	return report
+}
+
+// healthPath returns the path of the job in the HealthReport. Jobs which do not set the ID of their chain, like
+// those of the default EVM chain, are reported under their ID.
+func healthPath(jb Job) string {
+	id := strconv.Itoa(int(jb.ID))
+	var chainID *utils.Big
+	switch {
+	case jb.OCROracleSpec != nil:
+		chainID = jb.OCROracleSpec.EVMChainID
+	case jb.OCR2OracleSpec != nil:
+		if jb.OCR2OracleSpec.Relay != relay.EVM {
+			return id
+		}
+		if v, ok := jb.OCR2OracleSpec.RelayConfig["chainID"]; ok && v != nil {
+			return fmt.Sprintf(".EVM.%v.Jobs.%s", v, id)
+		}
+	case jb.DirectRequestSpec != nil:
+		chainID = jb.DirectRequestSpec.EVMChainID
+	case jb.FluxMonitorSpec != nil:
+		chainID = jb.FluxMonitorSpec.EVMChainID
+	case jb.KeeperSpec != nil:
+		chainID = jb.KeeperSpec.EVMChainID
+	case jb.VRFSpec != nil:
+		chainID = jb.VRFSpec.EVMChainID
+	case jb.BlockhashStoreSpec != nil:
+		chainID = jb.BlockhashStoreSpec.EVMChainID
+	}
+	if chainID == nil {
+		return id
+	}
+	return fmt.Sprintf(".EVM.%s.Jobs.%s", chainID, id)
+}
+
 func (js *spawner) activeJobIDs() []int32 {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -0,0 +1,34 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestHealthPath(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		jb   Job
+		path string
+	}{
+		{"no chain", Job{ID: 1, Type: Webhook, WebhookSpec: &WebhookSpec{}}, "1"},
+		{"default chain", Job{ID: 2, Type: DirectRequest, DirectRequestSpec: &DirectRequestSpec{}}, "2"},
+		{"EVM chain", Job{ID: 3, Type: OffchainReporting, OCROracleSpec: &OCROracleSpec{EVMChainID: utils.NewBigI(5)}}, ".EVM.5.Jobs.3"},
+		{"OCR2 on an EVM chain", Job{ID: 4, Type: OffchainReporting2, OCR2OracleSpec: &OCR2OracleSpec{
+			Relay:       relay.EVM,
+			RelayConfig: JSONConfig{"chainID": float64(137)},
+		}}, ".EVM.137.Jobs.4"},
+		{"OCR2 on another chain", Job{ID: 5, Type: OffchainReporting2, OCR2OracleSpec: &OCR2OracleSpec{
+			Relay:       relay.Solana,
+			RelayConfig: JSONConfig{"chainID": "devnet"},
+		}}, "5"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.path, healthPath(test.jb))
+		})
+	}
+}
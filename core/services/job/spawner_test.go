@@ -1,13 +1,17 @@
 package job_test
 
 import (
+	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 
 	"github.com/smartcontractkit/sqlx"
 
@@ -47,6 +51,35 @@ func (d delegate) ServicesForSpec(js job.Job) ([]job.ServiceCtx, error) {
 	return d.services, nil
 }
 
+// sharder owns the jobs in owned, and leases all of them unless acquireErr
+// is set.
+type sharder struct {
+	owned         sync.Map
+	acquireErr    atomic.Error
+	leaseDuration atomic.Duration
+	chRebalance   chan struct{}
+}
+
+func (s *sharder) Owns(jb job.Job) bool {
+	_, ok := s.owned.Load(jb.ID)
+	return ok
+}
+
+func (s *sharder) Exclusive(job.Job) bool { return true }
+
+func (s *sharder) AcquireLeases(_ context.Context, jobIDs []int32) ([]int32, error) {
+	if err := s.acquireErr.Load(); err != nil {
+		return nil, err
+	}
+	return jobIDs, nil
+}
+
+func (s *sharder) ReleaseLeases(context.Context, []int32) error { return nil }
+
+func (s *sharder) LeaseDuration() time.Duration { return s.leaseDuration.Load() }
+
+func (s *sharder) Rebalance() <-chan struct{} { return s.chRebalance }
+
 func clearDB(t *testing.T, db *sqlx.DB) {
 	cltest.ClearDBTables(t, db, "jobs", "pipeline_runs", "pipeline_specs", "pipeline_task_runs")
 }
@@ -79,7 +112,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		orm := NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), bridges.NewORM(db, lggr, config), keyStore, config)
 		a := utils.NewDependentAwaiter()
 		a.AddDependents(1)
-		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{}, db, lggr, []utils.DependentAwaiter{a})
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{}, db, lggr, []utils.DependentAwaiter{a}, nil)
 		// Starting the spawner should signal to the dependents
 		result := make(chan bool)
 		go func() {
@@ -119,7 +152,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
 			jobB.Type: delegateB,
-		}, db, lggr, nil)
+		}, db, lggr, nil, nil)
 		require.NoError(t, spawner.Start(testutils.Context(t)))
 		err := spawner.CreateJob(jobA)
 		require.NoError(t, err)
@@ -168,7 +201,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
-		}, db, lggr, nil)
+		}, db, lggr, nil, nil)
 
 		err := orm.CreateJob(jobA)
 		require.NoError(t, err)
@@ -203,7 +236,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
-		}, db, lggr, nil)
+		}, db, lggr, nil, nil)
 
 		err := orm.CreateJob(jobA)
 		require.NoError(t, err)
@@ -238,4 +271,100 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 			return exists
 		}, testutils.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(false))
 	})
+
+	clearDB(t, db)
+
+	t.Run("only runs the jobs it owns, and rebalances them", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		eventuallyStart := cltest.NewAwaiter()
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), bridges.NewORM(db, lggr, config), keyStore, config)
+		mailMon := srvctest.Start(t, utils.NewMailboxMonitor(t.Name()))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		s := &sharder{chRebalance: make(chan struct{})}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil, s)
+
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		defer spawner.Close()
+
+		// owned by another node
+		require.NoError(t, spawner.CreateJob(jobA))
+		delegateA.jobID = jobA.ID
+		assert.Empty(t, spawner.ActiveJobs())
+
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyStart.ItHappened() })
+		s.owned.Store(jobA.ID, struct{}{})
+		s.chRebalance <- struct{}{}
+		eventuallyStart.AwaitOrFail(t)
+
+		eventuallyClose := cltest.NewAwaiter()
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once().Run(func(mock.Arguments) { eventuallyClose.ItHappened() })
+		s.owned.Delete(jobA.ID)
+		s.chRebalance <- struct{}{}
+		eventuallyClose.AwaitOrFail(t)
+
+		gomega.NewWithT(t).Eventually(func() bool {
+			return len(spawner.ActiveJobs()) == 0
+		}, testutils.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(true))
+	})
+
+	clearDB(t, db)
+
+	t.Run("stops the exclusive jobs once their leases expire", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		eventuallyStart := cltest.NewAwaiter()
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyStart.ItHappened() })
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), bridges.NewORM(db, lggr, config), keyStore, config)
+		mailMon := srvctest.Start(t, utils.NewMailboxMonitor(t.Name()))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		s := &sharder{chRebalance: make(chan struct{})}
+		s.leaseDuration.Store(time.Hour)
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil, s)
+
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		defer spawner.Close()
+
+		require.NoError(t, spawner.CreateJob(jobA))
+		delegateA.jobID = jobA.ID
+		assert.Empty(t, spawner.ActiveJobs())
+
+		s.owned.Store(jobA.ID, struct{}{})
+		s.chRebalance <- struct{}{}
+		eventuallyStart.AwaitOrFail(t)
+
+		// The job keeps running while its lease has not expired.
+		s.acquireErr.Store(errors.New("database is down"))
+		s.chRebalance <- struct{}{}
+		s.chRebalance <- struct{}{}
+		assert.Len(t, spawner.ActiveJobs(), 1)
+
+		eventuallyClose := cltest.NewAwaiter()
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once().Run(func(mock.Arguments) { eventuallyClose.ItHappened() })
+		s.leaseDuration.Store(0)
+		s.chRebalance <- struct{}{}
+		eventuallyClose.AwaitOrFail(t)
+
+		gomega.NewWithT(t).Eventually(func() bool {
+			return len(spawner.ActiveJobs()) == 0
+		}, testutils.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(true))
+	})
 }
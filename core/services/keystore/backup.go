@@ -0,0 +1,265 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// backupVersion is the version of the format written by ExportBackup.
+const backupVersion = 1
+
+// ErrBackupConflicts is returned when a backup cannot be imported because it
+// conflicts with the keys or eth key states of the node.
+var ErrBackupConflicts = errors.New("backup conflicts with the keystore")
+
+// encryptedBackup is the document produced by ExportBackup. The payload is
+// encrypted like the key ring, and Checksum is the hex encoded sha256 of the
+// plaintext payload.
+type encryptedBackup struct {
+	Version   int                     `json:"version"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Checksum  string                  `json:"checksum"`
+	Crypto    gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+type backupPayload struct {
+	Keys      rawKeyRing
+	EthStates []backupEthState
+}
+
+type backupEthState struct {
+	Address    ethkey.EIP55Address
+	EVMChainID utils.Big
+	NextNonce  int64
+	Disabled   bool
+}
+
+func (s backupEthState) id() string {
+	return fmt.Sprintf("EthState/%s/%s", s.Address.Hex(), s.EVMChainID.String())
+}
+
+// BackupImportReport describes the outcome of importing a backup, or, for a
+// dry run, what importing it would do. Keys are identified by their type and
+// ID, e.g. "P2P/<peer id>", and eth key states by "EthState/<address>/<chain id>".
+type BackupImportReport struct {
+	DryRun bool
+	// Imported lists the keys and states added to the keystore.
+	Imported []string
+	// Skipped lists the keys and states already present and identical.
+	Skipped []string
+	// Conflicts lists the keys and states that differ from those of the node.
+	// Nothing is imported when there is any.
+	Conflicts []BackupConflict
+}
+
+type BackupConflict struct {
+	ID     string
+	Reason string
+}
+
+// ExportBackup returns the whole key ring, with the eth key states and their
+// nonces, encrypted with password. Keys held by the remote signer are not
+// included.
+func (ks *master) ExportBackup(password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	if password == "" {
+		return nil, errors.New("backup password must not be empty")
+	}
+	payload := backupPayload{Keys: ks.keyRing.raw()}
+	// the cached nonces may be stale, the DB is the source of truth
+	states, err := ks.orm.loadKeyStates()
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states.All {
+		if key, exists := ks.keyRing.Eth[state.KeyID()]; !exists || key.IsRemote() {
+			continue
+		}
+		payload.EthStates = append(payload.EthStates, backupEthState{
+			Address:    state.Address,
+			EVMChainID: state.EVMChainID,
+			NextNonce:  state.NextNonce,
+			Disabled:   state.Disabled,
+		})
+	}
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(plaintext, []byte(adulteratedPassword(password)), ks.scryptParams.N, ks.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt backup")
+	}
+	checksum := sha256.Sum256(plaintext)
+	return json.Marshal(encryptedBackup{
+		Version:   backupVersion,
+		CreatedAt: time.Now(),
+		Checksum:  hex.EncodeToString(checksum[:]),
+		Crypto:    cryptoJSON,
+	})
+}
+
+// ImportBackup adds the keys and eth key states of a backup made by
+// ExportBackup to the keystore, in a single transaction. Keys and states that
+// are already present are skipped. If anything conflicts, nothing is imported
+// and ErrBackupConflicts is returned with the report. With dryRun, the report
+// is returned without changing anything.
+func (ks *master) ImportBackup(backupJSON []byte, password string, dryRun bool) (report BackupImportReport, err error) {
+	report.DryRun = dryRun
+	payload, err := decryptBackup(backupJSON, password)
+	if err != nil {
+		return report, err
+	}
+	backupRing, err := payload.Keys.keys()
+	if err != nil {
+		return report, errors.Wrap(err, "invalid backup")
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return report, ErrLocked
+	}
+
+	type newKey struct {
+		field string
+		id    reflect.Value
+		key   reflect.Value
+	}
+	var newKeys []newKey
+	current := reflect.ValueOf(ks.keyRing).Elem()
+	backup := reflect.ValueOf(backupRing).Elem()
+	for i := 0; i < backup.NumField(); i++ {
+		field := backup.Type().Field(i).Name
+		iter := backup.Field(i).MapRange()
+		for iter.Next() {
+			id := field + "/" + iter.Key().String()
+			existing := current.FieldByName(field).MapIndex(iter.Key())
+			switch {
+			case !existing.IsValid():
+				report.Imported = append(report.Imported, id)
+				newKeys = append(newKeys, newKey{field, iter.Key(), iter.Value()})
+			case isRemoteKey(existing):
+				report.Conflicts = append(report.Conflicts, BackupConflict{id, "key is held by the remote signer"})
+			case !reflect.DeepEqual(rawKey(existing), rawKey(iter.Value())):
+				report.Conflicts = append(report.Conflicts, BackupConflict{id, "a different key with the same ID exists"})
+			default:
+				report.Skipped = append(report.Skipped, id)
+			}
+		}
+	}
+
+	states, err := ks.orm.loadKeyStates()
+	if err != nil {
+		return report, err
+	}
+	var newStates []backupEthState
+	for _, s := range payload.EthStates {
+		existing := states.get(s.Address.Address(), s.EVMChainID.ToInt())
+		switch {
+		case existing == nil:
+			report.Imported = append(report.Imported, s.id())
+			newStates = append(newStates, s)
+		case existing.NextNonce != s.NextNonce || existing.Disabled != s.Disabled:
+			report.Conflicts = append(report.Conflicts, BackupConflict{s.id(), fmt.Sprintf(
+				"next nonce %d and disabled %t on this node, next nonce %d and disabled %t in backup",
+				existing.NextNonce, existing.Disabled, s.NextNonce, s.Disabled)})
+		default:
+			report.Skipped = append(report.Skipped, s.id())
+		}
+	}
+	sort.Strings(report.Imported)
+	sort.Strings(report.Skipped)
+	sort.Slice(report.Conflicts, func(i, j int) bool { return report.Conflicts[i].ID < report.Conflicts[j].ID })
+
+	if dryRun {
+		return report, nil
+	}
+	if len(report.Conflicts) > 0 {
+		report.Imported = nil
+		return report, ErrBackupConflicts
+	}
+	if len(newKeys) == 0 && len(newStates) == 0 {
+		return report, nil
+	}
+
+	for _, k := range newKeys {
+		current.FieldByName(k.field).SetMapIndex(k.id, k.key)
+	}
+	var inserted []*ethkey.State
+	err = ks.save(func(tx pg.Queryer) error {
+		for _, s := range newStates {
+			state := new(ethkey.State)
+			sql := `INSERT INTO evm_key_states (address, next_nonce, disabled, evm_chain_id, created_at, updated_at)
+VALUES ($1, $2, $3, $4, NOW(), NOW()) RETURNING id, next_nonce, address, evm_chain_id, disabled, created_at, updated_at;`
+			if err := tx.Get(state, sql, s.Address, s.NextNonce, s.Disabled, s.EVMChainID.String()); err != nil {
+				return errors.Wrapf(err, "failed to insert evm_key_state for %s on chain %s", s.Address.Hex(), s.EVMChainID.String())
+			}
+			inserted = append(inserted, state)
+		}
+		return nil
+	})
+	if err != nil {
+		// if save fails, remove keys from keyring
+		for _, k := range newKeys {
+			current.FieldByName(k.field).SetMapIndex(k.id, reflect.Value{})
+		}
+		report.Imported = nil
+		return report, err
+	}
+	for _, state := range inserted {
+		ks.keyStates.add(state)
+	}
+	ks.logger.Infow(fmt.Sprintf("Imported %d keys and eth key states from backup", len(report.Imported)), "imported", report.Imported)
+	ks.eth.notify()
+	return report, nil
+}
+
+// decryptBackup verifies and decrypts a backup made by ExportBackup.
+func decryptBackup(backupJSON []byte, password string) (payload backupPayload, err error) {
+	var eb encryptedBackup
+	if err = json.Unmarshal(backupJSON, &eb); err != nil {
+		return payload, errors.Wrap(err, "invalid backup")
+	}
+	if eb.Version != backupVersion {
+		return payload, errors.Errorf("unsupported backup version %d, expected %d", eb.Version, backupVersion)
+	}
+	plaintext, err := gethkeystore.DecryptDataV3(eb.Crypto, adulteratedPassword(password))
+	if err != nil {
+		return payload, errors.Wrap(err, "unable to decrypt backup")
+	}
+	checksum := sha256.Sum256(plaintext)
+	if hex.EncodeToString(checksum[:]) != eb.Checksum {
+		return payload, errors.New("backup checksum does not match its contents")
+	}
+	if err = json.Unmarshal(plaintext, &payload); err != nil {
+		return payload, errors.Wrap(err, "invalid backup")
+	}
+	return payload, nil
+}
+
+func isRemoteKey(key reflect.Value) bool {
+	k, ok := key.Interface().(ethkey.KeyV2)
+	return ok && k.IsRemote()
+}
+
+// rawKey returns the persisted form of a key of the key ring.
+func rawKey(key reflect.Value) interface{} {
+	return key.MethodByName("Raw").Call(nil)[0].Interface()
+}
@@ -0,0 +1,94 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+func TestMasterKeystore_ExportImportBackup(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	const backupPassword = "b4ckupP4ssw0rdIsL0ngEnough!"
+
+	source := keystore.ExposedNewMaster(t, pgtest.NewSqlxDB(t), cfg)
+	_, err := source.ExportBackup(backupPassword)
+	require.Equal(t, keystore.ErrLocked, err)
+
+	require.NoError(t, source.Unlock(cltest.Password))
+	ethKey, address := cltest.MustAddRandomKeyToKeystore(t, source.Eth())
+	require.NoError(t, source.Eth().Reset(address, &cltest.FixtureChainID, 5))
+	csaKey, err := source.CSA().Create()
+	require.NoError(t, err)
+	p2pKey, err := source.P2P().Create()
+	require.NoError(t, err)
+
+	backup, err := source.ExportBackup(backupPassword)
+	require.NoError(t, err)
+
+	db := pgtest.NewSqlxDB(t)
+	target := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, target.Unlock(cltest.Password))
+
+	t.Run("rejects a wrong password", func(t *testing.T) {
+		_, err := target.ImportBackup(backup, "wrong password", true)
+		require.ErrorContains(t, err, "unable to decrypt backup")
+	})
+
+	t.Run("rejects a tampered backup", func(t *testing.T) {
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(backup, &doc))
+		doc["checksum"] = "00"
+		tampered, err := json.Marshal(doc)
+		require.NoError(t, err)
+		_, err = target.ImportBackup(tampered, backupPassword, true)
+		require.EqualError(t, err, "backup checksum does not match its contents")
+	})
+
+	stateID := "EthState/" + address.Hex() + "/" + cltest.FixtureChainID.String()
+	expected := []string{"CSA/" + csaKey.ID(), "Eth/" + ethKey.ID(), stateID, "P2P/" + p2pKey.ID()}
+
+	report, err := target.ImportBackup(backup, backupPassword, true)
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, expected, report.Imported)
+	assert.Empty(t, report.Conflicts)
+	cltest.AssertCount(t, db, "evm_key_states", 0)
+	_, err = target.CSA().Get(csaKey.ID())
+	require.Error(t, err)
+
+	report, err = target.ImportBackup(backup, backupPassword, false)
+	require.NoError(t, err)
+	assert.Equal(t, expected, report.Imported)
+	nonce, err := target.Eth().GetNextNonce(address, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), nonce)
+	_, err = target.CSA().Get(csaKey.ID())
+	require.NoError(t, err)
+
+	// keys are persisted
+	target.ResetXXXTestOnly()
+	require.NoError(t, target.Unlock(cltest.Password))
+	_, err = target.P2P().Get(p2pKey.PeerID())
+	require.NoError(t, err)
+
+	report, err = target.ImportBackup(backup, backupPassword, false)
+	require.NoError(t, err)
+	assert.Empty(t, report.Imported)
+	assert.Equal(t, expected, report.Skipped)
+
+	require.NoError(t, target.Eth().Reset(address, &cltest.FixtureChainID, 7))
+	report, err = target.ImportBackup(backup, backupPassword, false)
+	require.ErrorIs(t, err, keystore.ErrBackupConflicts)
+	require.Len(t, report.Conflicts, 1)
+	assert.Equal(t, stateID, report.Conflicts[0].ID)
+	assert.Equal(t, "next nonce 7 and disabled false on this node, next nonce 5 and disabled false in backup", report.Conflicts[0].Reason)
+}
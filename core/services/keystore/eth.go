@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -10,9 +11,11 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
@@ -38,6 +41,7 @@ type Eth interface {
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 
 	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignHash(address common.Address, hash []byte) ([]byte, error)
 
 	EnabledKeysForChain(chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -128,6 +132,16 @@ func (ks *eth) EnsureKeys(chainIDs ...*big.Int) (err error) {
 		if len(keys) > 0 {
 			continue
 		}
+		if remoteKeys := ks.remoteKeys(); len(remoteKeys) > 0 {
+			// prefer keys held by the remote signer over creating a local one
+			for _, key := range remoteKeys {
+				if err = ks.enable(key.Address, chainID); err != nil {
+					return err
+				}
+				ks.logger.Infow(fmt.Sprintf("Enabled remote EVM key with ID %s", key.Address.Hex()), "address", key.Address.Hex(), "evmChainID", chainID)
+			}
+			continue
+		}
 		newKey, err := ethkey.NewV2()
 		if err != nil {
 			return err
@@ -174,6 +188,9 @@ func (ks *eth) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if key.IsRemote() {
+		return nil, errors.Errorf("key %s is held by the remote signer and cannot be exported", id)
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
@@ -305,6 +322,9 @@ func (ks *eth) Delete(id string) (ethkey.KeyV2, error) {
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
+	if key.IsRemote() {
+		return ethkey.KeyV2{}, errors.Errorf("key %s is held by the remote signer and cannot be deleted", id)
+	}
 	err = ks.safeRemoveKey(key, func(tx pg.Queryer) error {
 		_, err2 := tx.Exec(`DELETE FROM evm_key_states WHERE address = $1`, key.Address)
 		return err2
@@ -335,19 +355,57 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 }
 
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.Hex())
+	key, err := ks.signingKey(address)
 	if err != nil {
 		return nil, err
 	}
 	signer := types.LatestSignerForChainID(chainID)
+	if key.IsRemote() {
+		h := signer.Hash(tx)
+		sig, err := ks.signRemote(address, h[:])
+		if err != nil {
+			return nil, err
+		}
+		return tx.WithSignature(signer, sig)
+	}
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
+// SignHash signs the 32 byte hash with the key for address, returning a 65
+// byte [R || S || V] signature where V is 0 or 1.
+func (ks *eth) SignHash(address common.Address, hash []byte) ([]byte, error) {
+	key, err := ks.signingKey(address)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsRemote() {
+		return ks.signRemote(address, hash)
+	}
+	return crypto.Sign(hash, key.ToEcdsaPrivKey())
+}
+
+// signingKey returns the key for address. The lock is only held while looking the key up, so that a slow
+// remote signer does not block the keystore.
+func (ks *eth) signingKey(address common.Address) (ethkey.KeyV2, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	return ks.getByID(address.Hex())
+}
+
+// signRemote must be called without holding the lock, since the remote signer may be slow.
+func (ks *eth) signRemote(address common.Address, hash []byte) ([]byte, error) {
+	if ks.remoteSigner == nil {
+		return nil, errors.Errorf("key %s is held by a remote signer, but none is configured", address.Hex())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), remotesigner.DefaultTimeout)
+	defer cancel()
+	sig, err := ks.remoteSigner.SignHash(ctx, address, hash)
+	return sig, errors.Wrapf(err, "failed to sign with remote key %s", address.Hex())
+}
+
 // EnabledKeysForChain returns all keys that are enabled for the given chain
 func (ks *eth) EnabledKeysForChain(chainID *big.Int) (sendingKeys []ethkey.KeyV2, err error) {
 	if chainID == nil {
@@ -583,6 +641,17 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	return keys
 }
 
+// caller must hold lock!
+func (ks *eth) remoteKeys() (keys []ethkey.KeyV2) {
+	for _, k := range ks.keyRing.Eth {
+		if k.IsRemote() {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
+	return keys
+}
+
 // caller must hold lock!
 func (ks *eth) add(key ethkey.KeyV2, chainIDs ...*big.Int) (err error) {
 	err = ks.safeAddKey(key, func(tx pg.Queryer) (serr error) {
@@ -4,12 +4,17 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
@@ -19,8 +24,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -302,6 +309,116 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	remoteKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	remoteAddress := crypto.PubkeyToAddress(remoteKey.PublicKey)
+	localSigner := remotesigner.NewLocalSigner("", remoteKey)
+	var blockSigning atomic.Bool
+	signing, release := make(chan struct{}), make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if blockSigning.Load() && strings.HasPrefix(r.URL.Path, "/v1/signHash/") {
+			signing <- struct{}{}
+			<-release
+		}
+		localSigner.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	signer := remotesigner.NewClient(*u, "", logger.TestLogger(t))
+
+	keyStore := keystore.NewWithRemoteSigner(db, utils.FastScryptParams, logger.TestLogger(t), cfg, signer)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ethKeyStore := keyStore.Eth()
+
+	key, err := ethKeyStore.Get(remoteAddress.Hex())
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+
+	t.Run("EnsureKeys enables remote keys instead of creating local ones", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.EnsureKeys(&cltest.FixtureChainID))
+		keys, err := ethKeyStore.GetAll()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		enabled, err := ethKeyStore.EnabledKeysForChain(&cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, enabled, 1)
+		assert.Equal(t, remoteAddress, enabled[0].Address)
+	})
+
+	t.Run("SignTx", func(t *testing.T) {
+		chainID := big.NewInt(evmclient.NullClientChainID)
+		tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+		signed, err := ethKeyStore.SignTx(remoteAddress, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, remoteAddress, sender)
+	})
+
+	t.Run("SignHash", func(t *testing.T) {
+		hash := crypto.Keccak256([]byte("report"))
+		sig, err := ethKeyStore.SignHash(remoteAddress, hash)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		assert.Equal(t, remoteAddress, crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("a slow remote signer does not block the keystore", func(t *testing.T) {
+		blockSigning.Store(true)
+		defer blockSigning.Store(false)
+		errs := make(chan error)
+		go func() {
+			_, err := ethKeyStore.SignHash(remoteAddress, crypto.Keccak256([]byte("report")))
+			errs <- err
+		}()
+		<-signing
+
+		// GetRoundRobinAddress takes the write lock.
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, err := ethKeyStore.GetRoundRobinAddress(&cltest.FixtureChainID)
+			assert.NoError(t, err)
+		}()
+		select {
+		case <-done:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("keystore blocked while waiting for the remote signer")
+		}
+
+		close(release)
+		require.NoError(t, <-errs)
+	})
+
+	t.Run("remote keys cannot be exported or deleted", func(t *testing.T) {
+		_, err := ethKeyStore.Export(remoteAddress.Hex(), cltest.Password)
+		assert.ErrorContains(t, err, "is held by the remote signer and cannot be exported")
+		_, err = ethKeyStore.Delete(remoteAddress.Hex())
+		assert.ErrorContains(t, err, "is held by the remote signer and cannot be deleted")
+	})
+
+	t.Run("remote keys are not persisted", func(t *testing.T) {
+		_, err := ethKeyStore.Create(&cltest.FixtureChainID)
+		require.NoError(t, err)
+
+		local := keystore.New(db, utils.FastScryptParams, logger.TestLogger(t), cfg)
+		require.NoError(t, local.Unlock(cltest.Password))
+		keys, err := local.Eth().GetAll()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.NotEqual(t, remoteAddress, keys[0].Address)
+		assert.False(t, keys[0].IsRemote())
+	})
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
@@ -20,7 +20,7 @@ func mustNewEthKey(t *testing.T) *ethkey.KeyV2 {
 }
 
 func ExposedNewMaster(t *testing.T, db *sqlx.DB, cfg pg.QConfig) *master {
-	return newMaster(db, utils.FastScryptParams, logger.TestLogger(t), cfg)
+	return newMaster(db, utils.FastScryptParams, logger.TestLogger(t), cfg, nil)
 }
 
 func (m *master) ExportedSave() error {
@@ -64,6 +64,16 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromRemote returns a key for an address whose private key is held by an
+// external signer. Remote keys carry no private key material and are never
+// persisted to the encrypted key ring.
+func FromRemote(address common.Address) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: EIP55AddressFromAddress(address),
+	}
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
@@ -72,6 +82,11 @@ func (key KeyV2) Raw() Raw {
 	return key.privateKey.D.Bytes()
 }
 
+// IsRemote returns true if the private key is held by an external signer
+func (key KeyV2) IsRemote() bool {
+	return key.privateKey == nil
+}
+
 func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
@@ -41,3 +41,15 @@ func TestEthKeyV2_NewV2(t *testing.T) {
 	assert.NotNil(t, keyV2.privateKey)
 	assert.Equal(t, keyV2.Address.Hex(), keyV2.ID())
 }
+
+func TestEthKeyV2_FromRemote(t *testing.T) {
+	local, err := NewV2()
+	require.NoError(t, err)
+	assert.False(t, local.IsRemote())
+
+	remote := FromRemote(local.Address)
+	assert.True(t, remote.IsRemote())
+	assert.Nil(t, remote.ToEcdsaPrivKey())
+	assert.Equal(t, local.ID(), remote.ID())
+	assert.Equal(t, local.EIP55Address, remote.EIP55Address)
+}
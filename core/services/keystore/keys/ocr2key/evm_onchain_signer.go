@@ -0,0 +1,55 @@
+package ocr2key
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
+)
+
+// HashSigner signs a 32 byte hash, returning a 65 byte [R || S || V] signature.
+type HashSigner func(hash []byte) ([]byte, error)
+
+var _ KeyBundle = &evmOnchainSignerBundle{}
+
+// evmOnchainSignerBundle replaces the onchain keyring of an EVM bundle with an
+// account whose key is held elsewhere, e.g. by a remote signer.
+type evmOnchainSignerBundle struct {
+	KeyBundle
+	address common.Address
+	sign    HashSigner
+}
+
+// WithEVMOnchainSigner returns kb with reports signed by address through sign,
+// instead of by the onchain key of the bundle. The offchain keys of kb are
+// still used for everything else.
+func WithEVMOnchainSigner(kb KeyBundle, address common.Address, sign HashSigner) (KeyBundle, error) {
+	if kb.ChainType() != chaintype.EVM {
+		return nil, errors.Errorf("onchain signer is only supported for %s key bundles, got %s", chaintype.EVM, kb.ChainType())
+	}
+	return &evmOnchainSignerBundle{KeyBundle: kb, address: address, sign: sign}, nil
+}
+
+// PublicKey returns the address of the signer, like the evmKeyring
+func (b *evmOnchainSignerBundle) PublicKey() ocrtypes.OnchainPublicKey {
+	return b.address[:]
+}
+
+func (b *evmOnchainSignerBundle) OnChainPublicKey() string {
+	return hex.EncodeToString(b.PublicKey())
+}
+
+func (b *evmOnchainSignerBundle) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	return b.sign((&evmKeyring{}).reportToSigData(reportCtx, report))
+}
+
+func (b *evmOnchainSignerBundle) Verify(publicKey ocrtypes.OnchainPublicKey, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
+	return (&evmKeyring{}).Verify(publicKey, reportCtx, report, signature)
+}
+
+func (b *evmOnchainSignerBundle) MaxSignatureLength() int {
+	return (&evmKeyring{}).MaxSignatureLength()
+}
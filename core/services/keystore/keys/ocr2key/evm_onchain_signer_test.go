@@ -0,0 +1,44 @@
+package ocr2key
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/chaintype"
+)
+
+func TestWithEVMOnchainSigner(t *testing.T) {
+	signerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(signerKey.PublicKey)
+	sign := func(hash []byte) ([]byte, error) { return crypto.Sign(hash, signerKey) }
+
+	kb, err := New(chaintype.EVM)
+	require.NoError(t, err)
+	wrapped, err := WithEVMOnchainSigner(kb, address, sign)
+	require.NoError(t, err)
+
+	assert.Equal(t, kb.ID(), wrapped.ID())
+	assert.Equal(t, kb.OffchainPublicKey(), wrapped.OffchainPublicKey())
+	assert.Equal(t, ocrtypes.OnchainPublicKey(address.Bytes()), wrapped.PublicKey())
+	assert.Equal(t, hex.EncodeToString(address.Bytes()), wrapped.OnChainPublicKey())
+
+	reportCtx := ocrtypes.ReportContext{}
+	report := ocrtypes.Report{0x01, 0x02}
+	sig, err := wrapped.Sign(reportCtx, report)
+	require.NoError(t, err)
+	assert.True(t, wrapped.Verify(wrapped.PublicKey(), reportCtx, report, sig))
+	assert.True(t, kb.Verify(wrapped.PublicKey(), reportCtx, report, sig))
+	assert.False(t, wrapped.Verify(kb.PublicKey(), reportCtx, report, sig))
+
+	solKb, err := New(chaintype.Solana)
+	require.NoError(t, err)
+	_, err = WithEVMOnchainSigner(solKb, address, sign)
+	assert.EqualError(t, err, "onchain signer is only supported for evm key bundles, got solana")
+}
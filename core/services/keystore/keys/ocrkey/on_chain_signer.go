@@ -0,0 +1,33 @@
+package ocrkey
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+)
+
+var _ ocrtypes.PrivateKeys = &keyWithOnChainSigner{}
+
+// keyWithOnChainSigner replaces the on-chain signing key of a KeyV2 with an
+// account whose key is held elsewhere, e.g. by a remote signer.
+type keyWithOnChainSigner struct {
+	KeyV2
+	address common.Address
+	sign    func(hash []byte) ([]byte, error)
+}
+
+// WithOnChainSigner returns key with reports signed by address through sign,
+// which must return a 65 byte [R || S || V] signature of the 32 byte hash. The
+// off-chain keys of key are still used for everything else.
+func WithOnChainSigner(key KeyV2, address common.Address, sign func(hash []byte) ([]byte, error)) ocrtypes.PrivateKeys {
+	return &keyWithOnChainSigner{KeyV2: key, address: address, sign: sign}
+}
+
+// SignOnChain returns an ethereum-style ECDSA secp256k1 signature on msg.
+func (k *keyWithOnChainSigner) SignOnChain(msg []byte) (signature []byte, err error) {
+	return k.sign(onChainHash(msg))
+}
+
+// PublicKeyAddressOnChain returns the address of the on-chain signer
+func (k *keyWithOnChainSigner) PublicKeyAddressOnChain() ocrtypes.OnChainSigningAddress {
+	return ocrtypes.OnChainSigningAddress(k.address)
+}
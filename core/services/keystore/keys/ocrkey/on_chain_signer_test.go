@@ -0,0 +1,34 @@
+package ocrkey
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOCRKeys_WithOnChainSigner(t *testing.T) {
+	t.Parallel()
+
+	signerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(signerKey.PublicKey)
+
+	key, err := NewV2()
+	require.NoError(t, err)
+	k := WithOnChainSigner(key, address, func(hash []byte) ([]byte, error) {
+		return crypto.Sign(hash, signerKey)
+	})
+
+	assert.Equal(t, ocrtypes.OnChainSigningAddress(address), k.PublicKeyAddressOnChain())
+	assert.Equal(t, key.PublicKeyOffChain(), k.PublicKeyOffChain())
+
+	msg := []byte("hello world")
+	sig, err := k.SignOnChain(msg)
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(onChainHash(msg), sig)
+	require.NoError(t, err)
+	assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+}
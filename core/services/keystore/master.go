@@ -1,6 +1,8 @@
 package keystore
 
 import (
+	"context"
+	"crypto/subtle"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -23,12 +25,17 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 var ErrLocked = errors.New("Keystore is locked")
 
+// ErrWrongPassword is returned when rotating the password with an old password
+// that does not match the one the keystore was unlocked with.
+var ErrWrongPassword = errors.New("old password does not match")
+
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
 // necessary because it is lazily evaluated
 type DefaultEVMChainIDFunc func() (defaultEVMChainID *big.Int, err error)
@@ -48,6 +55,9 @@ type Master interface {
 	StarkNet() StarkNet
 	VRF() VRF
 	Unlock(password string) error
+	ChangePassword(oldPassword, newPassword string, scryptParams utils.ScryptParams) error
+	ExportBackup(password string) ([]byte, error)
+	ImportBackup(backupJSON []byte, password string, dryRun bool) (BackupImportReport, error)
 	Migrate(vrfPassword string, f DefaultEVMChainIDFunc) error
 	IsEmpty() (bool, error)
 }
@@ -68,15 +78,23 @@ type master struct {
 }
 
 func New(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.QConfig) Master {
-	return newMaster(db, scryptParams, lggr, cfg)
+	return newMaster(db, scryptParams, lggr, cfg, nil)
+}
+
+// NewWithRemoteSigner returns a Master whose Eth keystore also holds the keys
+// of an external signer. Those keys are added on Unlock and never persisted;
+// anything signed with them is delegated to the signer.
+func NewWithRemoteSigner(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.QConfig, signer remotesigner.Signer) Master {
+	return newMaster(db, scryptParams, lggr, cfg, signer)
 }
 
-func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.QConfig) *master {
+func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.QConfig, signer remotesigner.Signer) *master {
 	km := &keyManager{
 		orm:          NewORM(db, lggr, cfg),
 		scryptParams: scryptParams,
 		lock:         &sync.RWMutex{},
 		logger:       lggr.Named("KeyStore"),
+		remoteSigner: signer,
 	}
 
 	return &master{
@@ -243,6 +261,7 @@ type keyManager struct {
 	lock         *sync.RWMutex
 	password     string
 	logger       logger.Logger
+	remoteSigner remotesigner.Signer
 }
 
 func (km *keyManager) Unlock(password string) error {
@@ -272,10 +291,72 @@ func (km *keyManager) Unlock(password string) error {
 	}
 	km.keyStates = ks
 
+	if km.remoteSigner != nil {
+		if err = km.addRemoteEthKeys(); err != nil {
+			return err
+		}
+	}
+
 	km.password = password
 	return nil
 }
 
+// addRemoteEthKeys adds the keys held by the remote signer to the key ring.
+// caller must hold lock!
+func (km *keyManager) addRemoteEthKeys() error {
+	ctx, cancel := context.WithTimeout(context.Background(), remotesigner.DefaultTimeout)
+	defer cancel()
+	addresses, err := km.remoteSigner.Addresses(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get keys from remote signer")
+	}
+	for _, address := range addresses {
+		key := ethkey.FromRemote(address)
+		if _, exists := km.keyRing.Eth[key.ID()]; exists {
+			km.logger.Warnw("Key held by remote signer is also in the local keystore, using local key", "address", key.ID())
+			continue
+		}
+		km.keyRing.Eth[key.ID()] = key
+	}
+	km.logger.Infow(fmt.Sprintf("Loaded %d EVM keys from remote signer", len(addresses)), "addresses", addresses)
+	return nil
+}
+
+// ChangePassword re-encrypts the whole key ring with newPassword and
+// scryptParams. The previous ciphertext is kept as a backup, and the new one is
+// verified to decrypt before it replaces it.
+func (km *keyManager) ChangePassword(oldPassword, newPassword string, scryptParams utils.ScryptParams) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(km.password)) != 1 {
+		return ErrWrongPassword
+	}
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+	ekr, err := km.keyRing.Encrypt(newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	kr, err := ekr.Decrypt(newPassword)
+	if err != nil {
+		return errors.Wrap(err, "unable to verify re-encrypted keyRing")
+	}
+	if !reflect.DeepEqual(kr.persistedIDs(), km.keyRing.persistedIDs()) {
+		return errors.New("unable to verify re-encrypted keyRing: keys do not match")
+	}
+	if err = km.orm.rotateEncryptedKeyRing(&ekr); err != nil {
+		return err
+	}
+	km.password = newPassword
+	km.scryptParams = scryptParams
+	km.logger.Info("Keystore password rotated")
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(callbacks ...func(pg.Queryer) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
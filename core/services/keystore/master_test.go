@@ -10,6 +10,7 @@ import (
 	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -61,3 +62,39 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	const newPassword = "n3wP4ssw0rdIsL0ngEnough!"
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.Equal(t, keystore.ErrLocked, keyStore.ChangePassword(cltest.Password, newPassword, utils.FastScryptParams))
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	key, _ := cltest.MustAddRandomKeyToKeystore(t, keyStore.Eth())
+	_, err := keyStore.CSA().Create()
+	require.NoError(t, err)
+
+	require.Equal(t, keystore.ErrWrongPassword, keyStore.ChangePassword("wrong password", newPassword, utils.FastScryptParams))
+	cltest.AssertCount(t, db, "encrypted_key_ring_backups", 0)
+
+	require.NoError(t, keyStore.ChangePassword(cltest.Password, newPassword, utils.FastScryptParams))
+	cltest.AssertCount(t, db, "encrypted_key_ring_backups", 1)
+
+	// keys saved after rotation use the new password
+	_, err = keyStore.P2P().Create()
+	require.NoError(t, err)
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(cltest.Password))
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(newPassword))
+	_, err = keyStore.Eth().Get(key.Address.Hex())
+	require.NoError(t, err)
+	keys, err := keyStore.P2P().GetAll()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+}
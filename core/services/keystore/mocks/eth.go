@@ -6,6 +6,7 @@ import (
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
+
 	ethkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 
 	mock "github.com/stretchr/testify/mock"
@@ -429,6 +430,29 @@ func (_m *Eth) Reset(address common.Address, chainID *big.Int, nonce int64, qopt
 	return r0
 }
 
+// SignHash provides a mock function with given fields: address, hash
+func (_m *Eth) SignHash(address common.Address, hash []byte) ([]byte, error) {
+	ret := _m.Called(address, hash)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(common.Address, []byte) []byte); ok {
+		r0 = rf(address, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, []byte) error); ok {
+		r1 = rf(address, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignTx provides a mock function with given fields: fromAddress, tx, chainID
 func (_m *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(fromAddress, tx, chainID)
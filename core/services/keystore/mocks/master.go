@@ -4,7 +4,10 @@ package mocks
 
 import (
 	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
+
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -28,6 +31,20 @@ func (_m *Master) CSA() keystore.CSA {
 	return r0
 }
 
+// ChangePassword provides a mock function with given fields: oldPassword, newPassword, scryptParams
+func (_m *Master) ChangePassword(oldPassword string, newPassword string, scryptParams utils.ScryptParams) error {
+	ret := _m.Called(oldPassword, newPassword, scryptParams)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, utils.ScryptParams) error); ok {
+		r0 = rf(oldPassword, newPassword, scryptParams)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DKGEncrypt provides a mock function with given fields:
 func (_m *Master) DKGEncrypt() keystore.DKGEncrypt {
 	ret := _m.Called()
@@ -76,6 +93,50 @@ func (_m *Master) Eth() keystore.Eth {
 	return r0
 }
 
+// ExportBackup provides a mock function with given fields: password
+func (_m *Master) ExportBackup(password string) ([]byte, error) {
+	ret := _m.Called(password)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportBackup provides a mock function with given fields: backupJSON, password, dryRun
+func (_m *Master) ImportBackup(backupJSON []byte, password string, dryRun bool) (keystore.BackupImportReport, error) {
+	ret := _m.Called(backupJSON, password, dryRun)
+
+	var r0 keystore.BackupImportReport
+	if rf, ok := ret.Get(0).(func([]byte, string, bool) keystore.BackupImportReport); ok {
+		r0 = rf(backupJSON, password, dryRun)
+	} else {
+		r0 = ret.Get(0).(keystore.BackupImportReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, string, bool) error); ok {
+		r1 = rf(backupJSON, password, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsEmpty provides a mock function with given fields:
 func (_m *Master) IsEmpty() (bool, error) {
 	ret := _m.Called()
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/dkgencryptkey"
@@ -188,11 +190,32 @@ func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ek
 	}, nil
 }
 
+// persistedIDs returns the sorted type-prefixed IDs of all keys that are
+// persisted with the key ring, i.e. all keys but those of the remote signer.
+func (kr *keyRing) persistedIDs() (ids []string) {
+	v := reflect.ValueOf(kr).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		iter := v.Field(i).MapRange()
+		for iter.Next() {
+			if k, ok := iter.Value().Interface().(ethkey.KeyV2); ok && k.IsRemote() {
+				continue
+			}
+			ids = append(ids, v.Type().Field(i).Name+"/"+iter.Key().String())
+		}
+	}
+	sort.Strings(ids)
+	return
+}
+
 func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, csaKey := range kr.CSA {
 		rawKeys.CSA = append(rawKeys.CSA, csaKey.Raw())
 	}
 	for _, ethKey := range kr.Eth {
+		if ethKey.IsRemote() {
+			// private key is held by the remote signer
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, ethKey.Raw())
 	}
 	for _, ocrKey := range kr.OCR {
@@ -116,3 +116,21 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	require.Equal(t, originalKeyRing.DKGEncrypt[dkgencrypt1.ID()].PublicKey, decryptedKeyRing.DKGEncrypt[dkgencrypt1.ID()].PublicKey)
 	require.Equal(t, originalKeyRing.DKGEncrypt[dkgencrypt2.ID()].PublicKey, decryptedKeyRing.DKGEncrypt[dkgencrypt2.ID()].PublicKey)
 }
+
+func TestKeyRing_persistedIDs(t *testing.T) {
+	eth := mustNewEthKey(t)
+	remote := ethkey.FromRemote(mustNewEthKey(t).Address)
+	csa := csakey.MustNewV2XXXTestingOnly(big.NewInt(1))
+
+	kr := newKeyRing()
+	kr.Eth[eth.ID()] = *eth
+	kr.Eth[remote.ID()] = remote
+	kr.CSA[csa.ID()] = csa
+	require.Equal(t, []string{"CSA/" + csa.ID(), "Eth/" + eth.ID()}, kr.persistedIDs())
+
+	ekr, err := kr.Encrypt(password, utils.FastScryptParams)
+	require.NoError(t, err)
+	kr2, err := ekr.Decrypt(password)
+	require.NoError(t, err)
+	require.Equal(t, kr.persistedIDs(), kr2.persistedIDs())
+}
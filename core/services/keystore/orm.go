@@ -49,6 +49,25 @@ func (orm ksORM) saveEncryptedKeyRing(kr *encryptedKeyRing, callbacks ...func(pg
 	})
 }
 
+// rotateEncryptedKeyRing replaces the key ring with kr, keeping a backup of the
+// previous ciphertext.
+func (orm ksORM) rotateEncryptedKeyRing(kr *encryptedKeyRing) error {
+	return orm.q.Transaction(func(tx pg.Queryer) error {
+		_, err := tx.Exec(`
+		INSERT INTO encrypted_key_ring_backups (encrypted_keys, created_at)
+		SELECT encrypted_keys, NOW() FROM encrypted_key_rings
+	`)
+		if err != nil {
+			return errors.Wrap(err, "while backing up keyring")
+		}
+		_, err = tx.Exec(`
+		UPDATE encrypted_key_rings
+		SET encrypted_keys = $1, updated_at = NOW()
+	`, kr.EncryptedKeys)
+		return errors.Wrap(err, "while saving keyring")
+	})
+}
+
 func (orm ksORM) getEncryptedKeyRing() (kr encryptedKeyRing, err error) {
 	err = orm.q.Get(&kr, `SELECT * FROM encrypted_key_rings LIMIT 1`)
 	if errors.Is(err, sql.ErrNoRows) {
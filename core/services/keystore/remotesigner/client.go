@@ -0,0 +1,183 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// DefaultTimeout bounds every request made to the remote signer.
+const DefaultTimeout = 10 * time.Second
+
+// maxResponseSize limits how much of a response body is read.
+const maxResponseSize = 1 << 20
+
+var _ Signer = &client{}
+
+type client struct {
+	url         url.URL
+	bearerToken string
+	http        *http.Client
+	lggr        logger.SugaredLogger
+
+	mu         sync.RWMutex
+	publicKeys map[common.Address]string
+}
+
+// NewClient returns a Signer backed by the remote signer at u.
+func NewClient(u url.URL, bearerToken string, lggr logger.Logger) Signer {
+	return &client{
+		url:         u,
+		bearerToken: bearerToken,
+		http:        &http.Client{Timeout: DefaultTimeout},
+		lggr:        logger.Sugared(lggr.Named("RemoteSigner")),
+		publicKeys:  make(map[common.Address]string),
+	}
+}
+
+// Addresses fetches the public keys held by the signer, and refreshes the
+// address to public key mapping used by SignHash.
+func (c *client) Addresses(ctx context.Context) ([]common.Address, error) {
+	b, err := c.do(ctx, http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	var pubKeys []string
+	if err = json.Unmarshal(b, &pubKeys); err != nil {
+		return nil, errors.Wrap(err, "failed to decode public keys from remote signer")
+	}
+	publicKeys := make(map[common.Address]string, len(pubKeys))
+	addresses := make([]common.Address, 0, len(pubKeys))
+	for _, pk := range pubKeys {
+		raw, err := hexutil.Decode(pk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public key %q from remote signer", pk)
+		}
+		pub, err := crypto.UnmarshalPubkey(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public key %q from remote signer", pk)
+		}
+		address := crypto.PubkeyToAddress(*pub)
+		if _, exists := publicKeys[address]; exists {
+			continue
+		}
+		publicKeys[address] = hexutil.Encode(raw)
+		addresses = append(addresses, address)
+	}
+	c.mu.Lock()
+	c.publicKeys = publicKeys
+	c.mu.Unlock()
+	return addresses, nil
+}
+
+// SignHash signs hash with the remote key for address. The returned signature
+// is checked to recover to address, so a misbehaving signer cannot cause the
+// node to broadcast data signed by an unexpected account.
+func (c *client) SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error) {
+	if len(hash) != common.HashLength {
+		return nil, errors.Errorf("hash must be %d bytes, got %d", common.HashLength, len(hash))
+	}
+	pubKey, err := c.publicKey(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	req, err := json.Marshal(signRequest{Hash: hexutil.Encode(hash)})
+	if err != nil {
+		return nil, err
+	}
+	b, err := c.do(ctx, http.MethodPost, signPathPrefix+pubKey, req)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := decodeSignature(b)
+	if err != nil {
+		return nil, err
+	}
+	recovered, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from remote signer")
+	}
+	if got := crypto.PubkeyToAddress(*recovered); got != address {
+		return nil, errors.Errorf("remote signer returned a signature from %s, expected %s", got, address)
+	}
+	return sig, nil
+}
+
+// publicKey returns the public key for address, refreshing the keys from the
+// signer once if it is not known yet.
+func (c *client) publicKey(ctx context.Context, address common.Address) (string, error) {
+	c.mu.RLock()
+	pk, ok := c.publicKeys[address]
+	c.mu.RUnlock()
+	if ok {
+		return pk, nil
+	}
+	if _, err := c.Addresses(ctx); err != nil {
+		return "", err
+	}
+	c.mu.RLock()
+	pk, ok = c.publicKeys[address]
+	c.mu.RUnlock()
+	if !ok {
+		return "", errors.Errorf("remote signer does not hold a key for address %s", address)
+	}
+	return pk, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	u := c.url
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "remote signer request %s %s failed", method, path)
+	}
+	defer c.lggr.ErrorIfFn(resp.Body.Close, "Error closing remote signer response body")
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read remote signer response for %s %s", method, path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("remote signer request %s %s failed with status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+	return b, nil
+}
+
+// decodeSignature accepts a hex signature either as plain text or as a JSON
+// string, and normalizes V to 0 or 1.
+func decodeSignature(b []byte) ([]byte, error) {
+	s := strings.Trim(strings.TrimSpace(string(b)), `"`)
+	sig, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from remote signer")
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, errors.Errorf("signature from remote signer must be %d bytes, got %d", crypto.SignatureLength, len(sig))
+	}
+	if v := sig[crypto.RecoveryIDOffset]; v >= 27 {
+		sig[crypto.RecoveryIDOffset] = v - 27
+	}
+	return sig, nil
+}
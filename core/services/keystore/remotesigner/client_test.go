@@ -0,0 +1,101 @@
+package remotesigner_test
+
+import (
+	"crypto/ecdsa"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func newTestClient(t *testing.T, h http.Handler, token string) remotesigner.Signer {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return remotesigner.NewClient(*u, token, logger.TestLogger(t))
+}
+
+func TestClient_LocalSigner(t *testing.T) {
+	t.Parallel()
+
+	k1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	k2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	local := remotesigner.NewLocalSigner("secret", k1, k2)
+
+	t.Run("addresses", func(t *testing.T) {
+		c := newTestClient(t, local, "secret")
+		addrs, err := c.Addresses(testutils.Context(t))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []common.Address{crypto.PubkeyToAddress(k1.PublicKey), crypto.PubkeyToAddress(k2.PublicKey)}, addrs)
+	})
+
+	t.Run("sign", func(t *testing.T) {
+		c := newTestClient(t, local, "secret")
+		hash := crypto.Keccak256([]byte("report"))
+		for _, k := range []*ecdsa.PrivateKey{k1, k2} {
+			address := crypto.PubkeyToAddress(k.PublicKey)
+			sig, err := c.SignHash(testutils.Context(t), address, hash)
+			require.NoError(t, err)
+			require.Len(t, sig, crypto.SignatureLength)
+			assert.Less(t, sig[crypto.RecoveryIDOffset], byte(2))
+			pub, err := crypto.SigToPub(hash, sig)
+			require.NoError(t, err)
+			assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+		}
+	})
+
+	t.Run("unknown address", func(t *testing.T) {
+		c := newTestClient(t, local, "secret")
+		_, err := c.SignHash(testutils.Context(t), utils.RandomAddress(), crypto.Keccak256([]byte("report")))
+		assert.ErrorContains(t, err, "remote signer does not hold a key for address")
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+		c := newTestClient(t, local, "secret")
+		_, err := c.SignHash(testutils.Context(t), crypto.PubkeyToAddress(k1.PublicKey), []byte("short"))
+		assert.EqualError(t, err, "hash must be 32 bytes, got 5")
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		c := newTestClient(t, local, "wrong")
+		_, err := c.Addresses(testutils.Context(t))
+		assert.ErrorContains(t, err, "failed with status 401: unauthorized")
+	})
+}
+
+func TestClient_MismatchedSignature(t *testing.T) {
+	t.Parallel()
+
+	k1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	k2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	honest := remotesigner.NewLocalSigner("", k1)
+	other := remotesigner.NewLocalSigner("", k2)
+
+	// Advertise k1, but sign with k2
+	mux := http.NewServeMux()
+	mux.Handle("/v1/publicKeys", honest)
+	mux.HandleFunc("/v1/signHash/", func(w http.ResponseWriter, r *http.Request) {
+		r.URL.Path = "/v1/signHash/" + other.PublicKeys()[0]
+		other.ServeHTTP(w, r)
+	})
+	c := newTestClient(t, mux, "")
+
+	_, err = c.SignHash(testutils.Context(t), crypto.PubkeyToAddress(k1.PublicKey), crypto.Keccak256([]byte("report")))
+	assert.ErrorContains(t, err, "remote signer returned a signature from "+crypto.PubkeyToAddress(k2.PublicKey).String())
+}
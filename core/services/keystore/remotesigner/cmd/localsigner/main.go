@@ -0,0 +1,66 @@
+// localsigner serves the remote signer protocol over HTTP from keys held in memory. It is a stand-in for a real
+// external signer, for tests and local development only.
+//
+//	go run ./core/services/keystore/remotesigner/cmd/localsigner -addr 127.0.0.1:9000 -generate 2
+//
+// Private keys may be passed as comma separated hex with -keys (or the LOCAL_SIGNER_KEYS environment variable), and
+// are otherwise generated at startup. The public key and address of every key are printed before serving.
+package main
+
+import (
+	"crypto/ecdsa"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:9000", "address to listen on")
+	token := flag.String("token", "", "bearer token required from clients, if set")
+	keysHex := flag.String("keys", os.Getenv("LOCAL_SIGNER_KEYS"), "comma separated hex private keys")
+	generate := flag.Int("generate", 1, "number of keys to generate when -keys is not set")
+	flag.Parse()
+
+	keys, err := loadKeys(*keysHex, *generate)
+	if err != nil {
+		log.Fatal(err)
+	}
+	signer := remotesigner.NewLocalSigner(*token, keys...)
+	addresses := signer.Addresses()
+	for i, pk := range signer.PublicKeys() {
+		fmt.Printf("%s %s\n", addresses[i].Hex(), pk)
+	}
+
+	srv := &http.Server{Addr: *addr, Handler: signer, ReadHeaderTimeout: 10 * time.Second}
+	log.Printf("Serving %d keys on %s", len(keys), *addr)
+	log.Fatal(srv.ListenAndServe())
+}
+
+func loadKeys(keysHex string, generate int) (keys []*ecdsa.PrivateKey, err error) {
+	if keysHex == "" {
+		for i := 0; i < generate; i++ {
+			k, err := crypto.GenerateKey()
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, k)
+		}
+		return keys, nil
+	}
+	for _, s := range strings.Split(keysHex, ",") {
+		k, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(s), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
@@ -0,0 +1,101 @@
+package remotesigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var _ http.Handler = &LocalSigner{}
+
+// LocalSigner serves the remote signer protocol from keys held in memory. It
+// is a stand-in for a real external signer in tests and local development, and
+// must not be used to hold keys of value.
+type LocalSigner struct {
+	bearerToken string
+	keys        map[string]*ecdsa.PrivateKey
+}
+
+// NewLocalSigner returns a LocalSigner holding keys. If bearerToken is not
+// empty, requests without a matching Authorization header are rejected.
+func NewLocalSigner(bearerToken string, keys ...*ecdsa.PrivateKey) *LocalSigner {
+	s := &LocalSigner{bearerToken: bearerToken, keys: make(map[string]*ecdsa.PrivateKey, len(keys))}
+	for _, k := range keys {
+		s.keys[hexutil.Encode(crypto.FromECDSAPub(&k.PublicKey))] = k
+	}
+	return s
+}
+
+// PublicKeys returns the hex encoded uncompressed public keys held by the signer.
+func (s *LocalSigner) PublicKeys() []string {
+	pks := make([]string, 0, len(s.keys))
+	for pk := range s.keys {
+		pks = append(pks, pk)
+	}
+	sort.Strings(pks)
+	return pks
+}
+
+// Addresses returns the addresses of the keys held by the signer.
+func (s *LocalSigner) Addresses() []common.Address {
+	addrs := make([]common.Address, 0, len(s.keys))
+	for _, pk := range s.PublicKeys() {
+		addrs = append(addrs, crypto.PubkeyToAddress(s.keys[pk].PublicKey))
+	}
+	return addrs
+}
+
+func (s *LocalSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.bearerToken != "" {
+		got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(s.bearerToken)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+	switch {
+	case r.URL.Path == healthPath && r.Method == http.MethodGet:
+		_, _ = io.WriteString(w, "OK")
+	case r.URL.Path == publicKeysPath && r.Method == http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(s.PublicKeys())
+	case strings.HasPrefix(r.URL.Path, signPathPrefix) && r.Method == http.MethodPost:
+		s.sign(w, r, strings.TrimPrefix(r.URL.Path, signPathPrefix))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *LocalSigner) sign(w http.ResponseWriter, r *http.Request, publicKey string) {
+	key, ok := s.keys[strings.ToLower(publicKey)]
+	if !ok {
+		http.Error(w, "public key not found", http.StatusNotFound)
+		return
+	}
+	var req signRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxResponseSize)).Decode(&req); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	hash, err := hexutil.Decode(req.Hash)
+	if err != nil || len(hash) != common.HashLength {
+		http.Error(w, "hash must be a 0x-prefixed 32 byte digest", http.StatusBadRequest)
+		return
+	}
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// Report V as 27/28, which clients must accept as well as 0/1
+	sig[crypto.RecoveryIDOffset] += 27
+	_, _ = io.WriteString(w, hexutil.Encode(sig))
+}
@@ -0,0 +1,59 @@
+// Package remotesigner delegates secp256k1 signing to an external signer, so
+// that the private keys of EVM accounts never have to be loaded into the node.
+//
+// # Protocol
+//
+// The signer is reached over HTTP(S) with the small API below, which is
+// specific to Chainlink. It is not compatible with Web3Signer or Clef: those
+// hash or decode the payload themselves, while the node needs raw digests
+// signed, both for transactions and for OCR reports. Operators must run a
+// signer that implements this API, typically in front of an HSM or KMS.
+// Binary values are 0x-prefixed hex strings.
+//
+//	GET /v1/health
+//		200 OK once the signer is ready to serve requests.
+//
+//	GET /v1/publicKeys
+//		200 OK with a JSON array of the uncompressed (65 byte, 0x04 prefixed)
+//		public keys held by the signer.
+//
+//	POST /v1/signHash/{publicKey}
+//		Request body: {"hash": "0x<32 byte digest>"}
+//		200 OK with the 65 byte [R || S || V] secp256k1 signature of the
+//		digest, which must be signed as is, without hashing or prefixing it.
+//		The signature is returned either as plain text or as a JSON string,
+//		and V may be 0/1 or 27/28.
+//
+// When a bearer token is configured, every request carries it in the
+// Authorization header. Any other status code is treated as an error, and the
+// response body is reported as the reason. Signatures are always checked to
+// recover to the requested address.
+//
+// A LocalSigner implements the server side of the protocol in process, and
+// cmd/localsigner serves one over HTTP for tests and local development.
+package remotesigner
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	healthPath     = "/v1/health"
+	publicKeysPath = "/v1/publicKeys"
+	signPathPrefix = "/v1/signHash/"
+)
+
+// Signer signs digests with keys that are held outside of the node.
+type Signer interface {
+	// Addresses returns the addresses of all keys held by the signer.
+	Addresses(ctx context.Context) ([]common.Address, error)
+	// SignHash signs the 32 byte hash with the key for address, and returns
+	// the 65 byte [R || S || V] signature where V is 0 or 1.
+	SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error)
+}
+
+type signRequest struct {
+	Hash string `json:"hash"`
+}
@@ -86,6 +86,20 @@ func (_m *Checker) Register(name string, service services.Checkable) error {
 	return r0
 }
 
+// Report provides a mock function with given fields:
+func (_m *Checker) Report() services.Health {
+	ret := _m.Called()
+
+	var r0 services.Health
+	if rf, ok := ret.Get(0).(func() services.Health); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(services.Health)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *Checker) Start() error {
 	ret := _m.Called()
@@ -0,0 +1,533 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// pollInterval is how often due deliveries are polled for, besides right
+	// after notifications are enqueued.
+	pollInterval = 5 * time.Second
+	// deliveryBatchSize is the number of deliveries attempted concurrently.
+	deliveryBatchSize = 100
+	// maxBackoffShift caps the exponential backoff of retries to
+	// RetryBackoff * 2^maxBackoffShift.
+	maxBackoffShift = 10
+	// pruneInterval is how often finished deliveries are pruned.
+	pruneInterval = time.Hour
+	// deliveryRetention is how long finished deliveries are kept for.
+	deliveryRetention = 7 * 24 * time.Hour
+)
+
+// Config is the configuration of the Notifier.
+type Config interface {
+	NotificationsJobErrorThreshold() uint32
+	NotificationsKeyBalanceThreshold() *assets.Wei
+	NotificationsMaxAttempts() uint32
+	NotificationsRequestTimeout() time.Duration
+	NotificationsRetryBackoff() time.Duration
+	NotificationsWebhooks() []config.NotificationWebhook
+}
+
+// Event is the JSON payload posted to webhooks. Text is a human readable
+// summary, so that chat incoming webhooks can be used directly.
+type Event struct {
+	Event     config.NotificationEvent `json:"event"`
+	Text      string                   `json:"text"`
+	Data      any                      `json:"data"`
+	Timestamp time.Time                `json:"timestamp"`
+}
+
+// JobErrorsData is the data of JobErrors events.
+type JobErrorsData struct {
+	JobID         int32  `json:"jobID"`
+	ExternalJobID string `json:"externalJobID"`
+	JobName       string `json:"jobName"`
+	Occurrences   uint   `json:"occurrences"`
+	Description   string `json:"description"`
+}
+
+// KeyBalanceLowData is the data of KeyBalanceLow events. Balances are in wei.
+type KeyBalanceLowData struct {
+	EVMChainID string `json:"evmChainID"`
+	Address    string `json:"address"`
+	Balance    string `json:"balance"`
+	Threshold  string `json:"threshold"`
+}
+
+// RPCNodeOutOfSyncData is the data of RPCNodeOutOfSync events.
+type RPCNodeOutOfSyncData struct {
+	EVMChainID string `json:"evmChainID"`
+	Name       string `json:"name"`
+	From       string `json:"from"`
+}
+
+// TxFatalData is the data of TxFatal events.
+type TxFatalData struct {
+	ID          int64  `json:"id"`
+	EVMChainID  string `json:"evmChainID"`
+	FromAddress string `json:"fromAddress"`
+	ToAddress   string `json:"toAddress"`
+	Error       string `json:"error"`
+}
+
+// JobProposedData is the data of JobProposed events.
+type JobProposedData struct {
+	JobProposalID    int64  `json:"jobProposalID"`
+	RemoteUUID       string `json:"remoteUUID"`
+	Version          int32  `json:"version"`
+	FeedsManagerID   int64  `json:"feedsManagerID"`
+	FeedsManagerName string `json:"feedsManagerName"`
+}
+
+// Notifier posts node events to the configured webhooks. Events are received
+// from Postgres notifications and persisted as deliveries, which are retried
+// with exponential backoff until the webhook accepts them or MaxAttempts is
+// reached.
+type Notifier struct {
+	utils.StartStopOnce
+
+	cfg              Config
+	orm              ORM
+	eventBroadcaster pg.EventBroadcaster
+	jobORM           job.ORM
+	txmORM           txmgr.ORM
+	feedsORM         feeds.ORM
+	client           *http.Client
+	lggr             logger.Logger
+	webhooks         map[string]config.NotificationWebhook
+	isLeader         func() bool
+
+	subs   []pg.Subscription
+	chWake chan struct{}
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+var _ services.ServiceCtx = (*Notifier)(nil)
+
+// NewNotifier returns a Notifier for the configured webhooks, which must all
+// have a URL and SigningKey.
+func NewNotifier(cfg Config, orm ORM, eventBroadcaster pg.EventBroadcaster, jobORM job.ORM, txmORM txmgr.ORM, feedsORM feeds.ORM, client *http.Client, lggr logger.Logger) (*Notifier, error) {
+	webhooks := make(map[string]config.NotificationWebhook)
+	for _, w := range cfg.NotificationsWebhooks() {
+		if w.URL == nil || w.SigningKey == "" {
+			return nil, errors.Errorf("webhook %s: URL and SigningKey must be set in secrets", w.Name)
+		}
+		webhooks[w.Name] = w
+	}
+	return &Notifier{
+		cfg:              cfg,
+		orm:              orm,
+		eventBroadcaster: eventBroadcaster,
+		jobORM:           jobORM,
+		txmORM:           txmORM,
+		feedsORM:         feedsORM,
+		client:           client,
+		lggr:             lggr.Named("Notifier"),
+		webhooks:         webhooks,
+		chWake:           make(chan struct{}, 1),
+		chStop:           make(chan struct{}),
+	}, nil
+}
+
+// SetLeaderCheck makes the Notifier only enqueue notifications while isLeader
+// returns true, so that nodes sharing the database notify each event once. It
+// must be called before Start.
+func (n *Notifier) SetLeaderCheck(isLeader func() bool) {
+	n.isLeader = isLeader
+}
+
+// Start subscribes to the node events and starts delivering notifications.
+func (n *Notifier) Start(context.Context) error {
+	return n.StartOnce("Notifier", func() error {
+		for _, channel := range []string{
+			pg.ChannelJobSpecErrorRecorded,
+			pg.ChannelEthKeyBalanceChanged,
+			pg.ChannelEVMNodeStateChanged,
+			pg.ChannelEthTxStateChanged,
+			pg.ChannelJobProposalSpecCreated,
+		} {
+			sub, err := n.eventBroadcaster.Subscribe(channel, "")
+			if err != nil {
+				n.closeSubscriptions()
+				return errors.Wrapf(err, "failed to subscribe to %s", channel)
+			}
+			n.subs = append(n.subs, sub)
+		}
+
+		for _, sub := range n.subs {
+			n.wgDone.Add(1)
+			go n.receiveLoop(sub)
+		}
+		n.wgDone.Add(1)
+		go n.deliveryLoop()
+		return nil
+	})
+}
+
+// Close stops the Notifier. Pending deliveries are attempted again on the
+// next start.
+func (n *Notifier) Close() error {
+	return n.StopOnce("Notifier", func() error {
+		close(n.chStop)
+		n.wgDone.Wait()
+		n.closeSubscriptions()
+		return nil
+	})
+}
+
+func (n *Notifier) closeSubscriptions() {
+	for _, sub := range n.subs {
+		sub.Close()
+	}
+	n.subs = nil
+}
+
+func (n *Notifier) receiveLoop(sub pg.Subscription) {
+	defer n.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(n.chStop)
+	defer cancel()
+
+	for {
+		select {
+		case <-n.chStop:
+			return
+		case ev, open := <-sub.Events():
+			if !open {
+				return
+			}
+			if err := n.handle(ctx, ev); err != nil {
+				n.lggr.Errorw("Failed to handle event", "err", err, "channel", ev.Channel, "payload", ev.Payload)
+			}
+		}
+	}
+}
+
+// handle enqueues a delivery of the notification for the event, if any, to
+// each webhook subscribed to it.
+func (n *Notifier) handle(ctx context.Context, ev pg.Event) error {
+	if n.isLeader != nil && !n.isLeader() {
+		return nil
+	}
+	notification, err := n.notificationFor(ctx, ev)
+	if err != nil || notification == nil {
+		return err
+	}
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize notification")
+	}
+
+	var deliveries []Delivery
+	for name, w := range n.webhooks {
+		for _, e := range w.Events {
+			if e == notification.Event {
+				deliveries = append(deliveries, Delivery{
+					WebhookName: name,
+					Event:       notification.Event,
+					Payload:     payload,
+				})
+				break
+			}
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err = n.orm.EnqueueDeliveries(deliveries, pg.WithParentCtx(ctx)); err != nil {
+		return err
+	}
+	select {
+	case n.chWake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// notificationFor returns the notification of the event, or nil if it is not
+// notable.
+func (n *Notifier) notificationFor(ctx context.Context, ev pg.Event) (*Event, error) {
+	switch ev.Channel {
+	case pg.ChannelJobSpecErrorRecorded:
+		return n.jobErrors(ctx, ev.Payload)
+	case pg.ChannelEthKeyBalanceChanged:
+		return n.keyBalanceLow(ev.Payload)
+	case pg.ChannelEVMNodeStateChanged:
+		return n.rpcNodeOutOfSync(ev.Payload)
+	case pg.ChannelEthTxStateChanged:
+		return n.txFatal(ev.Payload)
+	case pg.ChannelJobProposalSpecCreated:
+		return n.jobProposed(ev.Payload)
+	}
+	return nil, errors.Errorf("unexpected channel %s", ev.Channel)
+}
+
+// jobErrors notifies once per error, when it reaches JobErrorThreshold
+// occurrences.
+func (n *Notifier) jobErrors(ctx context.Context, payload string) (*Event, error) {
+	var e pg.JobSpecErrorRecorded
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		return nil, err
+	}
+	if e.Occurrences != uint(n.cfg.NotificationsJobErrorThreshold()) {
+		return nil, nil
+	}
+	jb, err := n.jobORM.FindJob(ctx, e.JobID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load job %d", e.JobID)
+	}
+	return n.event(config.NotificationJobErrors,
+		fmt.Sprintf("Job %q (%d) has errored %d times: %s", jb.Name.ValueOrZero(), jb.ID, e.Occurrences, e.Description),
+		JobErrorsData{
+			JobID:         jb.ID,
+			ExternalJobID: jb.ExternalJobID.String(),
+			JobName:       jb.Name.ValueOrZero(),
+			Occurrences:   e.Occurrences,
+			Description:   e.Description,
+		}), nil
+}
+
+// keyBalanceLow notifies when a balance falls below KeyBalanceThreshold, or
+// is first seen below it.
+func (n *Notifier) keyBalanceLow(payload string) (*Event, error) {
+	var e pg.EthKeyBalanceChanged
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		return nil, err
+	}
+	threshold := n.cfg.NotificationsKeyBalanceThreshold().ToInt()
+	balance, ok := new(big.Int).SetString(e.Balance, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance %q", e.Balance)
+	}
+	if balance.Cmp(threshold) >= 0 {
+		return nil, nil
+	}
+	if e.PreviousBalance != "" {
+		previous, ok := new(big.Int).SetString(e.PreviousBalance, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid previous balance %q", e.PreviousBalance)
+		}
+		if previous.Cmp(threshold) < 0 {
+			// already notified
+			return nil, nil
+		}
+	}
+	return n.event(config.NotificationKeyBalanceLow,
+		fmt.Sprintf("Balance of key %s on chain %s is %s ETH, below the threshold of %s ETH", e.Address, e.EVMChainID,
+			(*assets.Eth)(balance).String(), (*assets.Eth)(threshold).String()),
+		KeyBalanceLowData{
+			EVMChainID: e.EVMChainID,
+			Address:    e.Address,
+			Balance:    balance.String(),
+			Threshold:  threshold.String(),
+		}), nil
+}
+
+func (n *Notifier) rpcNodeOutOfSync(payload string) (*Event, error) {
+	var e pg.EVMNodeStateChanged
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		return nil, err
+	}
+	if e.To != "OutOfSync" {
+		return nil, nil
+	}
+	return n.event(config.NotificationRPCNodeOutOfSync,
+		fmt.Sprintf("RPC node %s on chain %s is out of sync", e.Name, e.EVMChainID),
+		RPCNodeOutOfSyncData{
+			EVMChainID: e.EVMChainID,
+			Name:       e.Name,
+			From:       e.From,
+		}), nil
+}
+
+func (n *Notifier) txFatal(payload string) (*Event, error) {
+	var e pg.EthTxStateChanged
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		return nil, err
+	}
+	if txmgr.EthTxState(e.State) != txmgr.EthTxFatalError {
+		return nil, nil
+	}
+	etx, err := n.txmORM.FindEthTxWithAttempts(e.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load transaction %d", e.ID)
+	}
+	return n.event(config.NotificationTxFatal,
+		fmt.Sprintf("Transaction %d from %s on chain %s failed fatally: %s", etx.ID, etx.FromAddress, e.EVMChainID, etx.Error.ValueOrZero()),
+		TxFatalData{
+			ID:          etx.ID,
+			EVMChainID:  e.EVMChainID,
+			FromAddress: etx.FromAddress.String(),
+			ToAddress:   etx.ToAddress.String(),
+			Error:       etx.Error.ValueOrZero(),
+		}), nil
+}
+
+func (n *Notifier) jobProposed(payload string) (*Event, error) {
+	var e pg.JobProposalSpecCreated
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		return nil, err
+	}
+	jp, err := n.feedsORM.GetJobProposal(e.JobProposalID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load job proposal %d", e.JobProposalID)
+	}
+	mgr, err := n.feedsORM.GetManager(jp.FeedsManagerID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load feeds manager %d", jp.FeedsManagerID)
+	}
+	return n.event(config.NotificationJobProposed,
+		fmt.Sprintf("Feeds manager %s proposed version %d of job proposal %d", mgr.Name, e.Version, jp.ID),
+		JobProposedData{
+			JobProposalID:    jp.ID,
+			RemoteUUID:       jp.RemoteUUID.String(),
+			Version:          e.Version,
+			FeedsManagerID:   mgr.ID,
+			FeedsManagerName: mgr.Name,
+		}), nil
+}
+
+func (n *Notifier) event(event config.NotificationEvent, text string, data any) *Event {
+	return &Event{
+		Event:     event,
+		Text:      text,
+		Data:      data,
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+func (n *Notifier) deliveryLoop() {
+	defer n.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(n.chStop)
+	defer cancel()
+
+	poll := time.NewTicker(utils.WithJitter(pollInterval))
+	defer poll.Stop()
+	prune := time.NewTicker(utils.WithJitter(pruneInterval))
+	defer prune.Stop()
+
+	n.deliverDue(ctx)
+	for {
+		select {
+		case <-n.chStop:
+			return
+		case <-n.chWake:
+			n.deliverDue(ctx)
+		case <-poll.C:
+			n.deliverDue(ctx)
+		case <-prune.C:
+			n.pruneFinished(ctx)
+		}
+	}
+}
+
+// deliverDue attempts the due deliveries, in batches, until none are left.
+func (n *Notifier) deliverDue(ctx context.Context) {
+	// Deliveries are leased for longer than an attempt can take, so that they
+	// are not attempted again meanwhile.
+	lease := 2 * n.cfg.NotificationsRequestTimeout()
+	for ctx.Err() == nil {
+		deliveries, err := n.orm.LeaseDueDeliveries(deliveryBatchSize, lease, pg.WithParentCtx(ctx))
+		if err != nil {
+			n.lggr.Errorw("Failed to lease due deliveries", "err", err)
+			return
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(len(deliveries))
+		for _, d := range deliveries {
+			go func(d Delivery) {
+				defer wg.Done()
+				n.deliver(ctx, d)
+			}(d)
+		}
+		wg.Wait()
+
+		if len(deliveries) < deliveryBatchSize {
+			return
+		}
+	}
+}
+
+// deliver attempts a delivery, and records its outcome.
+func (n *Notifier) deliver(ctx context.Context, d Delivery) {
+	lggr := n.lggr.With("deliveryID", d.ID, "webhook", d.WebhookName, "event", d.Event, "attempt", d.Attempts)
+
+	w, ok := n.webhooks[d.WebhookName]
+	if !ok {
+		if err := n.orm.MarkAttemptFailed(d.ID, "webhook is no longer configured", nil, pg.WithParentCtx(ctx)); err != nil {
+			lggr.Errorw("Failed to mark delivery failed", "err", err)
+		}
+		return
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, n.cfg.NotificationsRequestTimeout())
+	sendErr := send(sendCtx, n.client, w, d, time.Now())
+	cancel()
+	if ctx.Err() != nil {
+		// stopping; the lease expires and the delivery is attempted again
+		return
+	}
+
+	if sendErr == nil {
+		lggr.Debug("Delivered notification")
+		if err := n.orm.MarkDelivered(d.ID, pg.WithParentCtx(ctx)); err != nil {
+			lggr.Errorw("Failed to mark delivery delivered", "err", err)
+		}
+		return
+	}
+
+	var next *time.Time
+	if d.Attempts < int64(n.cfg.NotificationsMaxAttempts()) {
+		t := time.Now().Add(backoff(n.cfg.NotificationsRetryBackoff(), d.Attempts))
+		next = &t
+		lggr.Warnw("Failed to deliver notification, will retry", "err", sendErr, "nextAttemptAt", t)
+	} else {
+		lggr.Errorw("Failed to deliver notification, giving up", "err", sendErr)
+	}
+	if err := n.orm.MarkAttemptFailed(d.ID, sendErr.Error(), next, pg.WithParentCtx(ctx)); err != nil {
+		lggr.Errorw("Failed to record failed delivery attempt", "err", err)
+	}
+}
+
+// backoff returns the delay before the attempt following the given one, which
+// doubles with each attempt.
+func backoff(base time.Duration, attempts int64) time.Duration {
+	shift := attempts - 1
+	if shift < 0 {
+		shift = 0
+	} else if shift > maxBackoffShift {
+		shift = maxBackoffShift
+	}
+	return base << shift
+}
+
+func (n *Notifier) pruneFinished(ctx context.Context) {
+	deleted, err := n.orm.DeleteFinishedDeliveries(time.Now().Add(-deliveryRetention), pg.WithParentCtx(ctx))
+	if err != nil {
+		n.lggr.Errorw("Failed to prune finished deliveries", "err", err)
+		return
+	}
+	if deleted > 0 {
+		n.lggr.Debugw("Pruned finished deliveries", "deleted", deleted)
+	}
+}
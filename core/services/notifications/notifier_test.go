@@ -0,0 +1,253 @@
+package notifications_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	feedsmocks "github.com/smartcontractkit/chainlink/core/services/feeds/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
+	"github.com/smartcontractkit/chainlink/core/services/notifications/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+)
+
+type testConfig struct {
+	webhooks []config.NotificationWebhook
+}
+
+func (c testConfig) NotificationsJobErrorThreshold() uint32 { return 3 }
+func (c testConfig) NotificationsKeyBalanceThreshold() *assets.Wei {
+	return assets.NewWeiI(1000)
+}
+func (c testConfig) NotificationsMaxAttempts() uint32                    { return 2 }
+func (c testConfig) NotificationsRequestTimeout() time.Duration          { return time.Second }
+func (c testConfig) NotificationsRetryBackoff() time.Duration            { return time.Minute }
+func (c testConfig) NotificationsWebhooks() []config.NotificationWebhook { return c.webhooks }
+
+type notifierTest struct {
+	orm      *mocks.ORM
+	jobORM   *jobmocks.ORM
+	channels map[string]chan pg.Event
+	notifier *notifications.Notifier
+}
+
+func newNotifierTest(t *testing.T, cfg testConfig, client *http.Client) *notifierTest {
+	nt := &notifierTest{
+		orm:      mocks.NewORM(t),
+		jobORM:   jobmocks.NewORM(t),
+		channels: make(map[string]chan pg.Event),
+	}
+	eb := pgmocks.NewEventBroadcaster(t)
+	for _, channel := range []string{
+		pg.ChannelJobSpecErrorRecorded,
+		pg.ChannelEthKeyBalanceChanged,
+		pg.ChannelEVMNodeStateChanged,
+		pg.ChannelEthTxStateChanged,
+		pg.ChannelJobProposalSpecCreated,
+	} {
+		ch := make(chan pg.Event)
+		nt.channels[channel] = ch
+		sub := pgmocks.NewSubscription(t)
+		sub.On("Events").Return((<-chan pg.Event)(ch))
+		sub.On("Close").Return()
+		eb.On("Subscribe", channel, "").Return(sub, nil).Once()
+	}
+
+	var err error
+	nt.notifier, err = notifications.NewNotifier(cfg, nt.orm, eb, nt.jobORM, txmmocks.NewORM(t), feedsmocks.NewORM(t), client, logger.TestLogger(t))
+	require.NoError(t, err)
+	return nt
+}
+
+func (nt *notifierTest) start(t *testing.T) {
+	require.NoError(t, nt.notifier.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, nt.notifier.Close()) })
+}
+
+func (nt *notifierTest) notify(t *testing.T, channel string, payload any) {
+	b, err := json.Marshal(payload)
+	require.NoError(t, err)
+	select {
+	case nt.channels[channel] <- pg.Event{Channel: channel, Payload: string(b)}:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out notifying event")
+	}
+}
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	u, err := url.Parse(s)
+	require.NoError(t, err)
+	return u
+}
+
+func TestNotifier_NewNotifier(t *testing.T) {
+	t.Parallel()
+
+	cfg := testConfig{webhooks: []config.NotificationWebhook{{Name: "ops", Events: []config.NotificationEvent{config.NotificationTxFatal}}}}
+	_, err := notifications.NewNotifier(cfg, mocks.NewORM(t), pgmocks.NewEventBroadcaster(t), jobmocks.NewORM(t), txmmocks.NewORM(t), feedsmocks.NewORM(t), http.DefaultClient, logger.TestLogger(t))
+	require.EqualError(t, err, "webhook ops: URL and SigningKey must be set in secrets")
+}
+
+func TestNotifier_EnqueuesSubscribedEvents(t *testing.T) {
+	t.Parallel()
+
+	cfg := testConfig{webhooks: []config.NotificationWebhook{
+		{Name: "ops", URL: mustParseURL(t, "https://ops.example"), SigningKey: "a", Events: []config.NotificationEvent{config.NotificationKeyBalanceLow, config.NotificationJobErrors}},
+		{Name: "infra", URL: mustParseURL(t, "https://infra.example"), SigningKey: "b", Events: []config.NotificationEvent{config.NotificationRPCNodeOutOfSync}},
+	}}
+	nt := newNotifierTest(t, cfg, http.DefaultClient)
+	nt.orm.On("LeaseDueDeliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+	enqueued := make(chan []notifications.Delivery, 10)
+	nt.orm.On("EnqueueDeliveries", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		enqueued <- args.Get(0).([]notifications.Delivery)
+	})
+	nt.jobORM.On("FindJob", mock.Anything, int32(42)).Return(job.Job{ID: 42, Name: null.StringFrom("prices")}, nil).Once()
+	nt.start(t)
+
+	expectEvent := func(webhook string, event config.NotificationEvent) notifications.Event {
+		var deliveries []notifications.Delivery
+		select {
+		case deliveries = <-enqueued:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatalf("timed out waiting for %s", event)
+		}
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, webhook, deliveries[0].WebhookName)
+		assert.Equal(t, event, deliveries[0].Event)
+		var e notifications.Event
+		require.NoError(t, json.Unmarshal(deliveries[0].Payload, &e))
+		assert.Equal(t, event, e.Event)
+		return e
+	}
+
+	t.Run("job errors at threshold", func(t *testing.T) {
+		nt.notify(t, pg.ChannelJobSpecErrorRecorded, pg.JobSpecErrorRecorded{ID: 1, JobID: 42, Occurrences: 2, Description: "boom"})
+		nt.notify(t, pg.ChannelJobSpecErrorRecorded, pg.JobSpecErrorRecorded{ID: 1, JobID: 42, Occurrences: 3, Description: "boom"})
+		e := expectEvent("ops", config.NotificationJobErrors)
+		assert.Equal(t, `Job "prices" (42) has errored 3 times: boom`, e.Text)
+		nt.notify(t, pg.ChannelJobSpecErrorRecorded, pg.JobSpecErrorRecorded{ID: 1, JobID: 42, Occurrences: 4, Description: "boom"})
+	})
+
+	t.Run("key balance falls below threshold", func(t *testing.T) {
+		nt.notify(t, pg.ChannelEthKeyBalanceChanged, pg.EthKeyBalanceChanged{EVMChainID: "1", Address: "0xabc", PreviousBalance: "2000", Balance: "1500"})
+		nt.notify(t, pg.ChannelEthKeyBalanceChanged, pg.EthKeyBalanceChanged{EVMChainID: "1", Address: "0xabc", PreviousBalance: "1500", Balance: "500"})
+		e := expectEvent("ops", config.NotificationKeyBalanceLow)
+		assert.Equal(t, map[string]any{"evmChainID": "1", "address": "0xabc", "balance": "500", "threshold": "1000"}, e.Data)
+		nt.notify(t, pg.ChannelEthKeyBalanceChanged, pg.EthKeyBalanceChanged{EVMChainID: "1", Address: "0xabc", PreviousBalance: "500", Balance: "400"})
+	})
+
+	t.Run("RPC node out of sync", func(t *testing.T) {
+		nt.notify(t, pg.ChannelEVMNodeStateChanged, pg.EVMNodeStateChanged{EVMChainID: "1", Name: "primary", From: "Alive", To: "OutOfSync"})
+		e := expectEvent("infra", config.NotificationRPCNodeOutOfSync)
+		assert.Equal(t, "RPC node primary on chain 1 is out of sync", e.Text)
+	})
+
+	t.Run("unsubscribed events", func(t *testing.T) {
+		nt.notify(t, pg.ChannelEthTxStateChanged, pg.EthTxStateChanged{ID: 1, EVMChainID: "1", State: "confirmed"})
+		nt.notify(t, pg.ChannelEVMNodeStateChanged, pg.EVMNodeStateChanged{EVMChainID: "1", Name: "primary", From: "OutOfSync", To: "Alive"})
+	})
+
+	// a later notable event is the next one enqueued
+	nt.notify(t, pg.ChannelEthKeyBalanceChanged, pg.EthKeyBalanceChanged{EVMChainID: "1", Address: "0xdef", Balance: "1"})
+	expectEvent("ops", config.NotificationKeyBalanceLow)
+}
+
+func TestNotifier_Deliveries(t *testing.T) {
+	t.Parallel()
+
+	const signingKey = "secret"
+	statuses := make(chan int, 10)
+	received := make(chan *http.Request, 10)
+	bodies := make(chan []byte, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- b
+		w.WriteHeader(<-statuses)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := testConfig{webhooks: []config.NotificationWebhook{
+		{Name: "ops", URL: mustParseURL(t, srv.URL), SigningKey: signingKey, Events: []config.NotificationEvent{config.NotificationTxFatal}},
+	}}
+	nt := newNotifierTest(t, cfg, srv.Client())
+
+	payload := []byte(`{"event":"TxFatal"}`)
+	nt.orm.On("LeaseDueDeliveries", 100, 2*time.Second, mock.Anything).Return([]notifications.Delivery{
+		{ID: 1, WebhookName: "ops", Event: config.NotificationTxFatal, Payload: payload, Attempts: 1},
+		{ID: 2, WebhookName: "ops", Event: config.NotificationTxFatal, Payload: payload, Attempts: 2},
+		{ID: 3, WebhookName: "removed", Event: config.NotificationTxFatal, Payload: payload, Attempts: 1},
+	}, nil).Once()
+	nt.orm.On("LeaseDueDeliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+	done := make(chan int64, 3)
+	recordDone := func(args mock.Arguments) { done <- args.Get(0).(int64) }
+	nt.orm.On("MarkAttemptFailed", int64(3), "webhook is no longer configured", (*time.Time)(nil), mock.Anything).Return(nil).Run(recordDone).Once()
+
+	// the first delivery fails and is retried, the second fails for good
+	statuses <- http.StatusInternalServerError
+	statuses <- http.StatusBadGateway
+	nt.orm.On("MarkAttemptFailed", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		next := args.Get(2).(*time.Time)
+		switch args.Get(0).(int64) {
+		case 1:
+			if assert.NotNil(t, next) {
+				assert.WithinDuration(t, time.Now().Add(time.Minute), *next, 10*time.Second)
+			}
+		case 2:
+			assert.Nil(t, next)
+		}
+		assert.Contains(t, args.String(1), "unexpected status")
+		done <- args.Get(0).(int64)
+	}).Twice()
+	nt.start(t)
+
+	var ids []int64
+	for i := 0; i < 3; i++ {
+		select {
+		case id := <-done:
+			ids = append(ids, id)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for deliveries")
+		}
+	}
+	assert.ElementsMatch(t, []int64{1, 2, 3}, ids)
+
+	for i := 0; i < 2; i++ {
+		r := <-received
+		body := <-bodies
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "TxFatal", r.Header.Get(notifications.HeaderEvent))
+		assert.JSONEq(t, string(payload), string(body))
+		ts := r.Header.Get(notifications.HeaderTimestamp)
+		assert.Equal(t, notifications.Sign(signingKey, ts, body), r.Header.Get(notifications.HeaderSignature))
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	// echo -n '1660000000.{}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "adec1f5fdfc32678ba7182519dd3588c5c2f59bc8683b5d276162806740ca6e4", notifications.Sign("secret", "1660000000", []byte("{}")))
+	assert.NotEqual(t, notifications.Sign("secret", "1660000000", []byte("{}")), notifications.Sign("secret", "1660000001", []byte("{}")))
+	assert.NotEqual(t, notifications.Sign("secret", "1660000000", []byte("{}")), notifications.Sign("other", "1660000000", []byte("{}")))
+}
@@ -0,0 +1,122 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/sqlx"
+	sqlxTypes "github.com/smartcontractkit/sqlx/types"
+
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// DeliveryState is the state of a notification delivery.
+type DeliveryState string
+
+const (
+	// DeliveryStatePending deliveries are waiting for their next attempt.
+	DeliveryStatePending DeliveryState = "pending"
+	// DeliveryStateDelivered deliveries were accepted by their webhook.
+	DeliveryStateDelivered DeliveryState = "delivered"
+	// DeliveryStateFailed deliveries were given up on after too many attempts.
+	DeliveryStateFailed DeliveryState = "failed"
+)
+
+// Delivery is the delivery of a notification to a webhook.
+type Delivery struct {
+	ID            int64
+	WebhookName   string
+	Event         config.NotificationEvent
+	Payload       sqlxTypes.JSONText
+	State         DeliveryState
+	Attempts      int64
+	NextAttemptAt time.Time
+	LastError     null.String
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+type ORM interface {
+	// EnqueueDeliveries persists pending deliveries, which are due now.
+	EnqueueDeliveries(deliveries []Delivery, qopts ...pg.QOpt) error
+	// LeaseDueDeliveries returns up to limit pending deliveries which are due,
+	// counting an attempt for each. They are not due again before leaseDuration,
+	// so that they are not attempted concurrently.
+	LeaseDueDeliveries(limit int, leaseDuration time.Duration, qopts ...pg.QOpt) ([]Delivery, error)
+	// MarkDelivered marks a delivery as delivered.
+	MarkDelivered(id int64, qopts ...pg.QOpt) error
+	// MarkAttemptFailed records the error of a failed attempt, and schedules
+	// the next attempt at nextAttemptAt. The delivery is failed if
+	// nextAttemptAt is nil.
+	MarkAttemptFailed(id int64, attemptErr string, nextAttemptAt *time.Time, qopts ...pg.QOpt) error
+	// DeleteFinishedDeliveries deletes the delivered and failed deliveries
+	// which finished before the time.
+	DeleteFinishedDeliveries(before time.Time, qopts ...pg.QOpt) (int64, error)
+}
+
+var _ ORM = (*orm)(nil)
+
+type orm struct {
+	q pg.Q
+}
+
+// NewORM returns an ORM for notification deliveries.
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{
+		q: pg.NewQ(db, lggr.Named("NotificationsORM"), cfg),
+	}
+}
+
+func (o *orm) EnqueueDeliveries(deliveries []Delivery, qopts ...pg.QOpt) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	stmt := `INSERT INTO notification_deliveries (webhook_name, event, payload, state, next_attempt_at, created_at, updated_at)
+VALUES (:webhook_name, :event, :payload, 'pending', NOW(), NOW(), NOW())`
+	return errors.Wrap(o.q.WithOpts(qopts...).ExecQNamed(stmt, deliveries), "EnqueueDeliveries failed")
+}
+
+func (o *orm) LeaseDueDeliveries(limit int, leaseDuration time.Duration, qopts ...pg.QOpt) (deliveries []Delivery, err error) {
+	stmt := `UPDATE notification_deliveries
+SET attempts = attempts + 1, next_attempt_at = NOW() + $2 * interval '1 microsecond', updated_at = NOW()
+WHERE id IN (
+	SELECT id FROM notification_deliveries
+	WHERE state = 'pending' AND next_attempt_at <= NOW()
+	ORDER BY next_attempt_at, id
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+	err = o.q.WithOpts(qopts...).Select(&deliveries, stmt, limit, leaseDuration.Microseconds())
+	return deliveries, errors.Wrap(err, "LeaseDueDeliveries failed")
+}
+
+func (o *orm) MarkDelivered(id int64, qopts ...pg.QOpt) error {
+	stmt := `UPDATE notification_deliveries SET state = 'delivered', last_error = NULL, updated_at = NOW() WHERE id = $1`
+	return errors.Wrap(o.q.WithOpts(qopts...).ExecQ(stmt, id), "MarkDelivered failed")
+}
+
+func (o *orm) MarkAttemptFailed(id int64, attemptErr string, nextAttemptAt *time.Time, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	var err error
+	if nextAttemptAt == nil {
+		err = q.ExecQ(`UPDATE notification_deliveries SET state = 'failed', last_error = $2, updated_at = NOW() WHERE id = $1`, id, attemptErr)
+	} else {
+		err = q.ExecQ(`UPDATE notification_deliveries SET last_error = $2, next_attempt_at = $3, updated_at = NOW() WHERE id = $1`, id, attemptErr, *nextAttemptAt)
+	}
+	return errors.Wrap(err, "MarkAttemptFailed failed")
+}
+
+func (o *orm) DeleteFinishedDeliveries(before time.Time, qopts ...pg.QOpt) (int64, error) {
+	res, cancel, err := o.q.WithOpts(qopts...).ExecQIter(`DELETE FROM notification_deliveries WHERE state <> 'pending' AND updated_at < $1`, before)
+	defer cancel()
+	if err != nil {
+		return 0, errors.Wrap(err, "DeleteFinishedDeliveries failed")
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,65 @@
+package notifications_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
+)
+
+func TestORM_Deliveries(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := notifications.NewORM(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+
+	payload := []byte(`{"event":"TxFatal","text":"boom"}`)
+	require.NoError(t, orm.EnqueueDeliveries([]notifications.Delivery{
+		{WebhookName: "ops", Event: config.NotificationTxFatal, Payload: payload},
+		{WebhookName: "infra", Event: config.NotificationTxFatal, Payload: payload},
+		{WebhookName: "chat", Event: config.NotificationTxFatal, Payload: payload},
+	}))
+
+	leased, err := orm.LeaseDueDeliveries(2, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, leased, 2)
+	for _, d := range leased {
+		assert.Equal(t, notifications.DeliveryStatePending, d.State)
+		assert.Equal(t, int64(1), d.Attempts)
+		assert.JSONEq(t, string(payload), string(d.Payload))
+		assert.True(t, d.NextAttemptAt.After(time.Now().Add(30*time.Second)))
+	}
+
+	// leased deliveries are not due
+	rest, err := orm.LeaseDueDeliveries(10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	none, err := orm.LeaseDueDeliveries(10, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, none)
+
+	require.NoError(t, orm.MarkDelivered(leased[0].ID))
+	require.NoError(t, orm.MarkAttemptFailed(leased[1].ID, "unexpected status", nil))
+	past := time.Now().Add(-time.Second)
+	require.NoError(t, orm.MarkAttemptFailed(rest[0].ID, "timeout", &past))
+
+	retried, err := orm.LeaseDueDeliveries(10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, retried, 1)
+	assert.Equal(t, rest[0].ID, retried[0].ID)
+	assert.Equal(t, int64(2), retried[0].Attempts)
+	assert.Equal(t, "timeout", retried[0].LastError.String)
+
+	deleted, err := orm.DeleteFinishedDeliveries(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+	deleted, err = orm.DeleteFinishedDeliveries(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+}
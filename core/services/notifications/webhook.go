@@ -0,0 +1,66 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/config"
+)
+
+// Headers of the webhook requests.
+const (
+	HeaderEvent     = "X-Chainlink-Event"
+	HeaderDelivery  = "X-Chainlink-Delivery"
+	HeaderTimestamp = "X-Chainlink-Timestamp"
+	HeaderSignature = "X-Chainlink-Signature"
+)
+
+// maxErrorBodyLength is the length of the response bodies kept in the error of
+// failed attempts.
+const maxErrorBodyLength = 256
+
+// Sign returns the signature of a webhook request, which is the hex encoded
+// HMAC-SHA256 of the timestamp header, a dot and the body, keyed with the
+// signing key of the webhook.
+func Sign(signingKey string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// send posts the payload of the delivery to the webhook. Any response other
+// than 2xx is an error.
+func send(ctx context.Context, client *http.Client, webhook config.NotificationWebhook, d Delivery, now time.Time) error {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL.String(), bytes.NewReader(d.Payload))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(d.Event))
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(webhook.SigningKey, timestamp, d.Payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected status %s: %s", resp.Status, body)
+	}
+	return nil
+}
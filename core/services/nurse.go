@@ -3,21 +3,27 @@ package services
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/pprof/profile"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -31,6 +37,8 @@ type Nurse struct {
 	checks   map[string]CheckFunc
 	checksMu sync.RWMutex
 
+	client *http.Client // for uploads
+
 	chGather chan gatherRequest
 	chStop   chan struct{}
 	wgDone   sync.WaitGroup
@@ -48,6 +56,12 @@ type Config interface {
 	AutoPprofMutexProfileFraction() int
 	AutoPprofMemThreshold() utils.FileSize
 	AutoPprofGoroutineThreshold() int
+	AutoPprofUploadAuthToken() string
+	AutoPprofUploadCPUDuration() models.Duration
+	AutoPprofUploadInterval() models.Duration
+	AutoPprofUploadProfileTypes() []string
+	AutoPprofUploadTimeout() models.Duration
+	AutoPprofUploadURL() *url.URL
 }
 
 type CheckFunc func() (unwell bool, meta Meta)
@@ -66,6 +80,7 @@ func NewNurse(cfg Config, log logger.Logger) *Nurse {
 		cfg:      cfg,
 		log:      log.Named("nurse"),
 		checks:   make(map[string]CheckFunc),
+		client:   &http.Client{},
 		chGather: make(chan gatherRequest, 1),
 		chStop:   make(chan struct{}),
 	}
@@ -126,6 +141,12 @@ func (n *Nurse) Start() error {
 			}
 		}()
 
+		// Uploader
+		if n.cfg.AutoPprofUploadURL() != nil {
+			n.wgDone.Add(1)
+			go n.uploadLoop()
+		}
+
 		return nil
 	})
 }
@@ -390,6 +411,11 @@ func (n *Nurse) openFile(now time.Time, typ string, shouldGzip bool) (io.WriteCl
 	return file, nil
 }
 
+// IsProfileFile returns true if name is the name of a file written by the Nurse in its profile root.
+func IsProfileFile(name string) bool {
+	return filepath.Ext(name) == ".pprof" || name == "nurse.log" || strings.HasSuffix(name, ".pprof.gz")
+}
+
 func (n *Nurse) totalProfileBytes() (uint64, error) {
 	entries, err := os.ReadDir(n.cfg.AutoPprofProfileRoot())
 	if err != nil {
@@ -397,10 +423,7 @@ func (n *Nurse) totalProfileBytes() (uint64, error) {
 	}
 	var size uint64
 	for _, entry := range entries {
-		if entry.IsDir() ||
-			(filepath.Ext(entry.Name()) != ".pprof" &&
-				entry.Name() != "nurse.log" &&
-				!strings.HasSuffix(entry.Name(), ".pprof.gz")) {
+		if entry.IsDir() || !IsProfileFile(entry.Name()) {
 			continue
 		}
 		info, err := entry.Info()
@@ -411,3 +434,91 @@ func (n *Nurse) totalProfileBytes() (uint64, error) {
 	}
 	return size, nil
 }
+
+// uploadLoop captures profiles every AutoPprofUploadInterval, and uploads them.
+func (n *Nurse) uploadLoop() {
+	defer n.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(n.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(n.cfg.AutoPprofUploadInterval().Duration())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-n.chStop:
+			return
+		case <-ticker.C:
+		}
+
+		for _, typ := range n.cfg.AutoPprofUploadProfileTypes() {
+			from := time.Now()
+			var buf bytes.Buffer
+			if err := n.capture(ctx, typ, &buf); err != nil {
+				n.log.Warnw(fmt.Sprintf("could not capture %v profile for upload", typ), "error", err)
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			if err := n.upload(ctx, typ, from, time.Now(), buf.Bytes()); err != nil {
+				n.log.Warnw(fmt.Sprintf("could not upload %v profile", typ), "error", err)
+			}
+		}
+	}
+}
+
+// capture writes the gzipped profile typ to w. The cpu profile is captured for AutoPprofUploadCPUDuration, while the
+// others are snapshots.
+func (n *Nurse) capture(ctx context.Context, typ string, w io.Writer) error {
+	if typ != "cpu" {
+		p := pprof.Lookup(typ)
+		if p == nil {
+			return errors.Errorf("pprof type '%v' does not exist", typ)
+		}
+		return p.WriteTo(w, 0)
+	}
+
+	// Fails while another cpu profile is being captured, e.g. by gatherCPU.
+	if err := pprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+	defer pprof.StopCPUProfile()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(n.cfg.AutoPprofUploadCPUDuration().Duration()):
+	}
+	return nil
+}
+
+func (n *Nurse) upload(ctx context.Context, typ string, from, until time.Time, body []byte) error {
+	u := *n.cfg.AutoPprofUploadURL()
+	q := u.Query()
+	q.Set("type", typ)
+	q.Set("from", strconv.FormatInt(from.Unix(), 10))
+	q.Set("until", strconv.FormatInt(until.Unix(), 10))
+	q.Set("version", static.Version)
+	u.RawQuery = q.Encode()
+
+	ctx, cancel := context.WithTimeout(ctx, n.cfg.AutoPprofUploadTimeout().Duration())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if token := n.cfg.AutoPprofUploadAuthToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package services_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestNurse_Upload(t *testing.T) {
+	var mu sync.Mutex
+	uploaded := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer upload-token", r.Header.Get("Authorization"))
+		assert.NotEmpty(t, r.URL.Query().Get("from"))
+		assert.NotEmpty(t, r.URL.Query().Get("until"))
+		assert.NotEmpty(t, r.URL.Query().Get("version"))
+		mu.Lock()
+		defer mu.Unlock()
+		uploaded[r.URL.Query().Get("type")]++
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.AutoPprof.ProfileRoot = ptr(t.TempDir())
+		c.AutoPprof.PollInterval = models.MustNewDuration(time.Hour)
+		c.AutoPprof.Upload.URL = models.MustParseURL(srv.URL + "/ingest")
+		c.AutoPprof.Upload.Interval = models.MustNewDuration(100 * time.Millisecond)
+		c.AutoPprof.Upload.CPUDuration = models.MustNewDuration(10 * time.Millisecond)
+		c.AutoPprof.Upload.ProfileTypes = &[]string{"cpu", "heap"}
+		token := models.Secret("upload-token")
+		s.AutoPprof.UploadAuthToken = &token
+	})
+
+	nurse := services.NewNurse(cfg, logger.TestLogger(t))
+	require.NoError(t, nurse.Start())
+	t.Cleanup(func() { assert.NoError(t, nurse.Close()) })
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return uploaded["cpu"] > 0 && uploaded["heap"] > 0
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	ocrkeys "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/telemetry"
@@ -206,6 +207,17 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 		if err != nil {
 			return nil, err
 		}
+		var privateKeys ocrtypes.PrivateKeys = ocrkey
+		if concreteSpec.OnchainSigningAddress != nil {
+			// Reports are signed by an EVM key, which may be held by a remote signer
+			address := concreteSpec.OnchainSigningAddress.Address()
+			if _, err = d.keyStore.Eth().Get(address.Hex()); err != nil {
+				return nil, errors.Wrap(err, "failed to get onchain signing key")
+			}
+			privateKeys = ocrkeys.WithOnChainSigner(ocrkey, address, func(hash []byte) ([]byte, error) {
+				return d.keyStore.Eth().SignHash(address, hash)
+			})
+		}
 		contractABI, err := abi.JSON(strings.NewReader(offchainaggregator.OffchainAggregatorABI))
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get contract ABI JSON")
@@ -299,7 +311,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 			LocalConfig:                  lc,
 			ContractTransmitter:          contractTransmitter,
 			ContractConfigTracker:        tracker,
-			PrivateKeys:                  ocrkey,
+			PrivateKeys:                  privateKeys,
 			BinaryNetworkEndpointFactory: peerWrapper.Peer1,
 			Logger:                       ocrLogger,
 			V1Bootstrappers:              v1BootstrapPeers,
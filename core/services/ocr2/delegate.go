@@ -14,6 +14,7 @@ import (
 	dkgpkg "github.com/smartcontractkit/ocr2vrf/dkg"
 	"github.com/smartcontractkit/ocr2vrf/ocr2vrf"
 	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
@@ -27,6 +28,7 @@ import (
 	drocr_service "github.com/smartcontractkit/chainlink/core/services/directrequestocr"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/directrequestocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/dkg"
@@ -110,8 +112,43 @@ func (d *Delegate) BeforeJobCreated(spec job.Job) {
 	// This is only called first time the job is created
 	d.isNewlyCreatedJob = true
 }
-func (d *Delegate) AfterJobCreated(spec job.Job)  {}
-func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job) {}
+
+// BeforeJobDeleted unregisters the log poller filters of EVM jobs, as they are persisted.
+func (d *Delegate) BeforeJobDeleted(jb job.Job) {
+	spec := jb.OCR2OracleSpec
+	if spec == nil || spec.Relay != relay.EVM {
+		return
+	}
+	rargs := types.RelayArgs{
+		ExternalJobID: jb.ExternalJobID,
+		JobID:         spec.ID,
+		ContractID:    spec.ContractID,
+		RelayConfig:   spec.RelayConfig.Bytes(),
+	}
+	var err error
+	switch spec.PluginType {
+	case job.OCR2Keeper:
+		err = evmrelay.UnregisterFilters(d.chainSet, rargs, ocr2keeper.LogProviderFilterName(common.HexToAddress(spec.ContractID)))
+	case job.OCR2VRF:
+		var cfg ocr2vrfconfig.PluginConfig
+		if err = json.Unmarshal(spec.PluginConfig.Bytes(), &cfg); err != nil {
+			err = errors.Wrap(err, "unmarshal ocr2vrf plugin config")
+			break
+		}
+		err = evmrelay.UnregisterFilters(d.chainSet, rargs, ocr2coordinator.FilterName(
+			common.HexToAddress(spec.ContractID),
+			common.HexToAddress(cfg.VRFCoordinatorAddress),
+			common.HexToAddress(cfg.DKGContractAddress),
+		))
+		// The DKG provider of the job registers filters for the DKG contract too.
+		rargs.ContractID = cfg.DKGContractAddress
+		err = multierr.Append(err, evmrelay.UnregisterFilters(d.chainSet, rargs))
+	default:
+		err = evmrelay.UnregisterFilters(d.chainSet, rargs)
+	}
+	d.lggr.ErrorIf(err, fmt.Sprintf("Failed to unregister log poller filters for job %d", jb.ID))
+}
 
 // ServicesForSpec returns the OCR2 services that need to run for this job
 func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
@@ -203,6 +240,19 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 	if err != nil {
 		return nil, err
 	}
+	if spec.OnchainSigningAddress != nil {
+		// Reports are signed by an EVM key, which may be held by a remote signer
+		address := spec.OnchainSigningAddress.Address()
+		if _, err = d.ethKs.Get(address.Hex()); err != nil {
+			return nil, errors.Wrap(err, "failed to get onchain signing key")
+		}
+		kb, err = ocr2key.WithEVMOnchainSigner(kb, address, func(hash []byte) ([]byte, error) {
+			return d.ethKs.SignHash(address, hash)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	runResults := make(chan pipeline.Run, d.cfg.JobPipelineResultWriteQueueDepth())
 
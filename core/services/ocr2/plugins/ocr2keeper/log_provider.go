@@ -26,6 +26,11 @@ type LogProvider struct {
 
 var _ plugintypes.PerformLogProvider = (*LogProvider)(nil)
 
+// LogProviderFilterName is the name of the log poller filter of the LogProvider of the registry at addr.
+func LogProviderFilterName(addr common.Address) string {
+	return logpoller.FilterName("OCR2KeeperRegistry - LogProvider", addr)
+}
+
 func NewLogProvider(
 	logger logger.Logger,
 	logPoller logpoller.LogPoller,
@@ -41,8 +46,9 @@ func NewLogProvider(
 	}
 
 	// Add log filters for the log poller so that it can poll and find the logs that
-	// we need. Not unregistering the filter later so we ignore the id
-	_, err = logPoller.RegisterFilter(logpoller.Filter{
+	// we need.
+	err = logPoller.RegisterFilter(logpoller.Filter{
+		Name: LogProviderFilterName(registryAddress),
 		EventSigs: []common.Hash{
 			registry.KeeperRegistryUpkeepPerformed{}.Topic(),
 		},
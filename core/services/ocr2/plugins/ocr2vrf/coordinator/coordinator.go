@@ -149,6 +149,11 @@ type coordinator struct {
 	coordinatorConfig        *ocr2vrftypes.CoordinatorConfig
 }
 
+// FilterName is the name of the log poller filter of the coordinator of the given contracts.
+func FilterName(beaconAddress, coordinatorAddress, dkgAddress common.Address) string {
+	return logpoller.FilterName("OCR2VRF Coordinator", beaconAddress, coordinatorAddress, dkgAddress)
+}
+
 // New creates a new CoordinatorInterface implementor.
 func New(
 	lggr logger.Logger,
@@ -168,7 +173,8 @@ func New(
 
 	// Add log filters for the log poller so that it can poll and find the logs that
 	// we need.
-	_, err = logPoller.RegisterFilter(logpoller.Filter{
+	err = logPoller.RegisterFilter(logpoller.Filter{
+		Name: FilterName(beaconAddress, coordinatorAddress, dkgAddress),
 		EventSigs: []common.Hash{
 			t.randomnessRequestedTopic,
 			t.randomnessFulfillmentRequestedTopic,
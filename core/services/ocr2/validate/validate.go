@@ -47,6 +47,9 @@ func ValidatedOracleSpecToml(config Config, tomlString string) (job.Job, error)
 	if _, ok := relay.SupportedRelays[spec.Relay]; !ok {
 		return jb, errors.Errorf("no such relay %v supported", spec.Relay)
 	}
+	if spec.OnchainSigningAddress != nil && spec.Relay != relay.EVM {
+		return jb, errors.Errorf("onchainSigningAddress is only supported by the %s relay", relay.EVM)
+	}
 	if len(spec.P2PV2Bootstrappers) > 0 {
 		_, err = ocrcommon.ParseBootstrapPeers(spec.P2PV2Bootstrappers)
 		if err != nil {
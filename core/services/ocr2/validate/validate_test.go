@@ -105,6 +105,48 @@ answer1      [type=median index=0];
 				assert.Equal(t, 1, int(os.SchemaVersion))
 			},
 		},
+		{
+			name: "onchain signing address",
+			toml: `
+type                  = "offchainreporting2"
+pluginType            = "median"
+schemaVersion         = 1
+relay                 = "evm"
+contractID            = "0x613a38AC1659769640aaE063C651F48E0250454C"
+onchainSigningAddress = "0xF67D0290337bca0847005C7ffD1BC75BA9AAE6e4"
+observationSource     = """
+ds1          [type=bridge name=voter_turnout];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, os.OCR2OracleSpec.OnchainSigningAddress)
+				assert.Equal(t, "0xF67D0290337bca0847005C7ffD1BC75BA9AAE6e4", os.OCR2OracleSpec.OnchainSigningAddress.String())
+			},
+		},
+		{
+			name: "onchain signing address requires evm relay",
+			toml: `
+type                  = "offchainreporting2"
+pluginType            = "median"
+schemaVersion         = 1
+relay                 = "solana"
+contractID            = "0x613a38AC1659769640aaE063C651F48E0250454C"
+onchainSigningAddress = "0xF67D0290337bca0847005C7ffD1BC75BA9AAE6e4"
+observationSource     = """
+ds1          [type=bridge name=voter_turnout];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				assert.EqualError(t, err, "onchainSigningAddress is only supported by the evm relay")
+			},
+		},
 		{
 			name: "raises error on extra keys",
 			toml: `
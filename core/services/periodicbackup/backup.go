@@ -1,12 +1,19 @@
 package periodicbackup
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -15,13 +22,20 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
+	filePrefix         = "cl_backup_"
+	filePattern        = filePrefix + "%s_%s.dump"
 	minBackupFrequency = time.Minute
 
+	// alwaysIncludedTables are dumped by selective backups too, so that their schema version is known.
+	alwaysIncludedTables = []string{
+		"goose_migrations",
+	}
+
 	excludedDataFromTables = []string{
 		"pipeline_runs",
 		"pipeline_task_runs",
@@ -33,6 +47,7 @@ type backupResult struct {
 	path            string
 	maskedArguments []string
 	pgDumpArguments []string
+	manifest        Manifest
 }
 
 type (
@@ -47,6 +62,12 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
+		tables          []string
+		excludeData     []string
+		maxBackups      uint32
+		maxAge          time.Duration
+		encryptionKey   string
+		upload          config.DatabaseBackupUpload
 		done            chan bool
 		utils.StartStopOnce
 	}
@@ -56,6 +77,12 @@ type (
 		DatabaseBackupFrequency() time.Duration
 		DatabaseBackupURL() *url.URL
 		DatabaseBackupDir() string
+		DatabaseBackupTables() []string
+		DatabaseBackupExcludeTableData() []string
+		DatabaseBackupMaxBackups() uint32
+		DatabaseBackupMaxAge() time.Duration
+		DatabaseBackupEncryptionKey() string
+		DatabaseBackupUpload() config.DatabaseBackupUpload
 		DatabaseURL() url.URL
 		RootDir() string
 	}
@@ -70,13 +97,9 @@ func NewDatabaseBackup(config Config, lggr logger.Logger) (DatabaseBackup, error
 		dbUrl = *dbBackupUrl
 	}
 
-	outputParentDir := filepath.Join(config.RootDir(), "backup")
-	if config.DatabaseBackupDir() != "" {
-		dir, err := filepath.Abs(config.DatabaseBackupDir())
-		if err != nil {
-			return nil, errors.Errorf("failed to get path for DATABASE_BACKUP_DIR (%s) - please set it to a valid directory path", config.DatabaseBackupDir())
-		}
-		outputParentDir = dir
+	outputParentDir, err := backupDir(config)
+	if err != nil {
+		return nil, err
 	}
 
 	return &databaseBackup{
@@ -85,11 +108,28 @@ func NewDatabaseBackup(config Config, lggr logger.Logger) (DatabaseBackup, error
 		config.DatabaseBackupMode(),
 		config.DatabaseBackupFrequency(),
 		outputParentDir,
+		config.DatabaseBackupTables(),
+		config.DatabaseBackupExcludeTableData(),
+		config.DatabaseBackupMaxBackups(),
+		config.DatabaseBackupMaxAge(),
+		config.DatabaseBackupEncryptionKey(),
+		config.DatabaseBackupUpload(),
 		make(chan bool),
 		utils.StartStopOnce{},
 	}, nil
 }
 
+func backupDir(config Config) (string, error) {
+	if config.DatabaseBackupDir() == "" {
+		return filepath.Join(config.RootDir(), "backup"), nil
+	}
+	dir, err := filepath.Abs(config.DatabaseBackupDir())
+	if err != nil {
+		return "", errors.Errorf("failed to get path for DATABASE_BACKUP_DIR (%s) - please set it to a valid directory path", config.DatabaseBackupDir())
+	}
+	return dir, nil
+}
+
 // Start starts DatabaseBackup.
 func (backup *databaseBackup) Start(context.Context) error {
 	return backup.StartOnce("DatabaseBackup", func() (err error) {
@@ -141,6 +181,18 @@ func (backup *databaseBackup) RunBackup(version string) error {
 		return err
 	}
 	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+
+	ctx := context.Background()
+	if backup.upload.Enabled() {
+		if err = backup.uploadBackup(ctx, result); err != nil {
+			backup.logger.Errorw("Backup upload failed", "err", err)
+			return err
+		}
+	}
+	if err = backup.prune(ctx, time.Now()); err != nil {
+		backup.logger.Errorw("Failed to prune old backups", "err", err)
+		return err
+	}
 	return nil
 }
 
@@ -164,12 +216,24 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		"-F", "c", // format: custom (zipped)
 	}
 
-	if backup.mode == config.DatabaseBackupModeLite {
-		for _, table := range excludedDataFromTables {
-			args = append(args, fmt.Sprintf("--exclude-table-data=%s", table))
+	if len(backup.tables) > 0 {
+		for _, table := range backup.tables {
+			args = append(args, fmt.Sprintf("--table=%s", table))
+		}
+		for _, table := range alwaysIncludedTables {
+			args = append(args, fmt.Sprintf("--table=%s", table))
 		}
 	}
 
+	var excludeData []string
+	if backup.mode == config.DatabaseBackupModeLite {
+		excludeData = append(excludeData, excludedDataFromTables...)
+	}
+	excludeData = append(excludeData, backup.excludeData...)
+	for _, table := range excludeData {
+		args = append(args, fmt.Sprintf("--exclude-table-data=%s", table))
+	}
+
 	maskArgs := func(args []string) []string {
 		masked := make([]string, len(args))
 		copy(masked, args)
@@ -200,14 +264,38 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		return partialResult, errors.Wrap(err, "pg_dump failed")
 	}
 
-	if version == "" {
-		version = "unknown"
+	dumpPath := tmpFile.Name()
+	defer os.Remove(dumpPath)
+
+	manifest := Manifest{
+		Version:          version,
+		Mode:             backup.mode,
+		Tables:           backup.tables,
+		ExcludeTableData: excludeData,
+		Encrypted:        backup.encryptionKey != "",
+		CreatedAt:        time.Now().UTC(),
+	}
+	if manifest.Version == "" {
+		manifest.Version = "unknown"
 	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
+	manifest.SchemaVersion, err = schemaVersion(backup.databaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.Encrypted {
+		encPath := dumpPath + encryptedSuffix
+		defer os.Remove(encPath)
+		if err = encryptFile(encPath, dumpPath, backup.encryptionKey); err != nil {
+			return nil, errors.Wrap(err, "Failed to encrypt the backup")
+		}
+		dumpPath = encPath
+	}
+
+	finalFilePath := filepath.Join(backup.outputParentDir, manifest.fileName())
 	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
+	err = os.Rename(dumpPath, finalFilePath)
 	if err != nil {
-		_ = os.Remove(tmpFile.Name())
 		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
 	}
 
@@ -215,11 +303,215 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to access the final backup file")
 	}
+	manifest.SHA256, err = fileSHA256(finalFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if err = manifest.write(finalFilePath); err != nil {
+		return nil, err
+	}
 
 	return &backupResult{
 		size:            file.Size(),
 		path:            finalFilePath,
 		maskedArguments: maskedArgs,
 		pgDumpArguments: args,
+		manifest:        manifest,
 	}, nil
 }
+
+func (backup *databaseBackup) uploadBackup(ctx context.Context, result *backupResult) error {
+	client := newS3Client(backup.upload)
+	key := backup.upload.Prefix + filepath.Base(result.path)
+
+	f, err := os.Open(result.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = client.put(ctx, key, f, result.size, result.manifest.SHA256); err != nil {
+		return err
+	}
+
+	b, err := result.manifest.marshal()
+	if err != nil {
+		return err
+	}
+	if err = client.put(ctx, key+manifestSuffix, bytes.NewReader(b), int64(len(b)), hexSHA256(b)); err != nil {
+		return err
+	}
+	backup.logger.Infow("Backup uploaded", "bucket", backup.upload.Bucket, "key", key)
+	return nil
+}
+
+// prune removes local and uploaded backups which are no longer retained by MaxBackups and MaxAge.
+func (backup *databaseBackup) prune(ctx context.Context, now time.Time) error {
+	if backup.maxBackups == 0 && backup.maxAge == 0 {
+		return nil
+	}
+	local, err := listLocalBackups(backup.outputParentDir)
+	if err != nil {
+		return err
+	}
+	for _, b := range expiredBackups(local, backup.maxBackups, backup.maxAge, now) {
+		backup.logger.Infow("Removing old backup", "filePath", b.Name)
+		if err = os.Remove(filepath.Join(backup.outputParentDir, b.Name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err = os.Remove(filepath.Join(backup.outputParentDir, b.Name+manifestSuffix)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if !backup.upload.Enabled() {
+		return nil
+	}
+	client := newS3Client(backup.upload)
+	remote, err := listRemoteBackups(ctx, client, backup.upload.Prefix)
+	if err != nil {
+		return err
+	}
+	for _, b := range expiredBackups(remote, backup.maxBackups, backup.maxAge, now) {
+		backup.logger.Infow("Removing old uploaded backup", "key", backup.upload.Prefix+b.Name)
+		if err = client.delete(ctx, backup.upload.Prefix+b.Name); err != nil {
+			return err
+		}
+		if err = client.delete(ctx, backup.upload.Prefix+b.Name+manifestSuffix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BackupFile is a backup, stored locally or uploaded.
+type BackupFile struct {
+	Name      string
+	Size      int64
+	CreatedAt time.Time
+}
+
+// expiredBackups returns the backups which are not retained: all but the newest maxBackups, and those older than
+// maxAge. Zero values disable the respective limit.
+func expiredBackups(backups []BackupFile, maxBackups uint32, maxAge time.Duration, now time.Time) (expired []BackupFile) {
+	sorted := make([]BackupFile, len(backups))
+	copy(sorted, backups)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].CreatedAt.After(sorted[j].CreatedAt) })
+	for i, b := range sorted {
+		if (maxBackups > 0 && i >= int(maxBackups)) || (maxAge > 0 && now.Sub(b.CreatedAt) > maxAge) {
+			expired = append(expired, b)
+		}
+	}
+	return
+}
+
+func isBackupFile(name string) bool {
+	return strings.HasPrefix(name, filePrefix) &&
+		(strings.HasSuffix(name, ".dump") || strings.HasSuffix(name, ".dump"+encryptedSuffix))
+}
+
+func listLocalBackups(dir string) ([]BackupFile, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backups in %s", dir)
+	}
+	var backups []BackupFile
+	for _, e := range entries {
+		if e.IsDir() || !isBackupFile(e.Name()) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, BackupFile{Name: e.Name(), Size: info.Size(), CreatedAt: info.ModTime()})
+	}
+	return backups, nil
+}
+
+func listRemoteBackups(ctx context.Context, client *s3Client, prefix string) ([]BackupFile, error) {
+	objects, err := client.list(ctx, prefix+filePrefix)
+	if err != nil {
+		return nil, err
+	}
+	var backups []BackupFile
+	for _, o := range objects {
+		name := strings.TrimPrefix(o.Key, prefix)
+		if strings.Contains(name, "/") || !isBackupFile(name) {
+			continue
+		}
+		backups = append(backups, BackupFile{Name: name, Size: o.Size, CreatedAt: o.LastModified})
+	}
+	return backups, nil
+}
+
+// ListBackups returns the local backups, and the uploaded ones if uploads are enabled, newest first.
+func ListBackups(ctx context.Context, config Config) (local, remote []BackupFile, err error) {
+	dir, err := backupDir(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	local, err = listLocalBackups(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	sortNewestFirst(local)
+	if upload := config.DatabaseBackupUpload(); upload.Enabled() {
+		remote, err = listRemoteBackups(ctx, newS3Client(upload), upload.Prefix)
+		if err != nil {
+			return nil, nil, err
+		}
+		sortNewestFirst(remote)
+	}
+	return
+}
+
+func sortNewestFirst(backups []BackupFile) {
+	sort.Slice(backups, func(i, j int) bool { return backups[i].CreatedAt.After(backups[j].CreatedAt) })
+}
+
+func encryptFile(dst, src, passphrase string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err = encrypt(out, in, passphrase); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", errors.Wrapf(err, "failed to hash %s", path)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// schemaVersion returns the latest applied migration of the database at dbURL.
+func schemaVersion(dbURL url.URL) (int64, error) {
+	db, err := sql.Open(string(dialects.Postgres), dbURL.String())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to open database")
+	}
+	defer db.Close()
+	// migrations which were rolled back have a later row with is_applied = false
+	var version int64
+	err = db.QueryRow(`SELECT COALESCE(MAX(version_id), 0) FROM (
+	SELECT DISTINCT ON (version_id) version_id, is_applied FROM goose_migrations ORDER BY version_id, id DESC
+) m WHERE is_applied`).Scan(&version)
+	return version, errors.Wrap(err, "failed to read the database schema version")
+}
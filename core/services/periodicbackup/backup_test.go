@@ -1,9 +1,12 @@
 package periodicbackup
 
 import (
+	"bytes"
+	"context"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,8 +16,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/config/envvar"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
 )
 
 func mustNewDatabaseBackup(t *testing.T, config Config) *databaseBackup {
@@ -120,10 +125,45 @@ func TestPeriodicBackup_AlternativeOutputDir(t *testing.T) {
 	require.NoError(t, err, "error not nil when checking for output file")
 
 	assert.Greater(t, file.Size(), int64(0))
-	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9.dump")
+	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9_")
+	assert.True(t, strings.HasSuffix(result.path, ".dump"))
 
 }
 
+func TestPeriodicBackup_RunBackupSelectiveEncrypted(t *testing.T) {
+	backupConfig := newTestConfig(time.Minute, nil, must(t, envvar.DatabaseURL), t.TempDir(), "", config.DatabaseBackupModeLite)
+	backupConfig.tables = []string{"jobs", "bridge_types"}
+	backupConfig.excludeTableData = []string{"logs"}
+	backupConfig.encryptionKey = "correct horse battery staple"
+	periodicBackup := mustNewDatabaseBackup(t, backupConfig)
+
+	result, err := periodicBackup.runBackup("0.9.9")
+	require.NoError(t, err, "error not nil for backup")
+
+	assert.True(t, strings.HasSuffix(result.path, ".dump.enc"))
+	assert.Contains(t, result.pgDumpArguments, "--table=jobs")
+	assert.Contains(t, result.pgDumpArguments, "--table=bridge_types")
+	assert.Contains(t, result.pgDumpArguments, "--table=goose_migrations")
+	assert.Contains(t, result.pgDumpArguments, "--exclude-table-data=pipeline_task_runs")
+	assert.Contains(t, result.pgDumpArguments, "--exclude-table-data=logs")
+
+	m, err := readManifest(result.path)
+	require.NoError(t, err)
+	assert.Equal(t, result.manifest, m)
+	assert.True(t, m.Encrypted)
+	assert.Greater(t, m.SchemaVersion, int64(0))
+	sum, err := fileSHA256(result.path)
+	require.NoError(t, err)
+	assert.Equal(t, sum, m.SHA256)
+
+	f, err := os.Open(result.path)
+	require.NoError(t, err)
+	defer f.Close()
+	var dump bytes.Buffer
+	require.NoError(t, decrypt(&dump, f, backupConfig.encryptionKey))
+	assert.True(t, bytes.HasPrefix(dump.Bytes(), []byte("PGDMP")), "expected a pg_dump custom format archive")
+}
+
 type testConfig struct {
 	databaseBackupFrequency time.Duration
 	databaseBackupMode      config.DatabaseBackupMode
@@ -131,6 +171,12 @@ type testConfig struct {
 	databaseBackupDir       string
 	databaseURL             url.URL
 	rootDir                 string
+	tables                  []string
+	excludeTableData        []string
+	maxBackups              uint32
+	maxAge                  time.Duration
+	encryptionKey           string
+	upload                  config.DatabaseBackupUpload
 }
 
 func (config testConfig) DatabaseBackupFrequency() time.Duration {
@@ -145,6 +191,24 @@ func (config testConfig) DatabaseBackupURL() *url.URL {
 func (config testConfig) DatabaseBackupDir() string {
 	return config.databaseBackupDir
 }
+func (config testConfig) DatabaseBackupTables() []string {
+	return config.tables
+}
+func (config testConfig) DatabaseBackupExcludeTableData() []string {
+	return config.excludeTableData
+}
+func (config testConfig) DatabaseBackupMaxBackups() uint32 {
+	return config.maxBackups
+}
+func (config testConfig) DatabaseBackupMaxAge() time.Duration {
+	return config.maxAge
+}
+func (config testConfig) DatabaseBackupEncryptionKey() string {
+	return config.encryptionKey
+}
+func (config testConfig) DatabaseBackupUpload() config.DatabaseBackupUpload {
+	return config.upload
+}
 func (config testConfig) DatabaseURL() url.URL {
 	return config.databaseURL
 }
@@ -162,3 +226,138 @@ func newTestConfig(frequency time.Duration, databaseBackupURL *url.URL, database
 		databaseBackupDir:       databaseBackupDir,
 	}
 }
+
+func TestExpiredBackups(t *testing.T) {
+	now := time.Now()
+	backups := []BackupFile{
+		{Name: "b", CreatedAt: now.Add(-2 * time.Hour)},
+		{Name: "a", CreatedAt: now.Add(-time.Hour)},
+		{Name: "d", CreatedAt: now.Add(-4 * time.Hour)},
+		{Name: "c", CreatedAt: now.Add(-3 * time.Hour)},
+	}
+	names := func(bs []BackupFile) (ns []string) {
+		for _, b := range bs {
+			ns = append(ns, b.Name)
+		}
+		return
+	}
+	assert.Empty(t, expiredBackups(backups, 0, 0, now))
+	assert.Equal(t, []string{"c", "d"}, names(expiredBackups(backups, 2, 0, now)))
+	assert.Equal(t, []string{"d"}, names(expiredBackups(backups, 0, 210*time.Minute, now)))
+	assert.Equal(t, []string{"b", "c", "d"}, names(expiredBackups(backups, 3, 90*time.Minute, now)))
+}
+
+func writeTestBackup(t *testing.T, dir string, m Manifest, contents []byte) string {
+	path := filepath.Join(dir, m.fileName())
+	require.NoError(t, os.WriteFile(path, contents, 0600))
+	m.SHA256 = hexSHA256(contents)
+	require.NoError(t, m.write(path))
+	require.NoError(t, os.Chtimes(path, m.CreatedAt, m.CreatedAt))
+	return path
+}
+
+func TestPeriodicBackup_UploadAndPrune(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t, "backups")
+	backupConfig := newTestConfig(time.Minute, nil, url.URL{}, t.TempDir(), "", config.DatabaseBackupModeFull)
+	backupConfig.maxBackups = 2
+	backupConfig.upload = testUpload(t, srv)
+	b, err := NewDatabaseBackup(backupConfig, logger.TestLogger(t))
+	require.NoError(t, err)
+	backup := b.(*databaseBackup)
+	dir, err := backupDir(backupConfig)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+
+	start := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	for i := 0; i < 3; i++ {
+		m := Manifest{Version: "1.2.3", Mode: config.DatabaseBackupModeFull, CreatedAt: start.Add(time.Duration(i) * time.Minute)}
+		contents := []byte(strings.Repeat("x", i+1))
+		path := writeTestBackup(t, dir, m, contents)
+		m.SHA256 = hexSHA256(contents)
+		require.NoError(t, backup.uploadBackup(ctx, &backupResult{path: path, size: int64(len(contents)), manifest: m}))
+		// uploads are timestamped by the server, so make them distinct
+		fake.mu.Lock()
+		key := backupConfig.upload.Prefix + m.fileName()
+		o := fake.objects[key]
+		o.LastModified = m.CreatedAt
+		fake.objects[key] = o
+		fake.mu.Unlock()
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unrelated.txt"), nil, 0600))
+
+	local, remote, err := ListBackups(ctx, backupConfig)
+	require.NoError(t, err)
+	assert.Len(t, local, 3)
+	assert.Len(t, remote, 3)
+
+	require.NoError(t, backup.prune(ctx, time.Now()))
+
+	local, remote, err = ListBackups(ctx, backupConfig)
+	require.NoError(t, err)
+	exp := []string{Manifest{Version: "1.2.3", CreatedAt: start.Add(2 * time.Minute)}.fileName(), Manifest{Version: "1.2.3", CreatedAt: start.Add(time.Minute)}.fileName()}
+	for _, bs := range [][]BackupFile{local, remote} {
+		require.Len(t, bs, 2)
+		assert.Equal(t, exp, []string{bs[0].Name, bs[1].Name})
+	}
+	_, err = os.Stat(filepath.Join(dir, Manifest{Version: "1.2.3", CreatedAt: start}.fileName()+manifestSuffix))
+	assert.True(t, os.IsNotExist(err), "manifest of pruned backup should be removed")
+	assert.Len(t, fake.objects, 4)
+
+	// download the latest backup again
+	require.NoError(t, os.Remove(filepath.Join(dir, exp[0])))
+	path, err := DownloadBackup(ctx, backupConfig, exp[0])
+	require.NoError(t, err)
+	m, err := readManifest(path)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3", m.Version)
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "xxx", string(contents))
+
+	_, err = DownloadBackup(ctx, backupConfig, "../"+exp[0])
+	require.Error(t, err)
+}
+
+func TestRestoreBackup_Verify(t *testing.T) {
+	ctx := context.Background()
+	backupConfig := newTestConfig(time.Minute, nil, url.URL{}, t.TempDir(), "", config.DatabaseBackupModeFull)
+	dir := t.TempDir()
+	latest, err := migrate.Latest()
+	require.NoError(t, err)
+	lggr := logger.TestLogger(t)
+
+	err = RestoreBackup(ctx, backupConfig, lggr, nil, filepath.Join(dir, "missing.dump"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "manifest")
+
+	path := writeTestBackup(t, dir, Manifest{Version: "1.2.3", SchemaVersion: latest + 1, CreatedAt: time.Now()}, []byte("dump"))
+	err = RestoreBackup(ctx, backupConfig, lggr, nil, path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is newer than the latest migration")
+
+	require.NoError(t, os.WriteFile(path, []byte("corrupt"), 0600))
+	err = RestoreBackup(ctx, backupConfig, lggr, nil, path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is corrupt")
+
+	path = writeTestBackup(t, dir, Manifest{Version: "1.2.3", SchemaVersion: latest, Encrypted: true, CreatedAt: time.Now().Add(time.Second)}, []byte("dump"))
+	err = RestoreBackup(ctx, backupConfig, lggr, nil, path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "BackupEncryptionKey")
+}
+
+func TestRestoreBackup_Target(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	backupConfig := newTestConfig(time.Minute, nil, url.URL{}, t.TempDir(), "", config.DatabaseBackupModeFull)
+	latest, err := migrate.Latest()
+	require.NoError(t, err)
+
+	path := writeTestBackup(t, t.TempDir(), Manifest{Version: "1.2.3", SchemaVersion: latest - 1, CreatedAt: time.Now()}, []byte("dump"))
+	err = RestoreBackup(ctx, backupConfig, logger.TestLogger(t), db.DB, path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "differs from the backup schema version")
+
+	require.NoError(t, checkRestoreTarget(ctx, db.DB, latest))
+}
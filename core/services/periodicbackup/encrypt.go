@@ -0,0 +1,151 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Encrypted backups are split into chunks which are sealed individually with AES-256-GCM, so that they can be
+// streamed without buffering the whole dump. Each chunk nonce is made of a random prefix, the chunk counter, and a flag
+// marking the last chunk, so that reordered, duplicated or truncated chunks fail to decrypt.
+//
+// Layout: magic | scrypt salt | nonce prefix | sealed chunk...
+const (
+	encryptedSuffix = ".enc"
+	chunkSize       = 64 * 1024
+	saltSize        = 16
+	noncePrefixSize = 7
+)
+
+var encryptionMagic = []byte("CLBACKUP\x01")
+
+func deriveKey(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive encryption key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// atEOF returns true if r has no more data.
+func atEOF(r *bufio.Reader) (bool, error) {
+	_, err := r.Peek(1)
+	if err == io.EOF {
+		return true, nil
+	}
+	return false, err
+}
+
+// encrypt writes the contents of r to w, encrypted with a key derived from passphrase.
+func encrypt(w io.Writer, r io.Reader, passphrase string) error {
+	header := make([]byte, len(encryptionMagic)+saltSize+noncePrefixSize)
+	copy(header, encryptionMagic)
+	if _, err := rand.Read(header[len(encryptionMagic):]); err != nil {
+		return errors.Wrap(err, "failed to generate salt and nonce")
+	}
+	salt := header[len(encryptionMagic) : len(encryptionMagic)+saltSize]
+	prefix := header[len(encryptionMagic)+saltSize:]
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(header); err != nil {
+		return err
+	}
+
+	br := bufio.NewReaderSize(r, chunkSize)
+	buf := make([]byte, chunkSize)
+	sealed := make([]byte, 0, chunkSize+gcm.Overhead())
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(br, buf)
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			return err
+		}
+		if !last {
+			if last, err = atEOF(br); err != nil {
+				return err
+			}
+		}
+		sealed = gcm.Seal(sealed[:0], chunkNonce(prefix, counter, last), buf[:n], nil)
+		if _, err = w.Write(sealed); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+		if counter == math.MaxUint32 {
+			return errors.New("backup is too large to encrypt")
+		}
+	}
+}
+
+// decrypt writes the contents of r, encrypted by encrypt, to w.
+func decrypt(w io.Writer, r io.Reader, passphrase string) error {
+	header := make([]byte, len(encryptionMagic)+saltSize+noncePrefixSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return errors.Wrap(err, "failed to read encryption header")
+	}
+	if !bytes.Equal(header[:len(encryptionMagic)], encryptionMagic) {
+		return errors.New("not an encrypted backup")
+	}
+	salt := header[len(encryptionMagic) : len(encryptionMagic)+saltSize]
+	prefix := header[len(encryptionMagic)+saltSize:]
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	br := bufio.NewReaderSize(r, chunkSize+gcm.Overhead())
+	buf := make([]byte, chunkSize+gcm.Overhead())
+	plain := make([]byte, 0, chunkSize)
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(br, buf)
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			return err
+		}
+		if !last {
+			if last, err = atEOF(br); err != nil {
+				return err
+			}
+		}
+		plain, err = gcm.Open(plain[:0], chunkNonce(prefix, counter, last), buf[:n], nil)
+		if err != nil {
+			return errors.New("failed to decrypt backup: wrong encryption key, or the file is corrupt or truncated")
+		}
+		if _, err = w.Write(plain); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
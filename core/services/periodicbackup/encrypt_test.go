@@ -0,0 +1,60 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncrypt_RoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3 * chunkSize} {
+		plain := make([]byte, size)
+		_, err := rand.Read(plain)
+		require.NoError(t, err)
+
+		var enc bytes.Buffer
+		require.NoError(t, encrypt(&enc, bytes.NewReader(plain), "passphrase"))
+		chunks := (size + chunkSize - 1) / chunkSize
+		if chunks == 0 {
+			chunks = 1
+		}
+		assert.Equal(t, len(encryptionMagic)+saltSize+noncePrefixSize+size+chunks*16, enc.Len())
+
+		var dec bytes.Buffer
+		require.NoError(t, decrypt(&dec, bytes.NewReader(enc.Bytes()), "passphrase"), "size %d", size)
+		assert.Equal(t, string(plain), dec.String(), "size %d", size)
+	}
+}
+
+func TestDecrypt_Invalid(t *testing.T) {
+	t.Parallel()
+	plain := make([]byte, 2*chunkSize+100)
+	var enc bytes.Buffer
+	require.NoError(t, encrypt(&enc, bytes.NewReader(plain), "passphrase"))
+	b := enc.Bytes()
+	header := len(encryptionMagic) + saltSize + noncePrefixSize
+	sealedChunk := chunkSize + 16
+
+	for _, tt := range []struct {
+		name       string
+		ciphertext []byte
+		passphrase string
+	}{
+		{"wrong-key", b, "wrong"},
+		{"not-encrypted", plain, "passphrase"},
+		{"truncated-at-chunk", b[:header+sealedChunk], "passphrase"},
+		{"truncated-mid-chunk", b[:len(b)-10], "passphrase"},
+		{"tampered", append(append([]byte{}, b[:header+5]...), append([]byte{b[header+5] ^ 1}, b[header+6:]...)...), "passphrase"},
+		{"reordered", append(append(append([]byte{}, b[:header]...), b[header+sealedChunk:header+2*sealedChunk]...), append(b[header:header+sealedChunk:header+sealedChunk], b[header+2*sealedChunk:]...)...), "passphrase"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var dec bytes.Buffer
+			assert.Error(t, decrypt(&dec, bytes.NewReader(tt.ciphertext), tt.passphrase))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package periodicbackup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/config"
+)
+
+const manifestSuffix = ".json"
+
+// Manifest describes a backup, and is stored next to it with the suffix .json.
+type Manifest struct {
+	// Version is the node version which took the backup.
+	Version string `json:"version"`
+	// SchemaVersion is the latest migration applied to the database.
+	SchemaVersion    int64                     `json:"schemaVersion"`
+	Mode             config.DatabaseBackupMode `json:"mode"`
+	Tables           []string                  `json:"tables,omitempty"`
+	ExcludeTableData []string                  `json:"excludeTableData,omitempty"`
+	Encrypted        bool                      `json:"encrypted"`
+	CreatedAt        time.Time                 `json:"createdAt"`
+	// SHA256 is the hex encoded hash of the backup file, after encryption.
+	SHA256 string `json:"sha256"`
+}
+
+// fileName returns the name of the backup file, which is unique per version and time.
+func (m Manifest) fileName() string {
+	name := fmt.Sprintf(filePattern, m.Version, m.CreatedAt.UTC().Format(amzDateFormat))
+	if m.Encrypted {
+		name += encryptedSuffix
+	}
+	return name
+}
+
+func (m Manifest) marshal() ([]byte, error) {
+	return json.MarshalIndent(m, "", "  ")
+}
+
+// write stores the manifest for the backup at path.
+func (m Manifest) write(path string) error {
+	b, err := m.marshal()
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(os.WriteFile(path+manifestSuffix, b, 0600), "failed to write backup manifest")
+}
+
+// readManifest reads the manifest for the backup at path.
+func readManifest(path string) (m Manifest, err error) {
+	b, err := os.ReadFile(path + manifestSuffix)
+	if os.IsNotExist(err) {
+		return m, errors.Errorf("backup manifest %s not found: backups taken by older versions must be restored manually with pg_restore", path+manifestSuffix)
+	} else if err != nil {
+		return m, errors.Wrap(err, "failed to read backup manifest")
+	}
+	err = errors.Wrapf(json.Unmarshal(b, &m), "failed to decode backup manifest %s", path+manifestSuffix)
+	return
+}
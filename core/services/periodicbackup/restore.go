@@ -0,0 +1,152 @@
+package periodicbackup
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
+)
+
+// DownloadBackup downloads the uploaded backup name, and its manifest, to the backup directory, and returns its path.
+func DownloadBackup(ctx context.Context, config Config, name string) (string, error) {
+	upload := config.DatabaseBackupUpload()
+	if !upload.Enabled() {
+		return "", errors.New("backup uploads are not configured: set Database.Backup.Upload.Endpoint and Bucket")
+	}
+	if !isBackupFile(name) || filepath.Base(name) != name {
+		return "", errors.Errorf("invalid backup name %q", name)
+	}
+	dir, err := backupDir(config)
+	if err != nil {
+		return "", err
+	}
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", errors.Wrapf(err, "Failed to create directories on the path: %s", dir)
+	}
+
+	client := newS3Client(upload)
+	path := filepath.Join(dir, name)
+	for _, n := range []string{name + manifestSuffix, name} {
+		if err = downloadFile(ctx, client, upload.Prefix+n, filepath.Join(dir, n)); err != nil {
+			return "", err
+		}
+	}
+	return path, nil
+}
+
+func downloadFile(ctx context.Context, client *s3Client, key, path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err = client.get(ctx, key, f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	return f.Close()
+}
+
+// RestoreBackup restores the backup at path to the database db. The backup is verified against its manifest, and must not
+// be newer than the latest migration known to this node. Since pg_restore only replaces the objects of the backup, the
+// database must either be empty, or have the schema version of the backup. Backups of older schemas are restored, but the
+// database must be migrated afterwards.
+func RestoreBackup(ctx context.Context, config Config, lggr logger.Logger, db *sql.DB, path string) error {
+	m, err := readManifest(path)
+	if err != nil {
+		return err
+	}
+	sum, err := fileSHA256(path)
+	if err != nil {
+		return err
+	}
+	if sum != m.SHA256 {
+		return errors.Errorf("backup %s is corrupt: SHA-256 is %s but the manifest expects %s", path, sum, m.SHA256)
+	}
+
+	latest, err := migrate.Latest()
+	if err != nil {
+		return errors.Wrap(err, "failed to determine the latest migration")
+	}
+	if m.SchemaVersion > latest {
+		return errors.Errorf("backup schema version %d is newer than the latest migration %d known to this node: restore it with node version %s or later", m.SchemaVersion, latest, m.Version)
+	}
+	key := config.DatabaseBackupEncryptionKey()
+	if m.Encrypted && key == "" {
+		return errors.New("backup is encrypted: set Database.BackupEncryptionKey in secrets to restore it")
+	}
+	if err = checkRestoreTarget(ctx, db, m.SchemaVersion); err != nil {
+		return err
+	}
+	if m.SchemaVersion < latest {
+		lggr.Warnf("Backup schema version %d is older than the latest migration %d: run `chainlink node db migrate` after restoring", m.SchemaVersion, latest)
+	}
+
+	dumpPath := path
+	if m.Encrypted {
+		tmp, err := os.CreateTemp(filepath.Dir(path), "cl_restore_tmp_")
+		if err != nil {
+			return errors.Wrap(err, "Failed to create a tmp file")
+		}
+		defer os.Remove(tmp.Name())
+		in, err := os.Open(path)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		err = decrypt(tmp, in, key)
+		in.Close()
+		if cerr := tmp.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+		dumpPath = tmp.Name()
+	}
+
+	dbURL := config.DatabaseURL()
+	args := []string{
+		"--clean", "--if-exists", // drop the restored objects first
+		"--no-owner",
+		"--single-transaction",
+		"-d", dbURL.String(),
+		dumpPath,
+	}
+	lggr.Infow("Restoring backup", "filePath", path, "version", m.Version, "schemaVersion", m.SchemaVersion, "createdAt", m.CreatedAt, "database", dbURL.Redacted())
+	cmd := exec.CommandContext(ctx, "pg_restore", args...)
+	if _, err = cmd.Output(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return errors.Wrapf(err, "pg_restore failed with output: %s", string(ee.Stderr))
+		}
+		return errors.Wrap(err, "pg_restore failed")
+	}
+	return nil
+}
+
+// checkRestoreTarget returns an error unless db is empty, or has the schema version of the backup. pg_restore would
+// leave the tables of later migrations in place, and fail to replace the objects of older ones.
+func checkRestoreTarget(ctx context.Context, db *sql.DB, schemaVersion int64) error {
+	var empty bool
+	if err := db.QueryRowContext(ctx, `SELECT NOT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = current_schema())`).Scan(&empty); err != nil {
+		return errors.Wrap(err, "failed to check whether the database is empty")
+	}
+	if empty {
+		return nil
+	}
+	current, err := migrate.Version(ctx, db)
+	if err != nil {
+		return errors.Wrap(err, "failed to determine the schema version of the database")
+	}
+	if current != schemaVersion {
+		return errors.Errorf("database schema version %d differs from the backup schema version %d: restore it into an empty database, or migrate the database to version %d first", current, schemaVersion, schemaVersion)
+	}
+	return nil
+}
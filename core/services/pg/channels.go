@@ -5,3 +5,82 @@ const (
 	ChannelInsertOnEthTx    = "insert_on_eth_txes"
 	ChannelInsertOnTerraMsg = "insert_on_terra_msg"
 )
+
+// Postgres channels backing the GraphQL subscriptions. Their payloads are JSON
+// objects.
+const (
+	// ChannelInsertOnPipelineRun payloads are PipelineRunInserted.
+	ChannelInsertOnPipelineRun = "insert_on_pipeline_runs"
+	// ChannelEthTxStateChanged payloads are EthTxStateChanged.
+	ChannelEthTxStateChanged = "eth_tx_state_changed"
+	// ChannelJobProposalChanged payloads are JobProposalChanged.
+	ChannelJobProposalChanged = "job_proposal_changed"
+	// ChannelEVMNodeStateChanged payloads are EVMNodeStateChanged. Unlike the
+	// others, it is notified by the node rather than by a trigger.
+	ChannelEVMNodeStateChanged = "evm_node_state_changed"
+)
+
+// PipelineRunInserted is notified when a pipeline run is saved.
+type PipelineRunInserted struct {
+	ID             int64 `json:"id"`
+	PipelineSpecID int32 `json:"pipelineSpecID"`
+}
+
+// EthTxStateChanged is notified when an eth_tx is created or changes state.
+type EthTxStateChanged struct {
+	ID         int64  `json:"id"`
+	EVMChainID string `json:"evmChainID"`
+	State      string `json:"state"`
+}
+
+// JobProposalChanged is notified when a job proposal is received or updated.
+type JobProposalChanged struct {
+	ID             int64 `json:"id"`
+	FeedsManagerID int64 `json:"feedsManagerID"`
+}
+
+// EVMNodeStateChanged is notified when an EVM RPC node changes state.
+type EVMNodeStateChanged struct {
+	EVMChainID string `json:"evmChainID"`
+	Name       string `json:"name"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+}
+
+// Postgres channels backing the webhook notifications. Their payloads are JSON
+// objects.
+const (
+	// ChannelJobSpecErrorRecorded payloads are JobSpecErrorRecorded.
+	ChannelJobSpecErrorRecorded = "job_spec_error_recorded"
+	// ChannelJobProposalSpecCreated payloads are JobProposalSpecCreated.
+	ChannelJobProposalSpecCreated = "job_proposal_spec_created"
+	// ChannelEthKeyBalanceChanged payloads are EthKeyBalanceChanged. It is
+	// notified by the balance monitor rather than by a trigger.
+	ChannelEthKeyBalanceChanged = "eth_key_balance_changed"
+)
+
+// JobSpecErrorRecorded is notified when a job spec error is recorded, or
+// occurs again.
+type JobSpecErrorRecorded struct {
+	ID          int64  `json:"id"`
+	JobID       int32  `json:"jobID"`
+	Occurrences uint   `json:"occurrences"`
+	Description string `json:"description"`
+}
+
+// JobProposalSpecCreated is notified when a feeds manager proposes a job, or a
+// new version of it.
+type JobProposalSpecCreated struct {
+	ID            int64 `json:"id"`
+	JobProposalID int64 `json:"jobProposalID"`
+	Version       int32 `json:"version"`
+}
+
+// EthKeyBalanceChanged is notified when the balance of an EVM key changes.
+// Balances are in wei. PreviousBalance is empty for the first balance of a key.
+type EthKeyBalanceChanged struct {
+	EVMChainID      string `json:"evmChainID"`
+	Address         string `json:"address"`
+	PreviousBalance string `json:"previousBalance,omitempty"`
+	Balance         string `json:"balance"`
+}
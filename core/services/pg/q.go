@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgconn"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -20,6 +21,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
+// Postgres error codes of queries which failed on a lock.
+const (
+	lockNotAvailable = "55P03"
+	deadlockDetected = "40P01"
+)
+
 var promSQLQueryTime = promauto.NewHistogram(prometheus.HistogramOpts{
 	Name:    "sql_query_timeout_percent",
 	Help:    "SQL query time as a pecentage of timeout.",
@@ -94,6 +101,15 @@ func WithLongQueryTimeout() func(q *Q) {
 	}
 }
 
+// WithReadOnly marks the queries as read-only, so that they are sent to the read replica of the database, if it has one
+// which is available. Only mark queries which tolerate reading slightly stale data, and which do not read what was
+// just written. Queries in a transaction always use the transaction.
+func WithReadOnly() func(q *Q) {
+	return func(q *Q) {
+		q.readOnly = true
+	}
+}
+
 var _ Queryer = Q{}
 
 type QConfig interface {
@@ -121,6 +137,7 @@ type Q struct {
 	logger       logger.Logger
 	config       QConfig
 	QueryTimeout time.Duration
+	readOnly     bool
 }
 
 func NewQ(db *sqlx.DB, logger logger.Logger, config QConfig, qopts ...QOpt) (q Q) {
@@ -164,10 +181,43 @@ func (q Q) Context() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(ctx, timeout)
 }
 
+// replica returns the read replica for read-only queries which are not in a transaction, if it is available.
+func (q Q) replica() *sqlx.DB {
+	if !q.readOnly || q.db == nil || q.Queryer != Queryer(q.db) {
+		return nil
+	}
+	if db := replicaOf(q.db); db != nil {
+		promReadOnlyQueries.WithLabelValues("replica").Inc()
+		return db
+	}
+	promReadOnlyQueries.WithLabelValues("primary").Inc()
+	return nil
+}
+
+// queryer returns the read replica for read-only queries, if available, or the underlying Queryer otherwise.
+func (q Q) queryer() Queryer {
+	if db := q.replica(); db != nil {
+		return db
+	}
+	return q.Queryer
+}
+
+// Transaction runs fc in a transaction, or in the current one if the Queryer is a transaction already. Read-only
+// transactions on the read replica are started with ReadOnly set.
 func (q Q) Transaction(fc func(q Queryer) error, txOpts ...TxOptions) error {
 	ctx, cancel := q.Context()
 	defer cancel()
-	return SqlxTransaction(ctx, q.Queryer, q.originalLogger(), fc, txOpts...)
+	queryer := q.Queryer
+	if db := q.replica(); db != nil {
+		queryer = db
+		opts := OptReadOnlyTx()
+		if len(txOpts) > 0 {
+			opts = txOpts[0]
+			opts.ReadOnly = true
+		}
+		txOpts = []TxOptions{opts}
+	}
+	return SqlxTransaction(ctx, queryer, q.originalLogger(), fc, txOpts...)
 }
 
 // CAUTION: A subtle problem lurks here, because the following code is buggy:
@@ -234,7 +284,7 @@ func (q Q) Select(dest interface{}, query string, args ...interface{}) error {
 	ql.logSqlQuery()
 	defer ql.postSqlLog(ctx, time.Now())
 
-	return ql.withLogError(q.Queryer.SelectContext(ctx, dest, query, args...))
+	return ql.withLogError(q.queryer().SelectContext(ctx, dest, query, args...))
 }
 func (q Q) Get(dest interface{}, query string, args ...interface{}) error {
 	ctx, cancel := q.Context()
@@ -244,7 +294,7 @@ func (q Q) Get(dest interface{}, query string, args ...interface{}) error {
 	ql.logSqlQuery()
 	defer ql.postSqlLog(ctx, time.Now())
 
-	return ql.withLogError(q.Queryer.GetContext(ctx, dest, query, args...))
+	return ql.withLogError(q.queryer().GetContext(ctx, dest, query, args...))
 }
 func (q Q) GetNamed(sql string, dest interface{}, arg interface{}) error {
 	query, args, err := q.BindNamed(sql, arg)
@@ -258,11 +308,11 @@ func (q Q) GetNamed(sql string, dest interface{}, arg interface{}) error {
 	ql.logSqlQuery()
 	defer ql.postSqlLog(ctx, time.Now())
 
-	return ql.withLogError(errors.Wrap(q.GetContext(ctx, dest, query, args...), "error in get query"))
+	return ql.withLogError(errors.Wrap(q.queryer().GetContext(ctx, dest, query, args...), "error in get query"))
 }
 
 func (q Q) newQueryLogger(query string, args []interface{}) *queryLogger {
-	return &queryLogger{Q: q, query: query, args: args}
+	return &queryLogger{Q: q, query: query, args: args, site: callSite()}
 }
 
 // sprintQ formats the query with the given args and returns the resulting string.
@@ -301,8 +351,10 @@ func sprintQ(query string, args []interface{}) string {
 type queryLogger struct {
 	Q
 
-	query string
-	args  []interface{}
+	query  string
+	args   []interface{}
+	site   CallSite
+	failed bool
 
 	str     string
 	strOnce sync.Once
@@ -322,13 +374,21 @@ func (q *queryLogger) logSqlQuery() {
 }
 
 func (q *queryLogger) withLogError(err error) error {
-	if err != nil && !errors.Is(err, sql.ErrNoRows) && q.config != nil && q.config.LogSQL() {
+	if err == nil || errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	q.failed = true
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && (pgErr.Code == lockNotAvailable || pgErr.Code == deadlockDetected) {
+		promSQLQueryLockErrors.WithLabelValues(q.site.Function, pgErr.Code).Inc()
+	}
+	if q.config != nil && q.config.LogSQL() {
 		q.logger.Errorw("SQL ERROR", "err", err, "sql", q)
 	}
 	return err
 }
 
-// postSqlLog logs about context cancellation and timing after a query returns.
+// postSqlLog logs about context cancellation and timing after a query returns, and records its duration by call site.
 // Queries which use their full timeout log critical level. More than 50% log error, and 10% warn.
 func (q *queryLogger) postSqlLog(ctx context.Context, begin time.Time) {
 	elapsed := time.Since(begin)
@@ -344,7 +404,7 @@ func (q *queryLogger) postSqlLog(ctx context.Context, begin time.Time) {
 	pct := float64(elapsed) / float64(timeout)
 	pct *= 100
 
-	kvs := []any{"ms", elapsed.Milliseconds(), "timeout", timeout.Milliseconds(), "percent", strconv.FormatFloat(pct, 'f', 1, 64), "sql", q}
+	kvs := []any{"ms", elapsed.Milliseconds(), "timeout", timeout.Milliseconds(), "percent", strconv.FormatFloat(pct, 'f', 1, 64), "caller", q.site, "sql", q}
 
 	if elapsed >= timeout {
 		q.logger.Criticalw("SLOW SQL QUERY", kvs...)
@@ -355,4 +415,6 @@ func (q *queryLogger) postSqlLog(ctx context.Context, begin time.Time) {
 	}
 
 	promSQLQueryTime.Observe(pct)
+	promSQLQueryDuration.WithLabelValues(q.site.Function).Observe(elapsed.Seconds())
+	globalQueryStats.record(q.query, q.site, elapsed, q.failed)
 }
@@ -0,0 +1,212 @@
+package pg
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// maxTrackedQueries bounds the memory used by the slow query tracker. When full, the statement with the fastest
+	// maximum duration is evicted.
+	maxTrackedQueries = 1000
+	// maxCallSites bounds the call sites recorded per statement.
+	maxCallSites = 10
+
+	modulePrefix = "github.com/smartcontractkit/chainlink/core/"
+	pgPackage    = modulePrefix + "services/pg."
+)
+
+var (
+	promSQLQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "sql_query_duration_seconds",
+		Help:    "SQL query duration in seconds, by the function which issued the query.",
+		Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"query"})
+	promSQLQueryLockErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sql_query_lock_errors_total",
+		Help: "The number of SQL queries which failed to acquire a lock or were aborted by a deadlock, by the function which issued the query and the error code.",
+	}, []string{"query", "code"})
+)
+
+// QueryStatsSort is the order of the statements returned by SlowestQueries.
+type QueryStatsSort string
+
+const (
+	QueryStatsSortMax   QueryStatsSort = "max"
+	QueryStatsSortMean  QueryStatsSort = "mean"
+	QueryStatsSortTotal QueryStatsSort = "total"
+)
+
+// ParseQueryStatsSort parses s, which defaults to QueryStatsSortMax when empty.
+func ParseQueryStatsSort(s string) (QueryStatsSort, error) {
+	switch QueryStatsSort(s) {
+	case "", QueryStatsSortMax:
+		return QueryStatsSortMax, nil
+	case QueryStatsSortMean, QueryStatsSortTotal:
+		return QueryStatsSort(s), nil
+	}
+	return "", fmt.Errorf("invalid sort %q: must be one of %s, %s or %s", s, QueryStatsSortMax, QueryStatsSortMean, QueryStatsSortTotal)
+}
+
+// CallSite is a location which issued a statement.
+type CallSite struct {
+	Function string
+	File     string
+	Line     int
+	Calls    int64
+}
+
+func (c CallSite) String() string {
+	return fmt.Sprintf("%s (%s:%d)", c.Function, c.File, c.Line)
+}
+
+// QueryStat aggregates the executions of a statement since the node started.
+type QueryStat struct {
+	Query     string
+	Calls     int64
+	Errors    int64
+	Total     time.Duration
+	Max       time.Duration
+	LastSeen  time.Time
+	CallSites []CallSite
+}
+
+// Mean returns the mean duration of the statement.
+func (s QueryStat) Mean() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Calls)
+}
+
+type queryStats struct {
+	mu      sync.Mutex
+	queries map[string]*QueryStat
+}
+
+var globalQueryStats = newQueryStats()
+
+func newQueryStats() *queryStats {
+	return &queryStats{queries: make(map[string]*QueryStat)}
+}
+
+func (s *queryStats) record(query string, site CallSite, elapsed time.Duration, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stat, ok := s.queries[query]
+	if !ok {
+		if len(s.queries) >= maxTrackedQueries {
+			s.evict()
+		}
+		stat = &QueryStat{Query: normalizeQuery(query)}
+		s.queries[query] = stat
+	}
+	stat.Calls++
+	if failed {
+		stat.Errors++
+	}
+	stat.Total += elapsed
+	if elapsed > stat.Max {
+		stat.Max = elapsed
+	}
+	stat.LastSeen = time.Now()
+
+	for i := range stat.CallSites {
+		if c := &stat.CallSites[i]; c.Function == site.Function && c.Line == site.Line && c.File == site.File {
+			c.Calls++
+			return
+		}
+	}
+	if len(stat.CallSites) < maxCallSites {
+		site.Calls = 1
+		stat.CallSites = append(stat.CallSites, site)
+	}
+}
+
+// evict removes the statement with the fastest maximum duration, which is the least interesting one.
+func (s *queryStats) evict() {
+	var fastest string
+	var max time.Duration = -1
+	for q, stat := range s.queries {
+		if max < 0 || stat.Max < max {
+			fastest, max = q, stat.Max
+		}
+	}
+	delete(s.queries, fastest)
+}
+
+func (s *queryStats) slowest(n int, by QueryStatsSort) []QueryStat {
+	s.mu.Lock()
+	stats := make([]QueryStat, 0, len(s.queries))
+	for _, stat := range s.queries {
+		cp := *stat
+		cp.CallSites = append([]CallSite(nil), stat.CallSites...)
+		stats = append(stats, cp)
+	}
+	s.mu.Unlock()
+
+	key := func(s QueryStat) time.Duration { return s.Max }
+	switch by {
+	case QueryStatsSortMean:
+		key = QueryStat.Mean
+	case QueryStatsSortTotal:
+		key = func(s QueryStat) time.Duration { return s.Total }
+	}
+	sort.Slice(stats, func(i, j int) bool { return key(stats[i]) > key(stats[j]) })
+	for i := range stats {
+		sort.Slice(stats[i].CallSites, func(a, b int) bool { return stats[i].CallSites[a].Calls > stats[i].CallSites[b].Calls })
+	}
+	if n > 0 && n < len(stats) {
+		stats = stats[:n]
+	}
+	return stats
+}
+
+// SlowestQueries returns the n slowest statements executed by Q since the node started, ordered by by.
+func SlowestQueries(n int, by QueryStatsSort) []QueryStat {
+	return globalQueryStats.slowest(n, by)
+}
+
+// normalizeQuery collapses the whitespace of query, for display.
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
+
+// callSite returns the first caller outside of this package and sqlx, which is the function issuing the query.
+func callSite() CallSite {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		if !isInternalFrame(f.Function) {
+			return CallSite{Function: strings.TrimPrefix(f.Function, modulePrefix), File: trimFile(f.File), Line: f.Line}
+		}
+		if !more {
+			return CallSite{Function: "unknown"}
+		}
+	}
+}
+
+func isInternalFrame(function string) bool {
+	return strings.HasPrefix(function, pgPackage) || strings.HasPrefix(function, "github.com/smartcontractkit/sqlx.")
+}
+
+// trimFile returns the path of file relative to the core module, or just its base name if it is outside.
+func trimFile(file string) string {
+	if i := strings.LastIndex(file, "/core/"); i >= 0 {
+		return file[i+len("/core/"):]
+	}
+	if i := strings.LastIndex(file, "/"); i >= 0 {
+		return file[i+1:]
+	}
+	return file
+}
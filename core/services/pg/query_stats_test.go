@@ -0,0 +1,87 @@
+package pg
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_queryStats(t *testing.T) {
+	t.Parallel()
+
+	s := newQueryStats()
+	a := CallSite{Function: "services/job.(*orm).FindJob", File: "services/job/orm.go", Line: 10}
+	b := CallSite{Function: "services/job.(*orm).FindJobs", File: "services/job/orm.go", Line: 20}
+	s.record("SELECT *\n\tFROM jobs WHERE id = $1", a, 10*time.Millisecond, false)
+	s.record("SELECT *\n\tFROM jobs WHERE id = $1", a, 30*time.Millisecond, true)
+	s.record("SELECT *\n\tFROM jobs WHERE id = $1", b, 20*time.Millisecond, false)
+	s.record("SELECT * FROM jobs", b, 25*time.Millisecond, false)
+
+	stats := s.slowest(0, QueryStatsSortMax)
+	require.Len(t, stats, 2)
+	job := stats[0]
+	assert.Equal(t, "SELECT * FROM jobs WHERE id = $1", job.Query)
+	assert.Equal(t, int64(3), job.Calls)
+	assert.Equal(t, int64(1), job.Errors)
+	assert.Equal(t, 60*time.Millisecond, job.Total)
+	assert.Equal(t, 20*time.Millisecond, job.Mean())
+	assert.Equal(t, 30*time.Millisecond, job.Max)
+	require.Len(t, job.CallSites, 2)
+	assert.Equal(t, a.Function, job.CallSites[0].Function)
+	assert.Equal(t, int64(2), job.CallSites[0].Calls)
+
+	stats = s.slowest(1, QueryStatsSortMean)
+	require.Len(t, stats, 1)
+	assert.Equal(t, "SELECT * FROM jobs", stats[0].Query)
+
+	stats = s.slowest(0, QueryStatsSortTotal)
+	assert.Equal(t, "SELECT * FROM jobs WHERE id = $1", stats[0].Query)
+
+	t.Run("evicts the fastest", func(t *testing.T) {
+		s := newQueryStats()
+		for i := 0; i < maxTrackedQueries; i++ {
+			s.record(fmt.Sprintf("SELECT %d", i), a, time.Duration(i+1)*time.Millisecond, false)
+		}
+		s.record("SELECT slow", a, time.Hour, false)
+		require.Len(t, s.queries, maxTrackedQueries)
+		assert.NotContains(t, s.queries, "SELECT 0")
+		assert.Contains(t, s.queries, "SELECT slow")
+	})
+
+	t.Run("bounds call sites", func(t *testing.T) {
+		s := newQueryStats()
+		for i := 0; i < 2*maxCallSites; i++ {
+			s.record("SELECT 1", CallSite{Function: "f", File: "f.go", Line: i}, time.Millisecond, false)
+		}
+		stats := s.slowest(1, QueryStatsSortMax)
+		assert.Equal(t, int64(2*maxCallSites), stats[0].Calls)
+		assert.Len(t, stats[0].CallSites, maxCallSites)
+	})
+}
+
+func Test_ParseQueryStatsSort(t *testing.T) {
+	t.Parallel()
+
+	for s, exp := range map[string]QueryStatsSort{"": QueryStatsSortMax, "max": QueryStatsSortMax, "mean": QueryStatsSortMean, "total": QueryStatsSortTotal} {
+		by, err := ParseQueryStatsSort(s)
+		require.NoError(t, err)
+		assert.Equal(t, exp, by)
+	}
+	_, err := ParseQueryStatsSort("calls")
+	assert.Error(t, err)
+}
+
+func Test_isInternalFrame(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isInternalFrame("github.com/smartcontractkit/chainlink/core/services/pg.Q.Select"))
+	assert.True(t, isInternalFrame("github.com/smartcontractkit/sqlx.(*DB).SelectContext"))
+	assert.False(t, isInternalFrame("github.com/smartcontractkit/chainlink/core/services/pipeline.(*orm).GetAllRuns"))
+	assert.False(t, isInternalFrame("github.com/smartcontractkit/chainlink/core/services/pgx.Foo"))
+
+	assert.Equal(t, "services/pg/q.go", trimFile("/src/chainlink/core/services/pg/q.go"))
+	assert.Equal(t, "main.go", trimFile("/src/other/main.go"))
+}
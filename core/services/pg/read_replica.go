@@ -0,0 +1,213 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promReadReplicaLag = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_read_replica_lag_seconds",
+		Help: "How far the read replica lags behind the main database.",
+	})
+	promReadReplicaHealthy = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_read_replica_healthy",
+		Help: "Whether read-only queries are sent to the read replica (1), or to the main database (0).",
+	})
+	promReadOnlyQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sql_read_only_queries_total",
+		Help: "The number of read-only queries, by the database they were sent to: replica or primary.",
+	}, []string{"target"})
+)
+
+// replicaStatusStmt returns whether the database is a standby, whether its WAL receiver is streaming from the primary,
+// whether it has replayed all of the WAL it received, and how long ago it replayed its latest transaction. The status
+// of the WAL receiver is only visible to roles with pg_read_all_stats, like pg_monitor: for other roles, a running WAL
+// receiver is taken to be streaming.
+const replicaStatusStmt = `SELECT pg_is_in_recovery(),
+	EXISTS (SELECT 1 FROM pg_stat_wal_receiver WHERE COALESCE(status, 'streaming') = 'streaming'),
+	COALESCE(pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn(), false),
+	EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())`
+
+// replicaStatus is the replication status of a read replica.
+type replicaStatus struct {
+	standby   bool
+	streaming bool
+	caughtUp  bool            // replayed all of the WAL received
+	replayAge sql.NullFloat64 // seconds since the latest transaction replayed
+}
+
+// lag returns how far the replica lags behind the primary, or an error if it is not replicating from it. A streaming
+// standby which has replayed all of the WAL it received is up to date, even if the primary has been idle for a while.
+// Once its WAL receiver is disconnected, it is not, however long ago it replayed its latest transaction.
+func (s replicaStatus) lag() (time.Duration, error) {
+	switch {
+	case !s.standby:
+		return 0, errors.New("replica is not a standby")
+	case !s.streaming:
+		return 0, errors.New("replica is not streaming WAL from the primary")
+	case s.caughtUp:
+		return 0, nil
+	case !s.replayAge.Valid:
+		return 0, errors.New("replica has not replayed any transaction yet")
+	}
+	return time.Duration(s.replayAge.Float64 * float64(time.Second)), nil
+}
+
+// readReplicas maps the primary databases to their read replica.
+var readReplicas sync.Map // map[*sqlx.DB]*ReadReplica
+
+// ReadReplicaConfig is the configuration of a ReadReplica.
+type ReadReplicaConfig interface {
+	ConnectionConfig
+	DatabaseDefaultQueryTimeout() time.Duration
+	DatabaseReadReplicaCheckInterval() time.Duration
+	DatabaseReadReplicaMaxLag() time.Duration
+}
+
+// ReadReplica is a streaming replica of a primary database. Read-only queries on the primary, marked by WithReadOnly,
+// are sent to the replica while it is reachable and lags behind by at most MaxLag. Otherwise they fall back to the
+// primary.
+type ReadReplica struct {
+	utils.StartStopOnce
+
+	primary *sqlx.DB
+	uri     url.URL
+	cfg     ReadReplicaConfig
+	lggr    logger.Logger
+
+	db      *sqlx.DB // set once connected, only accessed by run
+	healthy atomic.Pointer[sqlx.DB]
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+// NewReadReplica returns a ReadReplica at uri for the primary database. It connects on Start, and keeps retrying in
+// the background if the replica is unreachable.
+func NewReadReplica(primary *sqlx.DB, uri url.URL, cfg ReadReplicaConfig, lggr logger.Logger) *ReadReplica {
+	return &ReadReplica{
+		primary: primary,
+		uri:     uri,
+		cfg:     cfg,
+		lggr:    lggr.Named("ReadReplica"),
+		chStop:  make(chan struct{}),
+	}
+}
+
+// Start registers the replica of the primary database, and checks its lag right away, and then every CheckInterval.
+func (r *ReadReplica) Start(context.Context) error {
+	return r.StartOnce("ReadReplica", func() error {
+		if _, loaded := readReplicas.LoadOrStore(r.primary, r); loaded {
+			return errors.New("database already has a read replica")
+		}
+		r.lggr.Infow("Routing read-only queries to the read replica", "url", r.uri.Redacted(), "maxLag", r.cfg.DatabaseReadReplicaMaxLag())
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *ReadReplica) Close() error {
+	return r.StopOnce("ReadReplica", func() error {
+		readReplicas.Delete(r.primary)
+		close(r.chStop)
+		r.wgDone.Wait()
+		r.healthy.Store(nil)
+		promReadReplicaHealthy.Set(0)
+		if r.db != nil {
+			return r.db.Close()
+		}
+		return nil
+	})
+}
+
+func (r *ReadReplica) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(r.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(r.cfg.DatabaseReadReplicaCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		r.check(ctx)
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check connects to the replica if needed, and routes read-only queries to it only if it is within MaxLag.
+func (r *ReadReplica) check(ctx context.Context) {
+	s, err := r.status(ctx)
+	r.update(s, err)
+}
+
+// update routes read-only queries to the replica if its status is healthy, or to the primary otherwise.
+func (r *ReadReplica) update(s replicaStatus, err error) {
+	var lag time.Duration
+	if err == nil {
+		lag, err = s.lag()
+	}
+	if err == nil {
+		promReadReplicaLag.Set(lag.Seconds())
+		if lag > r.cfg.DatabaseReadReplicaMaxLag() {
+			err = errors.Errorf("replica lags behind by %s, more than the maximum of %s", lag, r.cfg.DatabaseReadReplicaMaxLag())
+		}
+	}
+	wasHealthy := r.healthy.Load() != nil
+	if err != nil {
+		r.healthy.Store(nil)
+		promReadReplicaHealthy.Set(0)
+		if wasHealthy {
+			r.lggr.Warnw("Read replica is unavailable, sending read-only queries to the main database", "err", err)
+		} else {
+			r.lggr.Debugw("Read replica is still unavailable", "err", err)
+		}
+		return
+	}
+	r.healthy.Store(r.db)
+	promReadReplicaHealthy.Set(1)
+	if !wasHealthy {
+		r.lggr.Infow("Read replica is available, sending read-only queries to it", "lag", lag)
+	}
+}
+
+func (r *ReadReplica) status(ctx context.Context) (s replicaStatus, err error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.DatabaseDefaultQueryTimeout())
+	defer cancel()
+	if r.db == nil {
+		db, err := NewConnection(r.uri.String(), dialects.Postgres, r.cfg)
+		if err != nil {
+			return s, errors.Wrap(err, "failed to connect")
+		}
+		r.db = db
+	}
+	err = r.db.QueryRowxContext(ctx, replicaStatusStmt).Scan(&s.standby, &s.streaming, &s.caughtUp, &s.replayAge)
+	return s, errors.Wrap(err, "failed to check replication status")
+}
+
+// replicaOf returns the read replica of the primary database, if it has one which is available.
+func replicaOf(primary *sqlx.DB) *sqlx.DB {
+	v, ok := readReplicas.Load(primary)
+	if !ok {
+		return nil
+	}
+	return v.(*ReadReplica).healthy.Load()
+}
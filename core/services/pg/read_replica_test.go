@@ -0,0 +1,106 @@
+package pg
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestQ_replica(t *testing.T) {
+	primary, replica, other := &sqlx.DB{}, &sqlx.DB{}, &sqlx.DB{}
+	r := &ReadReplica{primary: primary}
+	readReplicas.Store(primary, r)
+	t.Cleanup(func() { readReplicas.Delete(primary) })
+
+	q := Q{Queryer: primary, db: primary, readOnly: true}
+	assert.Nil(t, q.replica(), "unhealthy replica")
+
+	r.healthy.Store(replica)
+	assert.Equal(t, replica, q.replica())
+	assert.Equal(t, Queryer(replica), q.queryer())
+
+	q.readOnly = false
+	assert.Nil(t, q.replica(), "not read-only")
+	assert.Equal(t, Queryer(primary), q.queryer())
+
+	q = Q{Queryer: other, db: primary, readOnly: true}
+	assert.Nil(t, q.replica(), "in a transaction")
+
+	q = Q{Queryer: other, db: other, readOnly: true}
+	assert.Nil(t, q.replica(), "no replica")
+}
+
+func TestReplicaStatus_lag(t *testing.T) {
+	age := func(seconds float64) sql.NullFloat64 { return sql.NullFloat64{Float64: seconds, Valid: true} }
+	for _, tt := range []struct {
+		name    string
+		status  replicaStatus
+		lag     time.Duration
+		wantErr string
+	}{
+		{"caught up", replicaStatus{standby: true, streaming: true, caughtUp: true, replayAge: age(3600)}, 0, ""},
+		{"replaying", replicaStatus{standby: true, streaming: true, replayAge: age(2.5)}, 2500 * time.Millisecond, ""},
+		{"not a standby", replicaStatus{caughtUp: true}, 0, "not a standby"},
+		{"disconnected", replicaStatus{standby: true, caughtUp: true, replayAge: age(1)}, 0, "not streaming"},
+		{"nothing replayed", replicaStatus{standby: true, streaming: true}, 0, "not replayed any transaction"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			lag, err := tt.status.lag()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.lag, lag)
+		})
+	}
+}
+
+type replicaTestConfig struct {
+	ReadReplicaConfig
+	maxLag time.Duration
+}
+
+func (c replicaTestConfig) DatabaseReadReplicaMaxLag() time.Duration { return c.maxLag }
+
+func TestReadReplica_update(t *testing.T) {
+	primary, replica := &sqlx.DB{}, &sqlx.DB{}
+	r := &ReadReplica{primary: primary, db: replica, cfg: replicaTestConfig{maxLag: 10 * time.Second}, lggr: logger.TestLogger(t)}
+	readReplicas.Store(primary, r)
+	t.Cleanup(func() { readReplicas.Delete(primary) })
+	q := Q{Queryer: primary, db: primary, readOnly: true}
+
+	streaming := replicaStatus{standby: true, streaming: true, replayAge: sql.NullFloat64{Float64: 5, Valid: true}}
+	r.update(streaming, nil)
+	assert.Equal(t, Queryer(replica), q.queryer(), "within MaxLag")
+
+	lagging := streaming
+	lagging.replayAge.Float64 = 11
+	r.update(lagging, nil)
+	assert.Equal(t, Queryer(primary), q.queryer(), "lags behind by more than MaxLag")
+
+	r.update(streaming, nil)
+	assert.Equal(t, Queryer(replica), q.queryer(), "caught up again")
+
+	disconnected := streaming
+	disconnected.streaming = false
+	r.update(disconnected, nil)
+	assert.Equal(t, Queryer(primary), q.queryer(), "WAL receiver disconnected")
+
+	r.update(streaming, nil)
+	r.update(replicaStatus{}, errors.New("connection refused"))
+	assert.Equal(t, Queryer(primary), q.queryer(), "unreachable")
+
+	promoted := streaming
+	promoted.standby = false
+	r.update(promoted, nil)
+	assert.Equal(t, Queryer(primary), q.queryer(), "not a standby")
+}
@@ -1,11 +1,17 @@
 package pg
 
 import (
+	"context"
 	"database/sql"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 const dbStatsInternal = 10 * time.Second
@@ -23,6 +29,14 @@ var (
 		Name: "db_conns_used",
 		Help: "The number of connections currently in use.",
 	})
+	promDBConnsIdle = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_conns_idle",
+		Help: "The number of idle connections.",
+	})
+	promDBConnsSaturation = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_conns_saturation",
+		Help: "The fraction of the maximum number of open connections currently in use, or 0 if unlimited.",
+	})
 	promDBWaitCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "db_wait_count",
 		Help: "The total number of connections waited for.",
@@ -31,13 +45,102 @@ var (
 		Name: "db_wait_time_seconds",
 		Help: "The total time blocked waiting for a new connection.",
 	})
+	promDBLockWaiting = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_lock_waiting_queries",
+		Help: "The number of queries on the database currently waiting for a lock.",
+	})
+	promDBLockWaitMax = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "db_lock_wait_max_seconds",
+		Help: "The longest time a query on the database has currently been waiting for a lock.",
+	})
 )
 
 func publishStats(stats sql.DBStats) {
 	promDBConnsMax.Set(float64(stats.MaxOpenConnections))
 	promDBConnsOpen.Set(float64(stats.OpenConnections))
 	promDBConnsInUse.Set(float64(stats.InUse))
+	promDBConnsIdle.Set(float64(stats.Idle))
+	if stats.MaxOpenConnections > 0 {
+		promDBConnsSaturation.Set(float64(stats.InUse) / float64(stats.MaxOpenConnections))
+	} else {
+		promDBConnsSaturation.Set(0)
+	}
 
 	promDBWaitCount.Set(float64(stats.WaitCount))
 	promDBWaitDuration.Set(stats.WaitDuration.Seconds())
 }
+
+// lockWaitsStmt counts the backends of the current database waiting for a heavyweight lock, like a row or table lock,
+// and the longest such wait, by the time the waiting statement started.
+const lockWaitsStmt = `SELECT count(*), COALESCE(EXTRACT(EPOCH FROM max(now() - query_start)), 0)
+FROM pg_stat_activity WHERE datname = current_database() AND wait_event_type = 'Lock'`
+
+// StatsReporter periodically publishes the connection pool stats of a database, and the lock waits on it.
+type StatsReporter struct {
+	utils.StartStopOnce
+
+	db   *sqlx.DB
+	cfg  QConfig
+	lggr logger.Logger
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+// NewStatsReporter returns a StatsReporter for db.
+func NewStatsReporter(db *sqlx.DB, cfg QConfig, lggr logger.Logger) *StatsReporter {
+	return &StatsReporter{
+		db:     db,
+		cfg:    cfg,
+		lggr:   lggr.Named("DBStatsReporter"),
+		chStop: make(chan struct{}),
+	}
+}
+
+func (r *StatsReporter) Start(context.Context) error {
+	return r.StartOnce("DBStatsReporter", func() error {
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *StatsReporter) Close() error {
+	return r.StopOnce("DBStatsReporter", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return nil
+	})
+}
+
+func (r *StatsReporter) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(r.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(dbStatsInternal)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+			publishStats(r.db.Stats())
+			r.publishLockWaits(ctx)
+		}
+	}
+}
+
+func (r *StatsReporter) publishLockWaits(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.DatabaseDefaultQueryTimeout())
+	defer cancel()
+	var waiting int64
+	var maxWait float64
+	if err := r.db.QueryRowxContext(ctx, lockWaitsStmt).Scan(&waiting, &maxWait); err != nil {
+		r.lggr.Debugw("Failed to load lock waits", "err", err)
+		return
+	}
+	promDBLockWaiting.Set(float64(waiting))
+	promDBLockWaitMax.Set(maxWait)
+}
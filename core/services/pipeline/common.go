@@ -60,6 +60,7 @@ type (
 	}
 
 	Config interface {
+		BridgeBearerToken(name string) string
 		BridgeResponseURL() *url.URL
 		BridgeCacheTTL() time.Duration
 		DatabaseURL() url.URL
@@ -16,6 +16,20 @@ type Config struct {
 	mock.Mock
 }
 
+// BridgeBearerToken provides a mock function with given fields: name
+func (_m *Config) BridgeBearerToken(name string) string {
+	ret := _m.Called(name)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // BridgeCacheTTL provides a mock function with given fields:
 func (_m *Config) BridgeCacheTTL() time.Duration {
 	ret := _m.Called()
@@ -99,20 +99,27 @@ func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error
 	return r0
 }
 
-// FindRun provides a mock function with given fields: id
-func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
-	ret := _m.Called(id)
+// FindRun provides a mock function with given fields: id, qopts
+func (_m *ORM) FindRun(id int64, qopts ...pg.QOpt) (pipeline.Run, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 pipeline.Run
-	if rf, ok := ret.Get(0).(func(int64) pipeline.Run); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) pipeline.Run); ok {
+		r0 = rf(id, qopts...)
 	} else {
 		r0 = ret.Get(0).(pipeline.Run)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(id, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -120,13 +127,19 @@ func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	return r0, r1
 }
 
-// GetAllRuns provides a mock function with given fields:
-func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
-	ret := _m.Called()
+// GetAllRuns provides a mock function with given fields: qopts
+func (_m *ORM) GetAllRuns(qopts ...pg.QOpt) ([]pipeline.Run, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 []pipeline.Run
-	if rf, ok := ret.Get(0).(func() []pipeline.Run); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) []pipeline.Run); ok {
+		r0 = rf(qopts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]pipeline.Run)
@@ -134,8 +147,8 @@ func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(...pg.QOpt) error); ok {
+		r1 = rf(qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
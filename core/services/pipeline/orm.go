@@ -82,8 +82,8 @@ type ORM interface {
 	InsertFinishedRuns(run []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error)
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
-	FindRun(id int64) (Run, error)
-	GetAllRuns() ([]Run, error)
+	FindRun(id int64, qopts ...pg.QOpt) (Run, error)
+	GetAllRuns(qopts ...pg.QOpt) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 	GetQ() pg.Q
 }
@@ -437,9 +437,9 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 	return nil
 }
 
-func (o *orm) FindRun(id int64) (r Run, err error) {
+func (o *orm) FindRun(id int64, qopts ...pg.QOpt) (r Run, err error) {
 	var runs []*Run
-	err = o.q.Transaction(func(tx pg.Queryer) error {
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
 		if err = tx.Select(&runs, `SELECT * from pipeline_runs WHERE id = $1 LIMIT 1`, id); err != nil {
 			return errors.Wrap(err, "failed to load runs")
 		}
@@ -451,9 +451,9 @@ func (o *orm) FindRun(id int64) (r Run, err error) {
 	return *runs[0], err
 }
 
-func (o *orm) GetAllRuns() (runs []Run, err error) {
+func (o *orm) GetAllRuns(qopts ...pg.QOpt) (runs []Run, err error) {
 	var runsPtrs []*Run
-	err = o.q.Transaction(func(tx pg.Queryer) error {
+	err = o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
 		err = tx.Select(&runsPtrs, `SELECT * from pipeline_runs ORDER BY created_at ASC, id ASC`)
 		if err != nil {
 			return errors.Wrap(err, "failed to load runs")
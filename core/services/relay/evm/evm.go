@@ -17,10 +17,12 @@ import (
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median/evmreportcodec"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
 	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/multierr"
 
 	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
 	txm "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -196,6 +198,28 @@ func newConfigProvider(lggr logger.Logger, chainSet evm.ChainSet, args relaytype
 	return newConfigWatcher(lggr, contractAddress, contractABI, offchainConfigDigester, configPoller, chain, relayConfig.FromBlock, args.New), nil
 }
 
+// UnregisterFilters removes the log poller filters registered for the contract of an OCR2 job, and the
+// plugin filters named by filterNames, so that their logs are no longer saved once the job is deleted.
+func UnregisterFilters(chainSet evm.ChainSet, args relaytypes.RelayArgs, filterNames ...string) error {
+	var relayConfig types.RelayConfig
+	if err := json.Unmarshal(args.RelayConfig, &relayConfig); err != nil {
+		return err
+	}
+	chain, err := chainSet.Get(relayConfig.ChainID.ToInt())
+	if err != nil {
+		return err
+	}
+	contractAddress := common.HexToAddress(args.ContractID)
+	names := append([]string{configPollerFilterName(contractAddress), transmitterFilterName(contractAddress)}, filterNames...)
+	for _, name := range names {
+		// Not every plugin registers a contract transmitter filter, and jobs which never started registered none.
+		if err2 := chain.LogPoller().UnregisterFilter(name); err2 != nil && !errors.Is(err2, logpoller.ErrFilterNotFound) {
+			err = multierr.Append(err, err2)
+		}
+	}
+	return err
+}
+
 func newContractTransmitter(lggr logger.Logger, rargs relaytypes.RelayArgs, transmitterID string, configWatcher *configWatcher, ethKeystore keystore.Eth) (*ContractTransmitter, error) {
 	var relayConfig types.RelayConfig
 	if err := json.Unmarshal(rargs.RelayConfig, &relayConfig); err != nil {
@@ -354,12 +378,14 @@ func (r *Relayer) NewMercuryMedianProvider(relayConfig types.RelayConfig) (contr
 		return contractTransmitter, reportCodec, errors.New("EffectiveTransmitterAddress must be specified")
 	}
 	effectiveTransmitterAddress := common.HexToAddress(relayConfig.EffectiveTransmitterAddress.String)
-	var username, password string
-	username, password, err = r.cfg.MercuryCredentials(reportURL.String())
-	if err != nil {
+	// checked now, but fetched for every report, since they may be rotated
+	credentials := func() (string, string, error) {
+		return r.cfg.MercuryCredentials(reportURL.String())
+	}
+	if _, _, err = credentials(); err != nil {
 		return contractTransmitter, reportCodec, errors.Wrapf(err, "failed to get mercury credentials for URL: %s", reportURL.String())
 	}
-	contractTransmitter = mercury.NewTransmitter(r.lggr, http.DefaultClient, effectiveTransmitterAddress, reportURL.String(), username, password)
+	contractTransmitter = mercury.NewTransmitter(r.lggr, http.DefaultClient, effectiveTransmitterAddress, reportURL.String(), credentials)
 	if relayConfig.MercuryConfig.FeedID == (common.Hash{}) {
 		return contractTransmitter, reportCodec, errors.New("FeedID must be specified")
 	}
@@ -0,0 +1,47 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/core/chains/evm/logpoller/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+func TestUnregisterFilters(t *testing.T) {
+	contract := testutils.NewAddress()
+	lp := lpmocks.NewLogPoller(t)
+	chain := evmmocks.NewChain(t)
+	chain.On("LogPoller").Return(lp)
+	chainSet := evmmocks.NewChainSet(t)
+	chainSet.On("Get", big.NewInt(1)).Return(chain, nil)
+	args := relaytypes.RelayArgs{ContractID: contract.String(), RelayConfig: []byte(`{"chainID": 1}`)}
+
+	lp.On("UnregisterFilter", configPollerFilterName(contract)).Return(nil).Once()
+	// Filters which were never registered are ignored.
+	lp.On("UnregisterFilter", transmitterFilterName(contract)).Return(errors.Wrap(logpoller.ErrFilterNotFound, "not found")).Once()
+	lp.On("UnregisterFilter", "plugin filter").Return(nil).Once()
+	require.NoError(t, UnregisterFilters(chainSet, args, "plugin filter"))
+
+	lp.On("UnregisterFilter", mock.Anything).Return(errors.New("db down")).Times(2)
+	err := UnregisterFilters(chainSet, args)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "db down")
+}
+
+func TestUnregisterFilters_unknownChain(t *testing.T) {
+	chainSet := evmmocks.NewChainSet(t)
+	chainSet.On("Get", big.NewInt(2)).Return(nil, errors.New("chain not found"))
+	args := relaytypes.RelayArgs{ContractID: common.Address{}.String(), RelayConfig: []byte(`{"chainID": 2}`)}
+	require.EqualError(t, UnregisterFilters(chainSet, args), "chain not found")
+}
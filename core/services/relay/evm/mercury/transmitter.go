@@ -24,15 +24,18 @@ type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// CredentialsFunc returns the basic auth credentials of the Mercury server.
+// It is called for every report, so that the credentials can be rotated.
+type CredentialsFunc func() (username, password string, err error)
+
 type MercuryTransmitter struct {
 	lggr       logger.Logger
 	httpClient HTTPClient
 
 	fromAccount common.Address
 
-	reportURL string
-	username  string
-	password  string
+	reportURL   string
+	credentials CredentialsFunc
 }
 
 var payloadTypes = getPayloadTypes()
@@ -54,8 +57,8 @@ func getPayloadTypes() abi.Arguments {
 	})
 }
 
-func NewTransmitter(lggr logger.Logger, httpClient HTTPClient, fromAccount common.Address, reportURL, username, password string) *MercuryTransmitter {
-	return &MercuryTransmitter{lggr.Named("Mercury"), httpClient, fromAccount, reportURL, username, password}
+func NewTransmitter(lggr logger.Logger, httpClient HTTPClient, fromAccount common.Address, reportURL string, credentials CredentialsFunc) *MercuryTransmitter {
+	return &MercuryTransmitter{lggr.Named("Mercury"), httpClient, fromAccount, reportURL, credentials}
 }
 
 type MercuryReport struct {
@@ -100,8 +103,12 @@ func (mt *MercuryTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.R
 		return errors.Wrap(err, "failed to instantiate mercury server http request")
 	}
 
+	username, password, err := mt.credentials()
+	if err != nil {
+		return errors.Wrap(err, "failed to get mercury credentials")
+	}
 	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(mt.username, mt.password)
+	req.SetBasicAuth(username, password)
 	req = req.WithContext(ctx)
 
 	res, err := mt.httpClient.Do(req)
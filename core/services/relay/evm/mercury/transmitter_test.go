@@ -43,6 +43,7 @@ func Test_MercuryTransmitter_Transmit(t *testing.T) {
 	reportURL := "http://report.test/foo"
 	username := "my username"
 	password := "my password"
+	credentials := func() (string, string, error) { return username, password, nil }
 
 	t.Run("successful HTTP POST", func(t *testing.T) {
 		httpClient := MockHTTPClient{
@@ -59,6 +60,10 @@ func Test_MercuryTransmitter_Transmit(t *testing.T) {
 				assert.Equal(t, sampleFromAccount, mr.FromAccount)
 				assert.Equal(t, "report.test", req.Host)
 				assert.Equal(t, "/foo", req.URL.Path)
+				gotUsername, gotPassword, ok := req.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, username, gotUsername)
+				assert.Equal(t, password, gotPassword)
 				resp = new(http.Response)
 				resp.Body = io.NopCloser(bytes.NewBuffer([]byte{}))
 				resp.Status = "200 OK"
@@ -66,7 +71,7 @@ func Test_MercuryTransmitter_Transmit(t *testing.T) {
 				return resp, nil
 			},
 		}
-		mt := NewTransmitter(lggr, httpClient, sampleFromAccount, reportURL, username, password)
+		mt := NewTransmitter(lggr, httpClient, sampleFromAccount, reportURL, credentials)
 		err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
 
 		require.NoError(t, err)
@@ -78,7 +83,7 @@ func Test_MercuryTransmitter_Transmit(t *testing.T) {
 				return nil, errors.New("foo error")
 			},
 		}
-		mt := NewTransmitter(lggr, httpClient, sampleFromAccount, reportURL, username, password)
+		mt := NewTransmitter(lggr, httpClient, sampleFromAccount, reportURL, credentials)
 		err := mt.Transmit(testutils.Context(t), sampleReportContext, sampleReport, sampleSigs)
 
 		require.Error(t, err)
@@ -0,0 +1,282 @@
+package sharding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Config is the configuration of the Coordinator.
+type Config interface {
+	AppID() uuid.UUID
+	JobShardingHeartbeatInterval() time.Duration
+	JobShardingJobTypes() []string
+	JobShardingLeaseDuration() time.Duration
+	JobShardingMemberName() string
+}
+
+// Coordinator splits jobs between the nodes sharing the database. Each node
+// is a member which renews its membership every heartbeat. Jobs assigned to a
+// live member run on it, jobs of the sharded types are split between the live
+// members by rendezvous hashing, and the other jobs run on the leader, which
+// is the member which joined first.
+//
+// A member which fails to renew its membership for LeaseDuration considers
+// itself gone, and stops running jobs, before the other members take them
+// over.
+type Coordinator struct {
+	utils.StartStopOnce
+
+	cfg      Config
+	orm      ORM
+	lggr     logger.Logger
+	name     string
+	clientID uuid.UUID
+	sharded  map[job.Type]bool
+
+	mu            sync.RWMutex
+	lastHeartbeat time.Time
+	members       []Member
+	assignments   map[int32]string
+
+	chRebalance chan struct{}
+	chStop      chan struct{}
+	wgDone      sync.WaitGroup
+}
+
+var _ services.ServiceCtx = (*Coordinator)(nil)
+var _ job.Sharder = (*Coordinator)(nil)
+
+// NewCoordinator returns a Coordinator for the configured member.
+func NewCoordinator(cfg Config, orm ORM, lggr logger.Logger) *Coordinator {
+	sharded := make(map[job.Type]bool)
+	for _, t := range cfg.JobShardingJobTypes() {
+		sharded[job.Type(t)] = true
+	}
+	name := cfg.JobShardingMemberName()
+	return &Coordinator{
+		cfg:         cfg,
+		orm:         orm,
+		lggr:        lggr.Named("JobSharding").With("member", name),
+		name:        name,
+		clientID:    cfg.AppID(),
+		sharded:     sharded,
+		assignments: make(map[int32]string),
+		chRebalance: make(chan struct{}, 1),
+		chStop:      make(chan struct{}),
+	}
+}
+
+// Start joins the shard. If the member name is in use, it waits for up to
+// LeaseDuration for it to expire, in case it was used by this node before a
+// crash.
+func (c *Coordinator) Start(ctx context.Context) error {
+	return c.StartOnce("JobShardingCoordinator", func() error {
+		if c.name == "" {
+			return errors.New("JobSharding.MemberName must be set")
+		}
+		deadline := time.Now().Add(c.cfg.JobShardingLeaseDuration())
+		for {
+			joined, err := c.heartbeat(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to join shard")
+			}
+			if joined {
+				break
+			}
+			if time.Now().After(deadline) {
+				return errors.Errorf("member name %q is used by another node", c.name)
+			}
+			c.lggr.Warnw("Member name is in use, waiting for it to expire", "leaseDuration", c.cfg.JobShardingLeaseDuration())
+			select {
+			case <-ctx.Done():
+				return errors.Wrap(ctx.Err(), "failed to join shard")
+			case <-time.After(c.cfg.JobShardingHeartbeatInterval()):
+			}
+		}
+		if err := c.refresh(ctx); err != nil {
+			return errors.Wrap(err, "failed to load members")
+		}
+		c.lggr.Infow("Joined shard", "leader", c.IsLeader())
+
+		c.wgDone.Add(1)
+		go c.run()
+		return nil
+	})
+}
+
+// Close leaves the shard, releasing the leases of this member so that other
+// members take over its jobs right away.
+func (c *Coordinator) Close() error {
+	return c.StopOnce("JobShardingCoordinator", func() error {
+		close(c.chStop)
+		c.wgDone.Wait()
+
+		ctx, cancel := context.WithTimeout(context.Background(), c.cfg.JobShardingHeartbeatInterval())
+		defer cancel()
+		return c.orm.Leave(c.name, c.clientID, pg.WithParentCtx(ctx))
+	})
+}
+
+func (c *Coordinator) run() {
+	defer c.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(c.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(c.cfg.JobShardingHeartbeatInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.chStop:
+			return
+		case <-ticker.C:
+			wasLeader := c.IsLeader()
+			if joined, err := c.heartbeat(ctx); err != nil {
+				c.lggr.Errorw("Failed to renew membership", "err", err)
+			} else if !joined {
+				c.lggr.Criticalw("Member name was taken over by another node, while this node's membership had expired")
+			}
+			if err := c.refresh(ctx); err != nil {
+				c.lggr.Errorw("Failed to load members", "err", err)
+			}
+			if isLeader := c.IsLeader(); isLeader != wasLeader {
+				c.lggr.Infow("Leadership changed", "leader", isLeader)
+			}
+			c.signalRebalance()
+		}
+	}
+}
+
+// heartbeat renews the membership. The time is taken before the query, so
+// that this member never considers itself live for longer than the others
+// do.
+func (c *Coordinator) heartbeat(ctx context.Context) (bool, error) {
+	start := time.Now()
+	joined, err := c.orm.Heartbeat(c.name, c.clientID, c.cfg.JobShardingLeaseDuration(), pg.WithParentCtx(ctx))
+	if err != nil || !joined {
+		return joined, err
+	}
+	c.mu.Lock()
+	c.lastHeartbeat = start
+	c.mu.Unlock()
+	return true, nil
+}
+
+func (c *Coordinator) refresh(ctx context.Context) error {
+	members, err := c.orm.LiveMembers(pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	assignments, err := c.orm.Assignments(pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	byJob := make(map[int32]string, len(assignments))
+	for _, a := range assignments {
+		byJob[a.JobID] = a.MemberName
+	}
+	c.mu.Lock()
+	c.members = members
+	c.assignments = byJob
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Coordinator) signalRebalance() {
+	select {
+	case c.chRebalance <- struct{}{}:
+	default:
+	}
+}
+
+// live must be called with mu held.
+func (c *Coordinator) live() bool {
+	return !c.lastHeartbeat.IsZero() && time.Since(c.lastHeartbeat) < c.cfg.JobShardingLeaseDuration()
+}
+
+// IsLeader returns true if this member is live and joined first. The leader
+// runs the jobs which are not sharded, and sends transactions.
+func (c *Coordinator) IsLeader() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.live() && len(c.members) > 0 && c.members[0].Name == c.name
+}
+
+// Owns implements job.Sharder. Webhook jobs are run by every member, since
+// they are triggered by requests to the member.
+func (c *Coordinator) Owns(jb job.Job) bool {
+	if jb.Type == job.Webhook {
+		return true
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.live() || len(c.members) == 0 {
+		return false
+	}
+	return owner(jb, c.members, c.assignments, c.sharded) == c.name
+}
+
+// owner returns the name of the member which should run the job. Members
+// must be ordered by the time they joined.
+func owner(jb job.Job, members []Member, assignments map[int32]string, sharded map[job.Type]bool) string {
+	if assigned, ok := assignments[jb.ID]; ok {
+		for _, m := range members {
+			if m.Name == assigned {
+				return assigned
+			}
+		}
+	}
+	if !sharded[jb.Type] {
+		return members[0].Name
+	}
+	// Rendezvous hashing moves only the jobs of the members which join or
+	// leave.
+	var best string
+	var bestScore uint64
+	for _, m := range members {
+		h := sha256.Sum256([]byte(fmt.Sprintf("%s:%d", m.Name, jb.ID)))
+		score := binary.BigEndian.Uint64(h[:8])
+		if best == "" || score > bestScore || (score == bestScore && m.Name < best) {
+			best, bestScore = m.Name, score
+		}
+	}
+	return best
+}
+
+// Exclusive implements job.Sharder.
+func (c *Coordinator) Exclusive(jb job.Job) bool {
+	return jb.Type != job.Webhook
+}
+
+// AcquireLeases implements job.Sharder.
+func (c *Coordinator) AcquireLeases(ctx context.Context, jobIDs []int32) ([]int32, error) {
+	return c.orm.AcquireLeases(c.name, jobIDs, c.cfg.JobShardingLeaseDuration(), pg.WithParentCtx(ctx))
+}
+
+// ReleaseLeases implements job.Sharder.
+func (c *Coordinator) ReleaseLeases(ctx context.Context, jobIDs []int32) error {
+	return c.orm.ReleaseLeases(c.name, jobIDs, pg.WithParentCtx(ctx))
+}
+
+// LeaseDuration implements job.Sharder.
+func (c *Coordinator) LeaseDuration() time.Duration {
+	return c.cfg.JobShardingLeaseDuration()
+}
+
+// Rebalance implements job.Sharder. It is notified after every heartbeat.
+func (c *Coordinator) Rebalance() <-chan struct{} {
+	return c.chRebalance
+}
@@ -0,0 +1,118 @@
+package sharding_test
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/sharding"
+	"github.com/smartcontractkit/chainlink/core/services/sharding/mocks"
+)
+
+type testConfig struct {
+	name          string
+	leaseDuration time.Duration
+}
+
+func (c testConfig) AppID() uuid.UUID { return uuid.NewV4() }
+func (c testConfig) JobShardingHeartbeatInterval() time.Duration {
+	return time.Hour
+}
+func (c testConfig) JobShardingJobTypes() []string           { return []string{"cron"} }
+func (c testConfig) JobShardingLeaseDuration() time.Duration { return c.leaseDuration }
+func (c testConfig) JobShardingMemberName() string           { return c.name }
+
+func startCoordinator(t *testing.T, name string, leaseDuration time.Duration, members []sharding.Member, assignments []sharding.Assignment) *sharding.Coordinator {
+	orm := mocks.NewORM(t)
+	orm.On("Heartbeat", name, mock.Anything, leaseDuration, mock.Anything).Return(true, nil).Once()
+	orm.On("LiveMembers", mock.Anything).Return(members, nil).Once()
+	orm.On("Assignments", mock.Anything).Return(assignments, nil).Once()
+	orm.On("Leave", name, mock.Anything, mock.Anything).Return(nil).Once()
+
+	c := sharding.NewCoordinator(testConfig{name: name, leaseDuration: leaseDuration}, orm, logger.TestLogger(t))
+	require.NoError(t, c.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+	return c
+}
+
+func TestCoordinator_Owns(t *testing.T) {
+	t.Parallel()
+
+	members := []sharding.Member{{Name: "node-a"}, {Name: "node-b"}, {Name: "node-c"}}
+	assignments := []sharding.Assignment{{JobID: 1, MemberName: "node-b"}, {JobID: 2, MemberName: "node-gone"}}
+	coordinators := make(map[string]*sharding.Coordinator)
+	for _, m := range members {
+		coordinators[m.Name] = startCoordinator(t, m.Name, time.Hour, members, assignments)
+	}
+
+	owners := func(jb job.Job) (names []string) {
+		for name, c := range coordinators {
+			if c.Owns(jb) {
+				names = append(names, name)
+			}
+		}
+		return
+	}
+
+	assert.True(t, coordinators["node-a"].IsLeader())
+	assert.False(t, coordinators["node-b"].IsLeader())
+
+	// assigned to a live member
+	assert.Equal(t, []string{"node-b"}, owners(job.Job{ID: 1, Type: job.DirectRequest}))
+	// not sharded, so run by the leader
+	assert.Equal(t, []string{"node-a"}, owners(job.Job{ID: 2, Type: job.DirectRequest}))
+	// sharded jobs are run by exactly one member, and spread between them
+	counts := make(map[string]int)
+	for id := int32(3); id < 303; id++ {
+		o := owners(job.Job{ID: id, Type: job.Cron})
+		require.Len(t, o, 1)
+		counts[o[0]]++
+	}
+	require.Len(t, counts, 3)
+	for name, n := range counts {
+		assert.Greater(t, n, 50, name)
+	}
+	// webhook jobs run everywhere
+	webhook := job.Job{ID: 1, Type: job.Webhook}
+	assert.Len(t, owners(webhook), 3)
+	assert.False(t, coordinators["node-a"].Exclusive(webhook))
+	assert.True(t, coordinators["node-a"].Exclusive(job.Job{ID: 1, Type: job.Cron}))
+}
+
+func TestCoordinator_Fencing(t *testing.T) {
+	t.Parallel()
+
+	members := []sharding.Member{{Name: "node-a"}}
+	c := startCoordinator(t, "node-a", 100*time.Millisecond, members, nil)
+	jb := job.Job{ID: 1, Type: job.Cron}
+	require.True(t, c.IsLeader())
+	require.True(t, c.Owns(jb))
+
+	// without heartbeats, the membership expires
+	require.Eventually(t, func() bool { return !c.Owns(jb) }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.False(t, c.IsLeader())
+	assert.True(t, c.Owns(job.Job{ID: 2, Type: job.Webhook}))
+}
+
+func TestCoordinator_Start(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requires a member name", func(t *testing.T) {
+		c := sharding.NewCoordinator(testConfig{leaseDuration: time.Second}, mocks.NewORM(t), logger.TestLogger(t))
+		require.EqualError(t, c.Start(testutils.Context(t)), "JobSharding.MemberName must be set")
+	})
+
+	t.Run("name in use", func(t *testing.T) {
+		orm := mocks.NewORM(t)
+		orm.On("Heartbeat", "node-a", mock.Anything, time.Duration(0), mock.Anything).Return(false, nil).Once()
+		c := sharding.NewCoordinator(testConfig{name: "node-a"}, orm, logger.TestLogger(t))
+		require.EqualError(t, c.Start(testutils.Context(t)), `member name "node-a" is used by another node`)
+	})
+}
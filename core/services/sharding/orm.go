@@ -0,0 +1,164 @@
+package sharding
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// Member is a node sharing jobs.
+type Member struct {
+	Name      string
+	ClientID  uuid.UUID
+	JoinedAt  time.Time
+	ExpiresAt time.Time
+	// Jobs is the number of jobs the member holds leases on.
+	Jobs int64
+}
+
+// Assignment pins a job to a member.
+type Assignment struct {
+	JobID      int32
+	MemberName string
+	CreatedAt  time.Time
+}
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+type ORM interface {
+	// Heartbeat joins the member, or renews its membership, until
+	// leaseDuration from now. It returns false if the name is used by another
+	// live member.
+	Heartbeat(name string, clientID uuid.UUID, leaseDuration time.Duration, qopts ...pg.QOpt) (bool, error)
+	// Leave removes the member and releases its leases.
+	Leave(name string, clientID uuid.UUID, qopts ...pg.QOpt) error
+	// LiveMembers returns the members whose membership has not expired, by
+	// the time they joined.
+	LiveMembers(qopts ...pg.QOpt) ([]Member, error)
+
+	// Assignments returns all the assignments of jobs to members.
+	Assignments(qopts ...pg.QOpt) ([]Assignment, error)
+	// AssignJob pins the job to the member, replacing its assignment if any.
+	AssignJob(jobID int32, memberName string, qopts ...pg.QOpt) error
+	// UnassignJob removes the assignment of the job, if any.
+	UnassignJob(jobID int32, qopts ...pg.QOpt) error
+
+	// AcquireLeases takes or renews the leases of the member on the jobs, until
+	// leaseDuration from now, and returns the IDs of the jobs it holds leases
+	// on. Unexpired leases of other members are left alone.
+	AcquireLeases(memberName string, jobIDs []int32, leaseDuration time.Duration, qopts ...pg.QOpt) ([]int32, error)
+	// ReleaseLeases releases the leases of the member on the jobs.
+	ReleaseLeases(memberName string, jobIDs []int32, qopts ...pg.QOpt) error
+}
+
+var _ ORM = (*orm)(nil)
+
+type orm struct {
+	q pg.Q
+}
+
+// NewORM returns an ORM for job sharding.
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return &orm{
+		q: pg.NewQ(db, lggr.Named("ShardingORM"), cfg),
+	}
+}
+
+func (o *orm) Heartbeat(name string, clientID uuid.UUID, leaseDuration time.Duration, qopts ...pg.QOpt) (bool, error) {
+	// A member keeps the time it joined until its membership expires, which
+	// makes the oldest member the leader.
+	stmt := `INSERT INTO job_shard_members (name, client_id, joined_at, expires_at)
+VALUES ($1, $2, NOW(), NOW() + $3 * interval '1 microsecond')
+ON CONFLICT (name) DO UPDATE SET
+	client_id = EXCLUDED.client_id,
+	joined_at = CASE
+		WHEN job_shard_members.client_id = EXCLUDED.client_id AND job_shard_members.expires_at > NOW() THEN job_shard_members.joined_at
+		ELSE NOW()
+	END,
+	expires_at = EXCLUDED.expires_at
+WHERE job_shard_members.client_id = EXCLUDED.client_id OR job_shard_members.expires_at <= NOW()
+RETURNING name`
+	var returned string
+	err := o.q.WithOpts(qopts...).Get(&returned, stmt, name, clientID, leaseDuration.Microseconds())
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, errors.Wrap(err, "Heartbeat failed")
+}
+
+func (o *orm) Leave(name string, clientID uuid.UUID, qopts ...pg.QOpt) error {
+	return o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		res, err := tx.Exec(`DELETE FROM job_shard_members WHERE name = $1 AND client_id = $2`, name, clientID)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete member")
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			// the name was taken over, along with the leases
+			return err
+		}
+		_, err = tx.Exec(`DELETE FROM job_leases WHERE member_name = $1`, name)
+		return errors.Wrap(err, "failed to release leases")
+	})
+}
+
+func (o *orm) LiveMembers(qopts ...pg.QOpt) (members []Member, err error) {
+	stmt := `SELECT m.*, (
+	SELECT COUNT(*) FROM job_leases l WHERE l.member_name = m.name AND l.expires_at > NOW()
+) AS jobs
+FROM job_shard_members m
+WHERE m.expires_at > NOW()
+ORDER BY m.joined_at, m.name`
+	err = o.q.WithOpts(qopts...).Select(&members, stmt)
+	return members, errors.Wrap(err, "LiveMembers failed")
+}
+
+func (o *orm) Assignments(qopts ...pg.QOpt) (assignments []Assignment, err error) {
+	err = o.q.WithOpts(qopts...).Select(&assignments, `SELECT * FROM job_shard_assignments ORDER BY job_id`)
+	return assignments, errors.Wrap(err, "Assignments failed")
+}
+
+func (o *orm) AssignJob(jobID int32, memberName string, qopts ...pg.QOpt) error {
+	stmt := `INSERT INTO job_shard_assignments (job_id, member_name, created_at) VALUES ($1, $2, NOW())
+ON CONFLICT (job_id) DO UPDATE SET member_name = EXCLUDED.member_name, created_at = EXCLUDED.created_at`
+	return errors.Wrap(o.q.WithOpts(qopts...).ExecQ(stmt, jobID, memberName), "AssignJob failed")
+}
+
+func (o *orm) UnassignJob(jobID int32, qopts ...pg.QOpt) error {
+	return errors.Wrap(o.q.WithOpts(qopts...).ExecQ(`DELETE FROM job_shard_assignments WHERE job_id = $1`, jobID), "UnassignJob failed")
+}
+
+func (o *orm) AcquireLeases(memberName string, jobIDs []int32, leaseDuration time.Duration, qopts ...pg.QOpt) (leased []int32, err error) {
+	if len(jobIDs) == 0 {
+		return nil, nil
+	}
+	stmt := `INSERT INTO job_leases (job_id, member_name, expires_at)
+SELECT id, $1, NOW() + $3 * interval '1 microsecond' FROM jobs WHERE id = ANY($2)
+ON CONFLICT (job_id) DO UPDATE SET member_name = EXCLUDED.member_name, expires_at = EXCLUDED.expires_at
+WHERE job_leases.member_name = EXCLUDED.member_name OR job_leases.expires_at <= NOW()
+RETURNING job_id`
+	err = o.q.WithOpts(qopts...).Select(&leased, stmt, memberName, pq.Array(int64s(jobIDs)), leaseDuration.Microseconds())
+	return leased, errors.Wrap(err, "AcquireLeases failed")
+}
+
+func (o *orm) ReleaseLeases(memberName string, jobIDs []int32, qopts ...pg.QOpt) error {
+	if len(jobIDs) == 0 {
+		return nil
+	}
+	stmt := `DELETE FROM job_leases WHERE member_name = $1 AND job_id = ANY($2)`
+	return errors.Wrap(o.q.WithOpts(qopts...).ExecQ(stmt, memberName, pq.Array(int64s(jobIDs))), "ReleaseLeases failed")
+}
+
+func int64s(ids []int32) []int64 {
+	r := make([]int64, len(ids))
+	for i, id := range ids {
+		r[i] = int64(id)
+	}
+	return r
+}
@@ -0,0 +1,131 @@
+package sharding_test
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/sharding"
+)
+
+func TestORM_Members(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := sharding.NewORM(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+
+	a, b := uuid.NewV4(), uuid.NewV4()
+	joined, err := orm.Heartbeat("node-a", a, time.Minute)
+	require.NoError(t, err)
+	require.True(t, joined)
+	joined, err = orm.Heartbeat("node-b", b, time.Minute)
+	require.NoError(t, err)
+	require.True(t, joined)
+
+	// the name of a live member cannot be taken
+	joined, err = orm.Heartbeat("node-a", b, time.Minute)
+	require.NoError(t, err)
+	require.False(t, joined)
+
+	// renewing keeps the time the member joined
+	joined, err = orm.Heartbeat("node-a", a, time.Minute)
+	require.NoError(t, err)
+	require.True(t, joined)
+	members, err := orm.LiveMembers()
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "node-a", members[0].Name)
+	assert.Equal(t, "node-b", members[1].Name)
+
+	// expired names can be taken, and rejoin last
+	joined, err = orm.Heartbeat("node-a", a, -time.Second)
+	require.NoError(t, err)
+	require.True(t, joined)
+	members, err = orm.LiveMembers()
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	joined, err = orm.Heartbeat("node-a", b, time.Minute)
+	require.NoError(t, err)
+	require.True(t, joined)
+	members, err = orm.LiveMembers()
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "node-b", members[0].Name)
+	assert.Equal(t, b, members[1].ClientID)
+
+	// leaving requires the same client
+	require.NoError(t, orm.Leave("node-a", a))
+	members, err = orm.LiveMembers()
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	require.NoError(t, orm.Leave("node-a", b))
+	members, err = orm.LiveMembers()
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+}
+
+func TestORM_Leases(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := sharding.NewORM(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+	jb1, _ := cltest.MustInsertWebhookSpec(t, db)
+	jb2, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	_, err := orm.Heartbeat("node-a", uuid.NewV4(), time.Minute)
+	require.NoError(t, err)
+
+	leased, err := orm.AcquireLeases("node-a", []int32{jb1.ID, jb2.ID, 999999}, time.Minute)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int32{jb1.ID, jb2.ID}, leased)
+
+	// unexpired leases are kept by their member
+	leased, err = orm.AcquireLeases("node-b", []int32{jb1.ID, jb2.ID}, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, leased)
+
+	members, err := orm.LiveMembers()
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, int64(2), members[0].Jobs)
+
+	require.NoError(t, orm.ReleaseLeases("node-a", []int32{jb1.ID}))
+	leased, err = orm.AcquireLeases("node-b", []int32{jb1.ID, jb2.ID}, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb1.ID}, leased)
+
+	// expired leases are taken over
+	leased, err = orm.AcquireLeases("node-a", []int32{jb2.ID}, -time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb2.ID}, leased)
+	leased, err = orm.AcquireLeases("node-b", []int32{jb2.ID}, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb2.ID}, leased)
+}
+
+func TestORM_Assignments(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := sharding.NewORM(db, logger.TestLogger(t), pgtest.NewQConfig(true))
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	require.NoError(t, orm.AssignJob(jb.ID, "node-a"))
+	require.NoError(t, orm.AssignJob(jb.ID, "node-b"))
+	assignments, err := orm.Assignments()
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Equal(t, jb.ID, assignments[0].JobID)
+	assert.Equal(t, "node-b", assignments[0].MemberName)
+
+	require.NoError(t, orm.UnassignJob(jb.ID))
+	assignments, err = orm.Assignments()
+	require.NoError(t, err)
+	assert.Empty(t, assignments)
+}
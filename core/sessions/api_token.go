@@ -0,0 +1,135 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"net"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// APIToken is a named API token of a user. Unlike the single legacy token of
+// each user, it may be limited to a subset of the user's permissions, to some
+// client IPs, and expire.
+type APIToken struct {
+	ID                int64
+	UserEmail         string
+	Name              string
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	// Permissions, if set, limit the access of the token to those of the
+	// user which they also allow
+	Permissions pq.StringArray
+	// AllowedIPs, if set, are the networks the token may be used from
+	AllowedIPs pq.StringArray `db:"allowed_ips"`
+	ExpiresAt  null.Time
+	LastUsed   null.Time
+	CreatedAt  time.Time
+}
+
+// APITokenRequest is the request to create a named API token.
+type APITokenRequest struct {
+	Name        string     `json:"name"`
+	Password    string     `json:"password"`
+	Permissions []string   `json:"permissions"`
+	AllowedIPs  []string   `json:"allowedIPs"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+}
+
+// NewAPIToken validates the request and returns a new named API token of the
+// user, along with its secret which is not stored.
+func NewAPIToken(email string, request APITokenRequest) (APIToken, *auth.Token, error) {
+	if !roleNameRegexp.MatchString(request.Name) {
+		return APIToken{}, nil, errors.New("token name must only contain lowercase letters, digits, '-' and '_'")
+	}
+	t := APIToken{UserEmail: email, Name: request.Name}
+	if len(request.Permissions) > 0 {
+		permissions, err := ValidatePermissions(request.Permissions)
+		if err != nil {
+			return APIToken{}, nil, err
+		}
+		t.Permissions = permissions
+	}
+	for _, s := range request.AllowedIPs {
+		network, err := parseNetwork(s)
+		if err != nil {
+			return APIToken{}, nil, err
+		}
+		t.AllowedIPs = append(t.AllowedIPs, network.String())
+	}
+	if request.ExpiresAt != nil {
+		if !request.ExpiresAt.After(time.Now()) {
+			return APIToken{}, nil, errors.New("token expiry must be in the future")
+		}
+		t.ExpiresAt = null.TimeFrom(*request.ExpiresAt)
+	}
+
+	token := auth.NewToken()
+	t.TokenKey = token.AccessKey
+	t.TokenSalt = utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, t.TokenSalt)
+	if err != nil {
+		return APIToken{}, nil, errors.Wrap(err, "api token")
+	}
+	t.TokenHashedSecret = hashedSecret
+	return t, token, nil
+}
+
+// parseNetwork parses an IP address or a CIDR network.
+func parseNetwork(s string) (*net.IPNet, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		bits := 8 * net.IPv4len
+		if ip.To4() == nil {
+			bits = 8 * net.IPv6len
+		} else {
+			ip = ip.To4()
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, errors.Errorf("invalid allowed IP %q: must be an IP address or CIDR network", s)
+	}
+	return network, nil
+}
+
+// Expired returns whether the token has expired.
+func (t *APIToken) Expired(now time.Time) bool {
+	return t.ExpiresAt.Valid && !now.Before(t.ExpiresAt.Time)
+}
+
+// AllowsIP returns whether the token may be used from the client IP.
+func (t *APIToken) AllowsIP(clientIP string) bool {
+	if len(t.AllowedIPs) == 0 {
+		return true
+	}
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+	for _, s := range t.AllowedIPs {
+		if network, err := parseNetwork(s); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthenticateAPIToken returns whether the token is the secret of the named
+// API token, and may be used now from the client IP.
+func AuthenticateAPIToken(token *auth.Token, t *APIToken, clientIP string) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, t.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.TokenHashedSecret)) != 1 {
+		return false, nil
+	}
+	return !t.Expired(time.Now()) && t.AllowsIP(clientIP), nil
+}
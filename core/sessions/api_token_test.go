@@ -0,0 +1,117 @@
+package sessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+func TestNewAPIToken(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Now().Add(time.Hour)
+	apiToken, token, err := sessions.NewAPIToken("ci@email.net", sessions.APITokenRequest{
+		Name:        "ci-deploy",
+		Permissions: []string{"jobs:edit", "bridges:view"},
+		AllowedIPs:  []string{"10.0.0.0/8", "192.168.1.7", "::1"},
+		ExpiresAt:   &expiresAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ci@email.net", apiToken.UserEmail)
+	assert.Equal(t, "ci-deploy", apiToken.Name)
+	assert.Equal(t, token.AccessKey, apiToken.TokenKey)
+	assert.Equal(t, []string{"jobs:edit", "bridges:view"}, []string(apiToken.Permissions))
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.7/32", "::1/128"}, []string(apiToken.AllowedIPs))
+	assert.Equal(t, null.TimeFrom(expiresAt), apiToken.ExpiresAt)
+
+	hashedSecret, err := auth.HashedSecret(token, apiToken.TokenSalt)
+	require.NoError(t, err)
+	assert.Equal(t, hashedSecret, apiToken.TokenHashedSecret)
+
+	unlimited, _, err := sessions.NewAPIToken("ci@email.net", sessions.APITokenRequest{Name: "all"})
+	require.NoError(t, err)
+	assert.Nil(t, unlimited.Permissions)
+	assert.Nil(t, unlimited.AllowedIPs)
+	assert.False(t, unlimited.ExpiresAt.Valid)
+
+	past := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name    string
+		request sessions.APITokenRequest
+		err     string
+	}{
+		{"name", sessions.APITokenRequest{Name: "CI Deploy"}, "token name must only contain lowercase letters, digits, '-' and '_'"},
+		{"permission", sessions.APITokenRequest{Name: "ci", Permissions: []string{"jobs"}}, `invalid permission "jobs": must be <resource>:<role>[:<scope>]`},
+		{"allowed IP", sessions.APITokenRequest{Name: "ci", AllowedIPs: []string{"10.0.0"}}, `invalid allowed IP "10.0.0": must be an IP address or CIDR network`},
+		{"expiry", sessions.APITokenRequest{Name: "ci", ExpiresAt: &past}, "token expiry must be in the future"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := sessions.NewAPIToken("ci@email.net", test.request)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestAPIToken_AllowsIP(t *testing.T) {
+	t.Parallel()
+
+	unrestricted := sessions.APIToken{}
+	assert.True(t, unrestricted.AllowsIP("203.0.113.9"))
+
+	restricted := sessions.APIToken{AllowedIPs: []string{"10.0.0.0/8", "2001:db8::/32"}}
+	assert.True(t, restricted.AllowsIP("10.1.2.3"))
+	assert.True(t, restricted.AllowsIP("2001:db8::1"))
+	assert.False(t, restricted.AllowsIP("11.1.2.3"))
+	assert.False(t, restricted.AllowsIP("not-an-ip"))
+}
+
+func TestAuthenticateAPIToken(t *testing.T) {
+	t.Parallel()
+
+	apiToken, token, err := sessions.NewAPIToken("ci@email.net", sessions.APITokenRequest{
+		Name:       "ci",
+		AllowedIPs: []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+
+	ok, err := sessions.AuthenticateAPIToken(token, &apiToken, "10.0.0.1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = sessions.AuthenticateAPIToken(token, &apiToken, "127.0.0.1")
+	require.NoError(t, err)
+	assert.False(t, ok, "client IP not allowed")
+
+	wrong := &auth.Token{AccessKey: token.AccessKey, Secret: "wrong"}
+	ok, err = sessions.AuthenticateAPIToken(wrong, &apiToken, "10.0.0.1")
+	require.NoError(t, err)
+	assert.False(t, ok, "wrong secret")
+
+	apiToken.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Second))
+	assert.True(t, apiToken.Expired(time.Now()))
+	ok, err = sessions.AuthenticateAPIToken(token, &apiToken, "10.0.0.1")
+	require.NoError(t, err)
+	assert.False(t, ok, "expired")
+}
+
+func TestUser_Authorized_TokenPermissions(t *testing.T) {
+	t.Parallel()
+
+	permissions, err := sessions.ParsePermissions([]string{"jobs:edit", "keys:admin"})
+	require.NoError(t, err)
+	user := sessions.User{Role: sessions.UserRoleRun, TokenPermissions: permissions}
+
+	assert.True(t, user.Authorized(sessions.ResourceJobs, sessions.UserRoleRun, ""))
+	// the token does not extend the access of the user's role
+	assert.False(t, user.Authorized(sessions.ResourceJobs, sessions.UserRoleEdit, ""))
+	assert.False(t, user.Authorized(sessions.ResourceKeys, sessions.UserRoleEdit, ""))
+	// nor does the user's role extend the access of the token
+	assert.False(t, user.Authorized(sessions.ResourceBridges, sessions.UserRoleView, ""))
+}
@@ -0,0 +1,37 @@
+package sessions
+
+import (
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// OIDCIdentity is a user authenticated by the OpenID Connect identity
+// provider, with the role mapped from their groups.
+type OIDCIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Role          UserRole
+}
+
+// LDAPIdentity is a user authenticated by the LDAP directory, with the role
+// mapped from their groups.
+type LDAPIdentity struct {
+	DN    string
+	Email string
+	Role  UserRole
+}
+
+// newExternalUser returns a new user authenticated by an external identity
+// provider. Its password is random and never disclosed, so that it can only
+// log in with the identity provider unless an admin resets it.
+func newExternalUser(email string, role UserRole) (User, error) {
+	pwd, err := utils.HashPassword(utils.NewSecret(utils.DefaultSecretSize))
+	if err != nil {
+		return User{}, err
+	}
+	return User{
+		Email:          email,
+		HashedPassword: pwd,
+		Role:           role,
+	}, nil
+}
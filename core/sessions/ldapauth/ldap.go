@@ -0,0 +1,373 @@
+// Package ldapauth authenticates users against an LDAP directory, mapping
+// their group membership to roles, and keeps the users created from the
+// directory in sync with it.
+package ldapauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	// ErrNoRole is returned when a user is not in any of the groups mapped to a role.
+	ErrNoRole = errors.New("user is not in any LDAP group with a role")
+
+	errUserNotFound    = errors.New("user not found in the LDAP directory")
+	errUserInactive    = errors.New("user is inactive in the LDAP directory")
+	errInvalidPassword = errors.New("Invalid password")
+)
+
+// Config configures the directory and how its users are given roles.
+type Config interface {
+	LDAPServerURL() *url.URL
+	LDAPReadOnlyUserDN() string
+	LDAPReadOnlyUserPassword() string
+	LDAPBaseUserDN() string
+	LDAPBaseGroupDN() string
+	LDAPEmailAttribute() string
+	LDAPGroupMemberAttribute() string
+	LDAPActiveAttribute() string
+	LDAPActiveAttributeAllowedValue() string
+	LDAPAdminGroups() []string
+	LDAPEditGroups() []string
+	LDAPRunGroups() []string
+	LDAPViewGroups() []string
+	LDAPQueryTimeout() time.Duration
+	LDAPBindCacheDuration() time.Duration
+	LDAPSyncInterval() time.Duration
+}
+
+// conn is the subset of *ldap.Conn used to query the directory.
+type conn interface {
+	Bind(username, password string) error
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close()
+}
+
+// cachedBind is a recent successful login, which is trusted again for the
+// same password until it expires.
+type cachedBind struct {
+	identity     sessions.LDAPIdentity
+	passwordHash [sha256.Size]byte
+	expiresAt    time.Time
+}
+
+// ORM is a sessions.ORM which logs users in with their directory account, if
+// they have one, or else with their local account. It is also a service,
+// which periodically deactivates the users created from the directory who may
+// no longer log in.
+type ORM struct {
+	sessions.ORM
+	utils.StartStopOnce
+
+	cfg         Config
+	roleGroups  sessions.RoleGroups
+	reaper      utils.SleeperTask
+	lggr        logger.Logger
+	auditLogger audit.AuditLogger
+	dial        func() (conn, error)
+
+	// salt is random for each node run, so that cached passwords are never
+	// stored in the clear
+	salt  []byte
+	mu    sync.Mutex
+	binds map[string]cachedBind
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+var _ sessions.ORM = (*ORM)(nil)
+
+// NewORM returns an ORM which authenticates the users of the directory, and
+// wakes the session reaper to terminate the sessions of deactivated users.
+func NewORM(orm sessions.ORM, cfg Config, reaper utils.SleeperTask, lggr logger.Logger, auditLogger audit.AuditLogger) *ORM {
+	o := &ORM{
+		ORM: orm,
+		cfg: cfg,
+		roleGroups: sessions.RoleGroups{
+			sessions.UserRoleAdmin: cfg.LDAPAdminGroups(),
+			sessions.UserRoleEdit:  cfg.LDAPEditGroups(),
+			sessions.UserRoleRun:   cfg.LDAPRunGroups(),
+			sessions.UserRoleView:  cfg.LDAPViewGroups(),
+		},
+		reaper:      reaper,
+		lggr:        lggr.Named("LDAP"),
+		auditLogger: auditLogger,
+		salt:        make([]byte, 32),
+		binds:       make(map[string]cachedBind),
+		chStop:      make(chan struct{}),
+	}
+	if _, err := rand.Read(o.salt); err != nil {
+		panic(err)
+	}
+	o.dial = o.dialServer
+	return o
+}
+
+func (o *ORM) dialServer() (conn, error) {
+	timeout := o.cfg.LDAPQueryTimeout()
+	c, err := ldap.DialURL(o.cfg.LDAPServerURL().String(), ldap.DialWithDialer(&net.Dialer{Timeout: timeout}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the LDAP directory")
+	}
+	c.SetTimeout(timeout)
+	return c, nil
+}
+
+// Start starts syncing the users created from the directory.
+func (o *ORM) Start(context.Context) error {
+	return o.StartOnce("LDAPORM", func() error {
+		o.wg.Add(1)
+		go o.run()
+		return nil
+	})
+}
+
+// Close stops syncing users.
+func (o *ORM) Close() error {
+	return o.StopOnce("LDAPORM", func() error {
+		close(o.chStop)
+		o.wg.Wait()
+		return nil
+	})
+}
+
+func (o *ORM) run() {
+	defer o.wg.Done()
+	ticker := time.NewTicker(utils.WithJitter(o.cfg.LDAPSyncInterval()))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-o.chStop:
+			return
+		case <-ticker.C:
+			if err := o.SyncUsers(); err != nil {
+				o.lggr.Errorw("Failed to sync users with the LDAP directory", "err", err)
+			}
+		}
+	}
+}
+
+// CreateSession logs the user in with their directory account, if they have
+// one, or else with their local account. Local accounts which were not linked
+// to the directory by an admin always log in locally, so that they cannot be
+// taken over by a directory entry with the same email, and keep working while
+// the directory is unavailable.
+func (o *ORM) CreateSession(sr sessions.SessionRequest) (string, error) {
+	user, err := o.ORM.FindUser(sr.Email)
+	if err == nil && !user.LDAPDN.Valid {
+		return o.ORM.CreateSession(sr)
+	} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+
+	identity, ok := o.cachedBind(sr.Email, sr.Password)
+	if !ok {
+		identity, err = o.authenticate(sr.Email, sr.Password)
+		if errors.Is(err, errUserNotFound) && !user.LDAPDN.Valid {
+			// neither a local nor a directory user
+			return o.ORM.CreateSession(sr)
+		} else if err != nil {
+			o.lggr.Warnw("LDAP login failed", "email", sr.Email, "err", err)
+			o.auditLogger.Audit(audit.AuthLoginFailedLDAP, map[string]interface{}{"email": sr.Email, "error": err.Error()})
+			return "", err
+		}
+		o.cacheBind(sr.Email, sr.Password, identity)
+	}
+	return o.ORM.CreateLDAPSession(identity)
+}
+
+// authenticate finds the user in the directory and binds as them, to check
+// their password.
+func (o *ORM) authenticate(email, password string) (identity sessions.LDAPIdentity, err error) {
+	c, err := o.dial()
+	if err != nil {
+		return identity, err
+	}
+	defer c.Close()
+
+	if err = o.bindReadOnly(c); err != nil {
+		return identity, err
+	}
+	if identity, err = o.lookup(c, email); err != nil {
+		return identity, err
+	}
+	// an empty password would be an unauthenticated bind, which succeeds
+	if password == "" {
+		return identity, errInvalidPassword
+	}
+	if err = c.Bind(identity.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return identity, errInvalidPassword
+		}
+		return identity, errors.Wrap(err, "failed to bind as user")
+	}
+	return identity, nil
+}
+
+func (o *ORM) bindReadOnly(c conn) error {
+	return errors.Wrap(c.Bind(o.cfg.LDAPReadOnlyUserDN(), o.cfg.LDAPReadOnlyUserPassword()), "failed to bind as read-only user")
+}
+
+// lookup returns the identity of the active user of the directory with the
+// email, and the role mapped from their groups.
+func (o *ORM) lookup(c conn, email string) (identity sessions.LDAPIdentity, err error) {
+	timeLimit := int(o.cfg.LDAPQueryTimeout().Seconds())
+	emailAttr := o.cfg.LDAPEmailAttribute()
+	activeAttr := o.cfg.LDAPActiveAttribute()
+	attrs := []string{emailAttr}
+	if activeAttr != "" {
+		attrs = append(attrs, activeAttr)
+	}
+
+	users, err := c.Search(ldap.NewSearchRequest(
+		o.cfg.LDAPBaseUserDN(), ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, timeLimit, false,
+		fmt.Sprintf("(%s=%s)", ldap.EscapeFilter(emailAttr), ldap.EscapeFilter(email)), attrs, nil,
+	))
+	if err != nil {
+		return identity, errors.Wrap(err, "failed to search for user")
+	}
+	switch len(users.Entries) {
+	case 0:
+		return identity, errUserNotFound
+	case 1:
+	default:
+		return identity, errors.Errorf("multiple users found in the LDAP directory with %s %s", emailAttr, email)
+	}
+	user := users.Entries[0]
+	if activeAttr != "" && !strings.EqualFold(user.GetAttributeValue(activeAttr), o.cfg.LDAPActiveAttributeAllowedValue()) {
+		return identity, errUserInactive
+	}
+
+	groups, err := c.Search(ldap.NewSearchRequest(
+		o.cfg.LDAPBaseGroupDN(), ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, timeLimit, false,
+		fmt.Sprintf("(%s=%s)", ldap.EscapeFilter(o.cfg.LDAPGroupMemberAttribute()), ldap.EscapeFilter(user.DN)), []string{"cn"}, nil,
+	))
+	if err != nil {
+		return identity, errors.Wrap(err, "failed to search for groups of user")
+	}
+	var names []string
+	for _, group := range groups.Entries {
+		names = append(names, group.GetAttributeValues("cn")...)
+	}
+	role, ok := o.roleGroups.Role(names)
+	if !ok {
+		return identity, ErrNoRole
+	}
+	return sessions.LDAPIdentity{DN: user.DN, Email: email, Role: role}, nil
+}
+
+// SyncUsers checks the active users created from the directory against it.
+// Users who are no longer in the directory, are inactive or are in none of the
+// groups with a role are deactivated, and the session reaper is woken to
+// terminate their sessions. The role of the other users is updated from their
+// groups.
+func (o *ORM) SyncUsers() error {
+	users, err := o.ORM.ListLDAPUsers()
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return nil
+	}
+
+	c, err := o.dial()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if err = o.bindReadOnly(c); err != nil {
+		return err
+	}
+
+	var deactivated int
+	defer func() {
+		if deactivated > 0 {
+			o.reaper.WakeUp()
+		}
+	}()
+	for _, user := range users {
+		identity, err := o.lookup(c, user.Email)
+		switch {
+		case errors.Is(err, errUserNotFound), errors.Is(err, errUserInactive), errors.Is(err, ErrNoRole):
+			if err2 := o.ORM.DeactivateUser(user.Email); err2 != nil {
+				return errors.Wrapf(err2, "failed to deactivate user %s", user.Email)
+			}
+			o.forgetBind(user.Email)
+			deactivated++
+			o.lggr.Infow("Deactivated user", "email", user.Email, "reason", err)
+			o.auditLogger.Audit(audit.LDAPUserDeactivated, map[string]interface{}{"email": user.Email, "reason": err.Error()})
+		case err != nil:
+			return errors.Wrapf(err, "failed to look up user %s", user.Email)
+		case identity.Role != user.Role || user.CustomRole.Valid:
+			if _, err = o.ORM.UpdateRole(user.Email, string(identity.Role)); err != nil {
+				return errors.Wrapf(err, "failed to update role of user %s", user.Email)
+			}
+			o.forgetBind(user.Email)
+			o.auditLogger.Audit(audit.LDAPUserRoleUpdated, map[string]interface{}{"email": user.Email, "role": identity.Role})
+		}
+	}
+	return nil
+}
+
+func (o *ORM) hashPassword(password string) [sha256.Size]byte {
+	return sha256.Sum256(append(append([]byte{}, o.salt...), password...))
+}
+
+func (o *ORM) cachedBind(email, password string) (sessions.LDAPIdentity, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	bind, ok := o.binds[strings.ToLower(email)]
+	if !ok || time.Now().After(bind.expiresAt) {
+		return sessions.LDAPIdentity{}, false
+	}
+	hash := o.hashPassword(password)
+	if subtle.ConstantTimeCompare(hash[:], bind.passwordHash[:]) != 1 {
+		return sessions.LDAPIdentity{}, false
+	}
+	return bind.identity, true
+}
+
+func (o *ORM) cacheBind(email, password string, identity sessions.LDAPIdentity) {
+	d := o.cfg.LDAPBindCacheDuration()
+	if d <= 0 {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := time.Now()
+	for e, bind := range o.binds {
+		if now.After(bind.expiresAt) {
+			delete(o.binds, e)
+		}
+	}
+	o.binds[strings.ToLower(email)] = cachedBind{
+		identity:     identity,
+		passwordHash: o.hashPassword(password),
+		expiresAt:    now.Add(d),
+	}
+}
+
+func (o *ORM) forgetBind(email string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.binds, strings.ToLower(email))
+}
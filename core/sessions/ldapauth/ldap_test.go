@@ -0,0 +1,289 @@
+package ldapauth
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/sessions/mocks"
+)
+
+const (
+	readOnlyDN       = "cn=chainlink,ou=services,dc=example,dc=com"
+	readOnlyPassword = "read-only-password"
+	usersDN          = "ou=users,dc=example,dc=com"
+	groupsDN         = "ou=groups,dc=example,dc=com"
+	aliceDN          = "uid=alice,ou=users,dc=example,dc=com"
+	alicePassword    = "alice-password"
+)
+
+type testConfig struct {
+	bindCacheDuration time.Duration
+}
+
+func (testConfig) LDAPServerURL() *url.URL                 { return &url.URL{Scheme: "ldap", Host: "localhost:389"} }
+func (testConfig) LDAPReadOnlyUserDN() string              { return readOnlyDN }
+func (testConfig) LDAPReadOnlyUserPassword() string        { return readOnlyPassword }
+func (testConfig) LDAPBaseUserDN() string                  { return usersDN }
+func (testConfig) LDAPBaseGroupDN() string                 { return groupsDN }
+func (testConfig) LDAPEmailAttribute() string              { return "mail" }
+func (testConfig) LDAPGroupMemberAttribute() string        { return "member" }
+func (testConfig) LDAPActiveAttribute() string             { return "organizationalStatus" }
+func (testConfig) LDAPActiveAttributeAllowedValue() string { return "active" }
+func (testConfig) LDAPAdminGroups() []string               { return []string{"cl-admins"} }
+func (testConfig) LDAPEditGroups() []string                { return nil }
+func (testConfig) LDAPRunGroups() []string                 { return []string{"cl-runners"} }
+func (testConfig) LDAPViewGroups() []string                { return []string{"cl-viewers"} }
+func (testConfig) LDAPQueryTimeout() time.Duration         { return time.Second }
+func (c testConfig) LDAPBindCacheDuration() time.Duration  { return c.bindCacheDuration }
+func (testConfig) LDAPSyncInterval() time.Duration         { return time.Hour }
+
+type directoryUser struct {
+	email    string
+	password string
+	status   string
+}
+
+// directory is a fake LDAP directory, which only answers the queries of the ORM.
+type directory struct {
+	mu     sync.Mutex
+	users  map[string]directoryUser // by DN
+	groups map[string][]string      // members by cn
+	binds  int
+}
+
+func newDirectory() *directory {
+	return &directory{
+		users: map[string]directoryUser{
+			aliceDN: {email: "alice@example.com", password: alicePassword, status: "active"},
+		},
+		groups: map[string][]string{
+			"cl-runners": {aliceDN},
+			"cl-viewers": {aliceDN},
+			"marketing":  {aliceDN},
+		},
+	}
+}
+
+func (d *directory) Bind(username, password string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.binds++
+	if username == readOnlyDN && password == readOnlyPassword {
+		return nil
+	}
+	if u, ok := d.users[username]; ok && u.password == password {
+		return nil
+	}
+	return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+}
+
+func (d *directory) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	res := &ldap.SearchResult{}
+	switch req.BaseDN {
+	case usersDN:
+		for dn, u := range d.users {
+			if req.Filter == fmt.Sprintf("(mail=%s)", ldap.EscapeFilter(u.email)) {
+				res.Entries = append(res.Entries, ldap.NewEntry(dn, map[string][]string{
+					"mail":                 {u.email},
+					"organizationalStatus": {u.status},
+				}))
+			}
+		}
+	case groupsDN:
+		for cn, members := range d.groups {
+			for _, member := range members {
+				if req.Filter == fmt.Sprintf("(member=%s)", ldap.EscapeFilter(member)) {
+					res.Entries = append(res.Entries, ldap.NewEntry("cn="+cn+","+groupsDN, map[string][]string{"cn": {cn}}))
+				}
+			}
+		}
+	default:
+		return nil, ldap.NewError(ldap.LDAPResultNoSuchObject, errors.New("no such object"))
+	}
+	return res, nil
+}
+
+func (d *directory) Close() {}
+
+type reaper struct{ wakeUps int }
+
+func (r *reaper) Stop() error      { return nil }
+func (r *reaper) WakeUp()          { r.wakeUps++ }
+func (r *reaper) WakeUpIfStarted() { r.wakeUps++ }
+
+func setupORM(t *testing.T, cfg testConfig) (*ORM, *mocks.ORM, *directory, *reaper) {
+	sessionORM := mocks.NewORM(t)
+	dir := newDirectory()
+	r := &reaper{}
+	o := NewORM(sessionORM, cfg, r, logger.TestLogger(t), audit.NoopLogger)
+	o.dial = func() (conn, error) { return dir, nil }
+	return o, sessionORM, dir, r
+}
+
+func TestORM_CreateSession(t *testing.T) {
+	t.Parallel()
+
+	alice := sessions.LDAPIdentity{DN: aliceDN, Email: "alice@example.com", Role: sessions.UserRoleRun}
+	setupORM := func(t *testing.T, cfg testConfig) (*ORM, *mocks.ORM, *directory, *reaper) {
+		o, sessionORM, dir, r := setupORM(t, cfg)
+		sessionORM.On("FindUser", "alice@example.com").Return(sessions.User{}, sql.ErrNoRows).Maybe()
+		return o, sessionORM, dir, r
+	}
+
+	t.Run("directory user", func(t *testing.T) {
+		o, sessionORM, _, _ := setupORM(t, testConfig{})
+		sessionORM.On("CreateLDAPSession", alice).Return("session-id", nil).Once()
+
+		sid, err := o.CreateSession(sessions.SessionRequest{Email: "alice@example.com", Password: alicePassword})
+		require.NoError(t, err)
+		assert.Equal(t, "session-id", sid)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		o, _, _, _ := setupORM(t, testConfig{})
+
+		_, err := o.CreateSession(sessions.SessionRequest{Email: "alice@example.com", Password: "wrong"})
+		assert.EqualError(t, err, "Invalid password")
+		_, err = o.CreateSession(sessions.SessionRequest{Email: "alice@example.com", Password: ""})
+		assert.EqualError(t, err, "Invalid password")
+	})
+
+	t.Run("inactive", func(t *testing.T) {
+		o, _, dir, _ := setupORM(t, testConfig{})
+		dir.users[aliceDN] = directoryUser{email: "alice@example.com", password: alicePassword, status: "disabled"}
+
+		_, err := o.CreateSession(sessions.SessionRequest{Email: "alice@example.com", Password: alicePassword})
+		assert.ErrorIs(t, err, errUserInactive)
+	})
+
+	t.Run("no role", func(t *testing.T) {
+		o, _, dir, _ := setupORM(t, testConfig{})
+		dir.groups = map[string][]string{"marketing": {aliceDN}}
+
+		_, err := o.CreateSession(sessions.SessionRequest{Email: "alice@example.com", Password: alicePassword})
+		assert.ErrorIs(t, err, ErrNoRole)
+	})
+
+	t.Run("local user", func(t *testing.T) {
+		o, sessionORM, _, _ := setupORM(t, testConfig{})
+		sr := sessions.SessionRequest{Email: "admin@example.com", Password: "local-password"}
+		sessionORM.On("FindUser", sr.Email).Return(sessions.User{Email: sr.Email}, nil).Once()
+		sessionORM.On("CreateSession", sr).Return("local-session-id", nil).Once()
+
+		sid, err := o.CreateSession(sr)
+		require.NoError(t, err)
+		assert.Equal(t, "local-session-id", sid)
+	})
+
+	t.Run("local user with the email of a directory user", func(t *testing.T) {
+		o, sessionORM, dir, _ := setupORM(t, testConfig{})
+		sr := sessions.SessionRequest{Email: "admin@example.com", Password: "local-password"}
+		dir.users["uid=mallory,ou=users,dc=example,dc=com"] = directoryUser{email: sr.Email, password: "mallory-password", status: "active"}
+		dir.groups["cl-admins"] = []string{"uid=mallory,ou=users,dc=example,dc=com"}
+		sessionORM.On("FindUser", sr.Email).Return(sessions.User{Email: sr.Email}, nil).Twice()
+		sessionORM.On("CreateSession", sr).Return("local-session-id", nil).Once()
+		sessionORM.On("CreateSession", mock.Anything).Return("", errors.New("Invalid password")).Once()
+
+		sid, err := o.CreateSession(sr)
+		require.NoError(t, err)
+		assert.Equal(t, "local-session-id", sid)
+		// the directory password does not log in, nor link, the local user
+		_, err = o.CreateSession(sessions.SessionRequest{Email: sr.Email, Password: "mallory-password"})
+		assert.EqualError(t, err, "Invalid password")
+		assert.Zero(t, dir.binds)
+	})
+
+	t.Run("local user while the directory is unavailable", func(t *testing.T) {
+		o, sessionORM, _, _ := setupORM(t, testConfig{})
+		o.dial = func() (conn, error) { return nil, errors.New("connection refused") }
+		sr := sessions.SessionRequest{Email: "admin@example.com", Password: "local-password"}
+		sessionORM.On("FindUser", sr.Email).Return(sessions.User{Email: sr.Email}, nil).Once()
+		sessionORM.On("CreateSession", sr).Return("local-session-id", nil).Once()
+
+		sid, err := o.CreateSession(sr)
+		require.NoError(t, err)
+		assert.Equal(t, "local-session-id", sid)
+
+		_, err = o.CreateSession(sessions.SessionRequest{Email: "alice@example.com", Password: alicePassword})
+		assert.EqualError(t, err, "connection refused")
+	})
+
+	t.Run("user removed from directory", func(t *testing.T) {
+		o, sessionORM, _, _ := setupORM(t, testConfig{})
+		sr := sessions.SessionRequest{Email: "bob@example.com", Password: "bob-password"}
+		sessionORM.On("FindUser", sr.Email).Return(sessions.User{Email: sr.Email, LDAPDN: null.StringFrom("uid=bob,ou=users,dc=example,dc=com")}, nil).Once()
+
+		_, err := o.CreateSession(sr)
+		assert.ErrorIs(t, err, errUserNotFound)
+	})
+}
+
+func TestORM_CreateSession_BindCache(t *testing.T) {
+	t.Parallel()
+
+	o, sessionORM, dir, _ := setupORM(t, testConfig{bindCacheDuration: time.Minute})
+	sessionORM.On("FindUser", mock.Anything).Return(sessions.User{}, sql.ErrNoRows)
+	sessionORM.On("CreateLDAPSession", mock.Anything).Return("session-id", nil)
+
+	_, err := o.CreateSession(sessions.SessionRequest{Email: "alice@example.com", Password: alicePassword})
+	require.NoError(t, err)
+	binds := dir.binds
+
+	_, err = o.CreateSession(sessions.SessionRequest{Email: "Alice@example.com", Password: alicePassword})
+	require.NoError(t, err)
+	assert.Equal(t, binds, dir.binds, "login should be cached")
+
+	_, err = o.CreateSession(sessions.SessionRequest{Email: "alice@example.com", Password: "wrong"})
+	require.Error(t, err)
+	assert.Greater(t, dir.binds, binds, "a different password must not be cached")
+}
+
+func TestORM_SyncUsers(t *testing.T) {
+	t.Parallel()
+
+	o, sessionORM, dir, r := setupORM(t, testConfig{})
+	dir.users["uid=carol,ou=users,dc=example,dc=com"] = directoryUser{email: "carol@example.com", status: "disabled"}
+	dir.users["uid=dave,ou=users,dc=example,dc=com"] = directoryUser{email: "dave@example.com", status: "active"}
+	dir.groups["cl-admins"] = []string{"uid=dave,ou=users,dc=example,dc=com"}
+
+	sessionORM.On("ListLDAPUsers").Return([]sessions.User{
+		{Email: "alice@example.com", Role: sessions.UserRoleRun, LDAPDN: null.StringFrom(aliceDN)},
+		{Email: "bob@example.com", Role: sessions.UserRoleView, LDAPDN: null.StringFrom("uid=bob,ou=users,dc=example,dc=com")},
+		{Email: "carol@example.com", Role: sessions.UserRoleView, LDAPDN: null.StringFrom("uid=carol,ou=users,dc=example,dc=com")},
+		{Email: "dave@example.com", Role: sessions.UserRoleView, LDAPDN: null.StringFrom("uid=dave,ou=users,dc=example,dc=com")},
+	}, nil).Once()
+	sessionORM.On("DeactivateUser", "bob@example.com").Return(nil).Once()
+	sessionORM.On("DeactivateUser", "carol@example.com").Return(nil).Once()
+	sessionORM.On("UpdateRole", "dave@example.com", "admin").Return(sessions.User{}, nil).Once()
+
+	require.NoError(t, o.SyncUsers())
+	assert.Equal(t, 1, r.wakeUps)
+}
+
+func TestORM_SyncUsers_DirectoryUnavailable(t *testing.T) {
+	t.Parallel()
+
+	o, sessionORM, _, r := setupORM(t, testConfig{})
+	o.dial = func() (conn, error) { return nil, errors.New("connection refused") }
+	sessionORM.On("ListLDAPUsers").Return([]sessions.User{{Email: "alice@example.com", LDAPDN: null.StringFrom(aliceDN)}}, nil).Once()
+
+	// no one is deactivated when the directory cannot be reached
+	require.EqualError(t, o.SyncUsers(), "connection refused")
+	assert.Zero(t, r.wakeUps)
+}
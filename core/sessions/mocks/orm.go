@@ -4,6 +4,7 @@ package mocks
 
 import (
 	auth "github.com/smartcontractkit/chainlink/core/auth"
+
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 
 	mock "github.com/stretchr/testify/mock"
@@ -51,6 +52,20 @@ func (_m *ORM) ClearNonCurrentSessions(sessionID string) error {
 	return r0
 }
 
+// CreateAPIToken provides a mock function with given fields: token
+func (_m *ORM) CreateAPIToken(token *sessions.APIToken) error {
+	ret := _m.Called(token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*sessions.APIToken) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateAndSetAuthToken provides a mock function with given fields: user
 func (_m *ORM) CreateAndSetAuthToken(user *sessions.User) (*auth.Token, error) {
 	ret := _m.Called(user)
@@ -74,6 +89,62 @@ func (_m *ORM) CreateAndSetAuthToken(user *sessions.User) (*auth.Token, error) {
 	return r0, r1
 }
 
+// CreateLDAPSession provides a mock function with given fields: identity
+func (_m *ORM) CreateLDAPSession(identity sessions.LDAPIdentity) (string, error) {
+	ret := _m.Called(identity)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(sessions.LDAPIdentity) string); ok {
+		r0 = rf(identity)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(sessions.LDAPIdentity) error); ok {
+		r1 = rf(identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOIDCSession provides a mock function with given fields: identity
+func (_m *ORM) CreateOIDCSession(identity sessions.OIDCIdentity) (string, error) {
+	ret := _m.Called(identity)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(sessions.OIDCIdentity) string); ok {
+		r0 = rf(identity)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(sessions.OIDCIdentity) error); ok {
+		r1 = rf(identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRole provides a mock function with given fields: role
+func (_m *ORM) CreateRole(role *sessions.Role) error {
+	ret := _m.Called(role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*sessions.Role) error); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateSession provides a mock function with given fields: sr
 func (_m *ORM) CreateSession(sr sessions.SessionRequest) (string, error) {
 	ret := _m.Called(sr)
@@ -109,6 +180,20 @@ func (_m *ORM) CreateUser(user *sessions.User) error {
 	return r0
 }
 
+// DeactivateUser provides a mock function with given fields: email
+func (_m *ORM) DeactivateUser(email string) error {
+	ret := _m.Called(email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteAuthToken provides a mock function with given fields: user
 func (_m *ORM) DeleteAuthToken(user *sessions.User) error {
 	ret := _m.Called(user)
@@ -123,6 +208,20 @@ func (_m *ORM) DeleteAuthToken(user *sessions.User) error {
 	return r0
 }
 
+// DeleteRole provides a mock function with given fields: name
+func (_m *ORM) DeleteRole(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteUser provides a mock function with given fields: email
 func (_m *ORM) DeleteUser(email string) error {
 	ret := _m.Called(email)
@@ -174,6 +273,27 @@ func (_m *ORM) FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiato
 	return r0, r1
 }
 
+// FindRole provides a mock function with given fields: name
+func (_m *ORM) FindRole(name string) (sessions.Role, error) {
+	ret := _m.Called(name)
+
+	var r0 sessions.Role
+	if rf, ok := ret.Get(0).(func(string) sessions.Role); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(sessions.Role)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindUser provides a mock function with given fields: email
 func (_m *ORM) FindUser(email string) (sessions.User, error) {
 	ret := _m.Called(email)
@@ -216,6 +336,34 @@ func (_m *ORM) FindUserByAPIToken(apiToken string) (sessions.User, error) {
 	return r0, r1
 }
 
+// FindUserByNamedAPIToken provides a mock function with given fields: accessKey
+func (_m *ORM) FindUserByNamedAPIToken(accessKey string) (sessions.User, sessions.APIToken, error) {
+	ret := _m.Called(accessKey)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string) sessions.User); ok {
+		r0 = rf(accessKey)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 sessions.APIToken
+	if rf, ok := ret.Get(1).(func(string) sessions.APIToken); ok {
+		r1 = rf(accessKey)
+	} else {
+		r1 = ret.Get(1).(sessions.APIToken)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(accessKey)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetUserWebAuthn provides a mock function with given fields: email
 func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
 	ret := _m.Called(email)
@@ -239,6 +387,96 @@ func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
 	return r0, r1
 }
 
+// LinkLDAPUser provides a mock function with given fields: email, dn
+func (_m *ORM) LinkLDAPUser(email string, dn string) (sessions.User, error) {
+	ret := _m.Called(email, dn)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string, string) sessions.User); ok {
+		r0 = rf(email, dn)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(email, dn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAPITokens provides a mock function with given fields: email
+func (_m *ORM) ListAPITokens(email string) ([]sessions.APIToken, error) {
+	ret := _m.Called(email)
+
+	var r0 []sessions.APIToken
+	if rf, ok := ret.Get(0).(func(string) []sessions.APIToken); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.APIToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLDAPUsers provides a mock function with given fields:
+func (_m *ORM) ListLDAPUsers() ([]sessions.User, error) {
+	ret := _m.Called()
+
+	var r0 []sessions.User
+	if rf, ok := ret.Get(0).(func() []sessions.User); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with given fields:
+func (_m *ORM) ListRoles() ([]sessions.Role, error) {
+	ret := _m.Called()
+
+	var r0 []sessions.Role
+	if rf, ok := ret.Get(0).(func() []sessions.Role); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListUsers provides a mock function with given fields:
 func (_m *ORM) ListUsers() ([]sessions.User, error) {
 	ret := _m.Called()
@@ -262,6 +500,34 @@ func (_m *ORM) ListUsers() ([]sessions.User, error) {
 	return r0, r1
 }
 
+// MarkAPITokenUsed provides a mock function with given fields: id
+func (_m *ORM) MarkAPITokenUsed(id int64) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeAPIToken provides a mock function with given fields: email, name
+func (_m *ORM) RevokeAPIToken(email string, name string) error {
+	ret := _m.Called(email, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(email, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveWebAuthn provides a mock function with given fields: token
 func (_m *ORM) SaveWebAuthn(token *sessions.WebAuthn) error {
 	ret := _m.Called(token)
@@ -348,6 +614,27 @@ func (_m *ORM) UpdateRole(email string, newRole string) (sessions.User, error) {
 	return r0, r1
 }
 
+// UpdateRolePermissions provides a mock function with given fields: name, permissions
+func (_m *ORM) UpdateRolePermissions(name string, permissions []string) (sessions.Role, error) {
+	ret := _m.Called(name, permissions)
+
+	var r0 sessions.Role
+	if rf, ok := ret.Get(0).(func(string, []string) sessions.Role); ok {
+		r0 = rf(name, permissions)
+	} else {
+		r0 = ret.Get(0).(sessions.Role)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(name, permissions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewORM interface {
 	mock.TestingT
 	Cleanup(func())
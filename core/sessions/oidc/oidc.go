@@ -0,0 +1,408 @@
+// Package oidc implements logging in with an OpenID Connect identity provider,
+// using the authorization code flow with PKCE.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// ErrNoRole is returned when a user is not in any of the groups mapped to a role.
+var ErrNoRole = errors.New("user is not in any group with a role")
+
+// keysRefreshInterval limits how often the keys of the provider are fetched
+// again for an ID token signed with an unknown key.
+const keysRefreshInterval = time.Minute
+
+// Config configures the identity provider and how its users are given roles.
+type Config struct {
+	IssuerURL    *url.URL
+	ClientID     string
+	ClientSecret string
+	RedirectURL  *url.URL
+	Scopes       []string
+	GroupsClaim  string
+	// RoleGroups are the groups whose members are given each role
+	RoleGroups sessions.RoleGroups
+}
+
+// Role returns the highest role of any of the groups.
+func (c Config) Role(groups []string) (sessions.UserRole, error) {
+	if role, ok := c.RoleGroups.Role(groups); ok {
+		return role, nil
+	}
+	return "", ErrNoRole
+}
+
+// discovery is the provider metadata served at /.well-known/openid-configuration.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect identity provider. Its metadata and keys are
+// fetched when first needed, so that the node starts while it is unavailable.
+type Provider struct {
+	cfg    Config
+	client *http.Client
+	lggr   logger.Logger
+
+	mu            sync.Mutex
+	discovery     *discovery
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+// NewProvider returns a new Provider.
+func NewProvider(cfg Config, lggr logger.Logger) *Provider {
+	return &Provider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 30 * time.Second},
+		lggr:   lggr.Named("OIDC"),
+	}
+}
+
+// NewVerifier returns a random PKCE code verifier, which is also suitable for
+// the state and nonce of a login.
+func NewVerifier() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// challenge returns the S256 PKCE code challenge of the verifier.
+func challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the URL of the provider to redirect users to for logging in.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid authorization endpoint")
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientID)
+	q.Set("redirect_uri", p.cfg.RedirectURL.String())
+	q.Set("scope", strings.Join(p.scopes(), " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", challenge(verifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func (p *Provider) scopes() []string {
+	scopes := []string{"openid"}
+	for _, s := range p.cfg.Scopes {
+		if s != "openid" {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// Exchange exchanges the authorization code for an ID token, and returns the
+// identity of the user it was issued for.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (sessions.OIDCIdentity, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return sessions.OIDCIdentity{}, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL.String()},
+		"client_id":     {p.cfg.ClientID},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return sessions.OIDCIdentity{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	var resp struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.doJSON(req, &resp)
+	if err != nil {
+		return sessions.OIDCIdentity{}, errors.Wrap(err, "failed to exchange authorization code")
+	}
+	if resp.Error != "" {
+		return sessions.OIDCIdentity{}, errors.Errorf("failed to exchange authorization code: %s: %s", resp.Error, resp.ErrorDescription)
+	}
+	if status != http.StatusOK {
+		return sessions.OIDCIdentity{}, errors.Errorf("failed to exchange authorization code: unexpected status %d", status)
+	}
+	if resp.IDToken == "" {
+		return sessions.OIDCIdentity{}, errors.New("token response has no ID token")
+	}
+	return p.verify(ctx, resp.IDToken, nonce)
+}
+
+// verify verifies the ID token was issued by the provider for this client and
+// login, and returns the identity of its user.
+func (p *Provider) verify(ctx context.Context, rawIDToken, nonce string) (identity sessions.OIDCIdentity, err error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}))
+	if _, err = parser.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, kid)
+	}); err != nil {
+		return identity, errors.Wrap(err, "invalid ID token")
+	}
+
+	if !claims.VerifyIssuer(p.issuer(), true) {
+		return identity, errors.Errorf("invalid ID token: issuer is not %s", p.issuer())
+	}
+	if !claims.VerifyAudience(p.cfg.ClientID, true) {
+		return identity, errors.Errorf("invalid ID token: audience does not include %s", p.cfg.ClientID)
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return identity, errors.New("invalid ID token: expired")
+	}
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return identity, errors.New("invalid ID token: nonce does not match")
+	}
+
+	identity.Subject, _ = claims["sub"].(string)
+	if identity.Subject == "" {
+		return identity, errors.New("invalid ID token: no subject")
+	}
+	identity.Email, _ = claims["email"].(string)
+	if identity.Email == "" {
+		return identity, errors.New("invalid ID token: no email, is the email scope requested?")
+	}
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		identity.EmailVerified = v
+	case string:
+		identity.EmailVerified = v == "true"
+	}
+	identity.Role, err = p.cfg.Role(stringsClaim(claims[p.cfg.GroupsClaim]))
+	return identity, err
+}
+
+// stringsClaim returns the values of a claim which is either a string or a
+// list of strings.
+func stringsClaim(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var ss []string
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				ss = append(ss, s)
+			}
+		}
+		return ss
+	}
+	return nil
+}
+
+func (p *Provider) issuer() string {
+	return strings.TrimSuffix(p.cfg.IssuerURL.String(), "/")
+}
+
+func (p *Provider) getDiscovery(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.issuer()+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var d discovery
+	status, err := p.doJSON(req, &d)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to discover OIDC provider")
+	}
+	if status != http.StatusOK {
+		return nil, errors.Errorf("failed to discover OIDC provider: unexpected status %d", status)
+	}
+	if strings.TrimSuffix(d.Issuer, "/") != p.issuer() {
+		return nil, errors.Errorf("OIDC provider issuer %s does not match %s", d.Issuer, p.issuer())
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("OIDC provider configuration is missing endpoints")
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// getKey returns the provider's key with the ID, fetching the provider's keys
+// again if it is unknown.
+func (p *Provider) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.findKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.keysFetchedAt) < keysRefreshInterval {
+		return nil, errors.Errorf("unknown signing key %q", kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+	status, err := p.doJSON(req, &jwks)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch OIDC provider keys")
+	}
+	if status != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch OIDC provider keys: unexpected status %d", status)
+	}
+	p.keys = make(map[string]crypto.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			p.lggr.Warnw("Ignoring OIDC provider key", "kid", k.Kid, "err", err)
+			continue
+		}
+		p.keys[k.Kid] = key
+	}
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := p.findKey(kid); ok {
+		return key, nil
+	}
+	return nil, errors.Errorf("unknown signing key %q", kid)
+}
+
+// findKey returns the key with the ID, or the only key for tokens without one.
+func (p *Provider) findKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *Provider) doJSON(req *http.Request, v interface{}) (int, error) {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return 0, err
+	}
+	if err = json.Unmarshal(body, v); err != nil && resp.StatusCode == http.StatusOK {
+		return 0, errors.Wrap(err, "invalid response")
+	}
+	return resp.StatusCode, nil
+}
+
+// jwk is a JSON Web Key of the provider.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, errors.Errorf("unsupported key type %s", k.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,147 @@
+package oidc_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/oidctest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/sessions/oidc"
+)
+
+func newConfig(idp *oidctest.IdP) oidc.Config {
+	redirectURL, _ := url.Parse("https://node.example.com/oidc/callback")
+	return oidc.Config{
+		IssuerURL:   idp.IssuerURL(),
+		ClientID:    "chainlink",
+		RedirectURL: redirectURL,
+		Scopes:      []string{"openid", "email"},
+		GroupsClaim: "groups",
+		RoleGroups: sessions.RoleGroups{
+			sessions.UserRoleAdmin: {"cl-admins"},
+			sessions.UserRoleRun:   {"cl-runners"},
+			sessions.UserRoleView:  {"cl-viewers"},
+		},
+	}
+}
+
+// login follows the redirect of the IdP and returns the query of the callback.
+func login(t *testing.T, p *oidc.Provider, state, nonce, verifier string) url.Values {
+	authURL, err := p.AuthCodeURL(testutils.Context(t), state, nonce, verifier)
+	require.NoError(t, err)
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	callback, err := resp.Location()
+	require.NoError(t, err)
+	assert.Equal(t, "/oidc/callback", callback.Path)
+	return callback.Query()
+}
+
+func TestConfig_Role(t *testing.T) {
+	t.Parallel()
+
+	cfg := oidc.Config{RoleGroups: sessions.RoleGroups{
+		sessions.UserRoleAdmin: {"admins"},
+		sessions.UserRoleEdit:  {"editors"},
+		sessions.UserRoleView:  {"everyone"},
+	}}
+
+	role, err := cfg.Role([]string{"everyone", "editors"})
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, role)
+	role, err = cfg.Role([]string{"admins", "everyone"})
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, role)
+	_, err = cfg.Role([]string{"others"})
+	assert.ErrorIs(t, err, oidc.ErrNoRole)
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	t.Parallel()
+
+	idp := oidctest.NewIdP(t, "chainlink")
+	p := oidc.NewProvider(newConfig(idp), logger.TestLogger(t))
+	ctx := testutils.Context(t)
+
+	t.Run("success", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "alice-id", Email: "alice@example.com", EmailVerified: true, Groups: []string{"cl-viewers", "cl-runners"}})
+		verifier := oidc.NewVerifier()
+		query := login(t, p, "the-state", "the-nonce", verifier)
+		assert.Equal(t, "the-state", query.Get("state"))
+
+		identity, err := p.Exchange(ctx, query.Get("code"), verifier, "the-nonce")
+		require.NoError(t, err)
+		assert.Equal(t, sessions.OIDCIdentity{
+			Subject:       "alice-id",
+			Email:         "alice@example.com",
+			EmailVerified: true,
+			Role:          sessions.UserRoleRun,
+		}, identity)
+	})
+
+	t.Run("wrong verifier", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "alice-id", Email: "alice@example.com", Groups: []string{"cl-admins"}})
+		query := login(t, p, "state", "nonce", oidc.NewVerifier())
+
+		_, err := p.Exchange(ctx, query.Get("code"), oidc.NewVerifier(), "nonce")
+		assert.ErrorContains(t, err, "PKCE verification failed")
+	})
+
+	t.Run("wrong nonce", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "alice-id", Email: "alice@example.com", Groups: []string{"cl-admins"}})
+		verifier := oidc.NewVerifier()
+		query := login(t, p, "state", "nonce", verifier)
+
+		_, err := p.Exchange(ctx, query.Get("code"), verifier, "other-nonce")
+		assert.EqualError(t, err, "invalid ID token: nonce does not match")
+	})
+
+	t.Run("no role", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "bob-id", Email: "bob@example.com", Groups: []string{"marketing"}})
+		verifier := oidc.NewVerifier()
+		query := login(t, p, "state", "nonce", verifier)
+
+		_, err := p.Exchange(ctx, query.Get("code"), verifier, "nonce")
+		assert.ErrorIs(t, err, oidc.ErrNoRole)
+	})
+
+	t.Run("code reused", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "alice-id", Email: "alice@example.com", Groups: []string{"cl-admins"}})
+		verifier := oidc.NewVerifier()
+		query := login(t, p, "state", "nonce", verifier)
+
+		_, err := p.Exchange(ctx, query.Get("code"), verifier, "nonce")
+		require.NoError(t, err)
+		_, err = p.Exchange(ctx, query.Get("code"), verifier, "nonce")
+		assert.ErrorContains(t, err, "invalid_grant")
+	})
+}
+
+func TestProvider_Exchange_WrongAudience(t *testing.T) {
+	t.Parallel()
+
+	idp := oidctest.NewIdP(t, "chainlink")
+	idp.SetUser(oidctest.User{Subject: "alice-id", Email: "alice@example.com", Groups: []string{"cl-admins"}})
+	cfg := newConfig(idp)
+	p := oidc.NewProvider(cfg, logger.TestLogger(t))
+	verifier := oidc.NewVerifier()
+	query := login(t, p, "state", "nonce", verifier)
+
+	// another client of the same IdP must not accept the ID token
+	cfg.ClientID = "other-client"
+	other := oidc.NewProvider(cfg, logger.TestLogger(t))
+	_, err := other.Exchange(testutils.Context(t), query.Get("code"), verifier, "nonce")
+	assert.EqualError(t, err, "invalid ID token: audience does not include other-client")
+}
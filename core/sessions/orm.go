@@ -2,12 +2,16 @@ package sessions
 
 import (
 	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -28,16 +32,31 @@ type ORM interface {
 	DeleteUser(email string) error
 	DeleteUserSession(sessionID string) error
 	CreateSession(sr SessionRequest) (string, error)
+	CreateOIDCSession(identity OIDCIdentity) (string, error)
+	CreateLDAPSession(identity LDAPIdentity) (string, error)
+	ListLDAPUsers() ([]User, error)
+	LinkLDAPUser(email, dn string) (User, error)
+	DeactivateUser(email string) error
 	ClearNonCurrentSessions(sessionID string) error
 	CreateUser(user *User) error
 	UpdateRole(email, newRole string) (User, error)
 	SetAuthToken(user *User, token *auth.Token) error
 	CreateAndSetAuthToken(user *User) (*auth.Token, error)
 	DeleteAuthToken(user *User) error
+	CreateAPIToken(token *APIToken) error
+	ListAPITokens(email string) ([]APIToken, error)
+	RevokeAPIToken(email, name string) error
+	FindUserByNamedAPIToken(accessKey string) (User, APIToken, error)
+	MarkAPITokenUsed(id int64) error
 	SetPassword(user *User, newPassword string) error
 	Sessions(offset, limit int) ([]Session, error)
 	GetUserWebAuthn(email string) ([]WebAuthn, error)
 	SaveWebAuthn(token *WebAuthn) error
+	ListRoles() ([]Role, error)
+	FindRole(name string) (Role, error)
+	CreateRole(role *Role) error
+	UpdateRolePermissions(name string, permissions []string) (Role, error)
+	DeleteRole(name string) error
 
 	FindExternalInitiator(eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
@@ -69,16 +88,45 @@ func (o *orm) FindUser(email string) (User, error) {
 // FindUserByAPIToken will attempt to return an API user via the user's table token_key column.
 func (o *orm) FindUserByAPIToken(apiToken string) (user User, err error) {
 	sql := "SELECT * FROM users WHERE token_key = $1"
-	err = o.q.Get(&user, sql, apiToken)
+	if err = o.q.Get(&user, sql, apiToken); err != nil {
+		return
+	}
+	if user.DeactivatedAt.Valid {
+		return User{}, ErrUserDeactivated
+	}
+	err = loadCustomRolePermissions(o.q, &user)
 	return
 }
 
 func (o *orm) findUser(email string) (user User, err error) {
 	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
-	err = o.q.Get(&user, sql, email)
+	if err = o.q.Get(&user, sql, email); err != nil {
+		return
+	}
+	err = loadCustomRolePermissions(o.q, &user)
 	return
 }
 
+// loadCustomRolePermissions sets the permissions of the custom role of the
+// user, if it has one.
+func loadCustomRolePermissions(q interface {
+	Get(dest interface{}, query string, args ...interface{}) error
+}, user *User) error {
+	if !user.CustomRole.Valid {
+		return nil
+	}
+	var role Role
+	if err := q.Get(&role, "SELECT * FROM roles WHERE name = $1", user.CustomRole.String); err != nil {
+		return errors.Wrapf(err, "failed to load custom role %s", user.CustomRole.String)
+	}
+	permissions, err := ParsePermissions(role.Permissions)
+	if err != nil {
+		return errors.Wrapf(err, "invalid custom role %s", role.Name)
+	}
+	user.CustomRolePermissions = permissions
+	return nil
+}
+
 // ListUsers will load and return all user rows from the db.
 func (o *orm) ListUsers() (users []User, err error) {
 	sql := "SELECT * FROM users ORDER BY email ASC;"
@@ -89,6 +137,9 @@ func (o *orm) ListUsers() (users []User, err error) {
 // ErrUserSessionExpired defines the error triggered when the user session has expired
 var ErrUserSessionExpired = errors.New("session missing or expired, please login again")
 
+// ErrUserDeactivated is returned when a deactivated user tries to authenticate.
+var ErrUserDeactivated = errors.New("user is deactivated")
+
 // AuthorizedUserWithSession will return the API user associated with the Session ID if it
 // exists and hasn't expired, and update session's LastUsed field.
 func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
@@ -114,6 +165,12 @@ func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
 		if err := tx.Get(&user, "SELECT * FROM users WHERE lower(email) = lower($1)", foundSession.Email); err != nil {
 			return errors.Wrap(err, "no matching user for provided session email")
 		}
+		if user.DeactivatedAt.Valid {
+			return ErrUserDeactivated
+		}
+		if err := loadCustomRolePermissions(tx, &user); err != nil {
+			return err
+		}
 		// Session valid and tied to user, update last_used
 		_, err := tx.Exec("UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now()", sessionID, o.sessionDuration)
 		if err != nil {
@@ -184,6 +241,11 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 		return "", errors.New("Invalid password")
 	}
 
+	if user.DeactivatedAt.Valid {
+		o.auditLogger.Audit(audit.AuthLoginFailedDeactivated, map[string]interface{}{"email": sr.Email})
+		return "", ErrUserDeactivated
+	}
+
 	// Load all valid MFA tokens associated with user's email
 	uwas, err := o.GetUserWebAuthn(user.Email)
 	if err != nil {
@@ -197,7 +259,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 		lggr.Infof("No MFA for user. Creating Session")
 		session := NewSession()
 		_, err = o.q.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
-		o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": sr.Email})
+		o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": sr.Email, "role": user.RoleName()})
 		return session.ID, err
 	}
 
@@ -246,7 +308,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	if err != nil {
 		lggr.Errorf("error in Marshal credentials: %s", err)
 	} else {
-		o.auditLogger.Audit(audit.AuthLoginSuccessWith2FA, map[string]interface{}{"email": sr.Email, "credential": string(uwasj)})
+		o.auditLogger.Audit(audit.AuthLoginSuccessWith2FA, map[string]interface{}{"email": sr.Email, "role": user.RoleName(), "credential": string(uwasj)})
 	}
 
 	return session.ID, nil
@@ -263,6 +325,168 @@ func constantTimeEmailCompare(left, right string) bool {
 	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
 }
 
+// CreateOIDCSession creates a session for a user authenticated by the OpenID
+// Connect identity provider. The user is found by their subject, or else linked
+// by their verified email to an existing local account, or else created. Their
+// role is set to the one mapped from their identity provider groups.
+func (o *orm) CreateOIDCSession(identity OIDCIdentity) (string, error) {
+	if identity.Subject == "" {
+		return "", errors.New("OIDC identity has no subject")
+	}
+	if err := ValidateEmail(identity.Email); err != nil {
+		return "", errors.Wrap(err, "OIDC identity has an invalid email")
+	}
+	if _, err := GetUserRole(string(identity.Role)); err != nil {
+		return "", err
+	}
+
+	session := NewSession()
+	var user User
+	var provisioned bool
+	err := o.q.Transaction(func(tx pg.Queryer) error {
+		err := tx.Get(&user, "SELECT * FROM users WHERE oidc_subject = $1", identity.Subject)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = tx.Get(&user, "SELECT * FROM users WHERE lower(email) = lower($1)", identity.Email)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				if user, err = newExternalUser(identity.Email, identity.Role); err != nil {
+					return err
+				}
+				query := "INSERT INTO users (email, hashed_password, role, oidc_subject, created_at, updated_at) VALUES ($1, $2, $3, $4, now(), now()) RETURNING *"
+				if err = tx.Get(&user, query, strings.ToLower(user.Email), user.HashedPassword, user.Role, identity.Subject); err != nil {
+					return err
+				}
+				provisioned = true
+			case err != nil:
+				return err
+			case user.DeactivatedAt.Valid:
+				return ErrUserDeactivated
+			case user.OIDCSubject.Valid:
+				return errors.Errorf("user %s is linked to another OIDC identity", user.Email)
+			case !identity.EmailVerified:
+				return errors.Errorf("cannot link user %s to an OIDC identity with an unverified email", user.Email)
+			default:
+				if _, err = tx.Exec("UPDATE users SET oidc_subject = $1, updated_at = now() WHERE email = $2", identity.Subject, user.Email); err != nil {
+					return err
+				}
+			}
+		} else if err != nil {
+			return err
+		} else if user.DeactivatedAt.Valid {
+			return ErrUserDeactivated
+		}
+		return startExternalSession(tx, user, identity.Role, session)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if provisioned {
+		o.auditLogger.Audit(audit.OIDCUserProvisioned, map[string]interface{}{"email": user.Email, "subject": identity.Subject, "role": identity.Role})
+	}
+	o.auditLogger.Audit(audit.AuthLoginSuccessOIDC, map[string]interface{}{"email": user.Email, "subject": identity.Subject, "role": identity.Role})
+	return session.ID, nil
+}
+
+// ErrLDAPUserNotLinked is returned when a directory user has the email of a
+// local user which an admin has not linked to the directory.
+var ErrLDAPUserNotLinked = errors.New("user exists locally and is not linked to the LDAP directory")
+
+// CreateLDAPSession creates a session for a user authenticated by the LDAP
+// directory. The user is created if they do not exist yet, and is otherwise
+// reactivated. Existing users must have been linked to the same directory entry
+// with LinkLDAPUser. Their role is set to the one mapped from their directory
+// groups.
+func (o *orm) CreateLDAPSession(identity LDAPIdentity) (string, error) {
+	if identity.DN == "" {
+		return "", errors.New("LDAP identity has no DN")
+	}
+	if err := ValidateEmail(identity.Email); err != nil {
+		return "", errors.Wrap(err, "LDAP identity has an invalid email")
+	}
+	if _, err := GetUserRole(string(identity.Role)); err != nil {
+		return "", err
+	}
+
+	session := NewSession()
+	var user User
+	var provisioned, reactivated bool
+	err := o.q.Transaction(func(tx pg.Queryer) error {
+		err := tx.Get(&user, "SELECT * FROM users WHERE lower(email) = lower($1)", identity.Email)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			if user, err = newExternalUser(identity.Email, identity.Role); err != nil {
+				return err
+			}
+			query := "INSERT INTO users (email, hashed_password, role, ldap_dn, created_at, updated_at) VALUES ($1, $2, $3, $4, now(), now()) RETURNING *"
+			if err = tx.Get(&user, query, strings.ToLower(user.Email), user.HashedPassword, user.Role, identity.DN); err != nil {
+				return err
+			}
+			provisioned = true
+		case err != nil:
+			return err
+		case !user.LDAPDN.Valid:
+			return errors.Wrapf(ErrLDAPUserNotLinked, "cannot log in %s", user.Email)
+		case user.LDAPDN.String != identity.DN:
+			return errors.Errorf("user %s is linked to another LDAP entry", user.Email)
+		case user.DeactivatedAt.Valid:
+			if _, err = tx.Exec("UPDATE users SET deactivated_at = NULL, updated_at = now() WHERE email = $1", user.Email); err != nil {
+				return err
+			}
+			reactivated = true
+		}
+		return startExternalSession(tx, user, identity.Role, session)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if provisioned {
+		o.auditLogger.Audit(audit.LDAPUserProvisioned, map[string]interface{}{"email": user.Email, "dn": identity.DN, "role": identity.Role})
+	}
+	if reactivated {
+		o.auditLogger.Audit(audit.LDAPUserReactivated, map[string]interface{}{"email": user.Email, "dn": identity.DN})
+	}
+	o.auditLogger.Audit(audit.AuthLoginSuccessLDAP, map[string]interface{}{"email": user.Email, "dn": identity.DN, "role": identity.Role})
+	return session.ID, nil
+}
+
+// startExternalSession gives the user authenticated by an external identity
+// provider the role it mapped them to, replacing any custom role, and starts
+// the session for them.
+func startExternalSession(tx pg.Queryer, user User, role UserRole, session Session) error {
+	if user.Role != role || user.CustomRole.Valid {
+		if _, err := tx.Exec("UPDATE users SET role = $1, custom_role = NULL, updated_at = now() WHERE email = $2", role, user.Email); err != nil {
+			return err
+		}
+	}
+	_, err := tx.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
+	return err
+}
+
+// LinkLDAPUser links an existing user to the entry of the LDAP directory with
+// the DN, so that they log in with the directory from then on, or unlinks them
+// if the DN is empty.
+func (o *orm) LinkLDAPUser(email, dn string) (user User, err error) {
+	sql := "UPDATE users SET ldap_dn = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *"
+	err = o.q.Get(&user, sql, null.NewString(dn, dn != ""), email)
+	return
+}
+
+// ListLDAPUsers returns the active users linked to the LDAP directory.
+func (o *orm) ListLDAPUsers() (users []User, err error) {
+	sql := "SELECT * FROM users WHERE ldap_dn IS NOT NULL AND deactivated_at IS NULL ORDER BY email ASC"
+	err = o.q.Select(&users, sql)
+	return
+}
+
+// DeactivateUser stops the user from authenticating. Their sessions are
+// deleted by the session reaper.
+func (o *orm) DeactivateUser(email string) error {
+	_, err := o.q.Exec("UPDATE users SET deactivated_at = now(), updated_at = now() WHERE email = $1 AND deactivated_at IS NULL", email)
+	return err
+}
+
 // ClearNonCurrentSessions removes all sessions but the id passed in.
 func (o *orm) ClearNonCurrentSessions(sessionID string) error {
 	_, err := o.q.Exec("DELETE FROM sessions where id != $1", sessionID)
@@ -271,11 +495,13 @@ func (o *orm) ClearNonCurrentSessions(sessionID string) error {
 
 // CreateUser creates a new API user
 func (o *orm) CreateUser(user *User) error {
-	sql := "INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES ($1, $2, $3, now(), now()) RETURNING *"
-	return o.q.Get(user, sql, strings.ToLower(user.Email), user.HashedPassword, user.Role)
+	sql := "INSERT INTO users (email, hashed_password, role, custom_role, created_at, updated_at) VALUES ($1, $2, $3, $4, now(), now()) RETURNING *"
+	return o.q.Get(user, sql, strings.ToLower(user.Email), user.HashedPassword, user.Role, user.CustomRole)
 }
 
-// UpdateRole overwrites role field of the user specified by email.
+// UpdateRole overwrites role field of the user specified by email. newRole
+// is either a built-in or a custom role. Users given a custom role keep the
+// 'view' built-in role, which is not used to authorize them.
 func (o *orm) UpdateRole(email, newRole string) (User, error) {
 	var userToEdit User
 
@@ -292,9 +518,16 @@ func (o *orm) UpdateRole(email, newRole string) (User, error) {
 		// Patch validated role
 		userRole, err := GetUserRole(newRole)
 		if err != nil {
-			return err
+			var role Role
+			if rerr := tx.Get(&role, "SELECT * FROM roles WHERE name = $1", newRole); rerr != nil {
+				return err
+			}
+			userToEdit.Role = UserRoleView
+			userToEdit.CustomRole = null.StringFrom(role.Name)
+		} else {
+			userToEdit.Role = userRole
+			userToEdit.CustomRole = null.String{}
 		}
-		userToEdit.Role = userRole
 
 		_, err = tx.Exec("DELETE FROM sessions WHERE email = lower($1)", email)
 		if err != nil {
@@ -302,8 +535,8 @@ func (o *orm) UpdateRole(email, newRole string) (User, error) {
 			return errors.New("error updating API user")
 		}
 
-		sql := "UPDATE users SET role = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *"
-		if err := tx.Get(&userToEdit, sql, userToEdit.Role, email); err != nil {
+		sql := "UPDATE users SET role = $1, custom_role = $2, updated_at = now() WHERE lower(email) = lower($3) RETURNING *"
+		if err := tx.Get(&userToEdit, sql, userToEdit.Role, userToEdit.CustomRole, email); err != nil {
 			o.lggr.Errorf("Error updating API user", "err", err)
 			return errors.New("error updating API user")
 		}
@@ -352,6 +585,69 @@ func (o *orm) DeleteAuthToken(user *User) error {
 	return o.q.Get(user, sql, user.Email)
 }
 
+// CreateAPIToken creates a named API token of a user.
+func (o *orm) CreateAPIToken(token *APIToken) error {
+	sql := `INSERT INTO api_tokens (user_email, name, token_key, token_salt, token_hashed_secret, permissions, allowed_ips, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now()) RETURNING *`
+	return o.q.Get(token, sql, strings.ToLower(token.UserEmail), token.Name, token.TokenKey, token.TokenSalt, token.TokenHashedSecret, token.Permissions, token.AllowedIPs, token.ExpiresAt)
+}
+
+// ListAPITokens returns the named API tokens of a user.
+func (o *orm) ListAPITokens(email string) (tokens []APIToken, err error) {
+	err = o.q.Select(&tokens, "SELECT * FROM api_tokens WHERE user_email = lower($1) ORDER BY name ASC", email)
+	return
+}
+
+// RevokeAPIToken deletes a named API token of a user.
+func (o *orm) RevokeAPIToken(email, name string) error {
+	res, err := o.q.Exec("DELETE FROM api_tokens WHERE user_email = lower($1) AND name = $2", email, name)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// FindUserByNamedAPIToken returns the named API token with the access key,
+// and its user limited to the permissions of the token.
+func (o *orm) FindUserByNamedAPIToken(accessKey string) (user User, token APIToken, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err := tx.Get(&token, "SELECT * FROM api_tokens WHERE token_key = $1", accessKey); err != nil {
+			return err
+		}
+		if err := tx.Get(&user, "SELECT * FROM users WHERE email = $1", token.UserEmail); err != nil {
+			return err
+		}
+		if user.DeactivatedAt.Valid {
+			return ErrUserDeactivated
+		}
+		if err := loadCustomRolePermissions(tx, &user); err != nil {
+			return err
+		}
+		if len(token.Permissions) > 0 {
+			permissions, err := ParsePermissions(token.Permissions)
+			if err != nil {
+				return errors.Wrapf(err, "invalid api token %s", token.Name)
+			}
+			user.TokenPermissions = permissions
+		}
+		return nil
+	})
+	return
+}
+
+// MarkAPITokenUsed records that a named API token was just used.
+func (o *orm) MarkAPITokenUsed(id int64) error {
+	_, err := o.q.Exec("UPDATE api_tokens SET last_used = now() WHERE id = $1", id)
+	return err
+}
+
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(token *WebAuthn) error {
 	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
@@ -376,3 +672,54 @@ func (o *orm) FindExternalInitiator(
 	err := o.q.Get(exi, `SELECT * FROM external_initiators WHERE access_key = $1`, eia.AccessKey)
 	return exi, err
 }
+
+// ListRoles returns all custom roles.
+func (o *orm) ListRoles() (roles []Role, err error) {
+	err = o.q.Select(&roles, "SELECT * FROM roles ORDER BY name ASC")
+	return
+}
+
+// FindRole returns the custom role with the given name.
+func (o *orm) FindRole(name string) (role Role, err error) {
+	err = o.q.Get(&role, "SELECT * FROM roles WHERE name = $1", name)
+	return
+}
+
+// CreateRole creates a new custom role.
+func (o *orm) CreateRole(role *Role) error {
+	sql := "INSERT INTO roles (name, permissions, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *"
+	return o.q.Get(role, sql, role.Name, role.Permissions)
+}
+
+// UpdateRolePermissions replaces the permissions of a custom role. They apply
+// to the sessions of its users from their next request.
+func (o *orm) UpdateRolePermissions(name string, permissions []string) (role Role, err error) {
+	sql := "UPDATE roles SET permissions = $1, updated_at = now() WHERE name = $2 RETURNING *"
+	err = o.q.Get(&role, sql, pq.StringArray(permissions), name)
+	return
+}
+
+// DeleteRole deletes a custom role, which must not be assigned to any user.
+func (o *orm) DeleteRole(name string) error {
+	return o.q.Transaction(func(tx pg.Queryer) error {
+		var users []string
+		if err := tx.Select(&users, "SELECT email FROM users WHERE custom_role = $1 ORDER BY email", name); err != nil {
+			return err
+		}
+		if len(users) > 0 {
+			return fmt.Errorf("cannot delete role %s: %w: %s", name, ErrRoleAssigned, strings.Join(users, ", "))
+		}
+		res, err := tx.Exec("DELETE FROM roles WHERE name = $1", name)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+}
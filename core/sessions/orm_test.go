@@ -1,6 +1,7 @@
 package sessions_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
@@ -294,3 +295,231 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Empty(t, dbUser.TokenSalt.ValueOrZero())
 	assert.Empty(t, dbUser.TokenHashedSecret.ValueOrZero())
 }
+
+func TestORM_Roles(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	role, err := sessions.NewRole("bridge-admins", []string{"bridges:admin", "jobs:view"})
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateRole(&role))
+	assert.False(t, role.CreatedAt.IsZero())
+
+	user := cltest.MustNewUser(t, "custom@email.net", cltest.Password)
+	require.NoError(t, orm.CreateUser(&user))
+	user, err = orm.UpdateRole(user.Email, role.Name)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleView, user.Role)
+	assert.Equal(t, role.Name, user.CustomRole.String)
+
+	user, err = orm.FindUser(user.Email)
+	require.NoError(t, err)
+	assert.True(t, user.Authorized(sessions.ResourceBridges, sessions.UserRoleAdmin, ""))
+	assert.False(t, user.Authorized(sessions.ResourceJobs, sessions.UserRoleEdit, ""))
+
+	_, err = orm.UpdateRolePermissions(role.Name, []string{"jobs:edit"})
+	require.NoError(t, err)
+	user, err = orm.FindUser(user.Email)
+	require.NoError(t, err)
+	assert.False(t, user.Authorized(sessions.ResourceBridges, sessions.UserRoleView, ""))
+	assert.True(t, user.Authorized(sessions.ResourceJobs, sessions.UserRoleEdit, ""))
+
+	roles, err := orm.ListRoles()
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	assert.Equal(t, []string{"jobs:edit"}, []string(roles[0].Permissions))
+
+	err = orm.DeleteRole(role.Name)
+	require.ErrorIs(t, err, sessions.ErrRoleAssigned)
+	assert.ErrorContains(t, err, user.Email)
+
+	user, err = orm.UpdateRole(user.Email, string(sessions.UserRoleRun))
+	require.NoError(t, err)
+	assert.False(t, user.CustomRole.Valid)
+	require.NoError(t, orm.DeleteRole(role.Name))
+	require.ErrorIs(t, orm.DeleteRole(role.Name), sql.ErrNoRows)
+}
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	user := cltest.MustNewUser(t, "ci@email.net", cltest.Password)
+	require.NoError(t, orm.CreateUser(&user))
+
+	apiToken, token, err := sessions.NewAPIToken(user.Email, sessions.APITokenRequest{
+		Name:        "ci",
+		Permissions: []string{"jobs:edit"},
+		AllowedIPs:  []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateAPIToken(&apiToken))
+	assert.NotZero(t, apiToken.ID)
+	assert.False(t, apiToken.CreatedAt.IsZero())
+	assert.False(t, apiToken.LastUsed.Valid)
+
+	duplicate, _, err := sessions.NewAPIToken(user.Email, sessions.APITokenRequest{Name: "ci"})
+	require.NoError(t, err)
+	require.Error(t, orm.CreateAPIToken(&duplicate))
+
+	found, foundToken, err := orm.FindUserByNamedAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, found.Email)
+	assert.Equal(t, apiToken.ID, foundToken.ID)
+	assert.Equal(t, []string{"10.0.0.0/8"}, []string(foundToken.AllowedIPs))
+	assert.True(t, found.Authorized(sessions.ResourceJobs, sessions.UserRoleEdit, ""))
+	assert.False(t, found.Authorized(sessions.ResourceBridges, sessions.UserRoleView, ""))
+
+	require.NoError(t, orm.MarkAPITokenUsed(apiToken.ID))
+	tokens, err := orm.ListAPITokens(user.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.True(t, tokens[0].LastUsed.Valid)
+
+	require.NoError(t, orm.RevokeAPIToken(user.Email, "ci"))
+	require.ErrorIs(t, orm.RevokeAPIToken(user.Email, "ci"), sql.ErrNoRows)
+	_, _, err = orm.FindUserByNamedAPIToken(token.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestORM_CreateOIDCSession(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	local := cltest.MustNewUser(t, "local@email.net", cltest.Password)
+	require.NoError(t, orm.CreateUser(&local))
+
+	t.Run("provisions new user", func(t *testing.T) {
+		sid, err := orm.CreateOIDCSession(sessions.OIDCIdentity{Subject: "new-id", Email: "New@email.net", Role: sessions.UserRoleRun})
+		require.NoError(t, err)
+
+		user, err := orm.AuthorizedUserWithSession(sid)
+		require.NoError(t, err)
+		assert.Equal(t, "new@email.net", user.Email)
+		assert.Equal(t, sessions.UserRoleRun, user.Role)
+		assert.Equal(t, "new-id", user.OIDCSubject.String)
+	})
+
+	t.Run("unverified email does not link local user", func(t *testing.T) {
+		_, err := orm.CreateOIDCSession(sessions.OIDCIdentity{Subject: "local-id", Email: local.Email, Role: sessions.UserRoleAdmin})
+		require.EqualError(t, err, "cannot link user local@email.net to an OIDC identity with an unverified email")
+
+		user, err := orm.FindUser(local.Email)
+		require.NoError(t, err)
+		assert.False(t, user.OIDCSubject.Valid)
+		assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+	})
+
+	t.Run("links local user and syncs role", func(t *testing.T) {
+		_, err := orm.CreateOIDCSession(sessions.OIDCIdentity{Subject: "local-id", Email: local.Email, EmailVerified: true, Role: sessions.UserRoleView})
+		require.NoError(t, err)
+
+		user, err := orm.FindUser(local.Email)
+		require.NoError(t, err)
+		assert.Equal(t, "local-id", user.OIDCSubject.String)
+		assert.Equal(t, sessions.UserRoleView, user.Role)
+
+		// the local password still works
+		_, err = orm.CreateSession(sessions.SessionRequest{Email: local.Email, Password: cltest.Password})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects another identity with the email of a linked user", func(t *testing.T) {
+		_, err := orm.CreateOIDCSession(sessions.OIDCIdentity{Subject: "other-id", Email: local.Email, EmailVerified: true, Role: sessions.UserRoleAdmin})
+		require.EqualError(t, err, "user local@email.net is linked to another OIDC identity")
+	})
+}
+
+func TestORM_CreateLDAPSession(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	local := cltest.MustNewUser(t, "local@email.net", cltest.Password)
+	require.NoError(t, orm.CreateUser(&local))
+
+	t.Run("provisions new user", func(t *testing.T) {
+		sid, err := orm.CreateLDAPSession(sessions.LDAPIdentity{DN: "uid=new,dc=example,dc=com", Email: "New@email.net", Role: sessions.UserRoleRun})
+		require.NoError(t, err)
+
+		user, err := orm.AuthorizedUserWithSession(sid)
+		require.NoError(t, err)
+		assert.Equal(t, "new@email.net", user.Email)
+		assert.Equal(t, sessions.UserRoleRun, user.Role)
+		assert.Equal(t, "uid=new,dc=example,dc=com", user.LDAPDN.String)
+	})
+
+	t.Run("refuses unlinked local user", func(t *testing.T) {
+		_, err := orm.CreateLDAPSession(sessions.LDAPIdentity{DN: "uid=local,dc=example,dc=com", Email: local.Email, Role: sessions.UserRoleAdmin})
+		require.ErrorIs(t, err, sessions.ErrLDAPUserNotLinked)
+
+		user, err := orm.FindUser(local.Email)
+		require.NoError(t, err)
+		assert.False(t, user.LDAPDN.Valid)
+		assert.Equal(t, local.Role, user.Role)
+	})
+
+	t.Run("logs in linked local user and syncs role", func(t *testing.T) {
+		_, err := orm.LinkLDAPUser("Local@email.net", "uid=local,dc=example,dc=com")
+		require.NoError(t, err)
+		_, err = orm.CreateLDAPSession(sessions.LDAPIdentity{DN: "uid=local,dc=example,dc=com", Email: local.Email, Role: sessions.UserRoleView})
+		require.NoError(t, err)
+
+		user, err := orm.FindUser(local.Email)
+		require.NoError(t, err)
+		assert.Equal(t, "uid=local,dc=example,dc=com", user.LDAPDN.String)
+		assert.Equal(t, sessions.UserRoleView, user.Role)
+
+		users, err := orm.ListLDAPUsers()
+		require.NoError(t, err)
+		assert.Len(t, users, 2)
+	})
+
+	t.Run("rejects another entry with the email of a linked user", func(t *testing.T) {
+		_, err := orm.CreateLDAPSession(sessions.LDAPIdentity{DN: "uid=other,dc=example,dc=com", Email: local.Email, Role: sessions.UserRoleAdmin})
+		require.EqualError(t, err, "user local@email.net is linked to another LDAP entry")
+	})
+
+	t.Run("deactivates and reactivates user", func(t *testing.T) {
+		sid, err := orm.CreateLDAPSession(sessions.LDAPIdentity{DN: "uid=local,dc=example,dc=com", Email: local.Email, Role: sessions.UserRoleView})
+		require.NoError(t, err)
+
+		require.NoError(t, orm.DeactivateUser(local.Email))
+
+		_, err = orm.AuthorizedUserWithSession(sid)
+		require.ErrorIs(t, err, sessions.ErrUserDeactivated)
+		_, err = orm.CreateSession(sessions.SessionRequest{Email: local.Email, Password: cltest.Password})
+		require.ErrorIs(t, err, sessions.ErrUserDeactivated)
+		users, err := orm.ListLDAPUsers()
+		require.NoError(t, err)
+		assert.Len(t, users, 1)
+
+		sid, err = orm.CreateLDAPSession(sessions.LDAPIdentity{DN: "uid=local,dc=example,dc=com", Email: local.Email, Role: sessions.UserRoleView})
+		require.NoError(t, err)
+		_, err = orm.AuthorizedUserWithSession(sid)
+		require.NoError(t, err)
+	})
+}
+
+func TestORM_LinkLDAPUser(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	local := cltest.MustNewUser(t, "local@email.net", cltest.Password)
+	require.NoError(t, orm.CreateUser(&local))
+
+	user, err := orm.LinkLDAPUser(local.Email, "uid=local,dc=example,dc=com")
+	require.NoError(t, err)
+	assert.Equal(t, "uid=local,dc=example,dc=com", user.LDAPDN.String)
+
+	user, err = orm.LinkLDAPUser(local.Email, "")
+	require.NoError(t, err)
+	assert.False(t, user.LDAPDN.Valid)
+
+	_, err = orm.LinkLDAPUser("unknown@email.net", "uid=unknown,dc=example,dc=com")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
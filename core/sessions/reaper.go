@@ -40,6 +40,9 @@ func (sr *sessionReaper) Work() {
 	if err != nil {
 		sr.lggr.Error("unable to reap stale sessions: ", err)
 	}
+	if err = sr.deleteDeactivatedUserSessions(); err != nil {
+		sr.lggr.Error("unable to reap sessions of deactivated users: ", err)
+	}
 }
 
 // DeleteStaleSessions deletes all sessions before the passed time.
@@ -47,3 +50,9 @@ func (sr *sessionReaper) deleteStaleSessions(before time.Time) error {
 	_, err := sr.db.Exec("DELETE FROM sessions WHERE last_used < $1", before)
 	return err
 }
+
+// deleteDeactivatedUserSessions deletes all sessions of deactivated users.
+func (sr *sessionReaper) deleteDeactivatedUserSessions() error {
+	_, err := sr.db.Exec("DELETE FROM sessions WHERE email IN (SELECT email FROM users WHERE deactivated_at IS NOT NULL)")
+	return err
+}
@@ -80,6 +80,38 @@ func TestSessionReaper_ReapSessions(t *testing.T) {
 	}
 }
 
+func TestSessionReaper_ReapDeactivatedUserSessions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := sessionReaperConfig{}
+	lggr := logger.TestLogger(t)
+	orm := sessions.NewORM(db, config.SessionTimeout().Duration(), lggr, pgtest.NewQConfig(true), audit.NoopLogger)
+
+	r := sessions.NewSessionReaper(db.DB, config, lggr)
+	t.Cleanup(func() {
+		assert.NoError(t, r.Stop())
+	})
+
+	user := cltest.MustNewUser(t, "deactivated@email.net", cltest.Password)
+	require.NoError(t, orm.CreateUser(&user))
+	for _, email := range []string{cltest.APIEmailAdmin, user.Email} {
+		_, err := db.Exec("INSERT INTO sessions (last_used, email, id, created_at) VALUES (now(), $1, $1, now())", email)
+		require.NoError(t, err)
+	}
+	require.NoError(t, orm.DeactivateUser(user.Email))
+
+	r.WakeUp()
+
+	gomega.NewWithT(t).Eventually(func() []sessions.Session {
+		sessions, err := orm.Sessions(0, 10)
+		assert.NoError(t, err)
+		return sessions
+	}).Should(gomega.HaveLen(1))
+	_, err := orm.AuthorizedUserWithSession(user.Email)
+	assert.Error(t, err)
+}
+
 // clearSessions removes all sessions.
 func clearSessions(t *testing.T, db *sql.DB) {
 	_, err := db.Exec("DELETE FROM sessions")
@@ -0,0 +1,192 @@
+package sessions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// Resource is a kind of object that users are authorized to access.
+type Resource string
+
+const (
+	ResourceAuditLog           Resource = "audit_log"
+	ResourceBridges            Resource = "bridges"
+	ResourceChains             Resource = "chains"
+	ResourceConfig             Resource = "config"
+	ResourceDebug              Resource = "debug"
+	ResourceExternalInitiators Resource = "external_initiators"
+	ResourceFeedsManagers      Resource = "feeds_managers"
+	ResourceJobProposals       Resource = "job_proposals"
+	ResourceJobs               Resource = "jobs"
+	ResourceKeys               Resource = "keys"
+	ResourceNodes              Resource = "nodes"
+	ResourceTransactions       Resource = "transactions"
+	ResourceUsers              Resource = "users"
+
+	// ResourceAll matches any resource in a Permission
+	ResourceAll Resource = "*"
+)
+
+// Resources lists all resources
+var Resources = []Resource{
+	ResourceAuditLog,
+	ResourceBridges,
+	ResourceChains,
+	ResourceConfig,
+	ResourceDebug,
+	ResourceExternalInitiators,
+	ResourceFeedsManagers,
+	ResourceJobProposals,
+	ResourceJobs,
+	ResourceKeys,
+	ResourceNodes,
+	ResourceTransactions,
+	ResourceUsers,
+}
+
+func parseResource(s string) (Resource, error) {
+	if s == string(ResourceAll) {
+		return ResourceAll, nil
+	}
+	for _, r := range Resources {
+		if s == string(r) {
+			return r, nil
+		}
+	}
+	return "", errors.Errorf("unknown resource: %s", s)
+}
+
+// rank orders the built-in roles, each of which includes the access of the
+// ones below it.
+var rank = map[UserRole]int{
+	UserRoleView:  1,
+	UserRoleRun:   2,
+	UserRoleEdit:  3,
+	UserRoleAdmin: 4,
+}
+
+// Includes returns whether r grants at least the access of required.
+func (r UserRole) Includes(required UserRole) bool {
+	return rank[r] > 0 && rank[r] >= rank[required]
+}
+
+// Permission grants the access of a built-in role to a resource, optionally
+// only within a scope like a single chain. It is written as
+// <resource>:<role>[:<scope>], e.g. "bridges:edit", "*:view" or
+// "job_proposals:edit:evm/5".
+type Permission struct {
+	Resource Resource
+	Role     UserRole
+	Scope    string
+}
+
+// ParsePermission parses a permission written as <resource>:<role>[:<scope>].
+func ParsePermission(s string) (p Permission, err error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) < 2 {
+		return p, errors.Errorf("invalid permission %q: must be <resource>:<role>[:<scope>]", s)
+	}
+	if p.Resource, err = parseResource(parts[0]); err != nil {
+		return p, errors.Wrapf(err, "invalid permission %q", s)
+	}
+	if p.Role, err = GetUserRole(parts[1]); err != nil {
+		return p, errors.Wrapf(err, "invalid permission %q", s)
+	}
+	if len(parts) == 3 {
+		if parts[2] == "" {
+			return p, errors.Errorf("invalid permission %q: scope must not be empty", s)
+		}
+		p.Scope = parts[2]
+	}
+	return p, nil
+}
+
+func (p Permission) String() string {
+	s := fmt.Sprintf("%s:%s", p.Resource, p.Role)
+	if p.Scope != "" {
+		s += ":" + p.Scope
+	}
+	return s
+}
+
+// Allows returns whether p grants role on resource within scope. A scoped
+// permission only applies to operations within that same scope.
+func (p Permission) Allows(resource Resource, role UserRole, scope string) bool {
+	if p.Resource != ResourceAll && p.Resource != resource {
+		return false
+	}
+	if p.Scope != "" && p.Scope != scope {
+		return false
+	}
+	return p.Role.Includes(role)
+}
+
+// ParsePermissions parses and validates a list of permissions.
+func ParsePermissions(ss []string) ([]Permission, error) {
+	if len(ss) == 0 {
+		return nil, errors.New("must grant at least one permission")
+	}
+	ps := make([]Permission, len(ss))
+	for i, s := range ss {
+		p, err := ParsePermission(s)
+		if err != nil {
+			return nil, err
+		}
+		ps[i] = p
+	}
+	return ps, nil
+}
+
+// ErrRoleAssigned is returned when deleting a custom role which users still have.
+var ErrRoleAssigned = errors.New("role is assigned to users")
+
+// Role is a custom role, which grants the union of its permissions.
+type Role struct {
+	Name        string
+	Permissions pq.StringArray
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+var roleNameRegexp = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// NewRole validates the name and permissions of a new custom role.
+func NewRole(name string, permissions []string) (Role, error) {
+	if err := ValidateRoleName(name); err != nil {
+		return Role{}, err
+	}
+	ps, err := ValidatePermissions(permissions)
+	if err != nil {
+		return Role{}, err
+	}
+	return Role{Name: name, Permissions: ps}, nil
+}
+
+// ValidatePermissions parses permissions and returns them in canonical form.
+func ValidatePermissions(permissions []string) ([]string, error) {
+	ps, err := ParsePermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	validated := make([]string, len(ps))
+	for i, p := range ps {
+		validated[i] = p.String()
+	}
+	return validated, nil
+}
+
+// ValidateRoleName is the single point of logic for custom role name validations
+func ValidateRoleName(name string) error {
+	if _, err := GetUserRole(name); err == nil {
+		return errors.Errorf("%s is a built-in role", name)
+	}
+	if !roleNameRegexp.MatchString(name) {
+		return errors.New("role name must only contain lowercase letters, digits, '-' and '_'")
+	}
+	return nil
+}
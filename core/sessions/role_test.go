@@ -0,0 +1,103 @@
+package sessions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected sessions.Permission
+		err      string
+	}{
+		{"bridges:edit", sessions.Permission{Resource: sessions.ResourceBridges, Role: sessions.UserRoleEdit}, ""},
+		{"*:view", sessions.Permission{Resource: sessions.ResourceAll, Role: sessions.UserRoleView}, ""},
+		{"job_proposals:edit:evm/5", sessions.Permission{Resource: sessions.ResourceJobProposals, Role: sessions.UserRoleEdit, Scope: "evm/5"}, ""},
+		{"bridges", sessions.Permission{}, `invalid permission "bridges": must be <resource>:<role>[:<scope>]`},
+		{"widgets:edit", sessions.Permission{}, `invalid permission "widgets:edit": unknown resource: widgets`},
+		{"bridges:owner", sessions.Permission{}, `invalid permission "bridges:owner"`},
+		{"chains:edit:", sessions.Permission{}, `invalid permission "chains:edit:": scope must not be empty`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			p, err := sessions.ParsePermission(test.input)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, p)
+			assert.Equal(t, test.input, p.String())
+		})
+	}
+}
+
+func TestPermission_Allows(t *testing.T) {
+	t.Parallel()
+
+	bridgesEdit := sessions.Permission{Resource: sessions.ResourceBridges, Role: sessions.UserRoleEdit}
+	assert.True(t, bridgesEdit.Allows(sessions.ResourceBridges, sessions.UserRoleView, ""))
+	assert.True(t, bridgesEdit.Allows(sessions.ResourceBridges, sessions.UserRoleEdit, ""))
+	assert.False(t, bridgesEdit.Allows(sessions.ResourceBridges, sessions.UserRoleAdmin, ""))
+	assert.False(t, bridgesEdit.Allows(sessions.ResourceKeys, sessions.UserRoleView, ""))
+	// unscoped permissions apply to every scope
+	assert.True(t, bridgesEdit.Allows(sessions.ResourceBridges, sessions.UserRoleEdit, "evm/5"))
+
+	allView := sessions.Permission{Resource: sessions.ResourceAll, Role: sessions.UserRoleView}
+	assert.True(t, allView.Allows(sessions.ResourceKeys, sessions.UserRoleView, ""))
+	assert.False(t, allView.Allows(sessions.ResourceKeys, sessions.UserRoleRun, ""))
+
+	scoped := sessions.Permission{Resource: sessions.ResourceJobProposals, Role: sessions.UserRoleEdit, Scope: "evm/5"}
+	assert.True(t, scoped.Allows(sessions.ResourceJobProposals, sessions.UserRoleEdit, "evm/5"))
+	assert.False(t, scoped.Allows(sessions.ResourceJobProposals, sessions.UserRoleEdit, "evm/4"))
+	assert.False(t, scoped.Allows(sessions.ResourceJobProposals, sessions.UserRoleEdit, ""))
+}
+
+func TestUser_Authorized(t *testing.T) {
+	t.Parallel()
+
+	run := sessions.User{Role: sessions.UserRoleRun}
+	assert.True(t, run.Authorized(sessions.ResourceKeys, sessions.UserRoleView, ""))
+	assert.True(t, run.Authorized(sessions.ResourceJobs, sessions.UserRoleRun, ""))
+	assert.False(t, run.Authorized(sessions.ResourceJobs, sessions.UserRoleEdit, ""))
+	assert.Equal(t, "run", run.RoleName())
+
+	permissions, err := sessions.ParsePermissions([]string{"bridges:edit", "job_proposals:edit:evm/5"})
+	require.NoError(t, err)
+	custom := sessions.User{
+		Role:                  sessions.UserRoleView,
+		CustomRole:            null.StringFrom("bridge-admins"),
+		CustomRolePermissions: permissions,
+	}
+	assert.True(t, custom.Authorized(sessions.ResourceBridges, sessions.UserRoleEdit, ""))
+	assert.True(t, custom.Authorized(sessions.ResourceJobProposals, sessions.UserRoleEdit, "evm/5"))
+	assert.False(t, custom.Authorized(sessions.ResourceJobProposals, sessions.UserRoleEdit, "evm/4"))
+	// the built-in role of a user with a custom role is not used
+	assert.False(t, custom.Authorized(sessions.ResourceKeys, sessions.UserRoleView, ""))
+	assert.Equal(t, "bridge-admins", custom.RoleName())
+}
+
+func TestNewRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := sessions.NewRole("feeds-approvers", []string{"job_proposals:edit:evm/5", "feeds_managers:view"})
+	require.NoError(t, err)
+	assert.Equal(t, "feeds-approvers", role.Name)
+	assert.Equal(t, []string{"job_proposals:edit:evm/5", "feeds_managers:view"}, []string(role.Permissions))
+
+	_, err = sessions.NewRole("admin", []string{"*:admin"})
+	assert.EqualError(t, err, "admin is a built-in role")
+	_, err = sessions.NewRole("Feeds Approvers", []string{"*:view"})
+	assert.EqualError(t, err, "role name must only contain lowercase letters, digits, '-' and '_'")
+	_, err = sessions.NewRole("empty", nil)
+	assert.EqualError(t, err, "must grant at least one permission")
+}
@@ -15,14 +15,31 @@ import (
 
 // User holds the credentials for API user.
 type User struct {
-	Email             string
-	HashedPassword    string
-	Role              UserRole
+	Email          string
+	HashedPassword string
+	Role           UserRole
+	// CustomRole, if set, authorizes the user instead of Role
+	CustomRole null.String
+	// CustomRolePermissions are the permissions of CustomRole, loaded when
+	// authenticating the user
+	CustomRolePermissions []Permission `db:"-"`
+	// TokenPermissions, if set, are the permissions of the named API token the
+	// user authenticated with, which further limit its access
+	TokenPermissions  []Permission `db:"-"`
 	CreatedAt         time.Time
 	TokenKey          null.String
 	TokenSalt         null.String
 	TokenHashedSecret null.String
 	UpdatedAt         time.Time
+	// OIDCSubject, if set, is the identity of the user at the OpenID Connect
+	// identity provider
+	OIDCSubject null.String `db:"oidc_subject"`
+	// LDAPDN, if set, is the DN of the user in the LDAP directory, which
+	// manages their role and whether they are active
+	LDAPDN null.String `db:"ldap_dn"`
+	// DeactivatedAt, if set, is when the user was deactivated. Deactivated
+	// users may not authenticate.
+	DeactivatedAt null.Time
 }
 
 type UserRole string
@@ -109,6 +126,56 @@ func GetUserRole(role string) (UserRole, error) {
 	return UserRole(""), errors.New(errStr)
 }
 
+// RoleGroups maps each role to the groups of an external identity provider,
+// such as OpenID Connect or LDAP, whose members are given it.
+type RoleGroups map[UserRole][]string
+
+// Role returns the highest role of any of the groups, or false if none of them
+// has a role.
+func (rg RoleGroups) Role(groups []string) (UserRole, bool) {
+	for _, role := range []UserRole{UserRoleAdmin, UserRoleEdit, UserRoleRun, UserRoleView} {
+		for _, g := range rg[role] {
+			for _, group := range groups {
+				if g == group {
+					return role, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// Authorized returns whether the user has at least the access of role to
+// resource. scope identifies what the operation is limited to, e.g. "evm/5"
+// for a single chain, and may be empty.
+func (u *User) Authorized(resource Resource, role UserRole, scope string) bool {
+	if u.TokenPermissions != nil && !anyAllows(u.TokenPermissions, resource, role, scope) {
+		return false
+	}
+	if !u.CustomRole.Valid {
+		return u.Role.Includes(role)
+	}
+	return anyAllows(u.CustomRolePermissions, resource, role, scope)
+}
+
+func anyAllows(permissions []Permission, resource Resource, role UserRole, scope string) bool {
+	for _, p := range permissions {
+		if p.Allows(resource, role, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleName returns the name of the custom role of the user, if any, or else
+// of its built-in role.
+func (u *User) RoleName() string {
+	if u.CustomRole.Valid {
+		return u.CustomRole.String
+	}
+	return string(u.Role)
+}
+
 // SessionRequest encapsulates the fields needed to generate a new SessionID,
 // including the hashed password.
 type SessionRequest struct {
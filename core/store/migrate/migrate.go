@@ -141,3 +141,29 @@ func Status(db *sql.DB, lggr logger.Logger) error {
 func Create(db *sql.DB, name, migrationType string) error {
 	return goose.Create(db, "core/store/migrate/migrations", name, migrationType)
 }
+
+// Version returns the current version of the database, or 0 if it was never
+// migrated. Unlike Current, it never writes to the database.
+func Version(ctx context.Context, db *sql.DB) (int64, error) {
+	var exists bool
+	if err := db.QueryRowContext(ctx, `SELECT to_regclass($1) IS NOT NULL`, goose.TableName()).Scan(&exists); err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, nil
+	}
+	return goose.GetDBVersion(db)
+}
+
+// Latest returns the version of the latest migration known to this binary.
+func Latest() (int64, error) {
+	ms, err := goose.CollectMigrations(MIGRATIONS_DIR, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, err
+	}
+	m, err := ms.Last()
+	if err != nil {
+		return 0, err
+	}
+	return m.Version, nil
+}
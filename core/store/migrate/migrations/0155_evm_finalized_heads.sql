@@ -0,0 +1,15 @@
+-- +goose Up
+
+CREATE TABLE evm_finalized_heads (
+    evm_chain_id numeric(78,0) PRIMARY KEY REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    hash bytea NOT NULL CHECK (octet_length(hash) = 32),
+    number bigint NOT NULL CHECK (number >= 0),
+    parent_hash bytea NOT NULL CHECK (octet_length(parent_hash) = 32),
+    timestamp timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+-- +goose Down
+
+DROP TABLE evm_finalized_heads;
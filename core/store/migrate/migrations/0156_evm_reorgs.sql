@@ -0,0 +1,23 @@
+-- +goose Up
+
+CREATE TABLE evm_reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    source text NOT NULL CHECK (source IN ('head_tracker', 'log_poller')),
+    depth bigint NOT NULL CHECK (depth > 0),
+    from_block bigint NOT NULL CHECK (from_block >= 0),
+    to_block bigint NOT NULL CHECK (to_block >= from_block),
+    old_hash bytea NOT NULL CHECK (octet_length(old_hash) = 32),
+    new_hash bytea NOT NULL CHECK (octet_length(new_hash) = 32),
+    affected_eth_tx_ids bigint[] NOT NULL DEFAULT '{}',
+    affected_logs bigint NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_evm_reorgs_evm_chain_id_created_at ON evm_reorgs (evm_chain_id, created_at DESC);
+-- A reorg detected again, because the recovery from it failed, is recorded once.
+CREATE UNIQUE INDEX idx_evm_reorgs_unique ON evm_reorgs (evm_chain_id, source, from_block, new_hash);
+
+-- +goose Down
+
+DROP TABLE evm_reorgs;
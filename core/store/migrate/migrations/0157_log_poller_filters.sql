@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE log_poller_filters (
+    id BIGSERIAL PRIMARY KEY,
+    name text NOT NULL CHECK (length(name) > 0),
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE,
+    address bytea NOT NULL CHECK (octet_length(address) = 20),
+    event bytea NOT NULL CHECK (octet_length(event) = 32),
+    -- ABI of the event, in the JSON format of contract ABIs, if it was registered with one.
+    event_abi jsonb,
+    -- Number of blocks for which matching logs are kept, 0 keeps them forever.
+    retention bigint NOT NULL DEFAULT 0 CHECK (retention >= 0),
+    -- Set while the filter's logs still need to be backfilled from this block.
+    backfill_start_block bigint CHECK (backfill_start_block > 0),
+    created_at timestamptz NOT NULL,
+    UNIQUE (name, evm_chain_id, address, event)
+);
+
+-- +goose Down
+DROP TABLE log_poller_filters;
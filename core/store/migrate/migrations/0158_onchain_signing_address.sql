@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE ocr_oracle_specs ADD COLUMN onchain_signing_address bytea CHECK (octet_length(onchain_signing_address) = 20);
+ALTER TABLE ocr2_oracle_specs ADD COLUMN onchain_signing_address bytea CHECK (octet_length(onchain_signing_address) = 20);
+
+-- +goose Down
+ALTER TABLE ocr_oracle_specs DROP COLUMN onchain_signing_address;
+ALTER TABLE ocr2_oracle_specs DROP COLUMN onchain_signing_address;
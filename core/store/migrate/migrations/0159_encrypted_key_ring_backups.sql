@@ -0,0 +1,10 @@
+-- +goose Up
+-- Previous ciphertexts of the key ring, kept when the keystore password is rotated.
+CREATE TABLE encrypted_key_ring_backups (
+    id BIGSERIAL PRIMARY KEY,
+    encrypted_keys jsonb,
+    created_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE encrypted_key_ring_backups;
@@ -0,0 +1,17 @@
+-- +goose Up
+
+-- Custom roles grant the union of their permissions, e.g. 'bridges:edit' or 'job_proposals:edit:evm/5'
+CREATE TABLE roles (
+    name text PRIMARY KEY CHECK (name ~ '^[a-z0-9_-]+$' AND name NOT IN ('admin', 'edit', 'run', 'view')),
+    permissions text[] NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+-- Users with a custom role are authorized by it instead of their built-in role
+ALTER TABLE users ADD custom_role text REFERENCES roles (name) ON DELETE RESTRICT;
+
+-- +goose Down
+
+ALTER TABLE users DROP COLUMN custom_role;
+DROP TABLE roles;
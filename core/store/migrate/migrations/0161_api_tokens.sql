@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- Named API tokens of users, which in addition to the legacy token of each user
+-- may be limited to a subset of its permissions, to some client IPs and in time
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_email text NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    name text NOT NULL CHECK (name ~ '^[a-z0-9_-]+$'),
+    token_key text NOT NULL UNIQUE,
+    token_salt text NOT NULL,
+    token_hashed_secret text NOT NULL,
+    -- NULL grants all the permissions of the user
+    permissions text[],
+    -- NULL allows any client IP
+    allowed_ips text[],
+    expires_at timestamptz,
+    last_used timestamptz,
+    created_at timestamptz NOT NULL,
+    UNIQUE (user_email, name)
+);
+
+-- +goose Down
+
+DROP TABLE api_tokens;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN oidc_subject text UNIQUE;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN oidc_subject;
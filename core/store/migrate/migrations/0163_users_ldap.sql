@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN ldap_dn text, ADD COLUMN deactivated_at timestamptz;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN ldap_dn, DROP COLUMN deactivated_at;
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    event_id text NOT NULL,
+    user_email text,
+    data text NOT NULL,
+    created_at timestamptz NOT NULL,
+    prev_hash bytea NOT NULL CHECK (octet_length(prev_hash) = 32),
+    hash bytea NOT NULL CHECK (octet_length(hash) = 32)
+);
+
+CREATE INDEX idx_audit_log_user_email ON audit_log (user_email, id DESC);
+CREATE INDEX idx_audit_log_event_id ON audit_log (event_id, id DESC);
+CREATE INDEX idx_audit_log_created_at ON audit_log (created_at);
+
+-- audit_log_append_only rejects changes to the audit log. Changes made while
+-- the triggers are disabled are detected by verifying the hash chain.
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE PROCEDURE audit_log_append_only();
+CREATE TRIGGER audit_log_append_only_truncate BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE PROCEDURE audit_log_append_only();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE audit_log;
+DROP FUNCTION audit_log_append_only;
+
+-- +goose StatementEnd
@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- These notifications back the GraphQL subscriptions. Payloads only identify
+-- the changed row, subscribers load it. Triggers must not query other tables,
+-- they run on every insert of the hottest tables.
+
+CREATE FUNCTION notify_pipeline_run_inserted() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('insert_on_pipeline_runs', json_build_object(
+        'id', NEW.id,
+        'pipelineSpecID', NEW.pipeline_spec_id
+    )::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_pipeline_run_inserted AFTER INSERT ON pipeline_runs
+    FOR EACH ROW EXECUTE PROCEDURE notify_pipeline_run_inserted();
+
+CREATE FUNCTION notify_eth_tx_state_changed() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('eth_tx_state_changed', json_build_object(
+        'id', NEW.id,
+        'evmChainID', NEW.evm_chain_id::text,
+        'state', NEW.state
+    )::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_eth_tx_state_inserted AFTER INSERT ON eth_txes
+    FOR EACH ROW EXECUTE PROCEDURE notify_eth_tx_state_changed();
+CREATE TRIGGER notify_eth_tx_state_updated AFTER UPDATE OF state ON eth_txes
+    FOR EACH ROW WHEN (OLD.state IS DISTINCT FROM NEW.state) EXECUTE PROCEDURE notify_eth_tx_state_changed();
+
+CREATE FUNCTION notify_job_proposal_changed() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('job_proposal_changed', json_build_object(
+        'id', NEW.id,
+        'feedsManagerID', NEW.feeds_manager_id
+    )::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_job_proposal_changed AFTER INSERT OR UPDATE ON job_proposals
+    FOR EACH ROW EXECUTE PROCEDURE notify_job_proposal_changed();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER notify_job_proposal_changed ON job_proposals;
+DROP FUNCTION notify_job_proposal_changed;
+DROP TRIGGER notify_eth_tx_state_updated ON eth_txes;
+DROP TRIGGER notify_eth_tx_state_inserted ON eth_txes;
+DROP FUNCTION notify_eth_tx_state_changed;
+DROP TRIGGER notify_pipeline_run_inserted ON pipeline_runs;
+DROP FUNCTION notify_pipeline_run_inserted;
+
+-- +goose StatementEnd
@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- notification_deliveries is the queue of webhook notifications. Deliveries
+-- are leased by pushing back next_attempt_at while they are being sent.
+CREATE TABLE notification_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_name text NOT NULL,
+    event text NOT NULL,
+    payload jsonb NOT NULL,
+    state text NOT NULL DEFAULT 'pending' CHECK (state IN ('pending', 'delivered', 'failed')),
+    attempts bigint NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL,
+    last_error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_notification_deliveries_next_attempt_at ON notification_deliveries (next_attempt_at) WHERE state = 'pending';
+CREATE INDEX idx_notification_deliveries_updated_at ON notification_deliveries (updated_at) WHERE state <> 'pending';
+
+-- Descriptions are truncated to keep payloads under the 8000 bytes limit of
+-- notifications.
+CREATE FUNCTION notify_job_spec_error_recorded() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('job_spec_error_recorded', json_build_object(
+        'id', NEW.id,
+        'jobID', NEW.job_id,
+        'occurrences', NEW.occurrences,
+        'description', left(NEW.description, 1000)
+    )::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_job_spec_error_recorded AFTER INSERT OR UPDATE OF occurrences ON job_spec_errors
+    FOR EACH ROW EXECUTE PROCEDURE notify_job_spec_error_recorded();
+
+CREATE FUNCTION notify_job_proposal_spec_created() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('job_proposal_spec_created', json_build_object(
+        'id', NEW.id,
+        'jobProposalID', NEW.job_proposal_id,
+        'version', NEW.version
+    )::text);
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_job_proposal_spec_created AFTER INSERT ON job_proposal_specs
+    FOR EACH ROW EXECUTE PROCEDURE notify_job_proposal_spec_created();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER notify_job_proposal_spec_created ON job_proposal_specs;
+DROP FUNCTION notify_job_proposal_spec_created;
+DROP TRIGGER notify_job_spec_error_recorded ON job_spec_errors;
+DROP FUNCTION notify_job_spec_error_recorded;
+DROP TABLE notification_deliveries;
+
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- job_shard_members are the nodes sharing jobs. A member is up until its
+-- membership expires, and the leader is the member which joined first.
+CREATE TABLE job_shard_members (
+    name text PRIMARY KEY,
+    client_id uuid NOT NULL,
+    joined_at timestamptz NOT NULL,
+    expires_at timestamptz NOT NULL
+);
+
+-- job_leases are held by the members running jobs, so that a job moving
+-- between members never runs on both.
+CREATE TABLE job_leases (
+    job_id integer PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    member_name text NOT NULL,
+    expires_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_job_leases_member_name ON job_leases (member_name);
+
+-- job_shard_assignments pin jobs to members, instead of hashing their ID.
+CREATE TABLE job_shard_assignments (
+    job_id integer PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    member_name text NOT NULL CHECK (member_name <> ''),
+    created_at timestamptz NOT NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE job_shard_assignments;
+DROP TABLE job_leases;
+DROP TABLE job_shard_members;
+
+-- +goose StatementEnd
@@ -0,0 +1,72 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AuditLogController queries and verifies the local audit log.
+type AuditLogController struct {
+	App chainlink.Application
+}
+
+// Index returns paginated audit events, most recent first. Events can be
+// filtered by user, event type and an RFC3339 time range.
+// Example:
+//
+//	"<application>/audit_log?user=<email>&event=<eventID>&from=<time>&to=<time>"
+func (ac *AuditLogController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseAuditEventFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	events, count, err := ac.App.AuditLogORM().Events(filter, offset, size)
+	resources := make([]presenters.AuditEventResource, len(events))
+	for i, e := range events {
+		resources[i] = presenters.NewAuditEventResource(e)
+	}
+	paginatedResponse(c, "audit events", size, page, resources, count, err)
+}
+
+func parseAuditEventFilter(c *gin.Context) (filter audit.EventFilter, err error) {
+	filter.User = c.Query("user")
+	filter.EventID = audit.EventID(c.Query("event"))
+	if s := c.Query("from"); s != "" {
+		if filter.From, err = time.Parse(time.RFC3339, s); err != nil {
+			return filter, errors.Wrap(err, "invalid from")
+		}
+	}
+	if s := c.Query("to"); s != "" {
+		if filter.To, err = time.Parse(time.RFC3339, s); err != nil {
+			return filter, errors.Wrap(err, "invalid to")
+		}
+	}
+	return
+}
+
+// Verify checks the hash chain of the audit log, reporting the first event
+// which was tampered with.
+// Example:
+//
+//	"<application>/audit_log/verify"
+func (ac *AuditLogController) Verify(c *gin.Context) {
+	v, err := ac.App.AuditLogORM().Verify()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	ac.App.GetAuditLogger().Audit(audit.AuditLogVerified, map[string]interface{}{
+		"valid":          v.Valid(),
+		"events":         v.Events,
+		"invalidEventID": v.InvalidEventID,
+	})
+	jsonAPIResponse(c, presenters.NewAuditLogVerificationResource(v), "audit_log_verification")
+}
@@ -0,0 +1,61 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestAuditLogController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Post("/v2/users", bytes.NewBufferString(`{"email": "auditor@chainlink.test", "password": "`+cltest.Password+`", "role": "view"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	// events are persisted asynchronously
+	var events []presenters.AuditEventResource
+	require.Eventually(t, func() bool {
+		resp, cleanup := client.Get("/v2/audit_log?user=auditor@chainlink.test")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &events))
+		return len(events) > 0
+	}, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+	assert.Equal(t, string(audit.UserCreated), events[0].EventID)
+	assert.Equal(t, "auditor@chainlink.test", events[0].User)
+	assert.JSONEq(t, `{"user":"auditor@chainlink.test","role":"view"}`, string(events[0].Data))
+
+	resp, cleanup = client.Get("/v2/audit_log?from=yesterday")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/audit_log/verify")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var verification presenters.AuditLogVerificationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &verification))
+	assert.True(t, verification.Valid)
+	assert.NotZero(t, verification.Events)
+
+	viewOnly := cltest.CreateUserWithRole(t, sessions.UserRoleView)
+	require.NoError(t, app.SessionORM().CreateUser(&viewOnly))
+	viewer := app.NewHTTPClient(viewOnly.Email)
+	resp, cleanup = viewer.Get("/v2/audit_log")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+}
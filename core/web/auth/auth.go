@@ -41,6 +41,8 @@ type Authenticator interface {
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
 	FindUser(email string) (clsessions.User, error)
 	FindUserByAPIToken(apiToken string) (clsessions.User, error)
+	FindUserByNamedAPIToken(accessKey string) (clsessions.User, clsessions.APIToken, error)
+	MarkAPITokenUsed(id int64) error
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -70,7 +72,8 @@ func AuthenticateBySession(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateBySession
 
-// AuthenticateByToken authenticates a User by their API token.
+// AuthenticateByToken authenticates a User by their API token, or one of
+// their named API tokens.
 //
 // Implements authMethod
 func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
@@ -83,7 +86,7 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 	user, err := authr.FindUserByAPIToken(token.AccessKey)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return auth.ErrorAuthFailed
+			return authenticateByNamedToken(c, authr, token)
 		}
 		return err
 	}
@@ -103,6 +106,31 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateByToken
 
+func authenticateByNamedToken(c *gin.Context, authr Authenticator, token *auth.Token) error {
+	user, apiToken, err := authr.FindUserByNamedAPIToken(token.AccessKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.ErrorAuthFailed
+		}
+		return err
+	}
+
+	ok, err := clsessions.AuthenticateAPIToken(token, &apiToken, c.ClientIP())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.ErrorAuthFailed
+	}
+	if err := authr.MarkAPITokenUsed(apiToken.ID); err != nil {
+		return err
+	}
+
+	c.Set(SessionUserKey, &user)
+
+	return nil
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -187,45 +215,31 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 }
 
 // RequiresRunRole extracts the user object from the context, and asserts the the user's role is at least
-// 'run'
+// 'run' on the resource of the route
 func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role == clsessions.UserRoleView {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
-		}
-		handler(c)
-	}
+	return requiresRole(clsessions.UserRoleRun, handler)
 }
 
 // RequiresEditRole extracts the user object from the context, and asserts the the user's role is at least
-// 'edit'
+// 'edit' on the resource of the route
 func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
-		}
-		handler(c)
-	}
+	return requiresRole(clsessions.UserRoleEdit, handler)
 }
 
 // RequiresAdminRole extracts the user object from the context, and asserts the the user's role is 'admin'
+// on the resource of the route
 func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
+	return requiresRole(clsessions.UserRoleAdmin, handler)
+}
+
+// AuthorizeView is middleware which asserts the user's role is at least 'view'
+// on the resource of the route. Users with a built-in role can view everything,
+// so this only restricts users with a custom role.
+func AuthorizeView(c *gin.Context) {
+	requiresRole(clsessions.UserRoleView, (*gin.Context).Next)(c)
+}
+
+func requiresRole(role clsessions.UserRole, handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
 		if !ok {
@@ -233,7 +247,8 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role != clsessions.UserRoleAdmin {
+		resource, scope, ok := resourceForRoute(c)
+		if ok && !user.Authorized(resource, role, scope) {
 			c.Abort()
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
 			return
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"database/sql"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -102,6 +104,71 @@ func TestAuthenticateByToken_AuthFailed(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+type namedTokenFinder struct {
+	sessions.ORM
+	user     sessions.User
+	apiToken sessions.APIToken
+	usedIDs  []int64
+}
+
+func (n *namedTokenFinder) FindUserByAPIToken(token string) (sessions.User, error) {
+	return sessions.User{}, sql.ErrNoRows
+}
+
+func (n *namedTokenFinder) FindUserByNamedAPIToken(accessKey string) (sessions.User, sessions.APIToken, error) {
+	if accessKey != n.apiToken.TokenKey {
+		return sessions.User{}, sessions.APIToken{}, sql.ErrNoRows
+	}
+	return n.user, n.apiToken, nil
+}
+
+func (n *namedTokenFinder) MarkAPITokenUsed(id int64) error {
+	n.usedIDs = append(n.usedIDs, id)
+	return nil
+}
+
+func TestAuthenticateByToken_NamedToken(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	apiToken, token, err := sessions.NewAPIToken(user.Email, sessions.APITokenRequest{
+		Name:       "ci",
+		AllowedIPs: []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+	apiToken.ID = 7
+	authr := &namedTokenFinder{user: user, apiToken: apiToken}
+
+	router := gin.New()
+	router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+	router.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, "")
+	})
+
+	for _, test := range []struct {
+		name       string
+		accessKey  string
+		secret     string
+		remoteAddr string
+		expected   int
+	}{
+		{"allowed", token.AccessKey, token.Secret, "10.1.2.3:1234", http.StatusOK},
+		{"ip not allowed", token.AccessKey, token.Secret, "192.168.1.1:1234", http.StatusUnauthorized},
+		{"wrong secret", token.AccessKey, "bad-secret", "10.1.2.3:1234", http.StatusUnauthorized},
+		{"unknown key", "unknown", token.Secret, "10.1.2.3:1234", http.StatusUnauthorized},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/", nil)
+			req.RemoteAddr = test.remoteAddr
+			req.Header.Set(webauth.APIKey, test.accessKey)
+			req.Header.Set(webauth.APISecret, test.secret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusText(test.expected), http.StatusText(w.Code))
+		})
+	}
+	assert.Equal(t, []int64{7}, authr.usedIDs)
+}
+
 func TestRequireAuth_NoneRequired(t *testing.T) {
 	called := false
 	var authr webauth.Authenticator
@@ -190,9 +257,17 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/users", false, false, false},
 	{"POST", "/v2/users", false, false, false},
 	{"PATCH", "/v2/users", false, false, false},
+	{"PATCH", "/v2/users/ldap", false, false, false},
 	{"DELETE", "/v2/users/MOCK", false, false, false},
+	{"GET", "/v2/roles", false, false, false},
+	{"POST", "/v2/roles", false, false, false},
+	{"PATCH", "/v2/roles/MOCK", false, false, false},
+	{"DELETE", "/v2/roles/MOCK", false, false, false},
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
+	{"GET", "/v2/user/tokens", true, true, true},
+	{"POST", "/v2/user/tokens", true, true, true},
+	{"POST", "/v2/user/tokens/MOCK/revoke", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
 	{"GET", "/v2/enroll_webauthn", true, true, true},
 	{"POST", "/v2/enroll_webauthn", true, true, true},
@@ -211,6 +286,8 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/config", true, true, true},
 	{"PATCH", "/v2/config", false, false, false},
 	{"GET", "/v2/config/v2", true, true, true},
+	{"GET", "/v2/database/queries", false, false, false},
+	{"GET", "/v2/debug/support", false, false, false},
 	{"GET", "/v2/tx_attempts", true, true, true},
 	{"GET", "/v2/tx_attempts/evm", true, true, true},
 	{"GET", "/v2/transactions/evm", true, true, true},
@@ -488,3 +565,67 @@ func TestRBAC_Routemap_ViewOnly(t *testing.T) {
 		}()
 	}
 }
+
+func TestRBAC_Routemap_CustomRole(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	router := web.Router(t, app, nil)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	role, err := sessions.NewRole("bridge-admins", []string{"bridges:admin", "chains:edit:evm/5"})
+	require.NoError(t, err)
+	require.NoError(t, app.SessionORM().CreateRole(&role))
+
+	// Create a test user with the custom role to work with
+	testUser := cltest.CreateUserWithRole(t, sessions.UserRoleView)
+	testUser.CustomRole = null.StringFrom(role.Name)
+	require.NoError(t, app.SessionORM().CreateUser(&testUser))
+	client := app.NewHTTPClient(testUser.Email)
+
+	for _, route := range []struct {
+		verb    string
+		path    string
+		allowed bool
+	}{
+		{"GET", "/v2/bridge_types", true},
+		{"POST", "/v2/bridge_types", true},
+		{"DELETE", "/v2/bridge_types/MOCK", true},
+		{"PATCH", "/v2/chains/evm/5", true},
+		{"PATCH", "/v2/chains/evm/4", false},
+		{"GET", "/v2/chains/evm", false},
+		{"GET", "/v2/jobs", false},
+		{"GET", "/v2/keys/eth", false},
+		{"GET", "/v2/users", false},
+		{"PATCH", "/v2/user/password", true},
+		{"GET", "/v2/build_info", true},
+		{"GET", "/v2/ping", true},
+		{"POST", "/v2/jobs/MOCK/runs", false},
+	} {
+		func() {
+			var resp *http.Response
+			var cleanup func()
+
+			switch route.verb {
+			case "GET":
+				resp, cleanup = client.Get(route.path)
+			case "POST":
+				resp, cleanup = client.Post(route.path, nil)
+			case "DELETE":
+				resp, cleanup = client.Delete(route.path)
+			case "PATCH":
+				resp, cleanup = client.Patch(route.path, nil)
+			default:
+				t.Fatalf("Unknown HTTP verb %s\n", route.verb)
+			}
+			defer cleanup()
+
+			if route.allowed {
+				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode, "%s %s", route.verb, route.path)
+			} else {
+				assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "%s %s", route.verb, route.path)
+			}
+		}()
+	}
+}
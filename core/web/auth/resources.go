@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// routeResources maps the first segment of /v2 routes to the resource they
+// operate on.
+var routeResources = map[string]clsessions.Resource{
+	"audit_log":           clsessions.ResourceAuditLog,
+	"bridge_types":        clsessions.ResourceBridges,
+	"chains":              clsessions.ResourceChains,
+	"config":              clsessions.ResourceConfig,
+	"debug":               clsessions.ResourceDebug,
+	"external_initiators": clsessions.ResourceExternalInitiators,
+	"jobs":                clsessions.ResourceJobs,
+	"keys":                clsessions.ResourceKeys,
+	"log":                 clsessions.ResourceConfig,
+	"logs":                clsessions.ResourceChains,
+	"nodes":               clsessions.ResourceNodes,
+	"pipeline":            clsessions.ResourceJobs,
+	"reorgs":              clsessions.ResourceChains,
+	"replay_from_block":   clsessions.ResourceChains,
+	"roles":               clsessions.ResourceUsers,
+	"transactions":        clsessions.ResourceTransactions,
+	"transfers":           clsessions.ResourceTransactions,
+	"tx_attempts":         clsessions.ResourceTransactions,
+	"users":               clsessions.ResourceUsers,
+}
+
+// selfServiceRoutes are available to every authenticated user, as they only
+// concern the user themselves or the node build.
+var selfServiceRoutes = map[string]bool{
+	"build_info":      true,
+	"enroll_webauthn": true,
+	"features":        true,
+	"ping":            true,
+	"user":            true,
+}
+
+// resourceForRoute returns the resource of the matched route, and the scope
+// of the operation, e.g. "evm/5" for a single chain. ok is false when the
+// route is self-service and does not need to be authorized. Unknown routes
+// can only be accessed with permissions on all resources.
+func resourceForRoute(c *gin.Context) (resource clsessions.Resource, scope string, ok bool) {
+	segments := strings.Split(strings.TrimPrefix(c.FullPath(), "/v2/"), "/")
+	if selfServiceRoutes[segments[0]] {
+		return "", "", false
+	}
+	resource, known := routeResources[segments[0]]
+	if !known {
+		return clsessions.ResourceAll, "", true
+	}
+	// /v2/chains/<type>/:ID[/nodes]
+	if resource == clsessions.ResourceChains && segments[0] == "chains" && len(segments) > 2 {
+		scope = segments[1] + "/" + c.Param("ID")
+	}
+	return resource, scope, true
+}
@@ -75,6 +75,51 @@ func (c *ConfigV2Resource) SetID(string) error {
 	return nil
 }
 
+// ConfigReloadResource reports the changed fields of a configuration reload,
+// by their dotted TOML paths.
+type ConfigReloadResource struct {
+	Applied         []string `json:"applied"`
+	RestartRequired []string `json:"restartRequired"`
+}
+
+func (c ConfigReloadResource) GetID() string {
+	return utils.NewBytes32ID()
+}
+
+func (c *ConfigReloadResource) SetID(string) error {
+	return nil
+}
+
+// Reload reloads the TOML configuration, applying the changes which are safe
+// to apply live, and reports the changes which require a restart.
+// Example:
+//
+//	"<application>/config/reload"
+func (cc *ConfigController) Reload(c *gin.Context) {
+	if _, ok := cc.App.GetConfig().(chainlink.ConfigV2); !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("unsupported with legacy ENV config"))
+		return
+	}
+	report, err := cc.App.ReloadConfig(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	response := ConfigReloadResource{Applied: report.Applied, RestartRequired: report.RestartRequired}
+	if response.Applied == nil {
+		response.Applied = []string{}
+	}
+	if response.RestartRequired == nil {
+		response.RestartRequired = []string{}
+	}
+
+	cc.App.GetAuditLogger().Audit(audit.ConfigReloaded, map[string]interface{}{
+		"applied":         response.Applied,
+		"restartRequired": response.RestartRequired,
+	})
+	jsonAPIResponse(c, &response, "config")
+}
+
 func (cc *ConfigController) Dump(c *gin.Context) {
 	cfg := cc.App.GetConfig()
 	if _, ok := cfg.(chainlink.ConfigV2); ok {
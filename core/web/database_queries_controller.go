@@ -0,0 +1,51 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// defaultDatabaseQueriesLimit is the number of statements listed by default.
+const defaultDatabaseQueriesLimit = 20
+
+// DatabaseQueriesController lists the slowest SQL statements executed by the
+// node since it started.
+type DatabaseQueriesController struct {
+	App chainlink.Application
+}
+
+// Index returns the slowest statements, with the functions which issued them.
+// Statements are sorted by their maximum duration by default, or by their
+// mean or total duration.
+// Example:
+//
+//	"<application>/database/queries?limit=<n>&sort=<max|mean|total>"
+func (dc *DatabaseQueriesController) Index(c *gin.Context) {
+	limit := defaultDatabaseQueriesLimit
+	if s := c.Query("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit < 1 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid limit %q: must be a positive integer", s))
+			return
+		}
+	}
+	by, err := pg.ParseQueryStatsSort(c.Query("sort"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	stats := pg.SlowestQueries(limit, by)
+	resources := make([]presenters.DatabaseQueryResource, len(stats))
+	for i, s := range stats {
+		resources[i] = presenters.NewDatabaseQueryResource(s)
+	}
+	jsonAPIResponse(c, resources, "databaseQueries")
+}
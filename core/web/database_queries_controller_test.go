@@ -0,0 +1,42 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestDatabaseQueriesController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Get("/v2/database/queries?limit=5&sort=total")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var queries []presenters.DatabaseQueryResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &queries))
+	require.NotEmpty(t, queries)
+	assert.LessOrEqual(t, len(queries), 5)
+	for i := 1; i < len(queries); i++ {
+		assert.GreaterOrEqual(t, queries[i-1].TotalMS, queries[i].TotalMS)
+	}
+	assert.NotEmpty(t, queries[0].CallSites)
+
+	resp, cleanup = client.Get("/v2/database/queries?limit=0")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/database/queries?sort=calls")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
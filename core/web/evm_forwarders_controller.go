@@ -4,9 +4,13 @@ import (
 	"net/http"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
 	"github.com/smartcontractkit/chainlink/core/chains/evm/forwarders"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -76,7 +80,7 @@ func (cc *EVMForwardersController) Delete(c *gin.Context) {
 	}
 
 	orm := forwarders.NewORM(cc.App.GetSqlxDB(), cc.App.GetLogger(), cc.App.GetConfig())
-	err = orm.DeleteForwarder(id)
+	err = orm.DeleteForwarder(id, cc.unregisterFilter)
 
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -86,3 +90,19 @@ func (cc *EVMForwardersController) Delete(c *gin.Context) {
 	cc.App.GetAuditLogger().Audit(audit.ForwarderDeleted, map[string]interface{}{"id": id})
 	jsonAPIResponseWithStatus(c, nil, "forwarder", http.StatusNoContent)
 }
+
+// unregisterFilter removes the log poller filter of a deleted forwarder, so that its logs are no longer saved.
+func (cc *EVMForwardersController) unregisterFilter(tx pg.Queryer, evmChainID utils.Big, addr common.Address) error {
+	name := forwarders.FilterName(addr)
+	chain, err := cc.App.GetChains().EVM.Get(evmChainID.ToInt())
+	if err != nil {
+		// The chain is not running, so only the persisted filter is left.
+		orm := logpoller.NewORM(evmChainID.ToInt(), cc.App.GetSqlxDB(), cc.App.GetLogger(), cc.App.GetConfig())
+		return orm.DeleteFilter(name, pg.WithQueryer(tx))
+	}
+	err = chain.LogPoller().UnregisterFilter(name, pg.WithQueryer(tx))
+	if errors.Is(err, logpoller.ErrFilterNotFound) || errors.Is(err, logpoller.ErrDisabled) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// MaxEVMLogsPageSize is the largest page of logs returned by EVMLogsController.
+const MaxEVMLogsPageSize = 1000
+
+// EVMLogsController displays the logs saved by the EVM log poller, for debugging.
+type EVMLogsController struct {
+	App chainlink.Application
+}
+
+// Index returns a page of the logs of an event emitted by a contract in a block range. The arguments of the logs
+// are decoded if the event ABI was registered with the log poller. Logs which fail to decode are returned with
+// their decoding error instead.
+// Example:
+//
+//	"<application>/logs/evm?address=0x...&eventSig=0x...&fromBlock=1&toBlock=100&size=25&page=1"
+func (lc *EVMLogsController) Index(c *gin.Context, size, page, offset int) {
+	if size > MaxEVMLogsPageSize {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid size param: must be at most %d", MaxEVMLogsPageSize))
+		return
+	}
+	chain, err := getChain(lc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	addressHex := c.Query("address")
+	if !common.IsHexAddress(addressHex) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid address: %q", addressHex))
+		return
+	}
+	eventSigBytes, err := hexutil.Decode(c.Query("eventSig"))
+	if err != nil || len(eventSigBytes) != common.HashLength {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid eventSig: %q", c.Query("eventSig")))
+		return
+	}
+	eventSig := common.BytesToHash(eventSigBytes)
+
+	lp := chain.LogPoller()
+	var fromBlock, toBlock int64
+	if s := c.Query("fromBlock"); s != "" {
+		if fromBlock, err = strconv.ParseInt(s, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid fromBlock"))
+			return
+		}
+	}
+	if s := c.Query("toBlock"); s != "" {
+		if toBlock, err = strconv.ParseInt(s, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid toBlock"))
+			return
+		}
+	} else if toBlock, err = lp.LatestBlock(pg.WithParentCtx(c.Request.Context())); errors.Is(err, sql.ErrNoRows) {
+		// Nothing has been polled yet.
+		paginatedResponse(c, "logs", size, page, []presenters.EVMLogResource{}, 0, nil)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	logs, count, err := lp.LogsPage(fromBlock, toBlock, eventSig, common.HexToAddress(addressHex), offset, size, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	event, decode := lp.EventABI(eventSig)
+	resources := make([]presenters.EVMLogResource, len(logs))
+	for i, l := range logs {
+		if !decode {
+			resources[i] = presenters.NewEVMLogResource(l, "", nil)
+			continue
+		}
+		args, err := logpoller.DecodeLogArgs(event, l)
+		if err != nil {
+			resources[i] = presenters.NewEVMLogResource(l, event.Name, nil)
+			resources[i].DecodeError = err.Error()
+			continue
+		}
+		resources[i] = presenters.NewEVMLogResource(l, event.Name, args)
+	}
+
+	paginatedResponse(c, "logs", size, page, resources, count, nil)
+}
@@ -0,0 +1,110 @@
+package web_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/log_emitter"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestEVMLogsController_Index(t *testing.T) {
+	t.Parallel()
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Feature.LogPoller = ptr(true)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, ethClient)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	chain, err := app.GetChains().EVM.Default()
+	require.NoError(t, err)
+
+	emitterABI, err := abi.JSON(strings.NewReader(log_emitter.LogEmitterABI))
+	require.NoError(t, err)
+	event := emitterABI.Events["Log1"]
+	address := testutils.NewAddress()
+	require.NoError(t, chain.LogPoller().RegisterFilter(logpoller.Filter{
+		Name:      "EVMLogsController test",
+		Events:    []abi.Event{event},
+		Addresses: []common.Address{address},
+	}))
+
+	// Logs 1-4 decode, log 5 has invalid data.
+	var logs []logpoller.Log
+	for i := int64(1); i <= 5; i++ {
+		data := common.BigToHash(big.NewInt(i)).Bytes()
+		if i == 5 {
+			data = []byte{1}
+		}
+		logs = append(logs, logpoller.Log{
+			EvmChainId:  utils.NewBig(chain.ID()),
+			BlockHash:   utils.NewHash(),
+			BlockNumber: i,
+			Topics:      [][]byte{event.ID.Bytes()},
+			EventSig:    event.ID,
+			Address:     address,
+			TxHash:      utils.NewHash(),
+			Data:        data,
+		})
+	}
+	orm := logpoller.NewORM(chain.ID(), app.GetSqlxDB(), app.GetLogger(), pgtest.NewQConfig(true))
+	require.NoError(t, orm.InsertLogs(logs))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	url := fmt.Sprintf("/v2/logs/evm?address=%s&eventSig=%s&fromBlock=1&toBlock=10", address, event.ID)
+
+	resp, cleanup := client.Get(url + "&size=2&page=2")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	body := cltest.ParseResponseBody(t, resp)
+	count, err := cltest.ParseJSONAPIResponseMetaCount(body)
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+	var links jsonapi.Links
+	var page []presenters.EVMLogResource
+	require.NoError(t, web.ParsePaginatedResponse(body, &page, &links))
+	require.Len(t, page, 2)
+	assert.Equal(t, int64(3), page[0].BlockNumber)
+	assert.Equal(t, "Log1", page[0].Event)
+	assert.NotEmpty(t, page[0].Args)
+	assert.Empty(t, page[0].DecodeError)
+
+	// A log which cannot be decoded does not fail the others.
+	resp, cleanup = client.Get(url + "&size=2&page=3")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	body = cltest.ParseResponseBody(t, resp)
+	page = nil
+	require.NoError(t, web.ParsePaginatedResponse(body, &page, &links))
+	require.Len(t, page, 1)
+	assert.Equal(t, int64(5), page[0].BlockNumber)
+	assert.Empty(t, page[0].Args)
+	assert.NotEmpty(t, page[0].DecodeError)
+
+	resp, cleanup = client.Get(url + "&size=0")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get(fmt.Sprintf("%s&size=%d", url, web.MaxEVMLogsPageSize+1))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// EVMReorgsController displays the reorgs detected on EVM chains.
+type EVMReorgsController struct {
+	App chainlink.Application
+}
+
+// Index returns paginated reorgs, most recent first.
+func (rc *EVMReorgsController) Index(c *gin.Context, size, page, offset int) {
+	reorgs, count, err := rc.App.ReorgORM().Reorgs(offset, size)
+	resources := make([]presenters.EVMReorgResource, len(reorgs))
+	for i, r := range reorgs {
+		resources[i] = presenters.NewEVMReorgResource(r)
+	}
+	paginatedResponse(c, "reorgs", size, page, resources, count, err)
+}
+
+// Show returns the details of a reorg.
+// Example:
+//
+//	"<application>/reorgs/evm/:ID"
+func (rc *EVMReorgsController) Show(c *gin.Context) {
+	id, err := stringutils.ToInt64(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	r, err := rc.App.ReorgORM().FindReorg(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Reorg not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMReorgResource(r), "reorg")
+}
@@ -0,0 +1,326 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/loader"
+)
+
+// graphqlWSProtocol is the graphql-transport-ws WebSocket sub-protocol, as
+// implemented by the graphql-ws client library:
+// https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+const graphqlWSProtocol = "graphql-transport-ws"
+
+// graphql-transport-ws message types
+const (
+	gqlWSConnectionInit = "connection_init"
+	gqlWSConnectionAck  = "connection_ack"
+	gqlWSPing           = "ping"
+	gqlWSPong           = "pong"
+	gqlWSSubscribe      = "subscribe"
+	gqlWSNext           = "next"
+	gqlWSError          = "error"
+	gqlWSComplete       = "complete"
+)
+
+// graphql-transport-ws close codes
+const (
+	gqlWSCloseBadRequest         = 4400
+	gqlWSCloseUnauthorized       = 4401
+	gqlWSCloseForbidden          = 4403
+	gqlWSCloseInitTimeout        = 4408
+	gqlWSCloseSubscriberExists   = 4409
+	gqlWSCloseTooManyInitRequest = 4429
+)
+
+var (
+	// gqlWSInitTimeout is how long clients have to initialise the connection.
+	gqlWSInitTimeout = 10 * time.Second
+	// gqlWSSessionCheckInterval is how often the session of the connection is
+	// checked, so that subscriptions stop when users log out.
+	gqlWSSessionCheckInterval = time.Minute
+	gqlWSWriteTimeout         = 10 * time.Second
+)
+
+type gqlWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type gqlWSSubscribePayload struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// graphqlWSHandler serves GraphQL operations over WebSocket, which is required
+// for subscriptions. Like POST /query, users are authenticated by their session
+// cookie.
+func graphqlWSHandler(app chainlink.Application, schema *graphql.Schema) gin.HandlerFunc {
+	upgrader := websocket.Upgrader{
+		Subprotocols: []string{graphqlWSProtocol},
+		// The CORS middleware does not apply to WebSocket handshakes
+		CheckOrigin: gqlWSCheckOrigin(app.GetConfig().AllowOrigins()),
+	}
+	lggr := app.GetLogger().Named("GQLWebSocket")
+	readLimit := app.GetConfig().DefaultHTTPLimit()
+
+	return func(c *gin.Context) {
+		ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			// the upgrader responded already
+			lggr.Debugw("Failed to upgrade GraphQL WebSocket connection", "err", err)
+			return
+		}
+		ws.SetReadLimit(readLimit)
+		conn := &gqlWSConn{
+			ws:         ws,
+			app:        app,
+			schema:     schema,
+			lggr:       lggr,
+			operations: make(map[string]context.CancelFunc),
+		}
+		conn.serve(c.Request.Context())
+	}
+}
+
+// gqlWSCheckOrigin returns a CheckOrigin func accepting the same origins as the
+// CORS middleware, as well as same-origin requests and non-browser clients,
+// which send no Origin header.
+func gqlWSCheckOrigin(allowOrigins string) func(*http.Request) bool {
+	if allowOrigins == "*" {
+		return func(*http.Request) bool { return true }
+	}
+	allowed := strings.Split(allowOrigins, ",")
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		for _, o := range allowed {
+			if strings.EqualFold(strings.TrimSpace(o), origin) {
+				return true
+			}
+		}
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		return strings.EqualFold(u.Host, r.Host)
+	}
+}
+
+// gqlWSConn is a graphql-transport-ws connection.
+type gqlWSConn struct {
+	ws     *websocket.Conn
+	app    chainlink.Application
+	schema *graphql.Schema
+	lggr   logger.Logger
+
+	writeMu sync.Mutex
+
+	operationsMu sync.Mutex
+	operations   map[string]context.CancelFunc // by ID
+	wg           sync.WaitGroup
+}
+
+func (c *gqlWSConn) serve(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		c.wg.Wait()
+		c.ws.Close()
+	}()
+
+	if c.ws.Subprotocol() != graphqlWSProtocol {
+		c.close(websocket.CloseProtocolError, fmt.Sprintf("Subprotocol must be %s", graphqlWSProtocol))
+		return
+	}
+
+	initialised := make(chan struct{})
+	initTimer := time.AfterFunc(gqlWSInitTimeout, func() {
+		select {
+		case <-initialised:
+		default:
+			c.close(gqlWSCloseInitTimeout, "Connection initialisation timeout")
+		}
+	})
+	defer initTimer.Stop()
+
+	for {
+		_, b, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		var msg gqlWSMessage
+		if err = json.Unmarshal(b, &msg); err != nil {
+			c.close(gqlWSCloseBadRequest, "Invalid message received")
+			return
+		}
+
+		switch msg.Type {
+		case gqlWSConnectionInit:
+			select {
+			case <-initialised:
+				c.close(gqlWSCloseTooManyInitRequest, "Too many initialisation requests")
+				return
+			default:
+			}
+			session, ok := auth.GetGQLAuthenticatedSession(ctx)
+			if !ok {
+				c.close(gqlWSCloseForbidden, "Forbidden")
+				return
+			}
+			close(initialised)
+			c.wg.Add(1)
+			go c.checkSession(ctx, session.SessionID)
+			c.write(gqlWSMessage{Type: gqlWSConnectionAck})
+
+		case gqlWSPing:
+			c.write(gqlWSMessage{Type: gqlWSPong, Payload: msg.Payload})
+
+		case gqlWSPong:
+
+		case gqlWSSubscribe:
+			select {
+			case <-initialised:
+			default:
+				c.close(gqlWSCloseUnauthorized, "Unauthorized")
+				return
+			}
+			var payload gqlWSSubscribePayload
+			if msg.ID == "" || json.Unmarshal(msg.Payload, &payload) != nil {
+				c.close(gqlWSCloseBadRequest, "Invalid message received")
+				return
+			}
+			if !c.startOperation(ctx, msg.ID, payload) {
+				c.close(gqlWSCloseSubscriberExists, fmt.Sprintf("Subscriber for %s already exists", msg.ID))
+				return
+			}
+
+		case gqlWSComplete:
+			c.stopOperation(msg.ID)
+
+		default:
+			c.close(gqlWSCloseBadRequest, "Invalid message received")
+			return
+		}
+	}
+}
+
+// startOperation executes the operation, streaming its results. It returns
+// false if an operation with the same ID is running.
+func (c *gqlWSConn) startOperation(ctx context.Context, id string, payload gqlWSSubscribePayload) bool {
+	c.operationsMu.Lock()
+	defer c.operationsMu.Unlock()
+	if _, exists := c.operations[id]; exists {
+		return false
+	}
+	// Each operation gets its own dataloader, since subscriptions clear it
+	ctx, cancel := context.WithCancel(loader.InjectDataloader(ctx, c.app))
+	c.operations[id] = cancel
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer cancel()
+
+		responses, err := c.schema.Subscribe(ctx, payload.Query, payload.OperationName, payload.Variables)
+		if err != nil {
+			if c.removeOperation(id) {
+				errs, _ := json.Marshal([]map[string]string{{"message": err.Error()}})
+				c.write(gqlWSMessage{ID: id, Type: gqlWSError, Payload: errs})
+			}
+			return
+		}
+		// responses is closed once ctx is done
+		for resp := range responses {
+			if ctx.Err() != nil {
+				continue
+			}
+			b, err := json.Marshal(resp)
+			if err != nil {
+				c.lggr.Errorw("Failed to serialize GraphQL response", "err", err, "operationID", id)
+				continue
+			}
+			c.write(gqlWSMessage{ID: id, Type: gqlWSNext, Payload: b})
+		}
+		if c.removeOperation(id) {
+			c.write(gqlWSMessage{ID: id, Type: gqlWSComplete})
+		}
+	}()
+	return true
+}
+
+// stopOperation stops the operation, which the client completed.
+func (c *gqlWSConn) stopOperation(id string) {
+	c.operationsMu.Lock()
+	defer c.operationsMu.Unlock()
+	if cancel, exists := c.operations[id]; exists {
+		cancel()
+		delete(c.operations, id)
+	}
+}
+
+// removeOperation removes a completed operation. It returns false if the
+// client completed it first.
+func (c *gqlWSConn) removeOperation(id string) bool {
+	c.operationsMu.Lock()
+	defer c.operationsMu.Unlock()
+	_, exists := c.operations[id]
+	delete(c.operations, id)
+	return exists
+}
+
+// checkSession closes the connection when the session expires or is deleted.
+func (c *gqlWSConn) checkSession(ctx context.Context, sessionID string) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(gqlWSSessionCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.app.SessionORM().AuthorizedUserWithSession(sessionID); err != nil {
+				c.close(gqlWSCloseForbidden, "Forbidden")
+				return
+			}
+		}
+	}
+}
+
+func (c *gqlWSConn) write(msg gqlWSMessage) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.ws.SetWriteDeadline(time.Now().Add(gqlWSWriteTimeout)); err != nil {
+		return
+	}
+	if err := c.ws.WriteJSON(msg); err != nil {
+		c.lggr.Debugw("Failed to write to GraphQL WebSocket", "err", err)
+	}
+}
+
+// close closes the connection with the code, which ends serve.
+func (c *gqlWSConn) close(code int, reason string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	msg := websocket.FormatCloseMessage(code, reason)
+	_ = c.ws.WriteControl(websocket.CloseMessage, msg, time.Now().Add(gqlWSWriteTimeout))
+	c.ws.Close()
+}
@@ -0,0 +1,183 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configMocks "github.com/smartcontractkit/chainlink/core/config/mocks"
+	coremocks "github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/resolver"
+	"github.com/smartcontractkit/chainlink/core/web/schema"
+)
+
+// newGraphQLWSServer serves graphqlWSHandler, and returns its WebSocket URL.
+func newGraphQLWSServer(t *testing.T, authenticated bool) (*pg.NullEventBroadcaster, string) {
+	t.Helper()
+
+	cfg := configMocks.NewGeneralConfig(t)
+	cfg.On("DefaultHTTPLimit").Return(int64(1024 * 1024))
+	cfg.On("AllowOrigins").Return("http://localhost:3000,http://localhost:6688")
+	eb := pg.NewNullEventBroadcaster()
+	app := coremocks.NewApplication(t)
+	app.On("GetConfig").Return(cfg)
+	app.On("GetLogger").Return(logger.Sugared(logger.TestLogger(t)))
+	app.On("GetEventBroadcaster").Return(eb).Maybe()
+
+	engine := gin.New()
+	engine.GET("/query", func(c *gin.Context) {
+		if authenticated {
+			user := clsessions.User{Email: "gqltester@chain.link", Role: clsessions.UserRoleAdmin}
+			c.Request = c.Request.WithContext(auth.SetGQLAuthenticatedSession(c.Request.Context(), user, "session"))
+		}
+	}, graphqlWSHandler(app, graphql.MustParseSchema(schema.MustGetRootSchema(), &resolver.Resolver{App: app})))
+	srv := httptest.NewServer(engine)
+	t.Cleanup(srv.Close)
+	return eb, "ws" + strings.TrimPrefix(srv.URL, "http") + "/query"
+}
+
+func setupGraphQLWS(t *testing.T, authenticated bool) (*pg.NullEventBroadcaster, *websocket.Conn) {
+	t.Helper()
+
+	eb, u := newGraphQLWSServer(t, authenticated)
+	dialer := websocket.Dialer{Subprotocols: []string{graphqlWSProtocol}}
+	ws, _, err := dialer.Dial(u, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { ws.Close() })
+	return eb, ws
+}
+
+func writeGQLWS(t *testing.T, ws *websocket.Conn, msg string) {
+	t.Helper()
+	require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte(msg)))
+}
+
+func readGQLWS(t *testing.T, ws *websocket.Conn) gqlWSMessage {
+	t.Helper()
+	var msg gqlWSMessage
+	require.NoError(t, ws.ReadJSON(&msg))
+	return msg
+}
+
+func assertGQLWSClosed(t *testing.T, ws *websocket.Conn, code int) {
+	t.Helper()
+	_, _, err := ws.ReadMessage()
+	var closeErr *websocket.CloseError
+	require.ErrorAs(t, err, &closeErr)
+	assert.Equal(t, code, closeErr.Code)
+}
+
+func TestGraphQLWS_Subscription(t *testing.T) {
+	t.Parallel()
+
+	eb, ws := setupGraphQLWS(t, true)
+
+	writeGQLWS(t, ws, `{"type":"connection_init"}`)
+	assert.Equal(t, gqlWSConnectionAck, readGQLWS(t, ws).Type)
+
+	writeGQLWS(t, ws, `{"type":"ping"}`)
+	assert.Equal(t, gqlWSPong, readGQLWS(t, ws).Type)
+
+	writeGQLWS(t, ws, `{"id":"1","type":"subscribe","payload":{"query":"subscription { rpcNodeStateChanged { name state } }"}}`)
+	eb.Sub.Ch <- pg.Event{Payload: `{"evmChainID":"1","name":"primary","from":"Alive","to":"OutOfSync"}`}
+	msg := readGQLWS(t, ws)
+	assert.Equal(t, gqlWSNext, msg.Type)
+	assert.Equal(t, "1", msg.ID)
+	assert.JSONEq(t, `{"data":{"rpcNodeStateChanged":{"name":"primary","state":"OutOfSync"}}}`, string(msg.Payload))
+
+	writeGQLWS(t, ws, `{"id":"1","type":"subscribe","payload":{"query":"subscription { rpcNodeStateChanged { name state } }"}}`)
+	assertGQLWSClosed(t, ws, gqlWSCloseSubscriberExists)
+}
+
+func TestGraphQLWS_Query(t *testing.T) {
+	t.Parallel()
+
+	_, ws := setupGraphQLWS(t, true)
+
+	writeGQLWS(t, ws, `{"type":"connection_init"}`)
+	assert.Equal(t, gqlWSConnectionAck, readGQLWS(t, ws).Type)
+
+	// operations other than subscriptions complete after their result
+	writeGQLWS(t, ws, `{"id":"q","type":"subscribe","payload":{"query":"{ unknownField }"}}`)
+	msg := readGQLWS(t, ws)
+	assert.Equal(t, gqlWSNext, msg.Type)
+	var resp struct{ Errors []json.RawMessage }
+	require.NoError(t, json.Unmarshal(msg.Payload, &resp))
+	assert.Len(t, resp.Errors, 1)
+	assert.Equal(t, gqlWSMessage{ID: "q", Type: gqlWSComplete}, readGQLWS(t, ws))
+}
+
+func TestGraphQLWS_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not initialised", func(t *testing.T) {
+		_, ws := setupGraphQLWS(t, true)
+		writeGQLWS(t, ws, `{"id":"1","type":"subscribe","payload":{"query":"subscription { rpcNodeStateChanged { name } }"}}`)
+		assertGQLWSClosed(t, ws, gqlWSCloseUnauthorized)
+	})
+
+	t.Run("initialised twice", func(t *testing.T) {
+		_, ws := setupGraphQLWS(t, true)
+		writeGQLWS(t, ws, `{"type":"connection_init"}`)
+		assert.Equal(t, gqlWSConnectionAck, readGQLWS(t, ws).Type)
+		writeGQLWS(t, ws, `{"type":"connection_init"}`)
+		assertGQLWSClosed(t, ws, gqlWSCloseTooManyInitRequest)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, ws := setupGraphQLWS(t, false)
+		writeGQLWS(t, ws, `{"type":"connection_init"}`)
+		assertGQLWSClosed(t, ws, gqlWSCloseForbidden)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		_, ws := setupGraphQLWS(t, true)
+		writeGQLWS(t, ws, `not json`)
+		assertGQLWSClosed(t, ws, gqlWSCloseBadRequest)
+	})
+}
+
+func TestGraphQLWS_CheckOrigin(t *testing.T) {
+	t.Parallel()
+
+	_, u := newGraphQLWSServer(t, true)
+	dialer := websocket.Dialer{Subprotocols: []string{graphqlWSProtocol}}
+
+	for _, tt := range []struct {
+		origin  string
+		allowed bool
+	}{
+		{"", true},
+		{"http://localhost:3000", true},
+		{"http://" + strings.TrimPrefix(strings.TrimSuffix(u, "/query"), "ws://"), true},
+		{"http://evil.example.com", false},
+	} {
+		tt := tt
+		t.Run(tt.origin, func(t *testing.T) {
+			header := http.Header{}
+			if tt.origin != "" {
+				header.Set("Origin", tt.origin)
+			}
+			ws, resp, err := dialer.Dial(u, header)
+			if tt.allowed {
+				require.NoError(t, err)
+				ws.Close()
+				return
+			}
+			require.ErrorIs(t, err, websocket.ErrBadHandshake)
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		})
+	}
+}
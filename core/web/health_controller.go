@@ -93,3 +93,18 @@ func (hc *HealthController) Health(c *gin.Context) {
 	// return a json description of all the checks
 	jsonAPIResponse(c, checks, "checks")
 }
+
+// Components returns the health of the application as a tree of its services and their components, with the time of
+// their last change of status. It responds with 503 only if the application is failing: a degraded application, with
+// only some of its components failing, still serves.
+func (hc *HealthController) Components(c *gin.Context) {
+	status := http.StatusOK
+
+	report := hc.App.GetHealthChecker().Report()
+
+	if report.Status == services.StatusFailing {
+		status = http.StatusServiceUnavailable
+	}
+
+	c.JSON(status, presenters.NewComponentHealth(report))
+}
@@ -1,12 +1,15 @@
 package web_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/mocks"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,3 +50,44 @@ func TestHealthController_Readyz(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthController_Components(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		status services.Status
+		code   int
+	}{
+		{"passing", services.StatusPassing, http.StatusOK},
+		{"degraded", services.StatusDegraded, http.StatusOK},
+		{"failing", services.StatusFailing, http.StatusServiceUnavailable},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			app := cltest.NewApplicationWithKey(t)
+			healthChecker := new(mocks.Checker)
+			healthChecker.On("Start").Return(nil).Once()
+			healthChecker.On("Report").Return(services.Health{
+				Name:   "Application",
+				Status: test.status,
+				Components: []services.Health{
+					{Name: "EVM", Status: services.StatusFailing, Output: "no live RPC nodes"},
+				},
+			}).Once()
+			healthChecker.On("Close").Return(nil).Once()
+
+			app.HealthChecker = healthChecker
+			require.NoError(t, app.Start(testutils.Context(t)))
+
+			client := app.NewHTTPClient(cltest.APIEmailAdmin)
+			resp, cleanup := client.Get("/health/components")
+			t.Cleanup(cleanup)
+			assert.Equal(t, test.code, resp.StatusCode)
+
+			var report presenters.ComponentHealth
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+			assert.Equal(t, test.status, report.Status)
+			require.Len(t, report.Components, 1)
+			assert.Equal(t, "EVM", report.Components[0].Name)
+			assert.Equal(t, "no live RPC nodes", report.Components[0].Output)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/sharding"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// JobShardingController manages the nodes sharing jobs, and the assignment of
+// jobs to them.
+type JobShardingController struct {
+	App chainlink.Application
+}
+
+// Members lists the live members, starting with the leader.
+// Example:
+// "GET <application>/job_shard_members"
+func (jsc *JobShardingController) Members(c *gin.Context) {
+	orm := sharding.NewORM(jsc.App.GetSqlxDB(), jsc.App.GetLogger(), jsc.App.GetConfig())
+	members, err := orm.LiveMembers(pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewJobShardMemberResources(members), "jobShardMembers")
+}
+
+// AssignJobRequest is a request to assign a job to a member.
+type AssignJobRequest struct {
+	MemberName string `json:"memberName"`
+}
+
+// Assign pins a job to a member, which runs it while it is up. The job moves
+// on the next heartbeat.
+// Example:
+// "PUT <application>/jobs/:ID/assignment"
+func (jsc *JobShardingController) Assign(c *gin.Context) {
+	jb, ok := jsc.findJob(c)
+	if !ok {
+		return
+	}
+	var request AssignJobRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.MemberName == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("memberName is required"))
+		return
+	}
+
+	orm := sharding.NewORM(jsc.App.GetSqlxDB(), jsc.App.GetLogger(), jsc.App.GetConfig())
+	if err := orm.AssignJob(jb.ID, request.MemberName, pg.WithParentCtx(c.Request.Context())); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsc.App.GetAuditLogger().Audit(audit.JobShardAssigned, map[string]interface{}{"id": jb.ID, "memberName": request.MemberName})
+	jsonAPIResponse(c, presenters.NewJobShardAssignmentResource(jb.ID, request.MemberName), "jobShardAssignments")
+}
+
+// Unassign removes the assignment of a job, which is then run by the member
+// it hashes to, or the leader.
+// Example:
+// "DELETE <application>/jobs/:ID/assignment"
+func (jsc *JobShardingController) Unassign(c *gin.Context) {
+	jb, ok := jsc.findJob(c)
+	if !ok {
+		return
+	}
+
+	orm := sharding.NewORM(jsc.App.GetSqlxDB(), jsc.App.GetLogger(), jsc.App.GetConfig())
+	if err := orm.UnassignJob(jb.ID, pg.WithParentCtx(c.Request.Context())); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsc.App.GetAuditLogger().Audit(audit.JobShardUnassigned, map[string]interface{}{"id": jb.ID})
+	jsonAPIResponseWithStatus(c, nil, "jobShardAssignments", http.StatusNoContent)
+}
+
+func (jsc *JobShardingController) findJob(c *gin.Context) (jb job.Job, ok bool) {
+	if !jsc.App.GetConfig().JobShardingEnabled() {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("job sharding is disabled"))
+		return
+	}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jb, err := jsc.App.JobORM().FindJob(c.Request.Context(), jb.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	return jb, true
+}
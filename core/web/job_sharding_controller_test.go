@@ -0,0 +1,84 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/sharding"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func setupJobShardingControllerTest(t *testing.T, enabled bool) (*cltest.TestApplication, cltest.HTTPClientCleaner) {
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobSharding.Enabled = ptr(enabled)
+		c.Database.Lock.Enabled = ptr(false)
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	return app, app.NewHTTPClient(cltest.APIEmailAdmin)
+}
+
+func TestJobShardingController_Assign(t *testing.T) {
+	t.Parallel()
+
+	app, client := setupJobShardingControllerTest(t, true)
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetSqlxDB())
+	path := fmt.Sprintf("/v2/jobs/%d/assignment", jb.ID)
+
+	body, err := json.Marshal(web.AssignJobRequest{MemberName: "node-b"})
+	require.NoError(t, err)
+	resp, cleanup := client.Put(path, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resource presenters.JobShardAssignmentResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+	assert.Equal(t, jb.ID, resource.JobID)
+	assert.Equal(t, "node-b", resource.MemberName)
+
+	orm := sharding.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig())
+	assignments, err := orm.Assignments()
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Equal(t, "node-b", assignments[0].MemberName)
+
+	resp, cleanup = client.Delete(path)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+	assignments, err = orm.Assignments()
+	require.NoError(t, err)
+	assert.Empty(t, assignments)
+
+	resp, cleanup = client.Put("/v2/jobs/999999/assignment", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestJobShardingController_Disabled(t *testing.T) {
+	t.Parallel()
+
+	app, client := setupJobShardingControllerTest(t, false)
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetSqlxDB())
+
+	resp, cleanup := client.Delete(fmt.Sprintf("/v2/jobs/%d/assignment", jb.ID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Get("/v2/job_shard_members")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var members []presenters.JobShardMemberResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &members))
+	assert.Empty(t, members)
+}
@@ -207,6 +207,13 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
+	jbj, err := json.Marshal(jb)
+	if err == nil {
+		jc.App.GetAuditLogger().Audit(audit.JobUpdated, map[string]interface{}{"job": string(jbj)})
+	} else {
+		jc.App.GetLogger().Errorf("Could not send audit log for JobUpdate", "err", err)
+	}
+
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
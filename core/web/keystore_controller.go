@@ -0,0 +1,137 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeystoreController manages the keystore as a whole, rather than its keys.
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// RotatePasswordRequest defines the request to re-encrypt the keystore with a
+// new password. ScryptN and ScryptP default to the node's configured
+// parameters when zero, and may not exceed utils.DefaultScryptParams.
+type RotatePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+	ScryptN     int    `json:"scryptN"`
+	ScryptP     int    `json:"scryptP"`
+}
+
+// RotatePassword re-encrypts the whole key ring with a new password.
+// Example:
+// "POST <application>/keys/rotate_password"
+func (kc *KeystoreController) RotatePassword(c *gin.Context) {
+	var request RotatePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := utils.VerifyPasswordComplexity(request.NewPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	scryptParams := utils.GetScryptParams(kc.App.GetConfig())
+	if request.ScryptN != 0 || request.ScryptP != 0 {
+		if request.ScryptN <= 1 || request.ScryptN&(request.ScryptN-1) != 0 || request.ScryptP <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("scryptN must be a power of 2 greater than 1, and scryptP must be positive"))
+			return
+		}
+		// Bound the work of a single request, which runs on the node's own CPU and memory.
+		if limit := utils.DefaultScryptParams; request.ScryptN > limit.N || request.ScryptP > limit.P {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("scryptN may not exceed %d, and scryptP may not exceed %d", limit.N, limit.P))
+			return
+		}
+		scryptParams = utils.ScryptParams{N: request.ScryptN, P: request.ScryptP}
+	}
+
+	err := kc.App.GetKeyStore().ChangePassword(request.OldPassword, request.NewPassword, scryptParams)
+	if errors.Is(err, keystore.ErrWrongPassword) {
+		kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotationAttemptFailedMismatch, map[string]interface{}{})
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotated, map[string]interface{}{
+		"scryptN": scryptParams.N,
+		"scryptP": scryptParams.P,
+	})
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
+
+// ExportBackup exports the whole keystore, including the eth key states and
+// their nonces, encrypted with newpassword.
+// Example:
+// "POST <application>/keys/backup/export?newpassword=..."
+func (kc *KeystoreController) ExportBackup(c *gin.Context) {
+	defer kc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Export request body")
+
+	newPassword := c.Query("newpassword")
+	if err := utils.VerifyPasswordComplexity(newPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	bytes, err := kc.App.GetKeyStore().ExportBackup(newPassword)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystoreBackupExported, map[string]interface{}{})
+
+	c.Data(http.StatusOK, MediaType, bytes)
+}
+
+// ImportBackup imports a keystore backup made by ExportBackup. With dryrun,
+// it only reports what would be imported, and any conflicts.
+// Example:
+// "POST <application>/keys/backup/import?oldpassword=...&dryrun=true"
+func (kc *KeystoreController) ImportBackup(c *gin.Context) {
+	defer kc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Import request body")
+
+	bytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	dryRun := false
+	if s := c.Query("dryrun"); s != "" {
+		if dryRun, err = strconv.ParseBool(s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid dryrun"))
+			return
+		}
+	}
+
+	oldPassword := c.Query("oldpassword")
+	report, err := kc.App.GetKeyStore().ImportBackup(bytes, oldPassword, dryRun)
+	if errors.Is(err, keystore.ErrBackupConflicts) {
+		jsonAPIResponseWithStatus(c, presenters.NewKeystoreBackupImportResource(report), "keystoreBackupImport", http.StatusConflict)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if !dryRun {
+		kc.App.GetAuditLogger().Audit(audit.KeystoreBackupImported, map[string]interface{}{
+			"imported": report.Imported,
+		})
+	}
+
+	jsonAPIResponse(c, presenters.NewKeystoreBackupImportResource(report), "keystoreBackupImport")
+}
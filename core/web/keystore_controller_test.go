@@ -0,0 +1,93 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestKeystoreController_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	const newPassword = "n3wP4ssw0rdIsL0ngEnough!"
+
+	rotate := func(req web.RotatePasswordRequest) *http.Response {
+		b, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/keys/rotate_password", bytes.NewReader(b))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	resp := rotate(web.RotatePasswordRequest{OldPassword: cltest.Password, NewPassword: "short"})
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp = rotate(web.RotatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword, ScryptN: 3, ScryptP: 1})
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp = rotate(web.RotatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword, ScryptN: 2 * utils.DefaultScryptParams.N, ScryptP: 1})
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp = rotate(web.RotatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword, ScryptN: utils.FastScryptParams.N, ScryptP: utils.DefaultScryptParams.P + 1})
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp = rotate(web.RotatePasswordRequest{OldPassword: "wrong password", NewPassword: newPassword})
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp = rotate(web.RotatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword,
+		ScryptN: utils.FastScryptParams.N, ScryptP: utils.FastScryptParams.P})
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+	cltest.AssertCount(t, app.GetSqlxDB(), "encrypted_key_ring_backups", 1)
+
+	// the old password no longer matches
+	resp = rotate(web.RotatePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword})
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+}
+
+func TestKeystoreController_ExportImportBackup(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	p2pKey, err := app.GetKeyStore().P2P().Create()
+	require.NoError(t, err)
+	const backupPassword = "b4ckupP4ssw0rdIsL0ngEnough!"
+
+	resp, cleanup := client.Post("/v2/keys/backup/export?newpassword=short", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/keys/backup/export?newpassword="+url.QueryEscape(backupPassword), nil)
+	t.Cleanup(cleanup)
+	backup := cltest.ParseResponseBody(t, resp)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(backup))
+
+	resp, cleanup = client.Post("/v2/keys/backup/import?oldpassword=wrong", bytes.NewReader(backup))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusInternalServerError)
+
+	resp, cleanup = client.Post("/v2/keys/backup/import?dryrun=true&oldpassword="+url.QueryEscape(backupPassword), bytes.NewReader(backup))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var report presenters.KeystoreBackupImportResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &report))
+	assert.True(t, report.DryRun)
+	assert.Empty(t, report.Imported)
+	assert.Contains(t, report.Skipped, "P2P/"+p2pKey.ID())
+	assert.Empty(t, report.Conflicts)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
 
@@ -28,7 +29,7 @@ func (b *ethTransactionAttemptBatcher) loadByEthTransactionIDs(ctx context.Conte
 		keyOrder[key.String()] = ix
 	}
 
-	attempts, err := b.app.TxmORM().FindEthTxAttemptsByEthTxIDs(ethTxsIDs)
+	attempts, err := b.app.TxmORM().FindEthTxAttemptsByEthTxIDs(ethTxsIDs, pg.WithReadOnly())
 	if err != nil {
 		return []*dataloader.Result{{Data: nil, Error: err}}
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
 
@@ -77,7 +78,7 @@ func (b *jobBatcher) loadByPipelineSpecIDs(_ context.Context, keys dataloader.Ke
 	}
 
 	// Fetch the jobs
-	jobs, err := b.app.JobORM().FindJobsByPipelineSpecIDs(plSpecIDs)
+	jobs, err := b.app.JobORM().FindJobsByPipelineSpecIDs(plSpecIDs, pg.WithReadOnly())
 	if err != nil {
 		return []*dataloader.Result{{Data: nil, Error: err}}
 	}
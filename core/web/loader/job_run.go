@@ -7,6 +7,7 @@ import (
 	"github.com/graph-gophers/dataloader"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
 
@@ -29,7 +30,7 @@ func (b *jobRunBatcher) loadByIDs(_ context.Context, keys dataloader.Keys) []*da
 	}
 
 	// Fetch the runs
-	runs, err := b.app.JobORM().FindPipelineRunsByIDs(runIDs)
+	runs, err := b.app.JobORM().FindPipelineRunsByIDs(runIDs, pg.WithReadOnly())
 	if err != nil {
 		return []*dataloader.Result{{Data: nil, Error: err}}
 	}
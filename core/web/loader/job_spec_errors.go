@@ -29,7 +29,7 @@ func (b *jobSpecErrorsBatcher) loadByJobIDs(ctx context.Context, keys dataloader
 		keyOrder[key.String()] = ix
 	}
 
-	specErrors, err := b.app.JobORM().FindSpecErrorsByJobIDs(jobIDs, pg.WithParentCtx(ctx))
+	specErrors, err := b.app.JobORM().FindSpecErrorsByJobIDs(jobIDs, pg.WithParentCtx(ctx), pg.WithReadOnly())
 	if err != nil {
 		return []*dataloader.Result{{Data: nil, Error: err}}
 	}
@@ -58,6 +58,27 @@ func New(app chainlink.Application) *Dataloader {
 	}
 }
 
+// ClearAll clears the caches of all the loaders. Subscriptions resolve every
+// event with the same dataloader, and clear it so that events are not resolved
+// with stale data.
+func (d *Dataloader) ClearAll() {
+	for _, l := range []*dataloader.Loader{
+		d.ChainsByIDLoader,
+		d.EthTxAttemptsByEthTxIDLoader,
+		d.FeedsManagersByIDLoader,
+		d.FeedsManagerChainConfigsByManagerIDLoader,
+		d.JobProposalsByManagerIDLoader,
+		d.JobProposalSpecsByJobProposalID,
+		d.JobRunsByIDLoader,
+		d.JobsByExternalJobIDs,
+		d.JobsByPipelineSpecIDLoader,
+		d.NodesByChainIDLoader,
+		d.SpecErrorsByJobIDLoader,
+	} {
+		l.ClearAll()
+	}
+}
+
 // Middleware injects the dataloader into a gin context.
 func Middleware(app chainlink.Application) gin.HandlerFunc {
 	return func(c *gin.Context) {
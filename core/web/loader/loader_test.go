@@ -223,7 +223,7 @@ func TestLoader_JobRuns(t *testing.T) {
 	run2 := pipeline.Run{ID: int64(2)}
 	run3 := pipeline.Run{ID: int64(3)}
 
-	jobsORM.On("FindPipelineRunsByIDs", []int64{3, 1, 2}).Return([]pipeline.Run{
+	jobsORM.On("FindPipelineRunsByIDs", []int64{3, 1, 2}, mock.Anything).Return([]pipeline.Run{
 		run3, run1, run2,
 	}, nil)
 	app.On("JobORM").Return(jobsORM)
@@ -257,7 +257,7 @@ func TestLoader_JobsByPipelineSpecIDs(t *testing.T) {
 		job2 := job.Job{ID: int32(3), PipelineSpecID: int32(2)}
 		job3 := job.Job{ID: int32(4), PipelineSpecID: int32(3)}
 
-		jobsORM.On("FindJobsByPipelineSpecIDs", []int32{3, 1, 2}).Return([]job.Job{
+		jobsORM.On("FindJobsByPipelineSpecIDs", []int32{3, 1, 2}, mock.Anything).Return([]job.Job{
 			job1, job2, job3,
 		}, nil)
 		app.On("JobORM").Return(jobsORM)
@@ -284,7 +284,7 @@ func TestLoader_JobsByPipelineSpecIDs(t *testing.T) {
 			mock.AssertExpectationsForObjects(t, app, jobsORM)
 		})
 
-		jobsORM.On("FindJobsByPipelineSpecIDs", []int32{3, 1, 2}).Return([]job.Job{}, sql.ErrNoRows)
+		jobsORM.On("FindJobsByPipelineSpecIDs", []int32{3, 1, 2}, mock.Anything).Return([]job.Job{}, sql.ErrNoRows)
 		app.On("JobORM").Return(jobsORM)
 
 		batcher := jobBatcher{app}
@@ -315,7 +315,7 @@ func TestLoader_JobsByExternalJobIDs(t *testing.T) {
 		ejID := uuid.NewV4()
 		job := job.Job{ID: int32(2), ExternalJobID: ejID}
 
-		jobsORM.On("FindJobByExternalJobID", ejID).Return(job, nil)
+		jobsORM.On("FindJobByExternalJobID", ejID, mock.Anything).Return(job, nil)
 		app.On("JobORM").Return(jobsORM)
 
 		batcher := jobBatcher{app}
@@ -350,7 +350,7 @@ func TestLoader_EthTransactionsAttempts(t *testing.T) {
 		EthTxID: ethTxIDs[1],
 	}
 
-	txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{ethTxIDs[2], ethTxIDs[1], ethTxIDs[0]}).Return([]txmgr.EthTxAttempt{
+	txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{ethTxIDs[2], ethTxIDs[1], ethTxIDs[0]}, mock.Anything).Return([]txmgr.EthTxAttempt{
 		attempt1, attempt2,
 	}, nil)
 	app.On("TxmORM").Return(txmORM)
@@ -384,7 +384,7 @@ func TestLoader_SpecErrorsByJobID(t *testing.T) {
 		specErr2 := job.SpecError{ID: int64(3), JobID: int32(2)}
 		specErr3 := job.SpecError{ID: int64(4), JobID: int32(3)}
 
-		jobsORM.On("FindSpecErrorsByJobIDs", []int32{3, 1, 2}, mock.Anything).Return([]job.SpecError{
+		jobsORM.On("FindSpecErrorsByJobIDs", []int32{3, 1, 2}, mock.Anything, mock.Anything).Return([]job.SpecError{
 			specErr1, specErr2, specErr3,
 		}, nil)
 		app.On("JobORM").Return(jobsORM)
@@ -411,7 +411,7 @@ func TestLoader_SpecErrorsByJobID(t *testing.T) {
 			mock.AssertExpectationsForObjects(t, app, jobsORM)
 		})
 
-		jobsORM.On("FindSpecErrorsByJobIDs", []int32{3, 1, 2}, mock.Anything).Return([]job.SpecError{}, sql.ErrNoRows)
+		jobsORM.On("FindSpecErrorsByJobIDs", []int32{3, 1, 2}, mock.Anything, mock.Anything).Return([]job.SpecError{}, sql.ErrNoRows)
 		app.On("JobORM").Return(jobsORM)
 
 		batcher := jobSpecErrorsBatcher{app}
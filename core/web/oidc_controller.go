@@ -0,0 +1,162 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/sessions/oidc"
+)
+
+const (
+	// oidcStateCookie binds a login to the browser which started it. It is
+	// separate from the session cookie, which is SameSite=Strict and so is not
+	// sent when the identity provider redirects back to the node.
+	oidcStateCookie = "clsession_oidc_state"
+	// oidcLoginTimeout is how long a user has to log in with the identity provider.
+	oidcLoginTimeout = 10 * time.Minute
+)
+
+// OIDCController logs users in with an OpenID Connect identity provider.
+type OIDCController struct {
+	App      chainlink.Application
+	provider *oidc.Provider
+	logins   *oidcLogins
+}
+
+func NewOIDCController(app chainlink.Application) *OIDCController {
+	config := app.GetConfig()
+	provider := oidc.NewProvider(oidc.Config{
+		IssuerURL:    config.OIDCIssuerURL(),
+		ClientID:     config.OIDCClientID(),
+		ClientSecret: config.OIDCClientSecret(),
+		RedirectURL:  config.OIDCRedirectURL(),
+		Scopes:       config.OIDCScopes(),
+		GroupsClaim:  config.OIDCGroupsClaim(),
+		RoleGroups: clsessions.RoleGroups{
+			clsessions.UserRoleAdmin: config.OIDCAdminGroups(),
+			clsessions.UserRoleEdit:  config.OIDCEditGroups(),
+			clsessions.UserRoleRun:   config.OIDCRunGroups(),
+			clsessions.UserRoleView:  config.OIDCViewGroups(),
+		},
+	}, app.GetLogger())
+	return &OIDCController{app, provider, newOIDCLogins()}
+}
+
+// Login redirects the user to the identity provider.
+// Example:
+// "GET <application>/oidc/login"
+func (oc *OIDCController) Login(c *gin.Context) {
+	login := oidcLogin{
+		nonce:     oidc.NewVerifier(),
+		verifier:  oidc.NewVerifier(),
+		expiresAt: time.Now().Add(oidcLoginTimeout),
+	}
+	state := oidc.NewVerifier()
+
+	authURL, err := oc.provider.AuthCodeURL(c.Request.Context(), state, login.nonce, login.verifier)
+	if err != nil {
+		oc.App.GetLogger().Errorw("Failed to build OIDC authorization URL", "err", err)
+		jsonAPIError(c, http.StatusBadGateway, errors.New("identity provider unavailable"))
+		return
+	}
+	oc.logins.put(state, login)
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, state, int(oidcLoginTimeout.Seconds()), "/oidc", "", oc.App.GetConfig().SecureCookies(), true)
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback completes the login, creating a session for the user identified
+// by the identity provider and provisioning them if they are new.
+// Example:
+// "GET <application>/oidc/callback?code=<code>&state=<state>"
+func (oc *OIDCController) Callback(c *gin.Context) {
+	defer oc.App.WakeSessionReaper()
+
+	state := c.Query("state")
+	cookieState, _ := c.Cookie(oidcStateCookie)
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, "", -1, "/oidc", "", oc.App.GetConfig().SecureCookies(), true)
+
+	login, ok := oc.logins.take(state)
+	if !ok || state != cookieState {
+		oc.loginFailed(c, "", errors.New("invalid or expired login state"))
+		return
+	}
+	if errCode := c.Query("error"); errCode != "" {
+		oc.loginFailed(c, "", fmt.Errorf("identity provider returned an error: %s", errCode))
+		return
+	}
+
+	identity, err := oc.provider.Exchange(c.Request.Context(), c.Query("code"), login.verifier, login.nonce)
+	if err != nil {
+		oc.loginFailed(c, "", err)
+		return
+	}
+	sid, err := oc.App.SessionORM().CreateOIDCSession(identity)
+	if err != nil {
+		oc.loginFailed(c, identity.Email, err)
+		return
+	}
+
+	if err := saveSessionID(sessions.Default(c), sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
+		return
+	}
+	c.Redirect(http.StatusFound, "/")
+}
+
+func (oc *OIDCController) loginFailed(c *gin.Context, email string, err error) {
+	oc.App.GetLogger().Warnw("OIDC login failed", "email", email, "err", err)
+	oc.App.GetAuditLogger().Audit(audit.AuthLoginFailedOIDC, map[string]interface{}{"email": email, "error": err.Error()})
+	jsonAPIError(c, http.StatusUnauthorized, err)
+}
+
+// oidcLogin is a login in progress with the identity provider.
+type oidcLogin struct {
+	nonce     string
+	verifier  string
+	expiresAt time.Time
+}
+
+// oidcLogins holds the logins in progress by their state, so that the
+// verifier never leaves the node.
+type oidcLogins struct {
+	mu     sync.Mutex
+	logins map[string]oidcLogin
+}
+
+func newOIDCLogins() *oidcLogins {
+	return &oidcLogins{logins: make(map[string]oidcLogin)}
+}
+
+func (l *oidcLogins) put(state string, login oidcLogin) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	for s, other := range l.logins {
+		if now.After(other.expiresAt) {
+			delete(l.logins, s)
+		}
+	}
+	l.logins[state] = login
+}
+
+// take removes and returns the login for the state, if it has not expired.
+func (l *oidcLogins) take(state string) (oidcLogin, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	login, ok := l.logins[state]
+	delete(l.logins, state)
+	return login, ok && time.Now().Before(login.expiresAt)
+}
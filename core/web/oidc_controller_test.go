@@ -0,0 +1,146 @@
+package web_test
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest2 "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/oidctest"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func setupOIDCApp(t *testing.T) (*cltest.TestApplication, *oidctest.IdP) {
+	idp := oidctest.NewIdP(t, "chainlink")
+	config := configtest2.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.WebServer.SecureCookies = ptr(false)
+		c.WebServer.OIDC.Enabled = ptr(true)
+		c.WebServer.OIDC.IssuerURL = models.MustParseURL(idp.URL)
+		c.WebServer.OIDC.ClientID = ptr("chainlink")
+		// the host is replaced by the test server when following the redirect
+		c.WebServer.OIDC.RedirectURL = models.MustParseURL("http://localhost/oidc/callback")
+		c.WebServer.OIDC.AdminGroups = &[]string{"cl-admins"}
+		c.WebServer.OIDC.ViewGroups = &[]string{"cl-viewers"}
+	})
+	app := cltest.NewApplicationWithConfig(t, config)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	return app, idp
+}
+
+// oidcLogin logs in with the IdP using a new browser, and returns the browser
+// and the response of the callback.
+func oidcLogin(t *testing.T, app *cltest.TestApplication) (*http.Client, *http.Response) {
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	browser := &http.Client{Jar: jar, CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+
+	resp, err := browser.Get(app.Server.URL + "/oidc/login")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	authURL, err := resp.Location()
+	require.NoError(t, err)
+
+	resp, err = browser.Get(authURL.String())
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	callbackURL, err := resp.Location()
+	require.NoError(t, err)
+	serverURL := cltest.MustParseURL(t, app.Server.URL)
+	callbackURL.Host = serverURL.Host
+
+	resp, err = browser.Get(callbackURL.String())
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+	return browser, resp
+}
+
+func TestOIDCController_Login(t *testing.T) {
+	t.Parallel()
+
+	app, idp := setupOIDCApp(t)
+
+	t.Run("provisions user", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "alice-id", Email: "alice@example.com", EmailVerified: true, Groups: []string{"cl-viewers"}})
+
+		browser, resp := oidcLogin(t, app)
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/", resp.Header.Get("Location"))
+
+		ping, err := browser.Get(app.Server.URL + "/v2/ping")
+		require.NoError(t, err)
+		defer ping.Body.Close()
+		assert.Equal(t, http.StatusOK, ping.StatusCode)
+
+		user, err := app.SessionORM().FindUser("alice@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRoleView, user.Role)
+		assert.Equal(t, "alice-id", user.OIDCSubject.String)
+	})
+
+	t.Run("updates role from groups", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "alice-id", Email: "alice@example.com", EmailVerified: true, Groups: []string{"cl-admins"}})
+
+		_, resp := oidcLogin(t, app)
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+
+		user, err := app.SessionORM().FindUser("alice@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+	})
+
+	t.Run("no role", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "bob-id", Email: "bob@example.com", EmailVerified: true, Groups: []string{"marketing"}})
+
+		_, resp := oidcLogin(t, app)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		_, err := app.SessionORM().FindUser("bob@example.com")
+		assert.Error(t, err)
+	})
+
+	t.Run("unverified email of local user", func(t *testing.T) {
+		idp.SetUser(oidctest.User{Subject: "mallory-id", Email: cltest.APIEmailAdmin, Groups: []string{"cl-admins"}})
+
+		_, resp := oidcLogin(t, app)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
+
+func TestOIDCController_Callback_InvalidState(t *testing.T) {
+	t.Parallel()
+
+	app, _ := setupOIDCApp(t)
+
+	// a callback which was not started by this browser, e.g. login CSRF
+	resp, err := http.Get(app.Server.URL + "/oidc/callback?" + url.Values{"code": {"code"}, "state": {"state"}}.Encode())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestOIDCController_Disabled(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(app.Server.URL + "/oidc/login")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.NotEqual(t, http.StatusFound, resp.StatusCode)
+}
@@ -0,0 +1,67 @@
+package presenters
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+)
+
+// AuditEventResource is an audit log event JSONAPI resource.
+type AuditEventResource struct {
+	JAID
+	EventID   string          `json:"eventID"`
+	User      string          `json:"user,omitempty"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"createdAt"`
+	PrevHash  string          `json:"prevHash"`
+	Hash      string          `json:"hash"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (AuditEventResource) GetName() string {
+	return "audit_events"
+}
+
+// NewAuditEventResource returns a new AuditEventResource for e.
+func NewAuditEventResource(e audit.Event) AuditEventResource {
+	return AuditEventResource{
+		JAID:      NewJAIDInt64(e.ID),
+		EventID:   string(e.EventID),
+		User:      e.User.String,
+		Data:      json.RawMessage(e.Data),
+		CreatedAt: e.CreatedAt,
+		PrevHash:  hex.EncodeToString(e.PrevHash),
+		Hash:      hex.EncodeToString(e.Hash),
+	}
+}
+
+// AuditLogVerificationResource is the result of verifying the audit log.
+type AuditLogVerificationResource struct {
+	JAID
+	Valid          bool   `json:"valid"`
+	Events         int64  `json:"events"`
+	HeadID         int64  `json:"headID"`
+	HeadHash       string `json:"headHash"`
+	InvalidEventID int64  `json:"invalidEventID,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (AuditLogVerificationResource) GetName() string {
+	return "audit_log_verifications"
+}
+
+// NewAuditLogVerificationResource returns a new AuditLogVerificationResource for v.
+func NewAuditLogVerificationResource(v audit.Verification) AuditLogVerificationResource {
+	return AuditLogVerificationResource{
+		JAID:           NewJAID("audit_log"),
+		Valid:          v.Valid(),
+		Events:         v.Events,
+		HeadID:         v.HeadID,
+		HeadHash:       hex.EncodeToString(v.HeadHash),
+		InvalidEventID: v.InvalidEventID,
+		Error:          v.Error,
+	}
+}
@@ -1,6 +1,8 @@
 package presenters
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink/core/services"
 )
 
@@ -14,3 +16,26 @@ type Check struct {
 func (c Check) GetName() string {
 	return "checks"
 }
+
+// ComponentHealth is the health of a component of the application, and of its own components.
+type ComponentHealth struct {
+	Name       string            `json:"name"`
+	Status     services.Status   `json:"status"`
+	Output     string            `json:"output,omitempty"`
+	Since      time.Time         `json:"since"`
+	Components []ComponentHealth `json:"components,omitempty"`
+}
+
+// NewComponentHealth returns the presenter of h and its components.
+func NewComponentHealth(h services.Health) ComponentHealth {
+	ch := ComponentHealth{
+		Name:   h.Name,
+		Status: h.Status,
+		Output: h.Output,
+		Since:  h.Since,
+	}
+	for _, c := range h.Components {
+		ch.Components = append(ch.Components, NewComponentHealth(c))
+	}
+	return ch
+}
@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// DatabaseQueryResource aggregates the executions of a SQL statement, as a
+// JSONAPI resource.
+type DatabaseQueryResource struct {
+	JAID
+	Query     string     `json:"query"`
+	Calls     int64      `json:"calls"`
+	Errors    int64      `json:"errors"`
+	TotalMS   float64    `json:"totalMS"`
+	MeanMS    float64    `json:"meanMS"`
+	MaxMS     float64    `json:"maxMS"`
+	LastSeen  time.Time  `json:"lastSeen"`
+	CallSites []CallSite `json:"callSites"`
+}
+
+// CallSite is a function which issued a statement.
+type CallSite struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Calls    int64  `json:"calls"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (DatabaseQueryResource) GetName() string {
+	return "databaseQueries"
+}
+
+// NewDatabaseQueryResource returns a new DatabaseQueryResource for s. The ID
+// is derived from the statement, so that it is stable across requests.
+func NewDatabaseQueryResource(s pg.QueryStat) DatabaseQueryResource {
+	sum := sha256.Sum256([]byte(s.Query))
+	r := DatabaseQueryResource{
+		JAID:     NewJAID(hex.EncodeToString(sum[:8])),
+		Query:    s.Query,
+		Calls:    s.Calls,
+		Errors:   s.Errors,
+		TotalMS:  ms(s.Total),
+		MeanMS:   ms(s.Mean()),
+		MaxMS:    ms(s.Max),
+		LastSeen: s.LastSeen,
+	}
+	for _, c := range s.CallSites {
+		r.CallSites = append(r.CallSites, CallSite{Function: c.Function, File: c.File, Line: c.Line, Calls: c.Calls})
+	}
+	return r
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,85 @@
+package presenters
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EVMLogResource is a JSONAPI resource for a log saved by the EVM log poller.
+type EVMLogResource struct {
+	JAID
+	EVMChainID  *utils.Big     `json:"evmChainID"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	BlockNumber int64          `json:"blockNumber"`
+	LogIndex    int64          `json:"logIndex"`
+	Address     common.Address `json:"address"`
+	EventSig    common.Hash    `json:"eventSig"`
+	Topics      []common.Hash  `json:"topics"`
+	TxHash      common.Hash    `json:"txHash"`
+	Data        hexutil.Bytes  `json:"data"`
+	// Event and Args are only set if the ABI of the event was registered with the log poller.
+	Event string         `json:"event,omitempty"`
+	Args  map[string]any `json:"args,omitempty"`
+	// DecodeError is set instead of Args if the log could not be decoded with the ABI of the event.
+	DecodeError string `json:"decodeError,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EVMLogResource) GetName() string {
+	return "evm_logs"
+}
+
+// NewEVMLogResource returns a new EVMLogResource for l. If event is not empty, args are the arguments
+// decoded from l.
+func NewEVMLogResource(l logpoller.Log, event string, args map[string]any) EVMLogResource {
+	topics := make([]common.Hash, len(l.Topics))
+	for i, t := range l.Topics {
+		topics[i] = common.BytesToHash(t)
+	}
+	var presented map[string]any
+	if len(args) > 0 {
+		presented = make(map[string]any, len(args))
+		for name, v := range args {
+			presented[name] = presentLogArg(v)
+		}
+	}
+	return EVMLogResource{
+		JAID:        NewJAID(fmt.Sprintf("%s-%d", l.BlockHash.Hex(), l.LogIndex)),
+		EVMChainID:  l.EvmChainId,
+		BlockHash:   l.BlockHash,
+		BlockNumber: l.BlockNumber,
+		LogIndex:    l.LogIndex,
+		Address:     l.Address,
+		EventSig:    l.EventSig,
+		Topics:      topics,
+		TxHash:      l.TxHash,
+		Data:        l.Data,
+		Event:       event,
+		Args:        presented,
+	}
+}
+
+// presentLogArg converts decoded integers to decimal strings and bytes to hex, so they survive JSON clients.
+func presentLogArg(v any) any {
+	switch t := v.(type) {
+	case *big.Int:
+		return t.String()
+	case []byte:
+		return hexutil.Encode(t)
+	case common.Address:
+		return t.Hex()
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+		return hexutil.Encode(b)
+	}
+	return v
+}
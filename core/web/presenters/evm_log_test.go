@@ -0,0 +1,71 @@
+package presenters
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestEVMLogResource(t *testing.T) {
+	t.Parallel()
+
+	l := logpoller.Log{
+		EvmChainId:  utils.NewBigI(5),
+		LogIndex:    2,
+		BlockHash:   common.HexToHash("0xb"),
+		BlockNumber: 10,
+		Topics:      [][]byte{common.HexToHash("0xe").Bytes(), common.HexToHash("0x1").Bytes()},
+		EventSig:    common.HexToHash("0xe"),
+		Address:     common.HexToAddress("0xa"),
+		TxHash:      common.HexToHash("0xc"),
+		Data:        []byte{1, 2},
+	}
+
+	b, err := jsonapi.Marshal(NewEVMLogResource(l, "", nil))
+	require.NoError(t, err)
+	expected := `
+	{
+		"data": {
+			"type": "evm_logs",
+			"id": "0x000000000000000000000000000000000000000000000000000000000000000b-2",
+			"attributes": {
+				"evmChainID": "5",
+				"blockHash": "0x000000000000000000000000000000000000000000000000000000000000000b",
+				"blockNumber": 10,
+				"logIndex": 2,
+				"address": "0x000000000000000000000000000000000000000a",
+				"eventSig": "0x000000000000000000000000000000000000000000000000000000000000000e",
+				"topics": [
+					"0x000000000000000000000000000000000000000000000000000000000000000e",
+					"0x0000000000000000000000000000000000000000000000000000000000000001"
+				],
+				"txHash": "0x000000000000000000000000000000000000000000000000000000000000000c",
+				"data": "0x0102"
+			}
+		}
+	}`
+	assert.JSONEq(t, expected, string(b))
+
+	r := NewEVMLogResource(l, "Transfer", map[string]any{
+		"value": big.NewInt(1),
+		"from":  common.HexToAddress("0xf"),
+		"id":    [32]byte{31: 1},
+		"data":  []byte{3},
+		"ok":    true,
+	})
+	assert.Equal(t, "Transfer", r.Event)
+	assert.Equal(t, map[string]any{
+		"value": "1",
+		"from":  "0x000000000000000000000000000000000000000F",
+		"id":    "0x0000000000000000000000000000000000000000000000000000000000000001",
+		"data":  "0x03",
+		"ok":    true,
+	}, r.Args)
+}
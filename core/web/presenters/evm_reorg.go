@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EVMReorgResource is an EVM reorg JSONAPI resource.
+type EVMReorgResource struct {
+	JAID
+	EVMChainID       utils.Big   `json:"evmChainID"`
+	Source           string      `json:"source"`
+	Depth            int64       `json:"depth"`
+	FromBlock        int64       `json:"fromBlock"`
+	ToBlock          int64       `json:"toBlock"`
+	OldHash          common.Hash `json:"oldHash"`
+	NewHash          common.Hash `json:"newHash"`
+	AffectedEthTxIDs []int64     `json:"affectedEthTxIDs"`
+	AffectedLogs     int64       `json:"affectedLogs"`
+	CreatedAt        time.Time   `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EVMReorgResource) GetName() string {
+	return "evm_reorgs"
+}
+
+// NewEVMReorgResource returns a new EVMReorgResource for r.
+func NewEVMReorgResource(r reorg.Reorg) EVMReorgResource {
+	return EVMReorgResource{
+		JAID:             NewJAIDInt64(r.ID),
+		EVMChainID:       r.EVMChainID,
+		Source:           string(r.Source),
+		Depth:            r.Depth,
+		FromBlock:        r.FromBlock,
+		ToBlock:          r.ToBlock,
+		OldHash:          r.OldHash,
+		NewHash:          r.NewHash,
+		AffectedEthTxIDs: r.AffectedEthTxIDs,
+		AffectedLogs:     r.AffectedLogs,
+		CreatedAt:        r.CreatedAt,
+	}
+}
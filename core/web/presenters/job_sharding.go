@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/sharding"
+)
+
+// JobShardMemberResource is a node sharing jobs, as a JSONAPI resource.
+type JobShardMemberResource struct {
+	JAID
+	Name      string    `json:"name"`
+	Leader    bool      `json:"leader"`
+	Jobs      int64     `json:"jobs"`
+	JoinedAt  time.Time `json:"joinedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobShardMemberResource) GetName() string {
+	return "jobShardMembers"
+}
+
+// NewJobShardMemberResources returns the resources of the live members, which
+// are ordered by the time they joined, so that the first is the leader.
+func NewJobShardMemberResources(members []sharding.Member) []JobShardMemberResource {
+	rs := make([]JobShardMemberResource, len(members))
+	for i, m := range members {
+		rs[i] = JobShardMemberResource{
+			JAID:      NewJAID(m.Name),
+			Name:      m.Name,
+			Leader:    i == 0,
+			Jobs:      m.Jobs,
+			JoinedAt:  m.JoinedAt,
+			ExpiresAt: m.ExpiresAt,
+		}
+	}
+	return rs
+}
+
+// JobShardAssignmentResource pins a job to a member, as a JSONAPI resource.
+type JobShardAssignmentResource struct {
+	JAID
+	JobID      int32  `json:"jobID"`
+	MemberName string `json:"memberName"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobShardAssignmentResource) GetName() string {
+	return "jobShardAssignments"
+}
+
+// NewJobShardAssignmentResource returns a new JobShardAssignmentResource.
+func NewJobShardAssignmentResource(jobID int32, memberName string) *JobShardAssignmentResource {
+	return &JobShardAssignmentResource{
+		JAID:       NewJAIDInt32(jobID),
+		JobID:      jobID,
+		MemberName: memberName,
+	}
+}
@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+// KeystoreBackupImportResource represents the outcome of importing a keystore
+// backup JSONAPI resource.
+type KeystoreBackupImportResource struct {
+	JAID
+	DryRun    bool                     `json:"dryRun"`
+	Imported  []string                 `json:"imported"`
+	Skipped   []string                 `json:"skipped"`
+	Conflicts []KeystoreBackupConflict `json:"conflicts"`
+}
+
+type KeystoreBackupConflict struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeystoreBackupImportResource) GetName() string {
+	return "keystoreBackupImports"
+}
+
+func NewKeystoreBackupImportResource(report keystore.BackupImportReport) *KeystoreBackupImportResource {
+	r := &KeystoreBackupImportResource{
+		JAID:      NewJAID("keystore"),
+		DryRun:    report.DryRun,
+		Imported:  append([]string{}, report.Imported...),
+		Skipped:   append([]string{}, report.Skipped...),
+		Conflicts: []KeystoreBackupConflict{},
+	}
+	for _, c := range report.Conflicts {
+		r.Conflicts = append(r.Conflicts, KeystoreBackupConflict{ID: c.ID, Reason: c.Reason})
+	}
+
+	return r
+}
@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// NamedAPITokenResource represents a named API token JSONAPI resource.
+type NamedAPITokenResource struct {
+	JAID
+	Name        string     `json:"name"`
+	AccessKey   string     `json:"accessKey"`
+	Secret      string     `json:"secret,omitempty"`
+	Permissions []string   `json:"permissions"`
+	AllowedIPs  []string   `json:"allowedIPs"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	LastUsed    *time.Time `json:"lastUsed"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r NamedAPITokenResource) GetName() string {
+	return "namedAPITokens"
+}
+
+// NewNamedAPITokenResource constructs a new NamedAPITokenResource. The secret
+// is only known when the token is created.
+func NewNamedAPITokenResource(t sessions.APIToken, secret string) *NamedAPITokenResource {
+	return &NamedAPITokenResource{
+		JAID:        NewJAID(t.Name),
+		Name:        t.Name,
+		AccessKey:   t.TokenKey,
+		Secret:      secret,
+		Permissions: append([]string{}, t.Permissions...),
+		AllowedIPs:  append([]string{}, t.AllowedIPs...),
+		ExpiresAt:   t.ExpiresAt.Ptr(),
+		LastUsed:    t.LastUsed.Ptr(),
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+func NewNamedAPITokenResources(tokens []sessions.APIToken) []NamedAPITokenResource {
+	rs := []NamedAPITokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, *NewNamedAPITokenResource(t, ""))
+	}
+	return rs
+}
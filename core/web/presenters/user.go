@@ -11,6 +11,7 @@ type UserResource struct {
 	JAID
 	Email             string            `json:"email"`
 	Role              sessions.UserRole `json:"role"`
+	CustomRole        string            `json:"customRole,omitempty"`
 	HasActiveApiToken string            `json:"hasActiveApiToken"`
 	CreatedAt         time.Time         `json:"createdAt"`
 	UpdatedAt         time.Time         `json:"updatedAt"`
@@ -33,6 +34,7 @@ func NewUserResource(u sessions.User) *UserResource {
 		JAID:              NewJAID(u.Email),
 		Email:             u.Email,
 		Role:              sessions.UserRole(u.Role),
+		CustomRole:        u.CustomRole.String,
 		HasActiveApiToken: hasToken,
 		CreatedAt:         u.CreatedAt,
 		UpdatedAt:         u.UpdatedAt,
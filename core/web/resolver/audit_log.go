@@ -0,0 +1,116 @@
+package resolver
+
+import (
+	"encoding/hex"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+)
+
+type AuditEventResolver struct {
+	e audit.Event
+}
+
+func NewAuditEvent(e audit.Event) *AuditEventResolver {
+	return &AuditEventResolver{e: e}
+}
+
+func NewAuditEvents(results []audit.Event) []*AuditEventResolver {
+	var resolver []*AuditEventResolver
+
+	for _, e := range results {
+		resolver = append(resolver, NewAuditEvent(e))
+	}
+
+	return resolver
+}
+
+func (r *AuditEventResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.e.ID))
+}
+
+func (r *AuditEventResolver) EventType() string {
+	return string(r.e.EventID)
+}
+
+func (r *AuditEventResolver) User() *string {
+	return r.e.User.Ptr()
+}
+
+func (r *AuditEventResolver) Data() string {
+	return r.e.Data
+}
+
+func (r *AuditEventResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.e.CreatedAt}
+}
+
+func (r *AuditEventResolver) PrevHash() string {
+	return hex.EncodeToString(r.e.PrevHash)
+}
+
+func (r *AuditEventResolver) Hash() string {
+	return hex.EncodeToString(r.e.Hash)
+}
+
+// -- AuditEvents Query --
+
+type AuditEventsPayloadResolver struct {
+	results []audit.Event
+	total   int32
+}
+
+func NewAuditEventsPayload(results []audit.Event, total int32) *AuditEventsPayloadResolver {
+	return &AuditEventsPayloadResolver{results: results, total: total}
+}
+
+func (r *AuditEventsPayloadResolver) Results() []*AuditEventResolver {
+	return NewAuditEvents(r.results)
+}
+
+func (r *AuditEventsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// -- AuditLogVerification Query --
+
+type AuditLogVerificationResolver struct {
+	v audit.Verification
+}
+
+func NewAuditLogVerification(v audit.Verification) *AuditLogVerificationResolver {
+	return &AuditLogVerificationResolver{v: v}
+}
+
+func (r *AuditLogVerificationResolver) Valid() bool {
+	return r.v.Valid()
+}
+
+func (r *AuditLogVerificationResolver) Events() int32 {
+	return int32(r.v.Events)
+}
+
+func (r *AuditLogVerificationResolver) HeadID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.v.HeadID))
+}
+
+func (r *AuditLogVerificationResolver) HeadHash() string {
+	return hex.EncodeToString(r.v.HeadHash)
+}
+
+func (r *AuditLogVerificationResolver) InvalidEventID() *graphql.ID {
+	if r.v.Valid() {
+		return nil
+	}
+	id := graphql.ID(stringutils.FromInt64(r.v.InvalidEventID))
+	return &id
+}
+
+func (r *AuditLogVerificationResolver) Error() *string {
+	if r.v.Valid() {
+		return nil
+	}
+	return &r.v.Error
+}
@@ -0,0 +1,148 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+)
+
+func TestResolver_AuditEvents(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetAuditEvents($user: String, $eventType: String, $from: Time) {
+			auditEvents(user: $user, eventType: $eventType, from: $from) {
+				results {
+					id
+					eventType
+					user
+					data
+					createdAt
+					prevHash
+					hash
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"user":      "alice@example.com",
+		"eventType": "JOB_CREATED",
+		"from":      "2022-10-01T00:00:00Z",
+	}
+	createdAt := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "auditEvents"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				filter := audit.EventFilter{
+					User:    "alice@example.com",
+					EventID: audit.JobCreated,
+					From:    time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC),
+				}
+				f.Mocks.auditLogORM.On("Events", filter, PageDefaultOffset, PageDefaultLimit).Return([]audit.Event{
+					{
+						ID:        2,
+						EventID:   audit.JobCreated,
+						User:      null.StringFrom("alice@example.com"),
+						Data:      `{"job":"{}"}`,
+						CreatedAt: createdAt,
+						PrevHash:  []byte{0x01},
+						Hash:      []byte{0x02},
+					},
+				}, 1, nil)
+				f.App.On("AuditLogORM").Return(f.Mocks.auditLogORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"auditEvents": {
+						"results": [{
+							"id": "2",
+							"eventType": "JOB_CREATED",
+							"user": "alice@example.com",
+							"data": "{\"job\":\"{}\"}",
+							"createdAt": "2022-10-01T12:00:00Z",
+							"prevHash": "01",
+							"hash": "02"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_AuditLogVerification(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query VerifyAuditLog {
+			auditLogVerification {
+				valid
+				events
+				headID
+				headHash
+				invalidEventID
+				error
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "auditLogVerification"),
+		{
+			name:          "valid",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.auditLogORM.On("Verify").Return(audit.Verification{Events: 3, HeadID: 3, HeadHash: []byte{0x03}}, nil)
+				f.App.On("AuditLogORM").Return(f.Mocks.auditLogORM)
+			},
+			query: query,
+			result: `
+				{
+					"auditLogVerification": {
+						"valid": true,
+						"events": 3,
+						"headID": "3",
+						"headHash": "03",
+						"invalidEventID": null,
+						"error": null
+					}
+				}`,
+		},
+		{
+			name:          "tampered",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.auditLogORM.On("Verify").Return(audit.Verification{Events: 1, HeadID: 1, HeadHash: []byte{0x01}, InvalidEventID: 2, Error: "event 2 was modified"}, nil)
+				f.App.On("AuditLogORM").Return(f.Mocks.auditLogORM)
+			},
+			query: query,
+			result: `
+				{
+					"auditLogVerification": {
+						"valid": false,
+						"events": 1,
+						"headID": "1",
+						"headHash": "01",
+						"invalidEventID": "2",
+						"error": "event 2 was modified"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/graph-gophers/graphql-go"
+
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 )
@@ -16,42 +18,54 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	if session.User.Role == sessions.UserRoleView {
-		return RoleNotPermittedErr{session.User.Role}
-	}
-	return nil
+// Authenticates the user from the session cookie and asserts at least 'view' role on the resource.
+func authenticateUserCanView(ctx context.Context, resource sessions.Resource) error {
+	return authorizeUser(ctx, resource, sessions.UserRoleView, nil)
 }
 
-// Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
+// Authenticates the user from the session cookie and asserts at least 'run' role on the resource.
+func authenticateUserCanRun(ctx context.Context, resource sessions.Resource) error {
+	return authorizeUser(ctx, resource, sessions.UserRoleRun, nil)
+}
+
+// Authenticates the user from the session cookie and asserts at least 'edit' role on the resource.
+func authenticateUserCanEdit(ctx context.Context, resource sessions.Resource) error {
+	return authorizeUser(ctx, resource, sessions.UserRoleEdit, nil)
+}
+
+// Authenticates the user from the session cookie and asserts has 'admin' role on the resource.
+func authenticateUserIsAdmin(ctx context.Context, resource sessions.Resource) error {
+	return authorizeUser(ctx, resource, sessions.UserRoleAdmin, nil)
+}
+
+// authorizeUser authenticates the user from the session cookie and asserts
+// at least role on the resource. scope returns what the operation is limited
+// to, e.g. "evm/5" for a single chain. It is only called for users with a
+// custom role, as built-in roles are not scoped.
+func authorizeUser(ctx context.Context, resource sessions.Resource, role sessions.UserRole, scope func() (string, error)) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	switch session.User.Role {
-	case sessions.UserRoleView, sessions.UserRoleRun:
-		return RoleNotPermittedErr{session.User.Role}
-	default:
+	user := session.User
+	var s string
+	if user.CustomRole.Valid && scope != nil {
+		var err error
+		if s, err = scope(); err != nil {
+			return err
+		}
+	}
+	if !user.Authorized(resource, role, s) {
+		return RoleNotPermittedErr{sessions.UserRole(user.RoleName())}
 	}
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
+// evmChainScope returns the scope of operations on a single EVM chain.
+func evmChainScope(id graphql.ID) func() (string, error) {
+	return func() (string, error) {
+		return "evm/" + string(id), nil
 	}
-	if session.User.Role != sessions.UserRoleAdmin {
-		return RoleNotPermittedErr{session.User.Role}
-	}
-	return nil
 }
 
 type unauthorizedError struct{}
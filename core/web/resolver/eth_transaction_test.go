@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
@@ -70,7 +71,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 					EVMChainID:     *utils.NewBigI(22),
 					Nonce:          nil,
 				}, nil)
-				f.Mocks.txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{1}).Return([]txmgr.EthTxAttempt{
+				f.Mocks.txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{1}, mock.Anything).Return([]txmgr.EthTxAttempt{
 					{
 						EthTxID:                 1,
 						Hash:                    hash,
@@ -126,7 +127,7 @@ func TestResolver_EthTransaction(t *testing.T) {
 					EVMChainID:     *utils.NewBigI(22),
 					Nonce:          &num,
 				}, nil)
-				f.Mocks.txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{1}).Return([]txmgr.EthTxAttempt{
+				f.Mocks.txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{1}, mock.Anything).Return([]txmgr.EthTxAttempt{
 					{
 						EthTxID:                 1,
 						Hash:                    hash,
@@ -254,7 +255,7 @@ func TestResolver_EthTransactions(t *testing.T) {
 						EVMChainID:     *utils.NewBigI(22),
 					},
 				}, 1, nil)
-				f.Mocks.txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{1}).Return([]txmgr.EthTxAttempt{
+				f.Mocks.txmORM.On("FindEthTxAttemptsByEthTxIDs", []int64{1}, mock.Anything).Return([]txmgr.EthTxAttempt{
 					{
 						EthTxID:                 1,
 						Hash:                    hash,
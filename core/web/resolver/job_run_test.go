@@ -39,7 +39,7 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 			name:          "success",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.Mocks.jobORM.On("PipelineRuns", (*int32)(nil), PageDefaultOffset, PageDefaultLimit).Return([]pipeline.Run{
+				f.Mocks.jobORM.On("PipelineRuns", (*int32)(nil), PageDefaultOffset, PageDefaultLimit, mock.Anything).Return([]pipeline.Run{
 					{
 						ID: int64(200),
 					},
@@ -63,7 +63,7 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 			name:          "generic error on PipelineRuns()",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.Mocks.jobORM.On("PipelineRuns", (*int32)(nil), PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.Mocks.jobORM.On("PipelineRuns", (*int32)(nil), PageDefaultOffset, PageDefaultLimit, mock.Anything).Return(nil, 0, gError)
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
 			},
 			query:  query,
@@ -130,7 +130,7 @@ func TestResolver_JobRun(t *testing.T) {
 			name:          "success",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2)).Return(pipeline.Run{
+				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2), mock.Anything).Return(pipeline.Run{
 					ID:             2,
 					PipelineSpecID: 5,
 					CreatedAt:      f.Timestamp(),
@@ -141,7 +141,7 @@ func TestResolver_JobRun(t *testing.T) {
 					Outputs:        outputs,
 					State:          pipeline.RunStatusErrored,
 				}, nil)
-				f.Mocks.jobORM.On("FindJobsByPipelineSpecIDs", []int32{5}).Return([]job.Job{
+				f.Mocks.jobORM.On("FindJobsByPipelineSpecIDs", []int32{5}, mock.Anything).Return([]job.Job{
 					{
 						ID:             1,
 						PipelineSpecID: 2,
@@ -179,7 +179,7 @@ func TestResolver_JobRun(t *testing.T) {
 			name:          "not found error",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2)).Return(pipeline.Run{}, sql.ErrNoRows)
+				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2), mock.Anything).Return(pipeline.Run{}, sql.ErrNoRows)
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
 			},
 			query:     query,
@@ -196,7 +196,7 @@ func TestResolver_JobRun(t *testing.T) {
 			name:          "generic error on FindPipelineRunByID()",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2)).Return(pipeline.Run{}, gError)
+				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2), mock.Anything).Return(pipeline.Run{}, gError)
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
 			},
 			query:     query,
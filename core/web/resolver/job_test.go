@@ -90,7 +90,7 @@ func TestResolver_Jobs(t *testing.T) {
 					On("FindPipelineRunIDsByJobID", int32(1), 0, 50).
 					Return([]int64{200}, nil)
 				f.Mocks.jobORM.
-					On("FindPipelineRunsByIDs", []int64{200}).
+					On("FindPipelineRunsByIDs", []int64{200}, mock.Anything).
 					Return([]pipeline.Run{{ID: 200}}, nil)
 				f.Mocks.jobORM.
 					On("CountPipelineRunsByJobID", int32(1)).
@@ -198,7 +198,7 @@ func TestResolver_Job(t *testing.T) {
 					On("FindPipelineRunIDsByJobID", int32(1), 0, 50).
 					Return([]int64{200}, nil)
 				f.Mocks.jobORM.
-					On("FindPipelineRunsByIDs", []int64{200}).
+					On("FindPipelineRunsByIDs", []int64{200}, mock.Anything).
 					Return([]pipeline.Run{{ID: 200}}, nil)
 				f.Mocks.jobORM.
 					On("CountPipelineRunsByJobID", int32(1)).
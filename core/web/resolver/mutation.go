@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
+	"github.com/jackc/pgconn"
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
@@ -37,6 +39,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
@@ -57,7 +60,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -107,7 +110,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -131,7 +134,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -171,7 +174,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeedsManagers); err != nil {
 		return nil, err
 	}
 
@@ -245,7 +248,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeedsManagers); err != nil {
 		return nil, err
 	}
 
@@ -298,7 +301,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeedsManagers); err != nil {
 		return nil, err
 	}
 
@@ -371,7 +374,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeedsManagers); err != nil {
 		return nil, err
 	}
 
@@ -424,7 +427,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -492,7 +495,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeedsManagers); err != nil {
 		return nil, err
 	}
 
@@ -537,7 +540,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -557,7 +560,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -576,7 +579,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) CreateNode(ctx context.Context, args struct {
 	Input *types.NewNode
 }) (*CreateNodePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceNodes); err != nil {
 		return nil, err
 	}
 
@@ -607,7 +610,7 @@ func (r *Resolver) CreateNode(ctx context.Context, args struct {
 func (r *Resolver) DeleteNode(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteNodePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceNodes); err != nil {
 		return nil, err
 	}
 
@@ -640,7 +643,7 @@ func (r *Resolver) DeleteNode(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -676,7 +679,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -699,7 +702,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -725,7 +728,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -747,7 +750,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -767,12 +770,45 @@ func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	return NewDeleteVRFKeyPayloadResolver(key, nil), nil
 }
 
+// jobProposalSpecScope returns the scope of operations on a job proposal
+// spec, which is the chain of its job if the definition names one: the EVM
+// chain of evmChainID, or the chain of the relay of an OCR2 job.
+func (r *Resolver) jobProposalSpecScope(specID graphql.ID) func() (string, error) {
+	return func() (string, error) {
+		id, err := stringutils.ToInt64(string(specID))
+		if err != nil {
+			return "", err
+		}
+		spec, err := r.App.GetFeedsService().GetSpec(id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", nil
+			}
+			return "", err
+		}
+		tree, err := toml.Load(spec.Definition)
+		if err != nil {
+			return "", nil
+		}
+		if chainID := tree.Get("evmChainID"); chainID != nil {
+			return fmt.Sprintf("evm/%v", chainID), nil
+		}
+		// OCR2 jobs name their chain in the relay config.
+		relay, _ := tree.Get("relay").(string)
+		chainID := tree.Get("relayConfig.chainID")
+		if relay == "" || chainID == nil {
+			return "", nil
+		}
+		return fmt.Sprintf("%s/%v", relay, chainID), nil
+	}
+}
+
 // ApproveJobProposalSpec approves the job proposal spec.
 func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobProposals, sessions.UserRoleEdit, r.jobProposalSpecScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -811,7 +847,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobProposals, sessions.UserRoleEdit, r.jobProposalSpecScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -846,7 +882,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobProposals, sessions.UserRoleEdit, r.jobProposalSpecScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -882,7 +918,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobProposals, sessions.UserRoleEdit, r.jobProposalSpecScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -956,7 +992,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -1047,7 +1083,7 @@ func (r *Resolver) CreateChain(ctx context.Context, args struct {
 		KeySpecificConfigs []*KeySpecificChainConfigInput
 	}
 }) (*CreateChainPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceChains, sessions.UserRoleEdit, evmChainScope(args.Input.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1101,7 +1137,7 @@ func (r *Resolver) UpdateChain(ctx context.Context, args struct {
 		KeySpecificConfigs []*KeySpecificChainConfigInput
 	}
 }) (*UpdateChainPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceChains, sessions.UserRoleEdit, evmChainScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1154,7 +1190,7 @@ func (r *Resolver) UpdateChain(ctx context.Context, args struct {
 func (r *Resolver) DeleteChain(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteChainPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceChains, sessions.UserRoleEdit, evmChainScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1187,7 +1223,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -1253,7 +1289,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -1287,7 +1323,7 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -1321,7 +1357,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authenticateUserCanRun(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -1351,7 +1387,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -1377,7 +1413,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -1404,7 +1440,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -1422,3 +1458,210 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]interface{}{"id": id})
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
+
+// CreateRole creates a custom role.
+func (r *Resolver) CreateRole(ctx context.Context, args struct {
+	Input struct {
+		Name        string
+		Permissions []string
+	}
+}) (*CreateRolePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceUsers); err != nil {
+		return nil, err
+	}
+
+	role, err := sessions.NewRole(args.Input.Name, args.Input.Permissions)
+	if err != nil {
+		return NewCreateRolePayload(nil, map[string]string{"input": err.Error()}), nil
+	}
+
+	if _, err = r.App.SessionORM().FindRole(role.Name); err == nil {
+		return NewCreateRolePayload(nil, map[string]string{"input/name": "role already exists"}), nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if err = r.App.SessionORM().CreateRole(&role); err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.RoleCreated, map[string]interface{}{
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	})
+
+	return NewCreateRolePayload(&role, nil), nil
+}
+
+// UpdateRole replaces the permissions of a custom role.
+func (r *Resolver) UpdateRole(ctx context.Context, args struct {
+	Name  string
+	Input struct {
+		Permissions []string
+	}
+}) (*UpdateRolePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceUsers); err != nil {
+		return nil, err
+	}
+
+	permissions, err := sessions.ValidatePermissions(args.Input.Permissions)
+	if err != nil {
+		return NewUpdateRolePayload(nil, map[string]string{"input/permissions": err.Error()}, nil), nil
+	}
+
+	role, err := r.App.SessionORM().UpdateRolePermissions(args.Name, permissions)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateRolePayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.RoleUpdated, map[string]interface{}{
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	})
+
+	return NewUpdateRolePayload(&role, nil, nil), nil
+}
+
+// DeleteRole deletes a custom role, which must not be assigned to any user.
+func (r *Resolver) DeleteRole(ctx context.Context, args struct {
+	Name string
+}) (*DeleteRolePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceUsers); err != nil {
+		return nil, err
+	}
+
+	role, err := r.App.SessionORM().FindRole(args.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDeleteRolePayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	if err = r.App.SessionORM().DeleteRole(args.Name); err != nil {
+		if errors.Is(err, sessions.ErrRoleAssigned) {
+			return NewDeleteRolePayload(nil, map[string]string{"name": err.Error()}, nil), nil
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDeleteRolePayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.RoleDeleted, map[string]interface{}{"name": role.Name})
+
+	return NewDeleteRolePayload(&role, nil, nil), nil
+}
+
+func (r *Resolver) CreateNamedAPIToken(ctx context.Context, args struct {
+	Input struct {
+		Name        string
+		Password    string
+		Permissions *[]string
+		AllowedIPs  *[]string
+		ExpiresAt   *graphql.Time
+	}
+}) (*CreateNamedAPITokenPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.CheckPasswordHash(args.Input.Password, dbUser.HashedPassword) {
+		r.App.GetAuditLogger().Audit(audit.NamedAPITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": dbUser.Email, "name": args.Input.Name})
+
+		return NewCreateNamedAPITokenPayload(nil, "", map[string]string{
+			"password": "incorrect password",
+		}), nil
+	}
+
+	request := sessions.APITokenRequest{Name: args.Input.Name}
+	if args.Input.Permissions != nil {
+		request.Permissions = *args.Input.Permissions
+	}
+	if args.Input.AllowedIPs != nil {
+		request.AllowedIPs = *args.Input.AllowedIPs
+	}
+	if args.Input.ExpiresAt != nil {
+		request.ExpiresAt = &args.Input.ExpiresAt.Time
+	}
+	apiToken, token, err := sessions.NewAPIToken(dbUser.Email, request)
+	if err != nil {
+		return NewCreateNamedAPITokenPayload(nil, "", map[string]string{"input": err.Error()}), nil
+	}
+
+	if err = r.App.SessionORM().CreateAPIToken(&apiToken); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return NewCreateNamedAPITokenPayload(nil, "", map[string]string{
+				"name": fmt.Sprintf("API token %s already exists", apiToken.Name),
+			}), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.NamedAPITokenCreated, map[string]interface{}{
+		"user":        dbUser.Email,
+		"name":        apiToken.Name,
+		"permissions": apiToken.Permissions,
+		"allowedIPs":  apiToken.AllowedIPs,
+		"expiresAt":   apiToken.ExpiresAt,
+	})
+
+	return NewCreateNamedAPITokenPayload(&apiToken, token.Secret, nil), nil
+}
+
+func (r *Resolver) RevokeNamedAPIToken(ctx context.Context, args struct {
+	Input struct {
+		Name     string
+		Password string
+	}
+}) (*RevokeNamedAPITokenPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.CheckPasswordHash(args.Input.Password, dbUser.HashedPassword) {
+		r.App.GetAuditLogger().Audit(audit.NamedAPITokenRevokeAttemptPasswordMismatch, map[string]interface{}{"user": dbUser.Email, "name": args.Input.Name})
+
+		return NewRevokeNamedAPITokenPayload("", nil, map[string]string{
+			"password": "incorrect password",
+		}), nil
+	}
+
+	if err = r.App.SessionORM().RevokeAPIToken(dbUser.Email, args.Input.Name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRevokeNamedAPITokenPayload("", err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.NamedAPITokenRevoked, map[string]interface{}{"user": dbUser.Email, "name": args.Input.Name})
+
+	return NewRevokeNamedAPITokenPayload(args.Input.Name, nil, nil), nil
+}
@@ -0,0 +1,177 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// NamedAPITokenResolver resolves the NamedAPIToken type.
+type NamedAPITokenResolver struct {
+	token sessions.APIToken
+}
+
+func NewNamedAPIToken(token sessions.APIToken) *NamedAPITokenResolver {
+	return &NamedAPITokenResolver{token: token}
+}
+
+func NewNamedAPITokens(tokens []sessions.APIToken) []*NamedAPITokenResolver {
+	var resolvers []*NamedAPITokenResolver
+	for _, t := range tokens {
+		resolvers = append(resolvers, NewNamedAPIToken(t))
+	}
+
+	return resolvers
+}
+
+// Name resolves the token's name.
+func (r *NamedAPITokenResolver) Name() string {
+	return r.token.Name
+}
+
+// AccessKey resolves the token's access key.
+func (r *NamedAPITokenResolver) AccessKey() string {
+	return r.token.TokenKey
+}
+
+// Permissions resolves the token's permissions. An empty list grants all the
+// permissions of the user.
+func (r *NamedAPITokenResolver) Permissions() []string {
+	return nonNilStrings(r.token.Permissions)
+}
+
+// AllowedIPs resolves the networks the token may be used from. An empty list
+// allows any client IP.
+func (r *NamedAPITokenResolver) AllowedIPs() []string {
+	return nonNilStrings(r.token.AllowedIPs)
+}
+
+// ExpiresAt resolves the token's expiry.
+func (r *NamedAPITokenResolver) ExpiresAt() *graphql.Time {
+	if !r.token.ExpiresAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.token.ExpiresAt.Time}
+}
+
+// LastUsed resolves when the token was last used.
+func (r *NamedAPITokenResolver) LastUsed() *graphql.Time {
+	if !r.token.LastUsed.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.token.LastUsed.Time}
+}
+
+// CreatedAt resolves the token's created at field.
+func (r *NamedAPITokenResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.token.CreatedAt}
+}
+
+func nonNilStrings(ss []string) []string {
+	if ss == nil {
+		return []string{}
+	}
+	return ss
+}
+
+// NamedAPITokensPayloadResolver resolves a list of named API tokens
+type NamedAPITokensPayloadResolver struct {
+	tokens []sessions.APIToken
+}
+
+func NewNamedAPITokensPayload(tokens []sessions.APIToken) *NamedAPITokensPayloadResolver {
+	return &NamedAPITokensPayloadResolver{tokens: tokens}
+}
+
+// Results returns the named API tokens.
+func (r *NamedAPITokensPayloadResolver) Results() []*NamedAPITokenResolver {
+	return NewNamedAPITokens(r.tokens)
+}
+
+// -- CreateNamedAPIToken Mutation --
+
+type CreateNamedAPITokenPayloadResolver struct {
+	token     *sessions.APIToken
+	secret    string
+	inputErrs map[string]string
+}
+
+func NewCreateNamedAPITokenPayload(token *sessions.APIToken, secret string, inputErrs map[string]string) *CreateNamedAPITokenPayloadResolver {
+	return &CreateNamedAPITokenPayloadResolver{token: token, secret: secret, inputErrs: inputErrs}
+}
+
+func (r *CreateNamedAPITokenPayloadResolver) ToCreateNamedAPITokenSuccess() (*CreateNamedAPITokenSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewCreateNamedAPITokenSuccess(*r.token, r.secret), true
+}
+
+func (r *CreateNamedAPITokenPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return toInputErrors(r.inputErrs), true
+	}
+
+	return nil, false
+}
+
+type CreateNamedAPITokenSuccessResolver struct {
+	token  sessions.APIToken
+	secret string
+}
+
+func NewCreateNamedAPITokenSuccess(token sessions.APIToken, secret string) *CreateNamedAPITokenSuccessResolver {
+	return &CreateNamedAPITokenSuccessResolver{token: token, secret: secret}
+}
+
+func (r *CreateNamedAPITokenSuccessResolver) Token() *NamedAPITokenResolver {
+	return NewNamedAPIToken(r.token)
+}
+
+// Secret resolves the token's secret, which is only available on creation.
+func (r *CreateNamedAPITokenSuccessResolver) Secret() string {
+	return r.secret
+}
+
+// -- RevokeNamedAPIToken Mutation --
+
+type RevokeNamedAPITokenPayloadResolver struct {
+	name      string
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewRevokeNamedAPITokenPayload(name string, err error, inputErrs map[string]string) *RevokeNamedAPITokenPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "API token not found"}
+
+	return &RevokeNamedAPITokenPayloadResolver{name: name, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *RevokeNamedAPITokenPayloadResolver) ToRevokeNamedAPITokenSuccess() (*RevokeNamedAPITokenSuccessResolver, bool) {
+	if r.err == nil && r.inputErrs == nil {
+		return NewRevokeNamedAPITokenSuccess(r.name), true
+	}
+
+	return nil, false
+}
+
+func (r *RevokeNamedAPITokenPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return toInputErrors(r.inputErrs), true
+	}
+
+	return nil, false
+}
+
+type RevokeNamedAPITokenSuccessResolver struct {
+	name string
+}
+
+func NewRevokeNamedAPITokenSuccess(name string) *RevokeNamedAPITokenSuccessResolver {
+	return &RevokeNamedAPITokenSuccessResolver{name: name}
+}
+
+func (r *RevokeNamedAPITokenSuccessResolver) Name() string {
+	return r.name
+}
@@ -0,0 +1,307 @@
+package resolver
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+func TestResolver_NamedAPITokens(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetNamedAPITokens {
+			namedAPITokens {
+				results {
+					name
+					accessKey
+					permissions
+					allowedIPs
+					expiresAt
+					lastUsed
+					createdAt
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "namedAPITokens"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.sessionsORM.On("ListAPITokens", session.User.Email).Return([]sessions.APIToken{{
+					Name:        "ci",
+					TokenKey:    "access-key",
+					Permissions: pq.StringArray{"jobs:edit"},
+					LastUsed:    null.TimeFrom(f.Timestamp()),
+					CreatedAt:   f.Timestamp(),
+				}}, nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query: query,
+			result: `
+				{
+					"namedAPITokens": {
+						"results": [{
+							"name": "ci",
+							"accessKey": "access-key",
+							"permissions": ["jobs:edit"],
+							"allowedIPs": [],
+							"expiresAt": null,
+							"lastUsed": "2021-01-01T00:00:00Z",
+							"createdAt": "2021-01-01T00:00:00Z"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_CreateNamedAPIToken(t *testing.T) {
+	t.Parallel()
+
+	defaultPassword := "my-password"
+	mutation := `
+		mutation CreateNamedAPIToken($input: CreateNamedAPITokenInput!) {
+			createNamedAPIToken(input: $input) {
+				... on CreateNamedAPITokenSuccess {
+					token {
+						name
+						permissions
+						allowedIPs
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := func(password, permission string) map[string]interface{} {
+		return map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":        "ci",
+				"password":    password,
+				"permissions": []interface{}{permission},
+				"allowedIPs":  []interface{}{"10.0.0.1"},
+			},
+		}
+	}
+	findUser := func(f *gqlTestFramework) {
+		session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+		require.True(t, ok)
+
+		pwd, err := utils.HashPassword(defaultPassword)
+		require.NoError(t, err)
+		session.User.HashedPassword = pwd
+
+		f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
+		f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables(defaultPassword, "jobs:edit")}, "createNamedAPIToken"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				findUser(f)
+				f.Mocks.sessionsORM.On("CreateAPIToken", mock.MatchedBy(func(token *sessions.APIToken) bool {
+					return token.Name == "ci" && token.TokenHashedSecret != ""
+				})).Return(nil)
+			},
+			query:     mutation,
+			variables: variables(defaultPassword, "jobs:edit"),
+			result: `
+				{
+					"createNamedAPIToken": {
+						"token": {
+							"name": "ci",
+							"permissions": ["jobs:edit"],
+							"allowedIPs": ["10.0.0.1/32"]
+						}
+					}
+				}`,
+		},
+		{
+			name:          "incorrect password",
+			authenticated: true,
+			before:        findUser,
+			query:         mutation,
+			variables:     variables("wrong-password", "jobs:edit"),
+			result: `
+				{
+					"createNamedAPIToken": {
+						"errors": [{
+							"path": "password",
+							"message": "incorrect password",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid permission",
+			authenticated: true,
+			before:        findUser,
+			query:         mutation,
+			variables:     variables(defaultPassword, "widgets:edit"),
+			result: `
+				{
+					"createNamedAPIToken": {
+						"errors": [{
+							"path": "input",
+							"message": "invalid permission \"widgets:edit\": unknown resource: widgets",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "already exists",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				findUser(f)
+				f.Mocks.sessionsORM.On("CreateAPIToken", mock.Anything).Return(&pgconn.PgError{Code: "23505"})
+			},
+			query:     mutation,
+			variables: variables(defaultPassword, "jobs:edit"),
+			result: `
+				{
+					"createNamedAPIToken": {
+						"errors": [{
+							"path": "name",
+							"message": "API token ci already exists",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RevokeNamedAPIToken(t *testing.T) {
+	t.Parallel()
+
+	defaultPassword := "my-password"
+	mutation := `
+		mutation RevokeNamedAPIToken($input: RevokeNamedAPITokenInput!) {
+			revokeNamedAPIToken(input: $input) {
+				... on RevokeNamedAPITokenSuccess {
+					name
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := func(password string) map[string]interface{} {
+		return map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":     "ci",
+				"password": password,
+			},
+		}
+	}
+	findUser := func(f *gqlTestFramework) {
+		session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+		require.True(t, ok)
+
+		pwd, err := utils.HashPassword(defaultPassword)
+		require.NoError(t, err)
+		session.User.HashedPassword = pwd
+
+		f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
+		f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables(defaultPassword)}, "revokeNamedAPIToken"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				findUser(f)
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.sessionsORM.On("RevokeAPIToken", session.User.Email, "ci").Return(nil)
+			},
+			query:     mutation,
+			variables: variables(defaultPassword),
+			result: `
+				{
+					"revokeNamedAPIToken": {
+						"name": "ci"
+					}
+				}`,
+		},
+		{
+			name:          "incorrect password",
+			authenticated: true,
+			before:        findUser,
+			query:         mutation,
+			variables:     variables("wrong-password"),
+			result: `
+				{
+					"revokeNamedAPIToken": {
+						"errors": [{
+							"path": "password",
+							"message": "incorrect password",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				findUser(f)
+				session, ok := webauth.GetGQLAuthenticatedSession(f.Ctx)
+				require.True(t, ok)
+
+				f.Mocks.sessionsORM.On("RevokeAPIToken", session.User.Email, "ci").Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables(defaultPassword),
+			result: `
+				{
+					"revokeNamedAPIToken": {
+						"message": "API token not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -14,16 +14,20 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/config"
 	config2 "github.com/smartcontractkit/chainlink/core/config/v2"
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
 )
 
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -49,7 +53,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*BridgesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -66,7 +70,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 
 // Chain retrieves a chain by id.
 func (r *Resolver) Chain(ctx context.Context, args struct{ ID graphql.ID }) (*ChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceChains, sessions.UserRoleView, evmChainScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +97,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*ChainsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 
@@ -110,7 +114,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 
 // FeedsManager retrieves a feeds manager by id.
 func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID }) (*FeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceFeedsManagers); err != nil {
 		return nil, err
 	}
 
@@ -132,7 +136,7 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 }
 
 func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceFeedsManagers); err != nil {
 		return nil, err
 	}
 
@@ -146,7 +150,7 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -173,7 +177,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -189,7 +193,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 }
 
 func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -202,7 +206,7 @@ func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadReso
 }
 
 func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -225,7 +229,7 @@ func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, erro
 
 // Node retrieves a node by ID (Name)
 func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*NodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceNodes); err != nil {
 		return nil, err
 	}
 
@@ -242,7 +246,7 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 }
 
 func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -256,7 +260,7 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -272,7 +276,7 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*VRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -291,7 +295,7 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobProposalPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobProposals); err != nil {
 		return nil, err
 	}
 
@@ -317,7 +321,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*NodesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceNodes); err != nil {
 		return nil, err
 	}
 
@@ -336,14 +340,14 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobRunsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
 	limit := pageLimit(args.Limit)
 	offset := pageOffset(args.Offset)
 
-	runs, count, err := r.App.JobORM().PipelineRuns(nil, offset, limit)
+	runs, count, err := r.App.JobORM().PipelineRuns(nil, offset, limit, pg.WithReadOnly())
 	if err != nil {
 		return nil, err
 	}
@@ -354,7 +358,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -376,7 +380,7 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 }
 
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -428,7 +432,7 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 
 // Config retrieves the Chainlink node's configuration
 func (r *Resolver) Config(ctx context.Context) (*ConfigPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -443,7 +447,7 @@ func (r *Resolver) Config(ctx context.Context) (*ConfigPayloadResolver, error) {
 
 // ConfigV2 retrieves the Chainlink node's configuration (V2 mode)
 func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -463,7 +467,7 @@ func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, erro
 func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	Hash graphql.ID
 }) (*EthTransactionPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceTransactions); err != nil {
 		return nil, err
 	}
 
@@ -484,7 +488,7 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceTransactions); err != nil {
 		return nil, err
 	}
 
@@ -503,7 +507,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsAttemptsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceTransactions); err != nil {
 		return nil, err
 	}
 
@@ -519,7 +523,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 }
 
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -529,7 +533,7 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 }
 
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -542,7 +546,7 @@ func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver,
 }
 
 func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -553,7 +557,7 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 
 // OCR2KeyBundles resolves the list of OCR2 key bundles
 func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -564,3 +568,138 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// Reorg retrieves a reorg by id.
+func (r *Resolver) Reorg(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ReorgPayloadResolver, error) {
+	if err := authenticateUserCanView(ctx, sessions.ResourceChains); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	rg, err := r.App.ReorgORM().FindReorg(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewReorgPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewReorgPayload(&rg, nil), nil
+}
+
+// Reorgs retrieves a paginated list of reorgs, most recent first.
+func (r *Resolver) Reorgs(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+}) (*ReorgsPayloadResolver, error) {
+	if err := authenticateUserCanView(ctx, sessions.ResourceChains); err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	reorgs, count, err := r.App.ReorgORM().Reorgs(offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReorgsPayload(reorgs, int32(count)), nil
+}
+
+// AuditEvents retrieves a page of audit events, most recent first.
+func (r *Resolver) AuditEvents(ctx context.Context, args struct {
+	User      *string
+	EventType *string
+	From      *graphql.Time
+	To        *graphql.Time
+	Offset    *int32
+	Limit     *int32
+}) (*AuditEventsPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceAuditLog); err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	var filter audit.EventFilter
+	if args.User != nil {
+		filter.User = *args.User
+	}
+	if args.EventType != nil {
+		filter.EventID = audit.EventID(*args.EventType)
+	}
+	if args.From != nil {
+		filter.From = args.From.Time
+	}
+	if args.To != nil {
+		filter.To = args.To.Time
+	}
+
+	events, count, err := r.App.AuditLogORM().Events(filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAuditEventsPayload(events, int32(count)), nil
+}
+
+// AuditLogVerification verifies the hash chain of the audit log.
+func (r *Resolver) AuditLogVerification(ctx context.Context) (*AuditLogVerificationResolver, error) {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceAuditLog); err != nil {
+		return nil, err
+	}
+
+	v, err := r.App.AuditLogORM().Verify()
+	if err != nil {
+		return nil, err
+	}
+	r.App.GetAuditLogger().Audit(audit.AuditLogVerified, map[string]interface{}{
+		"valid":          v.Valid(),
+		"events":         v.Events,
+		"invalidEventID": v.InvalidEventID,
+	})
+
+	return NewAuditLogVerification(v), nil
+}
+
+// NamedAPITokens retrieves the named API tokens of the current user.
+func (r *Resolver) NamedAPITokens(ctx context.Context) (*NamedAPITokensPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("Failed to obtain current user from context")
+	}
+
+	tokens, err := r.App.SessionORM().ListAPITokens(session.User.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNamedAPITokensPayload(tokens), nil
+}
+
+// Roles retrieves the custom roles.
+func (r *Resolver) Roles(ctx context.Context) (*RolesPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceUsers); err != nil {
+		return nil, err
+	}
+
+	roles, err := r.App.SessionORM().ListRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRolesPayload(roles), nil
+}
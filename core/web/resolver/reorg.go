@@ -0,0 +1,127 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/core/web/loader"
+)
+
+type ReorgResolver struct {
+	r reorg.Reorg
+}
+
+func NewReorg(r reorg.Reorg) *ReorgResolver {
+	return &ReorgResolver{r: r}
+}
+
+func NewReorgs(results []reorg.Reorg) []*ReorgResolver {
+	var resolver []*ReorgResolver
+
+	for _, r := range results {
+		resolver = append(resolver, NewReorg(r))
+	}
+
+	return resolver
+}
+
+func (r *ReorgResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.r.ID))
+}
+
+func (r *ReorgResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.r.EVMChainID.String())
+}
+
+// Chain resolves the reorg's chain object field.
+func (r *ReorgResolver) Chain(ctx context.Context) (*ChainResolver, error) {
+	chain, err := loader.GetChainByID(ctx, string(r.EVMChainID()))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChain(*chain), nil
+}
+
+func (r *ReorgResolver) Source() string {
+	return string(r.r.Source)
+}
+
+func (r *ReorgResolver) Depth() int32 {
+	return int32(r.r.Depth)
+}
+
+func (r *ReorgResolver) FromBlock() string {
+	return stringutils.FromInt64(r.r.FromBlock)
+}
+
+func (r *ReorgResolver) ToBlock() string {
+	return stringutils.FromInt64(r.r.ToBlock)
+}
+
+func (r *ReorgResolver) OldHash() string {
+	return r.r.OldHash.Hex()
+}
+
+func (r *ReorgResolver) NewHash() string {
+	return r.r.NewHash.Hex()
+}
+
+func (r *ReorgResolver) AffectedEthTransactionIDs() []graphql.ID {
+	ids := make([]graphql.ID, len(r.r.AffectedEthTxIDs))
+	for i, id := range r.r.AffectedEthTxIDs {
+		ids[i] = graphql.ID(stringutils.FromInt64(id))
+	}
+	return ids
+}
+
+func (r *ReorgResolver) AffectedLogs() int32 {
+	return int32(r.r.AffectedLogs)
+}
+
+func (r *ReorgResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.r.CreatedAt}
+}
+
+// -- Reorg Query --
+
+type ReorgPayloadResolver struct {
+	r *reorg.Reorg
+	NotFoundErrorUnionType
+}
+
+func NewReorgPayload(r *reorg.Reorg, err error) *ReorgPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "reorg not found", isExpectedErrorFn: nil}
+
+	return &ReorgPayloadResolver{r: r, NotFoundErrorUnionType: e}
+}
+
+func (r *ReorgPayloadResolver) ToReorg() (*ReorgResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewReorg(*r.r), true
+}
+
+// -- Reorgs Query --
+
+type ReorgsPayloadResolver struct {
+	results []reorg.Reorg
+	total   int32
+}
+
+func NewReorgsPayload(results []reorg.Reorg, total int32) *ReorgsPayloadResolver {
+	return &ReorgsPayloadResolver{results: results, total: total}
+}
+
+func (r *ReorgsPayloadResolver) Results() []*ReorgResolver {
+	return NewReorgs(r.results)
+}
+
+func (r *ReorgsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
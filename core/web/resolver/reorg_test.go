@@ -0,0 +1,195 @@
+package resolver
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/reorg"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestResolver_Reorg(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetReorg($id: ID!) {
+			reorg(id: $id) {
+				... on Reorg {
+					id
+					evmChainID
+					chain {
+						id
+					}
+					source
+					depth
+					fromBlock
+					toBlock
+					oldHash
+					newHash
+					affectedEthTransactionIDs
+					affectedLogs
+					createdAt
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "1",
+	}
+	chainID := *utils.NewBigI(22)
+	createdAt := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "reorg"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.reorgORM.On("FindReorg", int64(1)).Return(reorg.Reorg{
+					ID:               1,
+					EVMChainID:       chainID,
+					Source:           reorg.SourceHeadTracker,
+					Depth:            2,
+					FromBlock:        41,
+					ToBlock:          42,
+					OldHash:          common.HexToHash("0x01"),
+					NewHash:          common.HexToHash("0x02"),
+					AffectedEthTxIDs: pq.Int64Array{3, 4},
+					AffectedLogs:     5,
+					CreatedAt:        createdAt,
+				}, nil)
+				f.App.On("ReorgORM").Return(f.Mocks.reorgORM)
+				f.Mocks.evmORM.PutChains(types.DBChain{ID: chainID})
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"reorg": {
+						"id": "1",
+						"evmChainID": "22",
+						"chain": {
+							"id": "22"
+						},
+						"source": "head_tracker",
+						"depth": 2,
+						"fromBlock": "41",
+						"toBlock": "42",
+						"oldHash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+						"newHash": "0x0000000000000000000000000000000000000000000000000000000000000002",
+						"affectedEthTransactionIDs": ["3", "4"],
+						"affectedLogs": 5,
+						"createdAt": "2022-10-01T12:00:00Z"
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.reorgORM.On("FindReorg", int64(1)).Return(reorg.Reorg{}, sql.ErrNoRows)
+				f.App.On("ReorgORM").Return(f.Mocks.reorgORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"reorg": {
+						"code": "NOT_FOUND",
+						"message": "reorg not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.reorgORM.On("FindReorg", int64(1)).Return(reorg.Reorg{}, gError)
+				f.App.On("ReorgORM").Return(f.Mocks.reorgORM)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"reorg"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetReorgs {
+			reorgs {
+				results {
+					id
+					source
+					depth
+					fromBlock
+					toBlock
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "reorgs"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.reorgORM.On("Reorgs", PageDefaultOffset, PageDefaultLimit).Return([]reorg.Reorg{
+					{
+						ID:        2,
+						Source:    reorg.SourceLogPoller,
+						Depth:     1,
+						FromBlock: 100,
+						ToBlock:   100,
+					},
+				}, 1, nil)
+				f.App.On("ReorgORM").Return(f.Mocks.reorgORM)
+			},
+			query: query,
+			result: `
+				{
+					"reorgs": {
+						"results": [{
+							"id": "2",
+							"source": "log_poller",
+							"depth": 1,
+							"fromBlock": "100",
+							"toBlock": "100"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
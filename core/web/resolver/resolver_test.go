@@ -13,12 +13,14 @@ import (
 	bridgeORMMocks "github.com/smartcontractkit/chainlink/core/bridges/mocks"
 	evmConfigMocks "github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
 	evmORMMocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	reorgMocks "github.com/smartcontractkit/chainlink/core/chains/evm/reorg/mocks"
 	txmgrMocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	configMocks "github.com/smartcontractkit/chainlink/core/config/mocks"
 	coremocks "github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	auditMocks "github.com/smartcontractkit/chainlink/core/logger/audit/mocks"
 	feedsMocks "github.com/smartcontractkit/chainlink/core/services/feeds/mocks"
 	jobORMMocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
@@ -54,6 +56,8 @@ type mocks struct {
 	eIMgr       *webhookmocks.ExternalInitiatorManager
 	balM        *evmORMMocks.BalanceMonitor
 	txmORM      *txmgrMocks.ORM
+	reorgORM    *reorgMocks.ORM
+	auditLogORM *auditMocks.ORM
 	auditLogger *audit.AuditLoggerService
 }
 
@@ -112,6 +116,8 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		eIMgr:       webhookmocks.NewExternalInitiatorManager(t),
 		balM:        evmORMMocks.NewBalanceMonitor(t),
 		txmORM:      txmgrMocks.NewORM(t),
+		reorgORM:    reorgMocks.NewORM(t),
+		auditLogORM: auditMocks.NewORM(t),
 		auditLogger: &audit.AuditLoggerService{},
 	}
 
@@ -0,0 +1,191 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// RoleResolver resolves the Role type.
+type RoleResolver struct {
+	role sessions.Role
+}
+
+func NewRole(role sessions.Role) *RoleResolver {
+	return &RoleResolver{role: role}
+}
+
+func NewRoles(roles []sessions.Role) []*RoleResolver {
+	var resolvers []*RoleResolver
+	for _, r := range roles {
+		resolvers = append(resolvers, NewRole(r))
+	}
+
+	return resolvers
+}
+
+// Name resolves the role's name.
+func (r *RoleResolver) Name() string {
+	return r.role.Name
+}
+
+// Permissions resolves the role's permissions.
+func (r *RoleResolver) Permissions() []string {
+	return r.role.Permissions
+}
+
+// CreatedAt resolves the role's created at field.
+func (r *RoleResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.role.CreatedAt}
+}
+
+// UpdatedAt resolves the role's updated at field.
+func (r *RoleResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.role.UpdatedAt}
+}
+
+// RolesPayloadResolver resolves a list of roles
+type RolesPayloadResolver struct {
+	roles []sessions.Role
+}
+
+func NewRolesPayload(roles []sessions.Role) *RolesPayloadResolver {
+	return &RolesPayloadResolver{roles: roles}
+}
+
+// Results returns the roles.
+func (r *RolesPayloadResolver) Results() []*RoleResolver {
+	return NewRoles(r.roles)
+}
+
+func toInputErrors(inputErrs map[string]string) *InputErrorsResolver {
+	var errs []*InputErrorResolver
+	for path, message := range inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs)
+}
+
+// -- CreateRole Mutation --
+
+type CreateRolePayloadResolver struct {
+	role      *sessions.Role
+	inputErrs map[string]string
+}
+
+func NewCreateRolePayload(role *sessions.Role, inputErrs map[string]string) *CreateRolePayloadResolver {
+	return &CreateRolePayloadResolver{role, inputErrs}
+}
+
+func (r *CreateRolePayloadResolver) ToCreateRoleSuccess() (*CreateRoleSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewCreateRoleSuccess(*r.role), true
+}
+
+func (r *CreateRolePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return toInputErrors(r.inputErrs), true
+	}
+
+	return nil, false
+}
+
+type CreateRoleSuccessResolver struct {
+	role sessions.Role
+}
+
+func NewCreateRoleSuccess(role sessions.Role) *CreateRoleSuccessResolver {
+	return &CreateRoleSuccessResolver{role}
+}
+
+func (r *CreateRoleSuccessResolver) Role() *RoleResolver {
+	return NewRole(r.role)
+}
+
+// -- UpdateRole Mutation --
+
+type UpdateRolePayloadResolver struct {
+	role      *sessions.Role
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdateRolePayload(role *sessions.Role, inputErrs map[string]string, err error) *UpdateRolePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "role not found"}
+
+	return &UpdateRolePayloadResolver{role: role, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *UpdateRolePayloadResolver) ToUpdateRoleSuccess() (*UpdateRoleSuccessResolver, bool) {
+	if r.role != nil {
+		return NewUpdateRoleSuccess(*r.role), true
+	}
+
+	return nil, false
+}
+
+func (r *UpdateRolePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return toInputErrors(r.inputErrs), true
+	}
+
+	return nil, false
+}
+
+type UpdateRoleSuccessResolver struct {
+	role sessions.Role
+}
+
+func NewUpdateRoleSuccess(role sessions.Role) *UpdateRoleSuccessResolver {
+	return &UpdateRoleSuccessResolver{role}
+}
+
+func (r *UpdateRoleSuccessResolver) Role() *RoleResolver {
+	return NewRole(r.role)
+}
+
+// -- DeleteRole Mutation --
+
+type DeleteRolePayloadResolver struct {
+	role      *sessions.Role
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewDeleteRolePayload(role *sessions.Role, inputErrs map[string]string, err error) *DeleteRolePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "role not found"}
+
+	return &DeleteRolePayloadResolver{role: role, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *DeleteRolePayloadResolver) ToDeleteRoleSuccess() (*DeleteRoleSuccessResolver, bool) {
+	if r.role != nil {
+		return NewDeleteRoleSuccess(*r.role), true
+	}
+
+	return nil, false
+}
+
+func (r *DeleteRolePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		return toInputErrors(r.inputErrs), true
+	}
+
+	return nil, false
+}
+
+type DeleteRoleSuccessResolver struct {
+	role sessions.Role
+}
+
+func NewDeleteRoleSuccess(role sessions.Role) *DeleteRoleSuccessResolver {
+	return &DeleteRoleSuccessResolver{role}
+}
+
+func (r *DeleteRoleSuccessResolver) Role() *RoleResolver {
+	return NewRole(r.role)
+}
@@ -0,0 +1,433 @@
+package resolver
+
+import (
+	"database/sql"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+func TestResolver_Roles(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetRoles {
+			roles {
+				results {
+					name
+					permissions
+					createdAt
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "roles"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("ListRoles").Return([]sessions.Role{{
+					Name:        "bridge-admins",
+					Permissions: pq.StringArray{"bridges:admin"},
+					CreatedAt:   f.Timestamp(),
+				}}, nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query: query,
+			result: `
+				{
+					"roles": {
+						"results": [{
+							"name": "bridge-admins",
+							"permissions": ["bridges:admin"],
+							"createdAt": "2021-01-01T00:00:00Z"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_CreateRole(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CreateRole($input: CreateRoleInput!) {
+			createRole(input: $input) {
+				... on CreateRoleSuccess {
+					role {
+						name
+						permissions
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := func(permission string) map[string]interface{} {
+		return map[string]interface{}{
+			"input": map[string]interface{}{
+				"name":        "bridge-admins",
+				"permissions": []interface{}{permission},
+			},
+		}
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables("bridges:admin")}, "createRole"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindRole", "bridge-admins").Return(sessions.Role{}, sql.ErrNoRows)
+				f.Mocks.sessionsORM.On("CreateRole", &sessions.Role{
+					Name:        "bridge-admins",
+					Permissions: pq.StringArray{"bridges:admin"},
+				}).Return(nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables("bridges:admin"),
+			result: `
+				{
+					"createRole": {
+						"role": {
+							"name": "bridge-admins",
+							"permissions": ["bridges:admin"]
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid permission",
+			authenticated: true,
+			query:         mutation,
+			variables:     variables("widgets:admin"),
+			result: `
+				{
+					"createRole": {
+						"errors": [{
+							"path": "input",
+							"message": "invalid permission \"widgets:admin\": unknown resource: widgets",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "already exists",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindRole", "bridge-admins").Return(sessions.Role{Name: "bridge-admins"}, nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables("bridges:admin"),
+			result: `
+				{
+					"createRole": {
+						"errors": [{
+							"path": "input/name",
+							"message": "role already exists",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_UpdateRole(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation UpdateRole($name: String!, $input: UpdateRoleInput!) {
+			updateRole(name: $name, input: $input) {
+				... on UpdateRoleSuccess {
+					role {
+						name
+						permissions
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"name": "bridge-admins",
+		"input": map[string]interface{}{
+			"permissions": []interface{}{"bridges:edit"},
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateRole"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("UpdateRolePermissions", "bridge-admins", []string{"bridges:edit"}).Return(sessions.Role{
+					Name:        "bridge-admins",
+					Permissions: pq.StringArray{"bridges:edit"},
+				}, nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateRole": {
+						"role": {
+							"name": "bridge-admins",
+							"permissions": ["bridges:edit"]
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("UpdateRolePermissions", "bridge-admins", []string{"bridges:edit"}).Return(sessions.Role{}, sql.ErrNoRows)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateRole": {
+						"message": "role not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_DeleteRole(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation DeleteRole($name: String!) {
+			deleteRole(name: $name) {
+				... on DeleteRoleSuccess {
+					role {
+						name
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{"name": "bridge-admins"}
+	role := sessions.Role{Name: "bridge-admins", Permissions: pq.StringArray{"bridges:admin"}}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteRole"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindRole", "bridge-admins").Return(role, nil)
+				f.Mocks.sessionsORM.On("DeleteRole", "bridge-admins").Return(nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"deleteRole": {
+						"role": {
+							"name": "bridge-admins"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "assigned to users",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindRole", "bridge-admins").Return(role, nil)
+				f.Mocks.sessionsORM.On("DeleteRole", "bridge-admins").Return(sessions.ErrRoleAssigned)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"deleteRole": {
+						"errors": [{
+							"path": "name",
+							"message": "role is assigned to users",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindRole", "bridge-admins").Return(sessions.Role{}, sql.ErrNoRows)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"deleteRole": {
+						"message": "role not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_CustomRoleAuthorization(t *testing.T) {
+	t.Parallel()
+
+	injectCustomRoleUser := func(f *gqlTestFramework) {
+		permissions, err := sessions.ParsePermissions([]string{"bridges:view", "job_proposals:edit:evm/5"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Ctx = auth.SetGQLAuthenticatedSession(f.Ctx, sessions.User{
+			Email:                 "gqltester@chain.link",
+			Role:                  sessions.UserRoleView,
+			CustomRole:            null.StringFrom("feeds-approvers"),
+			CustomRolePermissions: permissions,
+		}, "gqltesterSession")
+	}
+	notPermitted := func(path string) []*gqlerrors.QueryError {
+		return []*gqlerrors.QueryError{{
+			ResolverError: RoleNotPermittedErr{Role: "feeds-approvers"},
+			Path:          []interface{}{path},
+			Message:       "Not permitted with current role: feeds-approvers",
+		}}
+	}
+	approve := `
+		mutation ApproveJobProposalSpec($id: ID!) {
+			approveJobProposalSpec(id: $id) {
+				... on ApproveJobProposalSpecSuccess {
+					spec {
+						id
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		{
+			name: "denied resource",
+			before: func(f *gqlTestFramework) {
+				injectCustomRoleUser(f)
+			},
+			query:  `query { csaKeys { results { id } } }`,
+			result: "null",
+			errors: notPermitted("csaKeys"),
+		},
+		{
+			name: "denied resource role",
+			before: func(f *gqlTestFramework) {
+				injectCustomRoleUser(f)
+			},
+			query:  `mutation { deleteBridge(id: "bridge1") { ... on DeleteBridgeSuccess { bridge { name } } } }`,
+			result: "null",
+			errors: notPermitted("deleteBridge"),
+		},
+		{
+			name: "denied scope",
+			before: func(f *gqlTestFramework) {
+				injectCustomRoleUser(f)
+				f.Mocks.feedsSvc.On("GetSpec", int64(1)).Return(&feeds.JobProposalSpec{
+					ID:         1,
+					Definition: "evmChainID = 4",
+				}, nil)
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+			},
+			query:     approve,
+			variables: map[string]interface{}{"id": "1"},
+			result:    "null",
+			errors:    notPermitted("approveJobProposalSpec"),
+		},
+		{
+			name: "allowed scope",
+			before: func(f *gqlTestFramework) {
+				injectCustomRoleUser(f)
+				f.Mocks.feedsSvc.On("GetSpec", int64(1)).Return(&feeds.JobProposalSpec{
+					ID:         1,
+					Definition: "evmChainID = 5",
+				}, nil)
+				f.Mocks.feedsSvc.On("ApproveSpec", mock.Anything, int64(1), false).Return(nil)
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+			},
+			query:     approve,
+			variables: map[string]interface{}{"id": "1"},
+			result: `
+				{
+					"approveJobProposalSpec": {
+						"spec": {
+							"id": "1"
+						}
+					}
+				}`,
+		},
+		{
+			name: "allowed scope of an OCR2 job",
+			before: func(f *gqlTestFramework) {
+				injectCustomRoleUser(f)
+				f.Mocks.feedsSvc.On("GetSpec", int64(1)).Return(&feeds.JobProposalSpec{
+					ID:         1,
+					Definition: "type = \"offchainreporting2\"\nrelay = \"evm\"\n[relayConfig]\nchainID = 5",
+				}, nil)
+				f.Mocks.feedsSvc.On("ApproveSpec", mock.Anything, int64(1), false).Return(nil)
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+			},
+			query:     approve,
+			variables: map[string]interface{}{"id": "1"},
+			result: `
+				{
+					"approveJobProposalSpec": {
+						"spec": {
+							"id": "1"
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
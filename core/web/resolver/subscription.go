@@ -0,0 +1,221 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/core/web/loader"
+)
+
+// subscribe returns a channel of the resolvers of the events notified on the
+// Postgres channel, until ctx is done. resolve returns nil for the events the
+// subscriber is not interested in.
+func subscribe[T any](ctx context.Context, app chainlink.Application, channel string, resolve func(payload string) (*T, error)) (<-chan *T, error) {
+	sub, err := app.GetEventBroadcaster().Subscribe(channel, "")
+	if err != nil {
+		return nil, err
+	}
+	lggr := app.GetLogger().Named("GQLSubscription").With("channel", channel)
+
+	ch := make(chan *T)
+	go func() {
+		defer close(ch)
+		defer sub.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, open := <-sub.Events():
+				if !open {
+					return
+				}
+				res, err := resolve(event.Payload)
+				if err != nil {
+					lggr.Errorw("Failed to resolve subscription event", "err", err, "payload", event.Payload)
+					continue
+				}
+				if res == nil {
+					continue
+				}
+				// Nested fields of the event must not be loaded from the
+				// cache of the previous events
+				loader.For(ctx).ClearAll()
+				select {
+				case ch <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// JobRunCreated streams the new runs of a job, or of all jobs.
+func (r *Resolver) JobRunCreated(ctx context.Context, args struct {
+	JobID *graphql.ID
+}) (<-chan *JobRunResolver, error) {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobs); err != nil {
+		return nil, err
+	}
+
+	// Notifications identify the pipeline spec of runs, not their job
+	var pipelineSpecID int32
+	if args.JobID != nil {
+		id, err := stringutils.ToInt32(string(*args.JobID))
+		if err != nil {
+			return nil, err
+		}
+		j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+		if err != nil {
+			return nil, err
+		}
+		pipelineSpecID = j.PipelineSpecID
+	}
+
+	return subscribe(ctx, r.App, pg.ChannelInsertOnPipelineRun, func(payload string) (*JobRunResolver, error) {
+		var n pg.PipelineRunInserted
+		if err := json.Unmarshal([]byte(payload), &n); err != nil {
+			return nil, err
+		}
+		if args.JobID != nil && n.PipelineSpecID != pipelineSpecID {
+			return nil, nil
+		}
+		run, err := r.App.JobORM().FindPipelineRunByID(n.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			// deleted since
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return NewJobRun(run, r.App), nil
+	})
+}
+
+// EthTransactionStateChanged streams the transactions which are created or
+// change state, on one chain or all of them.
+func (r *Resolver) EthTransactionStateChanged(ctx context.Context, args struct {
+	EVMChainID *graphql.ID
+}) (<-chan *EthTransactionResolver, error) {
+	if err := authenticateUserCanView(ctx, sessions.ResourceTransactions); err != nil {
+		return nil, err
+	}
+
+	return subscribe(ctx, r.App, pg.ChannelEthTxStateChanged, func(payload string) (*EthTransactionResolver, error) {
+		var n pg.EthTxStateChanged
+		if err := json.Unmarshal([]byte(payload), &n); err != nil {
+			return nil, err
+		}
+		if args.EVMChainID != nil && n.EVMChainID != string(*args.EVMChainID) {
+			return nil, nil
+		}
+		etx, err := r.App.TxmORM().FindEthTxWithAttempts(n.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			// deleted since
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return NewEthTransaction(etx), nil
+	})
+}
+
+// JobProposalChanged streams the job proposals which are received or updated,
+// from one feeds manager or all of them.
+func (r *Resolver) JobProposalChanged(ctx context.Context, args struct {
+	FeedsManagerID *graphql.ID
+}) (<-chan *JobProposalResolver, error) {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobProposals); err != nil {
+		return nil, err
+	}
+
+	var mgrID int64
+	if args.FeedsManagerID != nil {
+		id, err := stringutils.ToInt64(string(*args.FeedsManagerID))
+		if err != nil {
+			return nil, err
+		}
+		mgrID = id
+	}
+
+	return subscribe(ctx, r.App, pg.ChannelJobProposalChanged, func(payload string) (*JobProposalResolver, error) {
+		var n pg.JobProposalChanged
+		if err := json.Unmarshal([]byte(payload), &n); err != nil {
+			return nil, err
+		}
+		if args.FeedsManagerID != nil && n.FeedsManagerID != mgrID {
+			return nil, nil
+		}
+		jp, err := r.App.GetFeedsService().GetJobProposal(n.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			// deleted since
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return NewJobProposal(jp), nil
+	})
+}
+
+// RPCNodeStateChanged streams the state transitions of the RPC nodes of one
+// chain, or of all chains.
+func (r *Resolver) RPCNodeStateChanged(ctx context.Context, args struct {
+	EVMChainID *graphql.ID
+}) (<-chan *RPCNodeStateChangeResolver, error) {
+	if err := authenticateUserCanView(ctx, sessions.ResourceNodes); err != nil {
+		return nil, err
+	}
+
+	return subscribe(ctx, r.App, pg.ChannelEVMNodeStateChanged, func(payload string) (*RPCNodeStateChangeResolver, error) {
+		var n pg.EVMNodeStateChanged
+		if err := json.Unmarshal([]byte(payload), &n); err != nil {
+			return nil, err
+		}
+		if args.EVMChainID != nil && n.EVMChainID != string(*args.EVMChainID) {
+			return nil, nil
+		}
+		return NewRPCNodeStateChange(n), nil
+	})
+}
+
+// RPCNodeStateChangeResolver resolves the RPCNodeStateChange type.
+type RPCNodeStateChangeResolver struct {
+	change pg.EVMNodeStateChanged
+}
+
+func NewRPCNodeStateChange(change pg.EVMNodeStateChanged) *RPCNodeStateChangeResolver {
+	return &RPCNodeStateChangeResolver{change: change}
+}
+
+// Name resolves the node's name.
+func (r *RPCNodeStateChangeResolver) Name() string {
+	return r.change.Name
+}
+
+// Chain resolves the node's chain.
+func (r *RPCNodeStateChangeResolver) Chain(ctx context.Context) (*ChainResolver, error) {
+	chain, err := loader.GetChainByID(ctx, r.change.EVMChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChain(*chain), nil
+}
+
+// PreviousState resolves the state the node left.
+func (r *RPCNodeStateChangeResolver) PreviousState() string {
+	return r.change.From
+}
+
+// State resolves the state the node entered.
+func (r *RPCNodeStateChangeResolver) State() string {
+	return r.change.To
+}
@@ -0,0 +1,119 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// setupSubscription subscribes to query, and returns a function sending
+// notifications to the subscription and one receiving its next response.
+func setupSubscription(t *testing.T, f *gqlTestFramework, query string) (notify func(payload interface{}), next func() string) {
+	t.Helper()
+
+	eb := pg.NewNullEventBroadcaster()
+	f.App.On("GetEventBroadcaster").Return(eb).Maybe()
+	f.App.On("GetLogger").Return(logger.Sugared(logger.TestLogger(t))).Maybe()
+
+	ctx, cancel := context.WithCancel(f.Ctx)
+	t.Cleanup(cancel)
+	responses, err := f.RootSchema.Subscribe(ctx, query, "", nil)
+	require.NoError(t, err)
+
+	notify = func(payload interface{}) {
+		b, err := json.Marshal(payload)
+		require.NoError(t, err)
+		select {
+		case eb.Sub.Ch <- pg.Event{Payload: string(b)}:
+		case <-ctx.Done():
+		}
+	}
+	next = func() string {
+		select {
+		case resp := <-responses:
+			b, err := json.Marshal(resp)
+			require.NoError(t, err)
+			return string(b)
+		case <-testutils.Context(t).Done():
+			t.Fatal("timed out waiting for response")
+			return ""
+		}
+	}
+	return
+}
+
+func TestResolver_JobRunCreated(t *testing.T) {
+	t.Parallel()
+
+	query := `subscription { jobRunCreated(jobID: "1") { id status } }`
+
+	t.Run("unauthorized", func(t *testing.T) {
+		f := setupFramework(t)
+		_, next := setupSubscription(t, f, query)
+
+		assert.JSONEq(t, `{"errors":[{"message":"Unauthorized"}]}`, next())
+	})
+
+	t.Run("job runs", func(t *testing.T) {
+		f := setupFramework(t)
+		f.injectAuthenticatedUser()
+		f.App.On("JobORM").Return(f.Mocks.jobORM)
+		f.Mocks.jobORM.On("FindJobWithoutSpecErrors", int32(1)).Return(job.Job{ID: 1, PipelineSpecID: 5}, nil)
+		f.Mocks.jobORM.On("FindPipelineRunByID", int64(11)).Return(pipeline.Run{ID: 11, State: pipeline.RunStatusCompleted}, nil)
+		notify, next := setupSubscription(t, f, query)
+
+		// runs of other jobs are filtered out
+		notify(pg.PipelineRunInserted{ID: 10, PipelineSpecID: 4})
+		notify(pg.PipelineRunInserted{ID: 11, PipelineSpecID: 5})
+		assert.JSONEq(t, `{"data":{"jobRunCreated":{"id":"11","status":"COMPLETED"}}}`, next())
+	})
+}
+
+func TestResolver_EthTransactionStateChanged(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+	f.App.On("TxmORM").Return(f.Mocks.txmORM)
+	f.Mocks.txmORM.On("FindEthTxWithAttempts", int64(2)).Return(txmgr.EthTx{ID: 2, State: txmgr.EthTxUnconfirmed}, nil)
+	notify, next := setupSubscription(t, f, `subscription { ethTransactionStateChanged(evmChainID: "42") { state } }`)
+
+	notify(pg.EthTxStateChanged{ID: 1, EVMChainID: "1", State: "unconfirmed"})
+	notify(pg.EthTxStateChanged{ID: 2, EVMChainID: "42", State: "unconfirmed"})
+	assert.JSONEq(t, `{"data":{"ethTransactionStateChanged":{"state":"unconfirmed"}}}`, next())
+}
+
+func TestResolver_JobProposalChanged(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+	f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+	f.Mocks.feedsSvc.On("GetJobProposal", int64(3)).Return(&feeds.JobProposal{ID: 3, Status: feeds.JobProposalStatusPending}, nil)
+	notify, next := setupSubscription(t, f, `subscription { jobProposalChanged { id status } }`)
+
+	notify(pg.JobProposalChanged{ID: 3, FeedsManagerID: 1})
+	assert.JSONEq(t, `{"data":{"jobProposalChanged":{"id":"3","status":"PENDING"}}}`, next())
+}
+
+func TestResolver_RPCNodeStateChanged(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+	notify, next := setupSubscription(t, f, `subscription { rpcNodeStateChanged { name previousState state } }`)
+
+	notify(pg.EVMNodeStateChanged{EVMChainID: "1", Name: "primary", From: "Alive", To: "Unreachable"})
+	assert.JSONEq(t, `{"data":{"rpcNodeStateChanged":{"name":"primary","previousState":"Alive","state":"Unreachable"}}}`, next())
+}
@@ -0,0 +1,115 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	clsession "github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// RolesController manages custom roles.
+type RolesController struct {
+	App chainlink.Application
+}
+
+// RoleRequest defines the request to create or update a custom role.
+type RoleRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// Index lists all custom roles
+func (rc *RolesController) Index(c *gin.Context) {
+	roles, err := rc.App.SessionORM().ListRoles()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRoleResources(roles), "roles")
+}
+
+// Create creates a new custom role
+func (rc *RolesController) Create(c *gin.Context) {
+	var request RoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.NewRole(request.Name, request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if _, err = rc.App.SessionORM().FindRole(role.Name); err == nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("role %s already exists", role.Name))
+		return
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err = rc.App.SessionORM().CreateRole(&role); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleCreated, map[string]interface{}{
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewRoleResource(role), "role", http.StatusCreated)
+}
+
+// Update replaces the permissions of a custom role
+func (rc *RolesController) Update(c *gin.Context) {
+	var request RoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	permissions, err := clsession.ValidatePermissions(request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	role, err := rc.App.SessionORM().UpdateRolePermissions(c.Param("name"), permissions)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleUpdated, map[string]interface{}{
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	})
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Delete deletes a custom role, which must not be assigned to any user
+func (rc *RolesController) Delete(c *gin.Context) {
+	name := c.Param("name")
+	err := rc.App.SessionORM().DeleteRole(name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	} else if errors.Is(err, clsession.ErrRoleAssigned) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleDeleted, map[string]interface{}{"name": name})
+	jsonAPIResponseWithStatus(c, nil, "role", http.StatusNoContent)
+}
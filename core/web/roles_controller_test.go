@@ -0,0 +1,79 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestRolesController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Post("/v2/roles", bytes.NewBufferString(`{"name": "bridge-admins", "permissions": ["widgets:edit"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Post("/v2/roles", bytes.NewBufferString(`{"name": "bridge-admins", "permissions": ["bridges:admin"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var role presenters.RoleResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &role))
+	assert.Equal(t, "bridge-admins", role.Name)
+	assert.Equal(t, []string{"bridges:admin"}, role.Permissions)
+
+	resp, cleanup = client.Post("/v2/roles", bytes.NewBufferString(`{"name": "bridge-admins", "permissions": ["bridges:admin"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = client.Patch("/v2/roles/bridge-admins", bytes.NewBufferString(`{"permissions": ["bridges:edit", "jobs:view"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Patch("/v2/roles/unknown", bytes.NewBufferString(`{"permissions": ["bridges:edit"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	// Assign the role to a new user
+	resp, cleanup = client.Post("/v2/users", bytes.NewBufferString(`{"email": "bridges@chainlink.test", "password": "`+cltest.Password+`", "role": "bridge-admins"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var user presenters.UserResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &user))
+	assert.Equal(t, "bridge-admins", user.CustomRole)
+
+	resp, cleanup = client.Get("/v2/roles")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var roles []presenters.RoleResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &roles))
+	require.Len(t, roles, 1)
+	assert.Equal(t, []string{"bridges:edit", "jobs:view"}, roles[0].Permissions)
+
+	resp, cleanup = client.Delete("/v2/roles/bridge-admins")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Delete("/v2/users/bridges@chainlink.test")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Delete("/v2/roles/bridge-admins")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/roles/bridge-admins")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
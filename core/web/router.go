@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Depado/ginprom"
@@ -45,6 +46,10 @@ import (
 func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.Engine, error) {
 	engine := gin.New()
 	config := app.GetConfig()
+	// Only the configured proxies may set the client IP, which API tokens are restricted by.
+	if err := engine.SetTrustedProxies(config.TrustedProxies()); err != nil {
+		return nil, errors.Wrap(err, "invalid WebServer.TrustedProxies")
+	}
 	secret, err := app.SecretGenerator().Generate(config.RootDir())
 	if err != nil {
 		return nil, err
@@ -57,7 +62,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	}
 
 	engine.Use(
-		limits.RequestSizeLimiter(config.DefaultHTTPLimit()),
+		requestSizeLimiter(config.DefaultHTTPLimit),
 		loggerFunc(app.GetLogger()),
 		gin.Recovery(),
 		cors,
@@ -70,10 +75,9 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 
 	api := engine.Group(
 		"/",
-		rateLimiter(
-			config.AuthenticatedRateLimitPeriod().Duration(),
-			config.AuthenticatedRateLimit(),
-		),
+		reloadableRateLimiter(func() (time.Duration, int64) {
+			return config.AuthenticatedRateLimitPeriod().Duration(), config.AuthenticatedRateLimit()
+		}),
 		sessions.Sessions(auth.SessionName, sessionStore),
 	)
 
@@ -84,17 +88,23 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 
 	guiAssetRoutes(engine, config.Dev(), app.GetLogger())
 
+	schema := graphqlSchema(app)
 	api.POST("/query",
 		auth.AuthenticateGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler")),
 		loader.Middleware(app),
-		graphqlHandler(app),
+		graphqlHandler(schema),
+	)
+	// Subscriptions are served over WebSocket
+	api.GET("/query",
+		auth.AuthenticateGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler")),
+		graphqlWSHandler(app, schema),
 	)
 
 	return engine, nil
 }
 
-// Defining the Graphql handler
-func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
+// graphqlSchema parses the GraphQL schema, which is served by the handlers
+func graphqlSchema(app chainlink.Application) *graphql.Schema {
 	rootSchema := schema.MustGetRootSchema()
 
 	// Disable introspection and set a max query depth in production.
@@ -105,13 +115,16 @@ func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
 		)
 	}
 
-	schema := graphql.MustParseSchema(rootSchema,
+	return graphql.MustParseSchema(rootSchema,
 		&resolver.Resolver{
 			App: app,
 		},
 		schemaOpts...,
 	)
+}
 
+// Defining the Graphql handler
+func graphqlHandler(schema *graphql.Schema) gin.HandlerFunc {
 	h := relay.Handler{Schema: schema}
 
 	return func(c *gin.Context) {
@@ -128,6 +141,35 @@ func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 	return mgin.NewMiddleware(limiter.New(store, rate))
 }
 
+// reloadableRateLimiter returns a rateLimiter which follows the rate returned by
+// rate, so that it can be changed by a config reload. The limiter, and the
+// counts, are reset when the rate changes.
+func reloadableRateLimiter(rate func() (period time.Duration, limit int64)) gin.HandlerFunc {
+	var mu sync.Mutex
+	var period time.Duration
+	var limit int64
+	var handler gin.HandlerFunc
+	return func(c *gin.Context) {
+		p, l := rate()
+		mu.Lock()
+		if handler == nil || p != period || l != limit {
+			period, limit = p, l
+			handler = rateLimiter(p, l)
+		}
+		h := handler
+		mu.Unlock()
+		h(c)
+	}
+}
+
+// requestSizeLimiter limits the size of request bodies to the limit returned by
+// limit, so that it can be changed by a config reload.
+func requestSizeLimiter(limit func() int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limits.RequestSizeLimiter(limit())(c)
+	}
+}
+
 type SecurityConfig interface {
 	AllowOrigins() string
 	Dev() bool
@@ -206,12 +248,16 @@ func ginHandlerFromHTTP(h http.HandlerFunc) gin.HandlerFunc {
 
 func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	config := app.GetConfig()
-	unauth := r.Group("/", rateLimiter(
-		config.UnAuthenticatedRateLimitPeriod().Duration(),
-		config.UnAuthenticatedRateLimit(),
-	))
+	unauth := r.Group("/", reloadableRateLimiter(func() (time.Duration, int64) {
+		return config.UnAuthenticatedRateLimitPeriod().Duration(), config.UnAuthenticatedRateLimit()
+	}))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
+	if config.OIDCEnabled() {
+		oc := NewOIDCController(app)
+		unauth.GET("/oidc/login", oc.Login)
+		unauth.GET("/oidc/callback", oc.Callback)
+	}
 	auth := r.Group("/", auth.Authenticate(app.SessionORM(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
 }
@@ -220,6 +266,7 @@ func healthRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	hc := HealthController{app}
 	r.GET("/readyz", hc.Readyz)
 	r.GET("/health", hc.Health)
+	r.GET("/health/components", hc.Components)
 }
 
 func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
@@ -232,16 +279,26 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	authv2 := r.Group("/v2", auth.Authenticate(app.SessionORM(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.AuthorizeView)
 	{
 		uc := UserController{app}
 		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
 		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
 		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
+		authv2.PATCH("/users/ldap", auth.RequiresAdminRole(uc.LinkLDAP))
 		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		authv2.GET("/user/tokens", uc.ListNamedAPITokens)
+		authv2.POST("/user/tokens", uc.CreateNamedAPIToken)
+		authv2.POST("/user/tokens/:name/revoke", uc.RevokeNamedAPIToken)
+
+		rlc := RolesController{app}
+		authv2.GET("/roles", auth.RequiresAdminRole(rlc.Index))
+		authv2.POST("/roles", auth.RequiresAdminRole(rlc.Create))
+		authv2.PATCH("/roles/:name", auth.RequiresAdminRole(rlc.Update))
+		authv2.DELETE("/roles/:name", auth.RequiresAdminRole(rlc.Delete))
 
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
@@ -272,6 +329,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PATCH("/config", auth.RequiresAdminRole(cc.Patch))
 		authv2.GET("/config/dump-v1-as-v2", cc.Dump)
 		authv2.GET("/config/v2", cc.Show2)
+		authv2.POST("/config/reload", auth.RequiresAdminRole(cc.Reload))
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -283,9 +341,31 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
+		alc := AuditLogController{app}
+		authv2.GET("/audit_log", auth.RequiresAdminRole(paginatedRequest(alc.Index)))
+		authv2.GET("/audit_log/verify", auth.RequiresAdminRole(alc.Verify))
+
+		dqc := DatabaseQueriesController{app}
+		authv2.GET("/database/queries", auth.RequiresAdminRole(dqc.Index))
+
+		spc := SupportController{app}
+		authv2.GET("/debug/support", auth.RequiresAdminRole(spc.Show))
+
+		rgs := EVMReorgsController{app}
+		authv2.GET("/reorgs/evm", paginatedRequest(rgs.Index))
+		authv2.GET("/reorgs/evm/:ID", rgs.Show)
+
+		lgs := EVMLogsController{app}
+		authv2.GET("/logs/evm", paginatedRequest(lgs.Index))
+
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 
+		ksc := KeystoreController{app}
+		authv2.POST("/keys/rotate_password", auth.RequiresAdminRole(ksc.RotatePassword))
+		authv2.POST("/keys/backup/export", auth.RequiresAdminRole(ksc.ExportBackup))
+		authv2.POST("/keys/backup/import", auth.RequiresAdminRole(ksc.ImportBackup))
+
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
 		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
@@ -361,6 +441,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
+		// JobShardingController
+		jsc := JobShardingController{app}
+		authv2.GET("/job_shard_members", jsc.Members)
+		authv2.PUT("/jobs/:ID/assignment", auth.RequiresEditRole(jsc.Assign))
+		authv2.DELETE("/jobs/:ID/assignment", auth.RequiresEditRole(jsc.Unassign))
+
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
@@ -433,7 +519,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.AuthorizeView)
 	userOrEI.GET("/ping", ping.Show)
 	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
 }
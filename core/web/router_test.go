@@ -10,8 +10,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	configtest "github.com/smartcontractkit/chainlink/core/internal/testutils/configtest/v2"
 	clhttptest "github.com/smartcontractkit/chainlink/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -110,6 +114,50 @@ func TestTokenAuthRequired_BadTokenCredentials(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
 
+func TestTokenAuthRequired_TrustedProxies(t *testing.T) {
+	for _, test := range []struct {
+		name           string
+		trustedProxies []string
+		expected       int
+	}{
+		// The forwarded client IP is only honoured from a trusted proxy.
+		{"spoofed", nil, http.StatusUnauthorized},
+		{"trusted proxy", []string{"127.0.0.1", "::1"}, http.StatusOK},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.WebServer.TrustedProxies = &test.trustedProxies
+			})
+			app := cltest.NewApplicationWithConfig(t, cfg)
+			require.NoError(t, app.Start(testutils.Context(t)))
+
+			apiToken, token, err := sessions.NewAPIToken(cltest.APIEmailAdmin, sessions.APITokenRequest{
+				Name:       "ci",
+				AllowedIPs: []string{"10.0.0.0/8"},
+			})
+			require.NoError(t, err)
+			require.NoError(t, app.SessionORM().CreateAPIToken(&apiToken))
+
+			router := web.Router(t, app, nil)
+			ts := httptest.NewServer(router)
+			defer ts.Close()
+
+			request, err := http.NewRequest("GET", ts.URL+"/v2/bridge_types", nil)
+			require.NoError(t, err)
+			request.Header.Set(webauth.APIKey, token.AccessKey)
+			request.Header.Set(webauth.APISecret, token.Secret)
+			request.Header.Set("X-Forwarded-For", "10.1.2.3")
+
+			resp, err := clhttptest.NewTestLocalOnlyHTTPClient().Do(request)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, test.expected, resp.StatusCode)
+		})
+	}
+}
+
 func TestSessions_RateLimited(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start(testutils.Context(t)))
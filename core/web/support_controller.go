@@ -0,0 +1,168 @@
+package web
+
+import (
+	"archive/zip"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+const (
+	// maxSupportLogBytes is how much of the end of the log file is included
+	// in support archives.
+	maxSupportLogBytes = 10 * utils.MB
+	// supportSlowestQueries is the number of statements included in support
+	// archives.
+	supportSlowestQueries = 50
+)
+
+// SupportController builds support archives, to troubleshoot the node.
+type SupportController struct {
+	App chainlink.Application
+}
+
+// supportDatabaseStats are the database stats of support archives.
+type supportDatabaseStats struct {
+	Pool           sql.DBStats                        `json:"pool"`
+	SlowestQueries []presenters.DatabaseQueryResource `json:"slowestQueries"`
+}
+
+// Show returns a zip archive of the recent logs, the effective
+// configuration, the profiles gathered by the Nurse and the database stats.
+// Parts which cannot be collected are skipped, and their errors listed in
+// errors.txt.
+// Example:
+//
+//	"<application>/debug/support"
+func (sc *SupportController) Show(c *gin.Context) {
+	cfg := sc.App.GetConfig()
+	now := time.Now().UTC()
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("support-%s.zip", now.Format("20060102T150405Z"))))
+	c.Header("Content-Type", "application/zip")
+	c.Status(http.StatusOK)
+
+	zw := zip.NewWriter(c.Writer)
+	var errs []string
+	for _, part := range []struct {
+		name  string
+		write func(*zip.Writer) error
+	}{
+		{"config", func(zw *zip.Writer) error { return writeSupportConfig(zw, cfg) }},
+		{"logs", func(zw *zip.Writer) error { return writeSupportLogs(zw, cfg.LogFileDir()) }},
+		{"profiles", func(zw *zip.Writer) error { return writeSupportProfiles(zw, cfg.AutoPprofProfileRoot()) }},
+		{"database", func(zw *zip.Writer) error { return writeSupportDatabaseStats(zw, sc.App.GetSqlxDB().Stats()) }},
+	} {
+		if err := part.write(zw); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", part.name, err))
+		}
+	}
+	if len(errs) > 0 {
+		if w, err := zw.Create("errors.txt"); err == nil {
+			_, _ = io.WriteString(w, strings.Join(errs, "\n")+"\n")
+		}
+	}
+	if err := zw.Close(); err != nil {
+		sc.App.GetLogger().Errorw("Failed to write support archive", "err", err)
+	}
+}
+
+func writeSupportConfig(zw *zip.Writer, cfg config.GeneralConfig) error {
+	cfg2, ok := cfg.(chainlink.ConfigV2)
+	if !ok {
+		return fmt.Errorf("unsupported with legacy ENV config")
+	}
+	_, effective := cfg2.ConfigTOML()
+	w, err := zw.Create("config.toml")
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, effective)
+	return err
+}
+
+// writeSupportLogs writes the end of the log file, up to maxSupportLogBytes.
+func writeSupportLogs(zw *zip.Writer, dir string) error {
+	path := logger.Config{Dir: dir}.LogsFile()
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if offset := info.Size() - int64(maxSupportLogBytes); offset > 0 {
+		if _, err = f.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+	}
+	w, err := zw.Create("logs/" + filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// writeSupportProfiles writes the profiles gathered by the Nurse.
+func writeSupportProfiles(zw *zip.Writer, root string) error {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !services.IsProfileFile(entry.Name()) {
+			continue
+		}
+		if err = copyToZip(zw, filepath.Join(root, entry.Name()), "profiles/"+entry.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeSupportDatabaseStats(zw *zip.Writer, pool sql.DBStats) error {
+	stats := supportDatabaseStats{Pool: pool}
+	for _, s := range pg.SlowestQueries(supportSlowestQueries, pg.QueryStatsSortMax) {
+		stats.SlowestQueries = append(stats.SlowestQueries, presenters.NewDatabaseQueryResource(s))
+	}
+	w, err := zw.Create("database/stats.json")
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(stats)
+}
+
+func copyToZip(zw *zip.Writer, path, name string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, f)
+	return err
+}
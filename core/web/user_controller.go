@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/logger/audit"
@@ -55,10 +57,17 @@ func (c *UserController) Create(ctx *gin.Context) {
 		return
 	}
 
+	// Users given a custom role keep the 'view' built-in role, which is not used to authorize them
+	var customRole null.String
 	userRole, err := clsession.GetUserRole(request.Role)
 	if err != nil {
-		jsonAPIError(ctx, http.StatusBadRequest, err)
-		return
+		role, rerr := c.App.SessionORM().FindRole(request.Role)
+		if rerr != nil {
+			jsonAPIError(ctx, http.StatusBadRequest, err)
+			return
+		}
+		userRole = clsession.UserRoleView
+		customRole = null.StringFrom(role.Name)
 	}
 
 	if verr := clsession.ValidateEmail(request.Email); verr != nil {
@@ -76,6 +85,7 @@ func (c *UserController) Create(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusBadRequest, errors.Errorf("error creating API user: %s", err))
 		return
 	}
+	user.CustomRole = customRole
 	if err = c.App.SessionORM().CreateUser(&user); err != nil {
 		// If this is a duplicate key error (code 23505), return a nicer error message
 		var pgErr *pgconn.PgError
@@ -89,6 +99,7 @@ func (c *UserController) Create(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("error creating API user"))
 		return
 	}
+	c.App.GetAuditLogger().Audit(audit.UserCreated, map[string]interface{}{"user": user.Email, "role": user.RoleName()})
 
 	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
 }
@@ -122,6 +133,37 @@ func (c *UserController) UpdateRole(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("error updating API user"))
 		return
 	}
+	c.App.GetAuditLogger().Audit(audit.UserRoleUpdated, map[string]interface{}{"user": user.Email, "role": user.RoleName()})
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
+// LinkLDAPRequest links a local user to the entry of the LDAP directory with
+// the DN, or unlinks them if the DN is empty.
+type LinkLDAPRequest struct {
+	Email string `json:"email"`
+	DN    string `json:"dn"`
+}
+
+// LinkLDAP links an existing API user to the LDAP directory, so that they log
+// in with their directory account. Directory users are never linked to local
+// users automatically.
+func (c *UserController) LinkLDAP(ctx *gin.Context) {
+	var request LinkLDAPRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, err := c.App.SessionORM().LinkLDAPUser(request.Email, request.DN)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, errors.Errorf("specified user not found: %s", request.Email))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("error linking API user"))
+		return
+	}
+	c.App.GetAuditLogger().Audit(audit.LDAPUserLinked, map[string]interface{}{"user": user.Email, "dn": request.DN})
 
 	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
 }
@@ -153,6 +195,7 @@ func (c *UserController) Delete(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("error deleting API user"))
 		return
 	}
+	c.App.GetAuditLogger().Audit(audit.UserDeleted, map[string]interface{}{"user": email})
 
 	jsonAPIResponse(ctx, presenters.NewUserResource(clsession.User{Email: email}), "user")
 }
@@ -262,6 +305,110 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 	}
 }
 
+// ListNamedAPITokens lists the named API tokens of the current user.
+func (c *UserController) ListNamedAPITokens(ctx *gin.Context) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	tokens, err := c.App.SessionORM().ListAPITokens(sessionUser.Email)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(ctx, presenters.NewNamedAPITokenResources(tokens), "namedAPITokens")
+}
+
+// CreateNamedAPIToken creates a named API token for the current user, which
+// may be limited to some of their permissions, to some client IPs, and expire.
+func (c *UserController) CreateNamedAPIToken(ctx *gin.Context) {
+	var request clsession.APITokenRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	user, err := c.App.SessionORM().FindUser(sessionUser.Email)
+	if err != nil {
+		c.App.GetLogger().Errorf("failed to obtain current user record: %s", err)
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("unable to create API token"))
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		c.App.GetAuditLogger().Audit(audit.NamedAPITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": user.Email, "name": request.Name})
+		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+	apiToken, token, err := clsession.NewAPIToken(user.Email, request)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if err := c.App.SessionORM().CreateAPIToken(&apiToken); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(ctx, http.StatusBadRequest, errors.Errorf("API token %s already exists", request.Name))
+			return
+		}
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.App.GetAuditLogger().Audit(audit.NamedAPITokenCreated, map[string]interface{}{
+		"user":        user.Email,
+		"name":        apiToken.Name,
+		"permissions": apiToken.Permissions,
+		"allowedIPs":  apiToken.AllowedIPs,
+		"expiresAt":   apiToken.ExpiresAt,
+	})
+	jsonAPIResponseWithStatus(ctx, presenters.NewNamedAPITokenResource(apiToken, token.Secret), "namedAPIToken", http.StatusCreated)
+}
+
+// RevokeNamedAPIToken deletes a named API token of the current user, who must
+// confirm their password like when creating it.
+func (c *UserController) RevokeNamedAPIToken(ctx *gin.Context) {
+	var request clsession.ChangeAuthTokenRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	user, err := c.App.SessionORM().FindUser(sessionUser.Email)
+	if err != nil {
+		c.App.GetLogger().Errorf("failed to obtain current user record: %s", err)
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("unable to revoke API token"))
+		return
+	}
+	name := ctx.Param("name")
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		c.App.GetAuditLogger().Audit(audit.NamedAPITokenRevokeAttemptPasswordMismatch, map[string]interface{}{"user": user.Email, "name": name})
+		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+	if err := c.App.SessionORM().RevokeAPIToken(user.Email, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(ctx, http.StatusNotFound, errors.New("API token not found"))
+			return
+		}
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.App.GetAuditLogger().Audit(audit.NamedAPITokenRevoked, map[string]interface{}{"user": user.Email, "name": name})
+	jsonAPIResponseWithStatus(ctx, nil, "namedAPIToken", http.StatusNoContent)
+}
+
 func getCurrentSessionID(ctx *gin.Context) (string, error) {
 	session := sessions.Default(ctx)
 	sessionID, ok := session.Get(webauth.SessionIDKey).(string)
@@ -17,6 +17,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 func TestUserController_UpdatePassword(t *testing.T) {
@@ -226,6 +228,34 @@ func TestUserController_UpdateRole(t *testing.T) {
 	}
 }
 
+func TestUserController_LinkLDAP(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.SessionORM().CreateUser(&user))
+
+	resp, cleanup := client.Patch("/v2/users/ldap", bytes.NewBufferString(fmt.Sprintf(`{"email": "%s", "dn": "uid=user,dc=example,dc=com"}`, user.Email)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	found, err := app.SessionORM().FindUser(user.Email)
+	require.NoError(t, err)
+	assert.Equal(t, "uid=user,dc=example,dc=com", found.LDAPDN.String)
+
+	resp, cleanup = client.Patch("/v2/users/ldap", bytes.NewBufferString(`{"email": "unknown@chainlink.test", "dn": "uid=user,dc=example,dc=com"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	// only admins may link users
+	viewClient := app.NewHTTPClient(cltest.APIEmailViewOnly)
+	resp, cleanup = viewClient.Patch("/v2/users/ldap", bytes.NewBufferString(fmt.Sprintf(`{"email": "%s", "dn": ""}`, user.Email)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+}
+
 func TestUserController_DeleteUser(t *testing.T) {
 	t.Parallel()
 
@@ -323,3 +353,81 @@ func TestUserController_DeleteAPIKey_unauthorized(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
+
+func TestUserController_NamedAPITokens(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	create := func(request sessions.APITokenRequest) (*http.Response, func()) {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		return client.Post("/v2/user/tokens", bytes.NewBuffer(body))
+	}
+
+	resp, cleanup := create(sessions.APITokenRequest{Name: "ci", Password: "wrong-password"})
+	defer cleanup()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, cleanup = create(sessions.APITokenRequest{Name: "ci", Password: cltest.Password, Permissions: []string{"widgets:view"}})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = create(sessions.APITokenRequest{Name: "ci", Password: cltest.Password, Permissions: []string{"bridges:view"}})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var created presenters.NamedAPITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, "ci", created.Name)
+	assert.NotEmpty(t, created.Secret)
+	assert.Equal(t, []string{"bridges:view"}, created.Permissions)
+
+	resp, cleanup = create(sessions.APITokenRequest{Name: "ci", Password: cltest.Password})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	// The token is limited to its permissions
+	tokenGet := func(path string) int {
+		req, err := http.NewRequest("GET", app.Server.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set(webauth.APIKey, created.AccessKey)
+		req.Header.Set(webauth.APISecret, created.Secret)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusOK, tokenGet("/v2/bridge_types"))
+	assert.Equal(t, http.StatusUnauthorized, tokenGet("/v2/jobs"))
+
+	resp, cleanup = client.Get("/v2/user/tokens")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var tokens []presenters.NamedAPITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+	require.Len(t, tokens, 1)
+	assert.Empty(t, tokens[0].Secret)
+	assert.NotNil(t, tokens[0].LastUsed)
+
+	revoke := func(password string) (*http.Response, func()) {
+		body, err := json.Marshal(sessions.ChangeAuthTokenRequest{Password: password})
+		require.NoError(t, err)
+		return client.Post("/v2/user/tokens/ci/revoke", bytes.NewBuffer(body))
+	}
+
+	resp, cleanup = revoke("wrong-password")
+	defer cleanup()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, http.StatusOK, tokenGet("/v2/bridge_types"))
+
+	resp, cleanup = revoke(cltest.Password)
+	defer cleanup()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, http.StatusUnauthorized, tokenGet("/v2/bridge_types"))
+
+	resp, cleanup = revoke(cltest.Password)
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}